@@ -0,0 +1,203 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AddressType - Whether an address is a home or business address
+type AddressType string
+
+const (
+	// ResidentialAddress - a home address
+	ResidentialAddress AddressType = "residential"
+	// CommercialAddress - a business address
+	CommercialAddress AddressType = "commercial"
+)
+
+// Address describes a postal address of a customer, an order, a shipment, a
+// checkout or an inventory location. The V2 API names some fields differently
+// than the V3 API, e.g. street_1 and zip instead of address1 and postal_code;
+// Address decodes either naming and is sent in the naming of the endpoint.
+type Address struct {
+	ID                  int64                  `json:"id,omitempty"`          // The ID of a customer or order shipping address. Required to update V3 customer addresses.
+	CheckoutID          string                 `json:"-"`                     // The ID of a checkout address, which is a string. Read-only.
+	CustomerID          int64                  `json:"customer_id,omitempty"` // The customer the address belongs to. Required to create V3 customer addresses.
+	OrderID             int64                  `json:"order_id,omitempty"`    // The order the address belongs to. Read-only.
+	FirstName           string                 `json:"first_name,omitempty"`
+	LastName            string                 `json:"last_name,omitempty"`
+	Company             string                 `json:"company,omitempty"`
+	Email               string                 `json:"email,omitempty"` // Required for order and checkout billing addresses.
+	Phone               string                 `json:"phone,omitempty"`
+	Address1            string                 `json:"address1,omitempty"` // street_1 in V2.
+	Address2            string                 `json:"address2,omitempty"` // street_2 in V2.
+	City                string                 `json:"city,omitempty"`
+	StateOrProvince     string                 `json:"state_or_province,omitempty"`      // The state's full name, e.g. California. state in V2.
+	StateOrProvinceCode string                 `json:"state_or_province_code,omitempty"` // The state's abbreviation, e.g. CA. Only sent to checkouts.
+	PostalCode          string                 `json:"postal_code,omitempty"`            // zip in V2.
+	Country             string                 `json:"country,omitempty"`                // The country's full name, e.g. United States.
+	CountryCode         string                 `json:"country_code,omitempty"`           // The country's ISO 3166-1 alpha-2 code, e.g. US. country_iso2 in V2.
+	AddressType         AddressType            `json:"address_type,omitempty"`           // Residential or commercial, for customer addresses.
+	CustomFields        []*CheckoutCustomField `json:"custom_fields,omitempty"`          // Values of the store's address form fields, for checkout addresses.
+	GeoCoordinates      *GeoCoordinates        `json:"geo_coordinates,omitempty"`        // For inventory locations.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// UnmarshalJSON decodes an address in either the V2 or the V3 naming
+func (a *Address) UnmarshalJSON(data []byte) error {
+	type address Address
+	wire := struct {
+		*address
+		ID          json.RawMessage `json:"id"`
+		Street1     string          `json:"street_1"`
+		Street2     string          `json:"street_2"`
+		State       string          `json:"state"`
+		Zip         string          `json:"zip"`
+		CountryISO2 string          `json:"country_iso2"`
+	}{address: (*address)(a)}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	switch id := strings.TrimSpace(string(wire.ID)); {
+	case id == "" || id == "null":
+	case id[0] == '"':
+		if err := json.Unmarshal(wire.ID, &a.CheckoutID); err != nil {
+			return err
+		}
+	default:
+		if err := json.Unmarshal(wire.ID, &a.ID); err != nil {
+			return err
+		}
+	}
+	a.Address1 = defaultString(a.Address1, wire.Street1)
+	a.Address2 = defaultString(a.Address2, wire.Street2)
+	a.StateOrProvince = defaultString(a.StateOrProvince, wire.State)
+	a.PostalCode = defaultString(a.PostalCode, wire.Zip)
+	a.CountryCode = defaultString(a.CountryCode, wire.CountryISO2)
+	return nil
+}
+
+// v2Address is an Address in the naming of V2 orders and customer addresses
+type v2Address Address
+
+// MarshalJSON encodes the address with V2 field names
+func (a *v2Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ID          int64       `json:"id,omitempty"`
+		FirstName   string      `json:"first_name,omitempty"`
+		LastName    string      `json:"last_name,omitempty"`
+		Company     string      `json:"company,omitempty"`
+		Street1     string      `json:"street_1,omitempty"`
+		Street2     string      `json:"street_2,omitempty"`
+		City        string      `json:"city,omitempty"`
+		State       string      `json:"state,omitempty"`
+		Zip         string      `json:"zip,omitempty"`
+		Country     string      `json:"country,omitempty"`
+		CountryISO2 string      `json:"country_iso2,omitempty"`
+		Phone       string      `json:"phone,omitempty"`
+		Email       string      `json:"email,omitempty"`
+		AddressType AddressType `json:"address_type,omitempty"`
+	}{
+		a.ID, a.FirstName, a.LastName, a.Company, a.Address1, a.Address2, a.City,
+		a.StateOrProvince, a.PostalCode, a.Country, a.CountryCode, a.Phone, a.Email, a.AddressType,
+	})
+}
+
+// AddressNormalizer tidies addresses before they are saved or compared:
+// whitespace is trimmed, the country and state are resolved to both their
+// names and codes with the store's countries, postal codes are upper-cased
+// and phone numbers are stripped of punctuation with NormalizePhone.
+// Countries and states are read from the store once and cached.
+type AddressNormalizer struct {
+	Client *Client
+
+	countryCache
+}
+
+// NewAddressNormalizer returns an AddressNormalizer reading countries through
+// client
+func NewAddressNormalizer(client *Client) *AddressNormalizer {
+	return &AddressNormalizer{Client: client}
+}
+
+// Normalize normalizes addr in place. It returns an *AddressValidationError if
+// the country, or the state of a country with states, is unknown; addr is
+// still tidied.
+func (n *AddressNormalizer) Normalize(ctx context.Context, addr *Address) error {
+	for _, s := range []*string{
+		&addr.FirstName, &addr.LastName, &addr.Company, &addr.Email, &addr.Address1, &addr.Address2, &addr.City,
+		&addr.StateOrProvince, &addr.StateOrProvinceCode, &addr.PostalCode, &addr.Country, &addr.CountryCode,
+	} {
+		*s = strings.Join(strings.Fields(*s), " ")
+	}
+	addr.PostalCode = strings.ToUpper(addr.PostalCode)
+	addr.Phone = NormalizePhone(addr.Phone)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.loadCountries(ctx, n.Client); err != nil {
+		return err
+	}
+
+	verr := &AddressValidationError{Address: addr}
+	country := n.countries[strings.ToLower(addr.CountryCode)]
+	if country == nil {
+		country = n.countries[strings.ToLower(addr.Country)]
+	}
+	if country == nil {
+		if addr.CountryCode != "" || addr.Country != "" {
+			verr.Problems = append(verr.Problems, AddressProblem{Field: "country_code", Message: fmt.Sprintf("unknown country %q", defaultString(addr.CountryCode, addr.Country))})
+		}
+		return verr.orNil()
+	}
+	addr.CountryCode, addr.Country = country.CountryISO2, country.Country
+
+	states, err := n.loadStates(ctx, n.Client, country.ID)
+	if err != nil {
+		return err
+	}
+	if len(states) == 0 || addr.StateOrProvince == "" && addr.StateOrProvinceCode == "" {
+		return verr.orNil()
+	}
+	state := findState(states, addr.StateOrProvinceCode)
+	if state == nil {
+		state = findState(states, addr.StateOrProvince)
+	}
+	if state == nil {
+		msg := fmt.Sprintf("unknown state %q for %s", defaultString(addr.StateOrProvince, addr.StateOrProvinceCode), country.Country)
+		verr.Problems = append(verr.Problems, AddressProblem{Field: "state_or_province", Message: msg})
+		return verr.orNil()
+	}
+	addr.StateOrProvince, addr.StateOrProvinceCode = state.State, state.StateAbbreviation
+	return nil
+}
+
+// NormalizePhone strips a phone number of spaces and punctuation, keeping
+// a leading + and turning a leading 00 international prefix into +, e.g.
+// "0044 (20) 7946-0018" becomes "+442079460018". Numbers with letters, such
+// as extensions, are only trimmed.
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+	var b strings.Builder
+	for i, r := range phone {
+		switch {
+		case unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || strings.ContainsRune("-.()/", r):
+		default:
+			return phone
+		}
+	}
+	normalized := b.String()
+	if strings.HasPrefix(normalized, "00") {
+		normalized = "+" + normalized[2:]
+	}
+	return normalized
+}
@@ -0,0 +1,116 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAddress_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want Address
+	}{
+		{
+			"v2", `{"id":3,"customer_id":7,"street_1":"1 Main St","street_2":"Apt 2","state":"Texas","zip":"78701","country":"United States","country_iso2":"US"}`,
+			Address{ID: 3, CustomerID: 7, Address1: "1 Main St", Address2: "Apt 2", StateOrProvince: "Texas", PostalCode: "78701", Country: "United States", CountryCode: "US"},
+		},
+		{
+			"v3", `{"id":3,"address1":"1 Main St","state_or_province":"Texas","postal_code":"78701","country_code":"US"}`,
+			Address{ID: 3, Address1: "1 Main St", StateOrProvince: "Texas", PostalCode: "78701", CountryCode: "US"},
+		},
+		{
+			"checkout", `{"id":"5d7ff2e5","address1":"1 Main St","state_or_province_code":"TX","country_code":"US"}`,
+			Address{CheckoutID: "5d7ff2e5", Address1: "1 Main St", StateOrProvinceCode: "TX", CountryCode: "US"},
+		},
+		{
+			"location", `{"address1":"1 Main St","state":"Texas","zip":"78701","country_code":"US","geo_coordinates":{"latitude":30.27,"longitude":-97.74}}`,
+			Address{Address1: "1 Main St", StateOrProvince: "Texas", PostalCode: "78701", CountryCode: "US", GeoCoordinates: &GeoCoordinates{Latitude: 30.27, Longitude: -97.74}},
+		},
+	}
+	for _, tt := range tests {
+		var got Address
+		if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: decoded %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOrder_MarshalJSON(t *testing.T) {
+	addr := &Address{FirstName: "Jane", Address1: "1 Main St", StateOrProvince: "Texas", PostalCode: "78701", CountryCode: "US", Email: "jane@example.com"}
+	data, err := json.Marshal(&Order{CustomerID: 7, BillingAddress: addr, ShippingAddresses: OrderShippingAddresses{addr}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2 := map[string]interface{}{
+		"first_name": "Jane", "street_1": "1 Main St", "state": "Texas", "zip": "78701", "country_iso2": "US", "email": "jane@example.com",
+	}
+	want := map[string]interface{}{"customer_id": 7.0, "billing_address": v2, "shipping_addresses": []interface{}{v2}}
+	var got map[string]interface{}
+	json.Unmarshal(data, &got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Marshal = %s, want %v", data, want)
+	}
+}
+
+func TestOrderShippingAddress_UnmarshalJSON(t *testing.T) {
+	var got OrderShippingAddress
+	err := json.Unmarshal([]byte(`{"id":7,"order_id":101,"street_1":"1 Main St","shipping_method":"Free Shipping","items_total":2}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := OrderShippingAddress{Address: Address{ID: 7, OrderID: 101, Address1: "1 Main St"}, ShippingMethod: "Free Shipping", ItemsTotal: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded %+v, want %+v", got, want)
+	}
+}
+
+func TestAddressNormalizer(t *testing.T) {
+	client := NewClient("abc123", "token", WithSandboxServer(newCountrySandbox(t)))
+	n := NewAddressNormalizer(client)
+
+	addr := &Address{Address1: "  1  Main St ", StateOrProvince: "texas", PostalCode: "78701 ", Country: "united states", Phone: "(512) 555-0100"}
+	if err := n.Normalize(context.Background(), addr); err != nil {
+		t.Fatalf("Normalize returned error: %v", err)
+	}
+	want := &Address{Address1: "1 Main St", StateOrProvince: "Texas", StateOrProvinceCode: "TX", PostalCode: "78701", Country: "United States", CountryCode: "US", Phone: "5125550100"}
+	if !reflect.DeepEqual(addr, want) {
+		t.Errorf("Normalize = %+v, want %+v", addr, want)
+	}
+
+	addr = &Address{PostalCode: "d02 x285", CountryCode: "ie"}
+	if err := n.Normalize(context.Background(), addr); err != nil || addr.Country != "Ireland" || addr.PostalCode != "D02 X285" {
+		t.Errorf("Normalize = %+v, %v", addr, err)
+	}
+
+	var verr *AddressValidationError
+	err := n.Normalize(context.Background(), &Address{CountryCode: "US", StateOrProvinceCode: "ON"})
+	if !errors.As(err, &verr) || len(verr.Problems) != 1 || verr.Problems[0].Field != "state_or_province" {
+		t.Errorf("Normalize of an unknown state returned %v", err)
+	}
+	err = n.Normalize(context.Background(), &Address{Country: "Narnia"})
+	if !errors.As(err, &verr) || verr.Problems[0].Field != "country_code" {
+		t.Errorf("Normalize of an unknown country returned %v", err)
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := map[string]string{
+		"(512) 555-0100":      "5125550100",
+		"+1 512.555.0100":     "+15125550100",
+		"0044 (20) 7946-0018": "+442079460018",
+		"555-0100 ext. 12":    "555-0100 ext. 12",
+		"":                    "",
+	}
+	for phone, want := range tests {
+		if got := NormalizePhone(phone); got != want {
+			t.Errorf("NormalizePhone(%q) = %q, want %q", phone, got, want)
+		}
+	}
+}
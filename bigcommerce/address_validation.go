@@ -0,0 +1,217 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// AddressValidator checks addresses before they are sent to BigCommerce.
+// Implementations may call third-party address verification services; the
+// bundled CountryValidator only checks country and state codes.
+type AddressValidator interface {
+	// ValidateAddress returns an error, usually an *AddressValidationError, if
+	// addr should not be saved. It must not modify addr.
+	ValidateAddress(ctx context.Context, addr *Address) error
+}
+
+// AddressValidatorFunc adapts a function to the AddressValidator interface
+type AddressValidatorFunc func(ctx context.Context, addr *Address) error
+
+// ValidateAddress implements AddressValidator
+func (f AddressValidatorFunc) ValidateAddress(ctx context.Context, addr *Address) error {
+	return f(ctx, addr)
+}
+
+// WithAddressValidator checks every order, customer and checkout address with
+// v before it is created, and before it is updated if the update sets its
+// country. Other partial updates, e.g. of a phone number or of the state
+// alone, cannot be checked without the rest of the address and are sent as
+// they are. Requests with invalid addresses fail without being sent.
+func WithAddressValidator(v AddressValidator) ClientOption {
+	return func(c *Client) {
+		c.addressValidator = v
+	}
+}
+
+// AddressValidationError describes why an address was rejected
+type AddressValidationError struct {
+	Address  *Address
+	Problems []AddressProblem
+}
+
+// AddressProblem describes a problem with a single field of an address
+type AddressProblem struct {
+	Field   string // The JSON name of the field, e.g. "state_or_province".
+	Message string
+}
+
+func (e *AddressValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.Field + ": " + p.Message
+	}
+	return "bigcommerce: invalid address: " + strings.Join(msgs, "; ")
+}
+
+// validateAddresses runs the client's AddressValidator, if any, over addrs
+func (c *Client) validateAddresses(ctx context.Context, addrs ...*Address) error {
+	if c.addressValidator == nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if err := c.addressValidator.ValidateAddress(ctx, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateAddressUpdates runs the client's AddressValidator over the addrs of
+// partial updates setting a country, along with the state they set if any
+func (c *Client) validateAddressUpdates(ctx context.Context, addrs ...*Address) error {
+	var changed []*Address
+	for _, addr := range addrs {
+		if addr.Country != "" || addr.CountryCode != "" {
+			changed = append(changed, addr)
+		}
+	}
+	return c.validateAddresses(ctx, changed...)
+}
+
+// orderAddresses returns the addresses of an order create or update payload
+func orderAddresses(order *Order) []*Address {
+	var addrs []*Address
+	if order.BillingAddress != nil {
+		addrs = append(addrs, order.BillingAddress)
+	}
+	return append(addrs, order.ShippingAddresses...)
+}
+
+// CountryValidator is an AddressValidator checking that an address's country
+// exists and, for countries with states, that its state does too. Countries
+// and states are read from the store once and cached.
+type CountryValidator struct {
+	Client *Client
+
+	countryCache
+}
+
+// NewCountryValidator returns a CountryValidator reading countries through client
+func NewCountryValidator(client *Client) *CountryValidator {
+	return &CountryValidator{Client: client}
+}
+
+// ValidateAddress implements AddressValidator
+func (v *CountryValidator) ValidateAddress(ctx context.Context, addr *Address) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if err := v.loadCountries(ctx, v.Client); err != nil {
+		return err
+	}
+
+	verr := &AddressValidationError{Address: addr}
+	key, field := addr.CountryCode, "country_code"
+	if key == "" {
+		key, field = addr.Country, "country"
+	}
+	country, ok := v.countries[strings.ToLower(key)]
+	switch {
+	case key == "":
+		verr.Problems = append(verr.Problems, AddressProblem{Field: "country", Message: "is required"})
+	case !ok:
+		verr.Problems = append(verr.Problems, AddressProblem{Field: field, Message: fmt.Sprintf("unknown country %q", key)})
+	default:
+		states, err := v.loadStates(ctx, v.Client, country.ID)
+		if err != nil {
+			return err
+		}
+		state := defaultString(addr.StateOrProvinceCode, addr.StateOrProvince)
+		if len(states) > 0 && findState(states, state) == nil {
+			msg := fmt.Sprintf("unknown state %q for %s", state, country.Country)
+			if state == "" {
+				msg = "is required for " + country.Country
+			}
+			verr.Problems = append(verr.Problems, AddressProblem{Field: "state_or_province", Message: msg})
+		}
+	}
+
+	return verr.orNil()
+}
+
+// orNil returns e if it has problems, nil otherwise
+func (e *AddressValidationError) orNil() error {
+	if len(e.Problems) > 0 {
+		return e
+	}
+	return nil
+}
+
+// countryCache holds the countries and states of a store, read once. Callers
+// hold mu.
+type countryCache struct {
+	mu        sync.Mutex
+	countries map[string]*Country // Keyed by lower-case name and ISO2 code.
+	states    map[int64][]*State
+}
+
+func (v *countryCache) loadCountries(ctx context.Context, client *Client) error {
+	if v.countries != nil {
+		return nil
+	}
+	countries := map[string]*Country{}
+	opts := &ListOptions{Page: 1, Limit: 250}
+	for {
+		page, _, err := client.Countries.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, c := range page {
+			countries[strings.ToLower(c.Country)] = c
+			countries[strings.ToLower(c.CountryISO2)] = c
+		}
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+	v.countries = countries
+	v.states = map[int64][]*State{}
+	return nil
+}
+
+func (v *countryCache) loadStates(ctx context.Context, client *Client, countryID int64) ([]*State, error) {
+	if states, ok := v.states[countryID]; ok {
+		return states, nil
+	}
+	var states []*State
+	opts := &ListOptions{Page: 1, Limit: 250}
+	for {
+		page, _, err := client.Countries.ListStates(ctx, countryID, opts)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, page...)
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+	v.states[countryID] = states
+	return states, nil
+}
+
+// findState returns the state named or abbreviated name, or nil
+func findState(states []*State, name string) *State {
+	if name == "" {
+		return nil
+	}
+	for _, s := range states {
+		if strings.EqualFold(s.State, name) || strings.EqualFold(s.StateAbbreviation, name) {
+			return s
+		}
+	}
+	return nil
+}
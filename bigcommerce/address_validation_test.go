@@ -0,0 +1,98 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func newCountrySandbox(t *testing.T) *bctest.Server {
+	t.Helper()
+	server := bctest.NewServer()
+	err := server.Seed("v2/countries",
+		&Country{ID: 226, Country: "United States", CountryISO2: "US", CountryISO3: "USA"},
+		&Country{ID: 99, Country: "Ireland", CountryISO2: "IE", CountryISO3: "IRL"},
+	)
+	if err == nil {
+		err = server.Seed("v2/countries/226/states", &State{ID: 57, State: "Texas", StateAbbreviation: "TX", CountryID: 226})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server
+}
+
+func TestCountryValidator(t *testing.T) {
+	client := NewClient("abc123", "token", WithSandboxServer(newCountrySandbox(t)))
+	v := NewCountryValidator(client)
+
+	tests := []struct {
+		addr     Address
+		problems []AddressProblem
+	}{
+		{Address{CountryCode: "US", StateOrProvinceCode: "TX"}, nil},
+		{Address{Country: "united states", StateOrProvince: "texas"}, nil},
+		{Address{Country: "Ireland"}, nil},
+		{Address{}, []AddressProblem{{Field: "country", Message: "is required"}}},
+		{Address{CountryCode: "XX"}, []AddressProblem{{Field: "country_code", Message: `unknown country "XX"`}}},
+		{Address{CountryCode: "US", StateOrProvince: "Ontario"}, []AddressProblem{{Field: "state_or_province", Message: `unknown state "Ontario" for United States`}}},
+		{Address{CountryCode: "US"}, []AddressProblem{{Field: "state_or_province", Message: "is required for United States"}}},
+	}
+	for _, tt := range tests {
+		err := v.ValidateAddress(context.Background(), &tt.addr)
+		var verr *AddressValidationError
+		switch {
+		case tt.problems == nil && err != nil:
+			t.Errorf("ValidateAddress(%+v) = %v, want nil", tt.addr, err)
+		case tt.problems != nil && !errors.As(err, &verr):
+			t.Errorf("ValidateAddress(%+v) = %v, want *AddressValidationError", tt.addr, err)
+		case tt.problems != nil && (len(verr.Problems) != 1 || verr.Problems[0] != tt.problems[0]):
+			t.Errorf("ValidateAddress(%+v) problems = %+v, want %+v", tt.addr, verr.Problems, tt.problems)
+		}
+	}
+}
+
+func TestWithAddressValidator(t *testing.T) {
+	server := newCountrySandbox(t)
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+	client = NewClient("abc123", "token", WithSandboxServer(server), WithAddressValidator(NewCountryValidator(client)))
+
+	order := &Order{
+		BillingAddress: &Address{FirstName: "Jane", CountryCode: "US", StateOrProvince: "TX"},
+		ShippingAddresses: OrderShippingAddresses{
+			{FirstName: "Jane", CountryCode: "US", StateOrProvince: "Narnia"},
+		},
+		Products: OrderProducts{{ProductID: 32, Quantity: 1}},
+	}
+	_, _, err := client.Orders.Create(context.Background(), order)
+	var verr *AddressValidationError
+	if !errors.As(err, &verr) || verr.Problems[0].Field != "state_or_province" {
+		t.Fatalf("Create error = %v, want an invalid state", err)
+	}
+	if n := len(server.Objects("v2/orders")); n != 0 {
+		t.Errorf("Created %d orders with an invalid address", n)
+	}
+
+	order.ShippingAddresses[0].StateOrProvince = "Texas"
+	created, _, err := client.Orders.Create(context.Background(), order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Partial updates are only checked when they set a country
+	update := &Order{BillingAddress: &Address{Phone: "5125550100"}}
+	if _, _, err := client.Orders.Update(context.Background(), created.ID, update); err != nil {
+		t.Errorf("Update of the phone returned %v", err)
+	}
+	update.BillingAddress.StateOrProvince = "Texas"
+	if _, _, err := client.Orders.Update(context.Background(), created.ID, update); err != nil {
+		t.Errorf("Update of the state alone returned %v", err)
+	}
+	update.BillingAddress.StateOrProvince = "Narnia"
+	update.BillingAddress.CountryCode = "US"
+	if _, _, err := client.Orders.Update(context.Background(), created.ID, update); !errors.As(err, &verr) {
+		t.Errorf("Update to an invalid state returned %v", err)
+	}
+}
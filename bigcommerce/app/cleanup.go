@@ -0,0 +1,278 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+// CleanupOption configures Cleanup
+type CleanupOption func(*cleaner)
+
+// WithManifest also removes the widget templates and metafield namespaces of m
+func WithManifest(m *Manifest) CleanupOption {
+	return func(c *cleaner) {
+		for _, t := range m.WidgetTemplates {
+			c.templates = append(c.templates, &WidgetTemplate{WidgetTemplate: bigcommerce.WidgetTemplate{Name: t.Name}})
+		}
+		c.namespaces = append(c.namespaces, m.Namespaces...)
+	}
+}
+
+// WithWidgetTemplates also removes the named widget templates and every widget
+// built from them
+func WithWidgetTemplates(names ...string) CleanupOption {
+	return func(c *cleaner) {
+		for _, name := range names {
+			c.templates = append(c.templates, &WidgetTemplate{WidgetTemplate: bigcommerce.WidgetTemplate{Name: name}})
+		}
+	}
+}
+
+// WithNamespaces also removes the metafields of namespaces
+func WithNamespaces(namespaces ...*Namespace) CleanupOption {
+	return func(c *cleaner) {
+		c.namespaces = append(c.namespaces, namespaces...)
+	}
+}
+
+// WithTemplateFiles also removes the custom template associations to the
+// named theme files, e.g. ones the app added to the merchant's theme
+func WithTemplateFiles(files ...string) CleanupOption {
+	return func(c *cleaner) {
+		c.files = append(c.files, files...)
+	}
+}
+
+// Removal records a resource removed by Cleanup
+type Removal struct {
+	Resource string // The kind of resource, e.g. "script".
+	Name     string // The resource's name, or a description of it.
+	ID       string // The resource's ID or UUID.
+}
+
+// CleanupReport lists the resources removed by Cleanup
+type CleanupReport struct {
+	Removed []Removal
+}
+
+// Count returns the number of removed resources of a kind
+func (r *CleanupReport) Count(resource string) int {
+	n := 0
+	for _, removal := range r.Removed {
+		if removal.Resource == resource {
+			n++
+		}
+	}
+	return n
+}
+
+// CleanupError describes the resources Cleanup could not remove
+type CleanupError struct {
+	Errors []error
+}
+
+func (e *CleanupError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "app: cleanup: " + strings.Join(msgs, "; ")
+}
+
+// Cleanup removes what a well-behaved app deletes when it is uninstalled: all
+// of its scripts and webhooks, which the API only lists for the calling app,
+// and, as configured by opts, its widget templates with their widgets,
+// the metafields of its namespaces, and its custom template associations.
+// Metafields written by other apps are kept when client.ClientID is set.
+//
+// Unlike Applier.Uninstall, Cleanup needs no record of what was installed and
+// never rolls back: it removes as much as it can, returning every failure in
+// a *CleanupError alongside the report of what was removed.
+func Cleanup(ctx context.Context, client *bigcommerce.Client, opts ...CleanupOption) (*CleanupReport, error) {
+	c := &cleaner{client: client, report: new(CleanupReport)}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.removeTemplateAssociations(ctx)
+	c.removeWidgets(ctx)
+	c.removeScripts(ctx)
+	c.removeWebhooks(ctx)
+	c.removeMetafields(ctx)
+
+	if len(c.errs) > 0 {
+		return c.report, &CleanupError{Errors: c.errs}
+	}
+	return c.report, nil
+}
+
+type cleaner struct {
+	client     *bigcommerce.Client
+	templates  []*WidgetTemplate
+	namespaces []*Namespace
+	files      []string
+
+	report *CleanupReport
+	errs   []error
+}
+
+// removed records the outcome of removing a resource
+func (c *cleaner) removed(resource, name, id string, err error) {
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("removing %s %s: %v", resource, name, err))
+		return
+	}
+	c.report.Removed = append(c.report.Removed, Removal{Resource: resource, Name: name, ID: id})
+}
+
+func (c *cleaner) failed(what string, err error) {
+	c.errs = append(c.errs, fmt.Errorf("listing %s: %v", what, err))
+}
+
+func (c *cleaner) removeTemplateAssociations(ctx context.Context) {
+	if len(c.files) == 0 {
+		return
+	}
+	var associations []*bigcommerce.CustomTemplateAssociation
+	err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+		page, resp, err := c.client.CustomTemplates.List(ctx, &bigcommerce.CustomTemplateListOptions{ListOptions: *opts})
+		for _, a := range page {
+			if contains(c.files, a.File) {
+				associations = append(associations, a)
+			}
+		}
+		return resp, err
+	})
+	if err != nil {
+		c.failed("custom template associations", err)
+		return
+	}
+
+	for start := 0; start < len(associations); start += 250 {
+		end := start + 250
+		if end > len(associations) {
+			end = len(associations)
+		}
+		batch := associations[start:end]
+		ids := make([]int64, len(batch))
+		for i, a := range batch {
+			ids[i] = a.ID
+		}
+		_, err := c.client.CustomTemplates.Delete(ctx, ids)
+		for _, a := range batch {
+			c.removed("custom template association", fmt.Sprintf("%s of %s %d", a.File, a.EntityType, a.EntityID), strconv.FormatInt(a.ID, 10), err)
+		}
+	}
+}
+
+func (c *cleaner) removeWidgets(ctx context.Context) {
+	if len(c.templates) == 0 {
+		return
+	}
+	cur, err := (&Applier{Client: c.client}).load(ctx, &Manifest{WidgetTemplates: c.templates})
+	if err != nil {
+		c.failed("widgets", err)
+		return
+	}
+
+	for _, t := range c.templates {
+		template := cur.templates[t.Name]
+		if template == nil {
+			continue
+		}
+		clean := true
+		for _, w := range cur.widgets {
+			if w.WidgetTemplateUUID != template.UUID {
+				continue
+			}
+			for _, p := range cur.placements {
+				if p.WidgetUUID == w.UUID {
+					_, err := c.client.Widgets.DeletePlacement(ctx, p.UUID)
+					c.removed("placement", fmt.Sprintf("of widget %s on %s/%s", w.Name, p.TemplateFile, p.Region), p.UUID, err)
+				}
+			}
+			_, err := c.client.Widgets.Delete(ctx, w.UUID)
+			c.removed("widget", w.Name, w.UUID, err)
+			clean = clean && err == nil
+		}
+		if !clean {
+			// The template cannot be removed while widgets are built from it.
+			continue
+		}
+		_, err := c.client.Widgets.DeleteTemplate(ctx, template.UUID)
+		c.removed("widget template", template.Name, template.UUID, err)
+	}
+}
+
+func (c *cleaner) removeScripts(ctx context.Context) {
+	var scripts []*bigcommerce.Script
+	err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+		page, resp, err := c.client.Scripts.List(ctx, opts)
+		scripts = append(scripts, page...)
+		return resp, err
+	})
+	if err != nil {
+		c.failed("scripts", err)
+		return
+	}
+	for _, s := range scripts {
+		_, err := c.client.Scripts.Delete(ctx, s.UUID)
+		c.removed("script", s.Name, s.UUID, err)
+	}
+}
+
+func (c *cleaner) removeWebhooks(ctx context.Context) {
+	var hooks []*bigcommerce.Webhook
+	err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+		page, resp, err := c.client.Webhooks.List(ctx, &bigcommerce.WebhookListOptions{ListOptions: *opts})
+		hooks = append(hooks, page...)
+		return resp, err
+	})
+	if err != nil {
+		c.failed("webhooks", err)
+		return
+	}
+	for _, h := range hooks {
+		_, err := c.client.Webhooks.Delete(ctx, h.ID)
+		c.removed("webhook", webhookKey(h), strconv.FormatInt(h.ID, 10), err)
+	}
+}
+
+func (c *cleaner) removeMetafields(ctx context.Context) {
+	batches, err := (&Applier{Client: c.client}).metafields(ctx, &Manifest{Namespaces: c.namespaces})
+	if err != nil {
+		c.failed("metafields", err)
+		return
+	}
+	for _, batch := range batches {
+		var ids []int64
+		var owned []*bigcommerce.Metafield
+		for _, m := range batch.metafields {
+			if c.client.ClientID != "" && m.OwnerClientID != "" && m.OwnerClientID != c.client.ClientID {
+				continue
+			}
+			ids = append(ids, m.ID)
+			owned = append(owned, m)
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		_, err := c.client.Metafields.DeleteBatch(ctx, batch.resource, ids)
+		for _, m := range owned {
+			c.removed("metafield", fmt.Sprintf("%s.%s of %s %d", m.Namespace, m.Key, batch.resource, m.ResourceID), strconv.FormatInt(m.ID, 10), err)
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
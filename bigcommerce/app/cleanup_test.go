@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestCleanup(t *testing.T) {
+	sandbox := bctest.NewServer()
+	client := newTestClient(t, sandbox, nil)
+	m := readTestManifest(t)
+	if err := (&Applier{Client: client}).Install(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	sandbox.Seed("v3/hooks", &bigcommerce.Webhook{Scope: "store/cart/created", Destination: "https://reviews.test/legacy"})
+	sandbox.Seed("v3/content/widget-templates", &bigcommerce.WidgetTemplate{Name: "Theme Banner"})
+	sandbox.Seed("v3/catalog/products/metafields",
+		&bigcommerce.Metafield{Namespace: "reviews", Key: "rating", Value: "4.5", ResourceID: 1, OwnerClientID: "reviews-app"},
+		&bigcommerce.Metafield{Namespace: "reviews", Key: "rating", Value: "3", ResourceID: 1, OwnerClientID: "other-app"},
+	)
+	sandbox.Seed("v3/storefront/custom-template-associations",
+		&bigcommerce.CustomTemplateAssociation{ChannelID: 1, EntityType: bigcommerce.ProductTemplate, EntityID: 1, File: "custom-reviews.html"},
+		&bigcommerce.CustomTemplateAssociation{ChannelID: 1, EntityType: bigcommerce.ProductTemplate, EntityID: 2, File: "custom-sale.html"},
+	)
+	client.ClientID = "reviews-app"
+
+	report, err := Cleanup(context.Background(), client, WithManifest(m), WithTemplateFiles("custom-reviews.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{
+		"custom template association": 1, "placement": 1, "widget": 1, "widget template": 1, "script": 1, "webhook": 3, "metafield": 1,
+	}
+	for resource, n := range want {
+		if got := report.Count(resource); got != n {
+			t.Errorf("Removed %d of %s, want %d", got, resource, n)
+		}
+	}
+	if templates := sandbox.Objects("v3/content/widget-templates"); len(templates) != 1 || templates[0]["name"] != "Theme Banner" {
+		t.Errorf("Widget templates = %v, want only the theme's", templates)
+	}
+	if metafields := sandbox.Objects("v3/catalog/products/metafields"); len(metafields) != 1 || metafields[0]["owner_client_id"] != "other-app" {
+		t.Errorf("Metafields = %v, want only the other app's", metafields)
+	}
+	if associations := sandbox.Objects("v3/storefront/custom-template-associations"); len(associations) != 1 || associations[0]["file"] != "custom-sale.html" {
+		t.Errorf("Associations = %v, want only the other file's", associations)
+	}
+}
+
+func TestCleanup_partial(t *testing.T) {
+	sandbox := bctest.NewServer()
+	sandbox.Seed("v3/content/scripts", &bigcommerce.Script{Name: "A"}, &bigcommerce.Script{Name: "B"})
+	sandbox.Seed("v3/hooks", &bigcommerce.Webhook{Scope: "store/order/created", Destination: "https://reviews.test/hooks"})
+	uuid := sandbox.Objects("v3/content/scripts")[0]["uuid"].(string)
+	client := newTestClient(t, sandbox, map[string]http.HandlerFunc{"DELETE /v3/content/scripts/" + uuid: failWith(500)})
+
+	report, err := Cleanup(context.Background(), client)
+	var cerr *CleanupError
+	if !errors.As(err, &cerr) || len(cerr.Errors) != 1 {
+		t.Fatalf("Cleanup = %v, want a CleanupError with one failure", err)
+	}
+	if report.Count("script") != 1 || report.Count("webhook") != 1 {
+		t.Errorf("Removed = %+v, want the other script and the webhook", report.Removed)
+	}
+}
@@ -0,0 +1,504 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/journal"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/jsondiff"
+)
+
+// Applier installs and uninstalls the resources of a Manifest. Call Install
+// from the app's auth callback, and again after upgrading the manifest, and
+// Uninstall while the app's token is still valid, e.g. when a merchant
+// disconnects the app from its own settings page.
+type Applier struct {
+	Client *bigcommerce.Client
+}
+
+// ApplyError describes a failed install or uninstall
+type ApplyError struct {
+	Op             string  // "install" or "uninstall".
+	Step           string  // The change that failed, e.g. "create script Analytics".
+	Err            error   // Why it failed.
+	RollbackErrors []error // Changes that could not be undone, leaving the app partially installed.
+}
+
+func (e *ApplyError) Error() string {
+	msg := fmt.Sprintf("app: %s: %s: %v", e.Op, e.Step, e.Err)
+	if len(e.RollbackErrors) > 0 {
+		msg += fmt.Sprintf(" (rollback failed: %v)", e.RollbackErrors)
+	}
+	return msg
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+// Install creates the manifest's resources missing from the store and updates
+// those that differ from it, so installing again after an upgrade only
+// changes what the upgrade changed. Either every resource is installed or, if
+// a change fails, the changes already made are undone.
+func (a *Applier) Install(ctx context.Context, m *Manifest) error {
+	cur, err := a.load(ctx, m)
+	if err != nil {
+		return &ApplyError{Op: "install", Step: "read installed resources", Err: err}
+	}
+
+	j := new(journal.Journal)
+	fail := func(step string, err error) error {
+		return &ApplyError{Op: "install", Step: step, Err: err, RollbackErrors: j.Rollback()}
+	}
+
+	for _, s := range m.Scripts {
+		old := cur.scripts[s.Name]
+		switch {
+		case old == nil:
+			step := "create script " + s.Name
+			created, _, err := a.Client.Scripts.Create(ctx, s)
+			if err != nil {
+				return fail(step, err)
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, err := a.Client.Scripts.Delete(ctx, created.UUID)
+				return err
+			})
+		case jsondiff.Differs(s, old):
+			step := "update script " + s.Name
+			if _, _, err := a.Client.Scripts.Update(ctx, old.UUID, s); err != nil {
+				return fail(step, err)
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, _, err := a.Client.Scripts.Update(ctx, old.UUID, old)
+				return err
+			})
+		}
+	}
+
+	for _, h := range m.Webhooks {
+		old := cur.webhooks[webhookKey(h)]
+		switch {
+		case old == nil:
+			step := "create webhook " + webhookKey(h)
+			created, _, err := a.Client.Webhooks.Create(ctx, h)
+			if err != nil {
+				return fail(step, err)
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, err := a.Client.Webhooks.Delete(ctx, created.ID)
+				return err
+			})
+		case jsondiff.Differs(h, old):
+			step := "update webhook " + webhookKey(h)
+			if _, _, err := a.Client.Webhooks.Update(ctx, old.ID, h); err != nil {
+				return fail(step, err)
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, _, err := a.Client.Webhooks.Update(ctx, old.ID, old)
+				return err
+			})
+		}
+	}
+
+	for _, t := range m.WidgetTemplates {
+		uuid, step, err := a.installTemplate(ctx, j, cur, t)
+		if err != nil {
+			return fail(step, err)
+		}
+		for _, w := range t.Widgets {
+			if step, err := a.installWidget(ctx, j, cur, uuid, w); err != nil {
+				return fail(step, err)
+			}
+		}
+	}
+	return nil
+}
+
+// installTemplate creates or updates a widget template, returning its UUID
+func (a *Applier) installTemplate(ctx context.Context, j *journal.Journal, cur *installed, t *WidgetTemplate) (string, string, error) {
+	old := cur.templates[t.Name]
+	switch {
+	case old == nil:
+		step := "create widget template " + t.Name
+		created, _, err := a.Client.Widgets.CreateTemplate(ctx, &t.WidgetTemplate)
+		if err != nil {
+			return "", step, err
+		}
+		j.Done(step, func(ctx context.Context) error {
+			_, err := a.Client.Widgets.DeleteTemplate(ctx, created.UUID)
+			return err
+		})
+		return created.UUID, "", nil
+	case jsondiff.Differs(&t.WidgetTemplate, old):
+		step := "update widget template " + t.Name
+		if _, _, err := a.Client.Widgets.UpdateTemplate(ctx, old.UUID, &t.WidgetTemplate); err != nil {
+			return "", step, err
+		}
+		j.Done(step, func(ctx context.Context) error {
+			_, _, err := a.Client.Widgets.UpdateTemplate(ctx, old.UUID, old)
+			return err
+		})
+	}
+	return old.UUID, "", nil
+}
+
+// installWidget creates or updates a widget of a template and its placements
+func (a *Applier) installWidget(ctx context.Context, j *journal.Journal, cur *installed, templateUUID string, w *Widget) (string, error) {
+	desired := w.Widget
+	desired.WidgetTemplateUUID = templateUUID
+
+	var old *bigcommerce.Widget
+	for _, existing := range cur.widgets {
+		if existing.WidgetTemplateUUID == templateUUID && existing.Name == w.Name {
+			old = existing
+		}
+	}
+
+	uuid := ""
+	switch {
+	case old == nil:
+		step := "create widget " + w.Name
+		created, _, err := a.Client.Widgets.Create(ctx, &desired)
+		if err != nil {
+			return step, err
+		}
+		j.Done(step, func(ctx context.Context) error {
+			_, err := a.Client.Widgets.Delete(ctx, created.UUID)
+			return err
+		})
+		uuid = created.UUID
+	case jsondiff.Differs(&desired, old):
+		step := "update widget " + w.Name
+		if _, _, err := a.Client.Widgets.Update(ctx, old.UUID, &desired); err != nil {
+			return step, err
+		}
+		j.Done(step, func(ctx context.Context) error {
+			_, _, err := a.Client.Widgets.Update(ctx, old.UUID, old)
+			return err
+		})
+		uuid = old.UUID
+	default:
+		uuid = old.UUID
+	}
+
+	for _, p := range w.Placements {
+		placement := *p
+		placement.WidgetUUID = uuid
+		step := fmt.Sprintf("place widget %s on %s/%s", w.Name, p.TemplateFile, p.Region)
+
+		var old *bigcommerce.Placement
+		for _, existing := range cur.placements {
+			if existing.WidgetUUID == uuid && existing.TemplateFile == p.TemplateFile && existing.Region == p.Region && existing.EntityID == p.EntityID {
+				old = existing
+			}
+		}
+		switch {
+		case old == nil:
+			created, _, err := a.Client.Widgets.CreatePlacement(ctx, &placement)
+			if err != nil {
+				return step, err
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, err := a.Client.Widgets.DeletePlacement(ctx, created.UUID)
+				return err
+			})
+		case jsondiff.Differs(&placement, old):
+			if _, _, err := a.Client.Widgets.UpdatePlacement(ctx, old.UUID, &placement); err != nil {
+				return step, err
+			}
+			j.Done(step, func(ctx context.Context) error {
+				_, _, err := a.Client.Widgets.UpdatePlacement(ctx, old.UUID, old)
+				return err
+			})
+		}
+	}
+	return "", nil
+}
+
+// Uninstall deletes the manifest's resources from the store: its scripts and
+// webhooks, its widget templates with every widget built from them, including
+// widgets merchants added in Page Builder, and the metafields of its
+// namespaces. Resources already missing are skipped. If a deletion fails, the
+// resources already deleted are recreated; recreated resources get new IDs.
+func (a *Applier) Uninstall(ctx context.Context, m *Manifest) error {
+	cur, err := a.load(ctx, m)
+	if err != nil {
+		return &ApplyError{Op: "uninstall", Step: "read installed resources", Err: err}
+	}
+	metafields, err := a.metafields(ctx, m)
+	if err != nil {
+		return &ApplyError{Op: "uninstall", Step: "read metafields", Err: err}
+	}
+
+	j := new(journal.Journal)
+	fail := func(step string, err error) error {
+		return &ApplyError{Op: "uninstall", Step: step, Err: err, RollbackErrors: j.Rollback()}
+	}
+
+	// Recreated templates and widgets get new UUIDs, which the widgets and
+	// placements recreated after them must refer to.
+	renamed := map[string]string{}
+	rename := func(uuid string) string {
+		if r, ok := renamed[uuid]; ok {
+			return r
+		}
+		return uuid
+	}
+
+	for _, t := range m.WidgetTemplates {
+		old := cur.templates[t.Name]
+		if old == nil {
+			continue
+		}
+		for _, w := range cur.widgets {
+			w := w
+			if w.WidgetTemplateUUID != old.UUID {
+				continue
+			}
+			for _, p := range cur.placements {
+				p := p
+				if p.WidgetUUID != w.UUID {
+					continue
+				}
+				step := fmt.Sprintf("delete placement of widget %s on %s/%s", w.Name, p.TemplateFile, p.Region)
+				if _, err := a.Client.Widgets.DeletePlacement(ctx, p.UUID); err != nil {
+					return fail(step, err)
+				}
+				j.Done(step, func(ctx context.Context) error {
+					restored := *p
+					restored.UUID = ""
+					restored.WidgetUUID = rename(p.WidgetUUID)
+					_, _, err := a.Client.Widgets.CreatePlacement(ctx, &restored)
+					return err
+				})
+			}
+
+			step := "delete widget " + w.Name
+			if _, err := a.Client.Widgets.Delete(ctx, w.UUID); err != nil {
+				return fail(step, err)
+			}
+			j.Done(step, func(ctx context.Context) error {
+				restored := *w
+				restored.UUID = ""
+				restored.WidgetTemplateUUID = rename(w.WidgetTemplateUUID)
+				created, _, err := a.Client.Widgets.Create(ctx, &restored)
+				if err == nil {
+					renamed[w.UUID] = created.UUID
+				}
+				return err
+			})
+		}
+
+		step := "delete widget template " + t.Name
+		if _, err := a.Client.Widgets.DeleteTemplate(ctx, old.UUID); err != nil {
+			return fail(step, err)
+		}
+		j.Done(step, func(ctx context.Context) error {
+			restored := *old
+			restored.UUID = ""
+			created, _, err := a.Client.Widgets.CreateTemplate(ctx, &restored)
+			if err == nil {
+				renamed[old.UUID] = created.UUID
+			}
+			return err
+		})
+	}
+
+	for _, h := range m.Webhooks {
+		old := cur.webhooks[webhookKey(h)]
+		if old == nil {
+			continue
+		}
+		step := "delete webhook " + webhookKey(h)
+		if _, err := a.Client.Webhooks.Delete(ctx, old.ID); err != nil {
+			return fail(step, err)
+		}
+		j.Done(step, func(ctx context.Context) error {
+			restored := *old
+			restored.ID = 0
+			_, _, err := a.Client.Webhooks.Create(ctx, &restored)
+			return err
+		})
+	}
+
+	for _, s := range m.Scripts {
+		old := cur.scripts[s.Name]
+		if old == nil {
+			continue
+		}
+		step := "delete script " + s.Name
+		if _, err := a.Client.Scripts.Delete(ctx, old.UUID); err != nil {
+			return fail(step, err)
+		}
+		j.Done(step, func(ctx context.Context) error {
+			restored := *old
+			restored.UUID = ""
+			_, _, err := a.Client.Scripts.Create(ctx, &restored)
+			return err
+		})
+	}
+
+	for _, batch := range metafields {
+		batch := batch
+		step := fmt.Sprintf("delete %d metafields of %s", len(batch.metafields), batch.resource)
+		ids := make([]int64, len(batch.metafields))
+		for i, mf := range batch.metafields {
+			ids[i] = mf.ID
+		}
+		if _, err := a.Client.Metafields.DeleteBatch(ctx, batch.resource, ids); err != nil {
+			return fail(step, err)
+		}
+		j.Done(step, func(ctx context.Context) error {
+			restored := make([]*bigcommerce.Metafield, len(batch.metafields))
+			for i, mf := range batch.metafields {
+				r := *mf
+				r.ID = 0
+				restored[i] = &r
+			}
+			_, _, err := a.Client.Metafields.CreateBatch(ctx, batch.resource, restored)
+			return err
+		})
+	}
+	return nil
+}
+
+// installed holds the store's resources of the kinds a manifest declares
+type installed struct {
+	scripts    map[string]*bigcommerce.Script         // Keyed by name.
+	webhooks   map[string]*bigcommerce.Webhook        // Keyed by webhookKey.
+	templates  map[string]*bigcommerce.WidgetTemplate // Keyed by name.
+	widgets    []*bigcommerce.Widget                  // Widgets of the manifest's templates.
+	placements []*bigcommerce.Placement               // Placements of those widgets.
+}
+
+// load reads the store's resources of the kinds m declares
+func (a *Applier) load(ctx context.Context, m *Manifest) (*installed, error) {
+	cur := &installed{
+		scripts:   map[string]*bigcommerce.Script{},
+		webhooks:  map[string]*bigcommerce.Webhook{},
+		templates: map[string]*bigcommerce.WidgetTemplate{},
+	}
+
+	if len(m.Scripts) > 0 {
+		err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+			page, resp, err := a.Client.Scripts.List(ctx, opts)
+			for _, s := range page {
+				cur.scripts[s.Name] = s
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(m.Webhooks) > 0 {
+		err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+			page, resp, err := a.Client.Webhooks.List(ctx, &bigcommerce.WebhookListOptions{ListOptions: *opts})
+			for _, h := range page {
+				cur.webhooks[webhookKey(h)] = h
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(m.WidgetTemplates) == 0 {
+		return cur, nil
+	}
+	err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+		page, resp, err := a.Client.Widgets.ListTemplates(ctx, opts)
+		for _, t := range page {
+			cur.templates[t.Name] = t
+		}
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	widgets := map[string]bool{}
+	for _, t := range m.WidgetTemplates {
+		template := cur.templates[t.Name]
+		if template == nil {
+			continue
+		}
+		err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+			page, resp, err := a.Client.Widgets.List(ctx, &bigcommerce.WidgetListOptions{ListOptions: *opts, WidgetTemplateUUID: template.UUID})
+			for _, w := range page {
+				cur.widgets = append(cur.widgets, w)
+				widgets[w.UUID] = true
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(widgets) == 0 {
+		return cur, nil
+	}
+	err = pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+		page, resp, err := a.Client.Widgets.ListPlacements(ctx, &bigcommerce.PlacementListOptions{ListOptions: *opts})
+		for _, p := range page {
+			if widgets[p.WidgetUUID] {
+				cur.placements = append(cur.placements, p)
+			}
+		}
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+// metafieldBatch is a batch of metafields of a resource type
+type metafieldBatch struct {
+	resource   bigcommerce.MetafieldResource
+	metafields []*bigcommerce.Metafield
+}
+
+// metafields reads the metafields of the manifest's namespaces, in batches
+// the batch endpoints accept
+func (a *Applier) metafields(ctx context.Context, m *Manifest) ([]metafieldBatch, error) {
+	var batches []metafieldBatch
+	for _, ns := range m.Namespaces {
+		for _, resource := range ns.Resources {
+			err := pages(func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error) {
+				page, resp, err := a.Client.Metafields.ListAll(ctx, resource, &bigcommerce.MetafieldListOptions{ListOptions: *opts, Namespace: ns.Name})
+				if len(page) > 0 {
+					batches = append(batches, metafieldBatch{resource: resource, metafields: page})
+				}
+				return resp, err
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return batches, nil
+}
+
+// pages calls list with successive pages until the last one
+func pages(list func(opts *bigcommerce.ListOptions) (*bigcommerce.Response, error)) error {
+	opts := &bigcommerce.ListOptions{Page: 1, Limit: 250}
+	for {
+		resp, err := list(opts)
+		if err != nil {
+			return err
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+func webhookKey(h *bigcommerce.Webhook) string {
+	return h.Scope + " " + h.Destination
+}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+const testManifest = `{
+	"scripts": [{"name": "Reviews", "src": "https://cdn.reviews.test/widget.js", "kind": "src", "location": "footer", "visibility": "storefront", "auto_uninstall": true, "enabled": true}],
+	"webhooks": [
+		{"scope": "store/order/created", "destination": "https://reviews.test/hooks", "is_active": true},
+		{"scope": "store/app/uninstalled", "destination": "https://reviews.test/hooks", "is_active": true}
+	],
+	"widget_templates": [{
+		"name": "Review Carousel", "template": "<div class=\"reviews\">{{title}}</div>",
+		"widgets": [{
+			"name": "Home Reviews", "widget_configuration": {"title": "What customers say"},
+			"placements": [{"template_file": "pages/home", "region": "home_below_featured_products", "sort_order": 1, "status": "active"}]
+		}]
+	}],
+	"metafield_namespaces": [{"name": "reviews", "resources": ["v3/catalog/products"]}]
+}`
+
+func readTestManifest(t *testing.T) *Manifest {
+	m, err := ReadManifest(strings.NewReader(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// newTestClient returns a client of sandbox, with handler overrides keyed by
+// method and path, e.g. "POST /v3/hooks"
+func newTestClient(t *testing.T, sandbox *bctest.Server, overrides map[string]http.HandlerFunc) *bigcommerce.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h, ok := overrides[r.Method+" "+strings.TrimPrefix(r.URL.Path, "/stores/abc123")]; ok {
+			h(w, r)
+			return
+		}
+		sandbox.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/stores/abc123/")
+	return bigcommerce.NewClient("abc123", "token", bigcommerce.WithBaseURL(baseURL))
+}
+
+func failWith(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"status":500,"title":"Internal Server Error"}`)
+	}
+}
+
+func TestApplier_Install(t *testing.T) {
+	sandbox := bctest.NewServer()
+	client := newTestClient(t, sandbox, nil)
+	applier := &Applier{Client: client}
+	m := readTestManifest(t)
+
+	if err := applier.Install(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int{"v3/content/scripts": 1, "v3/hooks": 2, "v3/content/widget-templates": 1, "v3/content/widgets": 1, "v3/content/placements": 1}
+	for path, want := range counts {
+		if got := len(sandbox.Objects(path)); got != want {
+			t.Errorf("%d objects in %s, want %d", got, path, want)
+		}
+	}
+	widget := sandbox.Objects("v3/content/widgets")[0]
+	if widget["widget_template_uuid"] != sandbox.Objects("v3/content/widget-templates")[0]["uuid"] {
+		t.Errorf("Widget %v not built from the installed template", widget)
+	}
+	if placement := sandbox.Objects("v3/content/placements")[0]; placement["widget_uuid"] != widget["uuid"] {
+		t.Errorf("Placement %v does not place the installed widget", placement)
+	}
+
+	// Installing again after an upgrade only changes what the upgrade changed.
+	m.Scripts[0].Src = "https://cdn.reviews.test/widget.v2.js"
+	if err := applier.Install(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range counts {
+		if got := len(sandbox.Objects(path)); got != want {
+			t.Errorf("%d objects in %s after reinstalling, want %d", got, path, want)
+		}
+	}
+	if src := sandbox.Objects("v3/content/scripts")[0]["src"]; src != m.Scripts[0].Src {
+		t.Errorf("Script src = %v after upgrade, want %s", src, m.Scripts[0].Src)
+	}
+}
+
+func TestApplier_InstallRollback(t *testing.T) {
+	sandbox := bctest.NewServer()
+	client := newTestClient(t, sandbox, map[string]http.HandlerFunc{"POST /v3/content/widgets": failWith(500)})
+	sandbox.Seed("v3/hooks", &bigcommerce.Webhook{Scope: "store/order/created", Destination: "https://reviews.test/hooks", IsActive: false})
+	applier := &Applier{Client: client}
+
+	err := applier.Install(context.Background(), readTestManifest(t))
+	var aerr *ApplyError
+	if !errors.As(err, &aerr) || aerr.Step != "create widget Home Reviews" || len(aerr.RollbackErrors) != 0 {
+		t.Fatalf("Install = %v, want an ApplyError creating the widget", err)
+	}
+	for _, path := range []string{"v3/content/scripts", "v3/content/widget-templates", "v3/content/widgets"} {
+		if objects := sandbox.Objects(path); len(objects) != 0 {
+			t.Errorf("%s = %v after rollback, want none", path, objects)
+		}
+	}
+	if hooks := sandbox.Objects("v3/hooks"); len(hooks) != 1 || hooks[0]["is_active"] != false {
+		t.Errorf("Webhooks = %v after rollback, want the original inactive one", hooks)
+	}
+}
+
+func TestApplier_Uninstall(t *testing.T) {
+	sandbox := bctest.NewServer()
+	client := newTestClient(t, sandbox, nil)
+	applier := &Applier{Client: client}
+	m := readTestManifest(t)
+	if err := applier.Install(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	// A merchant built a second widget from the app's template in Page Builder.
+	template := sandbox.Objects("v3/content/widget-templates")[0]
+	sandbox.Seed("v3/content/widgets", &bigcommerce.Widget{Name: "Sale Reviews", WidgetTemplateUUID: template["uuid"].(string)})
+	sandbox.Seed("v3/content/scripts", &bigcommerce.Script{Name: "Other App"})
+	sandbox.Seed("v3/catalog/products/metafields",
+		&bigcommerce.Metafield{Namespace: "reviews", Key: "rating", Value: "4.5", ResourceID: 1},
+		&bigcommerce.Metafield{Namespace: "shipping", Key: "box", Value: "small", ResourceID: 1},
+	)
+
+	if err := applier.Uninstall(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"v3/hooks", "v3/content/widget-templates", "v3/content/widgets", "v3/content/placements"} {
+		if objects := sandbox.Objects(path); len(objects) != 0 {
+			t.Errorf("%s = %v after uninstalling, want none", path, objects)
+		}
+	}
+	if scripts := sandbox.Objects("v3/content/scripts"); len(scripts) != 1 || scripts[0]["name"] != "Other App" {
+		t.Errorf("Scripts = %v after uninstalling, want only the other app's", scripts)
+	}
+	if metafields := sandbox.Objects("v3/catalog/products/metafields"); len(metafields) != 1 || metafields[0]["namespace"] != "shipping" {
+		t.Errorf("Metafields = %v after uninstalling, want only the other namespace's", metafields)
+	}
+}
+
+func TestApplier_UninstallRollback(t *testing.T) {
+	sandbox := bctest.NewServer()
+	client := newTestClient(t, sandbox, nil)
+	applier := &Applier{Client: client}
+	m := readTestManifest(t)
+	if err := applier.Install(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	scriptUUID := sandbox.Objects("v3/content/scripts")[0]["uuid"].(string)
+	applier.Client = newTestClient(t, sandbox, map[string]http.HandlerFunc{"DELETE /v3/content/scripts/" + scriptUUID: failWith(500)})
+
+	err := applier.Uninstall(context.Background(), m)
+	var aerr *ApplyError
+	if !errors.As(err, &aerr) || aerr.Step != "delete script Reviews" || len(aerr.RollbackErrors) != 0 {
+		t.Fatalf("Uninstall = %v, want an ApplyError deleting the script", err)
+	}
+	counts := map[string]int{"v3/content/scripts": 1, "v3/hooks": 2, "v3/content/widget-templates": 1, "v3/content/widgets": 1, "v3/content/placements": 1}
+	for path, want := range counts {
+		if got := len(sandbox.Objects(path)); got != want {
+			t.Errorf("%d objects in %s after rollback, want %d", got, path, want)
+		}
+	}
+	widget := sandbox.Objects("v3/content/widgets")[0]
+	if widget["widget_template_uuid"] != sandbox.Objects("v3/content/widget-templates")[0]["uuid"] {
+		t.Errorf("Restored widget %v not built from the restored template", widget)
+	}
+	if placement := sandbox.Objects("v3/content/placements")[0]; placement["widget_uuid"] != widget["uuid"] {
+		t.Errorf("Restored placement %v does not place the restored widget", placement)
+	}
+}
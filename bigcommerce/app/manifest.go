@@ -0,0 +1,53 @@
+// Package app manages the store resources a BigCommerce app needs: its
+// storefront scripts, webhooks, widgets and metafield namespaces. The resources
+// are described by a Manifest and installed or uninstalled as a whole from the
+// app's lifecycle callbacks.
+package app
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+// Manifest describes the resources an app installs in a store. Manifests are
+// plain structs with JSON tags, so they can be declared in Go, decoded with
+// ReadManifest, or decoded from YAML by any library honoring JSON tags.
+type Manifest struct {
+	Scripts         []*bigcommerce.Script  `json:"scripts,omitempty"`              // Storefront scripts, matched by name.
+	Webhooks        []*bigcommerce.Webhook `json:"webhooks,omitempty"`             // Webhooks, matched by scope and destination.
+	WidgetTemplates []*WidgetTemplate      `json:"widget_templates,omitempty"`     // Widget templates, matched by name.
+	Namespaces      []*Namespace           `json:"metafield_namespaces,omitempty"` // Metafield namespaces owned by the app.
+}
+
+// WidgetTemplate is a widget template of a Manifest, with the widgets built
+// from it
+type WidgetTemplate struct {
+	bigcommerce.WidgetTemplate
+	Widgets []*Widget `json:"widgets,omitempty"` // Widgets, matched by name among the template's widgets.
+}
+
+// Widget is a widget of a Manifest, with its placements. The widget's
+// template is set when it is installed.
+type Widget struct {
+	bigcommerce.Widget
+	Placements []*bigcommerce.Placement `json:"placements,omitempty"` // Placements, matched by template file, region and entity.
+}
+
+// Namespace is a metafield namespace owned by an app. Installing creates
+// nothing, as metafields are written by the app as it runs; uninstalling
+// deletes the namespace's metafields of every listed resource type.
+type Namespace struct {
+	Name      string                          `json:"name"`
+	Resources []bigcommerce.MetafieldResource `json:"resources"` // The resource types the app attaches metafields to.
+}
+
+// ReadManifest decodes a JSON manifest
+func ReadManifest(r io.Reader) (*Manifest, error) {
+	m := new(Manifest)
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+func TestReadManifest(t *testing.T) {
+	m := readTestManifest(t)
+
+	if len(m.Scripts) != 1 || m.Scripts[0].Kind != bigcommerce.SrcScript || !m.Scripts[0].AutoUninstall {
+		t.Errorf("Scripts = %+v", m.Scripts)
+	}
+	if len(m.Webhooks) != 2 || m.Webhooks[1].Scope != "store/app/uninstalled" {
+		t.Errorf("Webhooks = %+v", m.Webhooks)
+	}
+	if len(m.WidgetTemplates) != 1 || len(m.WidgetTemplates[0].Widgets) != 1 {
+		t.Fatalf("WidgetTemplates = %+v", m.WidgetTemplates)
+	}
+	widget := m.WidgetTemplates[0].Widgets[0]
+	if widget.Name != "Home Reviews" || len(widget.Placements) != 1 || widget.Placements[0].Status != bigcommerce.ActivePlacement {
+		t.Errorf("Widget = %+v", widget)
+	}
+	if len(m.Namespaces) != 1 || m.Namespaces[0].Resources[0] != bigcommerce.ProductMetafields {
+		t.Errorf("Namespaces = %+v", m.Namespaces)
+	}
+}
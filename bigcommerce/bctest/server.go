@@ -0,0 +1,448 @@
+// Package bctest provides an in-memory fake of the BigCommerce API for tests and
+// demos. The fake is resource-agnostic: every V2 and V3 path is treated as a
+// collection of JSON objects supporting list, get, create, update and delete,
+// with V3 responses wrapped in the usual data/meta envelope. Objects under
+// "v3/content/", such as scripts and widgets, are also assigned a "uuid" by
+// which they can be addressed.
+package bctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server is an in-memory fake of the BigCommerce API
+type Server struct {
+	mu          sync.Mutex
+	collections map[string]*collection
+}
+
+type collection struct {
+	nextID  int64
+	objects map[int64]map[string]interface{}
+	uuids   bool // Whether objects are assigned a "uuid".
+}
+
+// NewServer returns an empty fake server
+func NewServer() *Server {
+	return &Server{collections: map[string]*collection{}}
+}
+
+// Transport returns an http.RoundTripper that serves requests from the fake
+// server in-process, without any network access
+func (s *Server) Transport() http.RoundTripper {
+	return roundTripper{s}
+}
+
+type roundTripper struct {
+	s *Server
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	rec := httptest.NewRecorder()
+	t.s.ServeHTTP(rec, req)
+	resp := rec.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// Seed adds objects to the collection at path, e.g. "v3/catalog/products".
+// Objects are converted through JSON; those without an "id" are assigned one.
+func (s *Server) Seed(path string, objects ...interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.collection(strings.Trim(path, "/"))
+	for _, o := range objects {
+		obj, err := toObject(o)
+		if err != nil {
+			return err
+		}
+		c.put(obj)
+	}
+	return nil
+}
+
+// Objects returns the objects of the collection at path, ordered by ID
+func (s *Server) Objects(path string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collection(strings.Trim(path, "/")).list()
+}
+
+// Reset removes all data from the server
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections = map[string]*collection{}
+}
+
+func (s *Server) collection(path string) *collection {
+	c, ok := s.collections[path]
+	if !ok {
+		c = &collection{objects: map[int64]map[string]interface{}{}, uuids: strings.HasPrefix(path, "v3/content/")}
+		s.collections[path] = c
+	}
+	return c
+}
+
+func (c *collection) put(obj map[string]interface{}) map[string]interface{} {
+	id := objectID(obj)
+	if id == 0 {
+		c.nextID++
+		id = c.nextID
+		obj["id"] = id
+	} else if id > c.nextID {
+		c.nextID = id
+	}
+	if _, ok := obj["uuid"]; c.uuids && !ok {
+		obj["uuid"] = fmt.Sprintf("00000000-0000-4000-8000-%012d", id)
+	}
+	c.objects[id] = obj
+	return obj
+}
+
+// byUUID returns the ID of the object with the given uuid, or 0
+func (c *collection) byUUID(uuid string) int64 {
+	for id, obj := range c.objects {
+		if obj["uuid"] == uuid {
+			return id
+		}
+	}
+	return 0
+}
+
+func (c *collection) list() []map[string]interface{} {
+	ids := make([]int64, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	objects := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		objects[i] = c.objects[id]
+	}
+	return objects
+}
+
+// ServeHTTP implements http.Handler. Paths may include a "/stores/{hash}/" prefix.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	if strings.HasPrefix(path, "stores/") {
+		if parts := strings.SplitN(path, "/", 3); len(parts) == 3 {
+			path = parts[2]
+		}
+	}
+	v3 := strings.HasPrefix(path, "v3/")
+	if !v3 && !strings.HasPrefix(path, "v2/") {
+		writeError(w, false, http.StatusNotFound, "unknown API version")
+		return
+	}
+	path = strings.TrimSuffix(path, ".json")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(path, "/")
+	last := segments[len(segments)-1]
+	if last == "count" && !v3 {
+		c := s.collection(strings.Join(segments[:len(segments)-1], "/"))
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(c.objects)})
+		return
+	}
+
+	if id, err := strconv.ParseInt(last, 10, 64); err == nil && len(segments) > 2 {
+		s.serveObject(w, r, v3, strings.Join(segments[:len(segments)-1], "/"), id)
+		return
+	}
+	if len(segments) > 2 {
+		parent := strings.Join(segments[:len(segments)-1], "/")
+		if c, ok := s.collections[parent]; ok && c.uuids {
+			if id := c.byUUID(last); id != 0 {
+				s.serveObject(w, r, v3, parent, id)
+			} else {
+				writeError(w, v3, http.StatusNotFound, fmt.Sprintf("%s/%s not found", parent, last))
+			}
+			return
+		}
+	}
+	s.serveCollection(w, r, v3, path)
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, v3 bool, path string) {
+	c := s.collection(path)
+	switch r.Method {
+	case "GET":
+		objects := filter(c.list(), r)
+		page, limit := pageParams(r)
+		total := len(objects)
+		start := (page - 1) * limit
+		if start > total {
+			start = total
+		}
+		end := start + limit
+		if end > total {
+			end = total
+		}
+		objects = objects[start:end]
+
+		if !v3 {
+			if len(objects) == 0 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			writeJSON(w, http.StatusOK, objects)
+			return
+		}
+		totalPages := (total + limit - 1) / limit
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": objects,
+			"meta": map[string]interface{}{"pagination": map[string]interface{}{
+				"total": total, "count": len(objects), "per_page": limit, "current_page": page, "total_pages": totalPages,
+			}},
+		})
+
+	case "POST", "PUT":
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, v3, http.StatusBadRequest, err.Error())
+			return
+		}
+		if list, ok := body.([]interface{}); ok {
+			// Batch create or update.
+			var results []map[string]interface{}
+			for _, item := range list {
+				obj, ok := item.(map[string]interface{})
+				if !ok {
+					writeError(w, v3, http.StatusUnprocessableEntity, "batch items must be objects")
+					return
+				}
+				if existing, ok := c.objects[objectID(obj)]; ok {
+					results = append(results, merge(existing, obj))
+				} else {
+					results = append(results, c.put(withParent(path, obj)))
+				}
+			}
+			writeResult(w, v3, http.StatusOK, results)
+			return
+		}
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			writeError(w, v3, http.StatusUnprocessableEntity, "body must be an object")
+			return
+		}
+		delete(obj, "id")
+		writeResult(w, v3, http.StatusOK, c.put(withParent(path, obj)))
+
+	case "DELETE":
+		// Batch deletes list IDs in the query or, for metafields, the body.
+		ids := strings.Split(r.URL.Query().Get("id:in"), ",")
+		if body, err := readBody(r); err == nil {
+			if list, ok := body.([]interface{}); ok {
+				ids = ids[:0]
+				for _, id := range list {
+					ids = append(ids, fmt.Sprint(id))
+				}
+			}
+		}
+		if len(ids) > 0 && ids[0] != "" {
+			for _, id := range ids {
+				n, _ := strconv.ParseInt(id, 10, 64)
+				delete(c.objects, n)
+			}
+		} else {
+			c.objects = map[int64]map[string]interface{}{}
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, v3, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, v3 bool, path string, id int64) {
+	c := s.collection(path)
+	obj, ok := c.objects[id]
+	if !ok && r.Method != "PUT" {
+		writeError(w, v3, http.StatusNotFound, fmt.Sprintf("%s/%d not found", path, id))
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeResult(w, v3, http.StatusOK, obj)
+
+	case "PUT":
+		body, err := readBody(r)
+		update, ok := body.(map[string]interface{})
+		if err != nil || !ok {
+			writeError(w, v3, http.StatusBadRequest, "body must be an object")
+			return
+		}
+		if obj == nil {
+			// Some endpoints create the object on PUT, e.g. metafields by key.
+			obj = c.put(withParent(path, map[string]interface{}{"id": id}))
+		}
+		update["id"] = id
+		writeResult(w, v3, http.StatusOK, merge(obj, update))
+
+	case "DELETE":
+		delete(c.objects, id)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, v3, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// filter keeps the objects matching every query parameter naming a top-level
+// field. Operators other than ":in" are ignored.
+func filter(objects []map[string]interface{}, r *http.Request) []map[string]interface{} {
+	q := r.URL.Query()
+	for key, values := range q {
+		switch key {
+		case "page", "limit", "include", "include_fields", "exclude_fields", "sort", "direction":
+			continue
+		}
+		field, in := key, false
+		if i := strings.Index(key, ":"); i >= 0 {
+			if key[i+1:] != "in" {
+				continue
+			}
+			field, in = key[:i], true
+		}
+		allowed := map[string]bool{}
+		for _, v := range values {
+			if in {
+				for _, part := range strings.Split(v, ",") {
+					allowed[part] = true
+				}
+			} else {
+				allowed[v] = true
+			}
+		}
+
+		var kept []map[string]interface{}
+		for _, obj := range objects {
+			if v, ok := obj[field]; ok && allowed[fmt.Sprint(v)] {
+				kept = append(kept, obj)
+			}
+		}
+		objects = kept
+	}
+	return objects
+}
+
+func pageParams(r *http.Request) (page, limit int) {
+	page, _ = strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ = strconv.Atoi(r.URL.Query().Get("limit"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 50
+	}
+	return page, limit
+}
+
+// withParent sets the parent ID field of objects in nested collections, e.g.
+// product_id for objects in "v3/catalog/products/32/variants"
+func withParent(path string, obj map[string]interface{}) map[string]interface{} {
+	segments := strings.Split(path, "/")
+	if len(segments) < 3 {
+		return obj
+	}
+	parentID, err := strconv.ParseInt(segments[len(segments)-2], 10, 64)
+	if err != nil {
+		return obj
+	}
+	field := singular(segments[len(segments)-3]) + "_id"
+	if _, ok := obj[field]; !ok {
+		obj[field] = parentID
+	}
+	return obj
+}
+
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "s"):
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
+
+func merge(obj, update map[string]interface{}) map[string]interface{} {
+	for k, v := range update {
+		obj[k] = v
+	}
+	return obj
+}
+
+func objectID(obj map[string]interface{}) int64 {
+	switch id := obj["id"].(type) {
+	case float64:
+		return int64(id)
+	case int64:
+		return id
+	case json.Number:
+		n, _ := id.Int64()
+		return n
+	}
+	return 0
+}
+
+func toObject(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	err = json.Unmarshal(data, &obj)
+	return obj, err
+}
+
+func readBody(r *http.Request) (interface{}, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func writeResult(w http.ResponseWriter, v3 bool, status int, v interface{}) {
+	if v3 {
+		v = map[string]interface{}{"data": v, "meta": map[string]interface{}{}}
+	}
+	writeJSON(w, status, v)
+}
+
+func writeError(w http.ResponseWriter, v3 bool, status int, msg string) {
+	if v3 {
+		writeJSON(w, status, map[string]interface{}{"status": status, "title": msg})
+		return
+	}
+	writeJSON(w, status, []map[string]interface{}{{"status": status, "message": msg}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,118 @@
+package bctest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serve(s *Server, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return w
+}
+
+func TestServer_v3(t *testing.T) {
+	s := NewServer()
+
+	w := serve(s, "POST", "/stores/abc/v3/catalog/products/5/variants", `{"sku":"A"}`)
+	if got, want := strings.TrimSpace(w.Body.String()), `{"data":{"id":1,"product_id":5,"sku":"A"},"meta":{}}`; got != want {
+		t.Errorf("POST = %s, want %s", got, want)
+	}
+	serve(s, "POST", "/stores/abc/v3/catalog/products/5/variants", `{"sku":"B"}`)
+
+	w = serve(s, "GET", "/stores/abc/v3/catalog/products/5/variants?sku=B", "")
+	if !strings.Contains(w.Body.String(), `"data":[{"id":2,"product_id":5,"sku":"B"}]`) || !strings.Contains(w.Body.String(), `"total":1`) {
+		t.Errorf("GET filtered = %s", w.Body.String())
+	}
+
+	w = serve(s, "PUT", "/stores/abc/v3/catalog/products/5/variants/1", `{"price":3}`)
+	if got, want := strings.TrimSpace(w.Body.String()), `{"data":{"id":1,"price":3,"product_id":5,"sku":"A"},"meta":{}}`; got != want {
+		t.Errorf("PUT = %s, want %s", got, want)
+	}
+
+	if w = serve(s, "DELETE", "/stores/abc/v3/catalog/products/5/variants/1", ""); w.Code != http.StatusNoContent {
+		t.Errorf("DELETE status = %d", w.Code)
+	}
+	if w = serve(s, "GET", "/stores/abc/v3/catalog/products/5/variants/1", ""); w.Code != http.StatusNotFound {
+		t.Errorf("GET deleted status = %d", w.Code)
+	}
+}
+
+func TestServer_v2(t *testing.T) {
+	s := NewServer()
+
+	if w := serve(s, "GET", "/v2/products", ""); w.Code != http.StatusNoContent {
+		t.Errorf("GET empty status = %d, want 204", w.Code)
+	}
+	if err := s.Seed("v2/products", map[string]interface{}{"name": "A"}, map[string]interface{}{"id": 10, "name": "B"}); err != nil {
+		t.Fatal(err)
+	}
+	w := serve(s, "GET", "/v2/products?limit=1&page=2", "")
+	if got, want := strings.TrimSpace(w.Body.String()), `[{"id":10,"name":"B"}]`; got != want {
+		t.Errorf("GET page 2 = %s, want %s", got, want)
+	}
+	w = serve(s, "GET", "/v2/products/count", "")
+	if got, want := strings.TrimSpace(w.Body.String()), `{"count":2}`; got != want {
+		t.Errorf("GET count = %s, want %s", got, want)
+	}
+	w = serve(s, "GET", "/v2/products/99", "")
+	if body, _ := io.ReadAll(w.Body); w.Code != http.StatusNotFound || !strings.HasPrefix(string(body), `[{"message"`) {
+		t.Errorf("GET missing = %d %s", w.Code, body)
+	}
+}
+
+func TestServer_batch(t *testing.T) {
+	s := NewServer()
+	s.Seed("v3/storefront/redirects", map[string]interface{}{"from_path": "/a"})
+
+	serve(s, "PUT", "/v3/storefront/redirects", `[{"id":1,"to_url":"/b"},{"from_path":"/c"}]`)
+	if objects := s.Objects("v3/storefront/redirects"); len(objects) != 2 || objects[0]["to_url"] != "/b" {
+		t.Errorf("Objects after batch = %v", objects)
+	}
+
+	serve(s, "DELETE", "/v3/storefront/redirects?id:in=1,2", "")
+	if objects := s.Objects("v3/storefront/redirects"); len(objects) != 0 {
+		t.Errorf("Objects after batch delete = %v", objects)
+	}
+}
+
+func TestServer_deleteBody(t *testing.T) {
+	s := NewServer()
+	s.Seed("v3/orders/metafields", map[string]interface{}{"key": "a"}, map[string]interface{}{"key": "b"})
+
+	serve(s, "DELETE", "/v3/orders/metafields", `[1]`)
+	if objects := s.Objects("v3/orders/metafields"); len(objects) != 1 || objects[0]["key"] != "b" {
+		t.Errorf("Objects after delete = %v", objects)
+	}
+}
+
+func TestServer_uuid(t *testing.T) {
+	s := NewServer()
+
+	rec := serve(s, "POST", "/v3/content/scripts", `{"name":"a"}`)
+	var created struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	json.NewDecoder(rec.Body).Decode(&created)
+	uuid, _ := created.Data["uuid"].(string)
+	if uuid == "" {
+		t.Fatalf("Created %v without a uuid", created.Data)
+	}
+
+	if rec := serve(s, "PUT", "/v3/content/scripts/"+uuid, `{"name":"b"}`); rec.Code != 200 {
+		t.Errorf("PUT by uuid = %d", rec.Code)
+	}
+	if objects := s.Objects("v3/content/scripts"); len(objects) != 1 || objects[0]["name"] != "b" {
+		t.Errorf("Objects after update = %v", objects)
+	}
+	if rec := serve(s, "DELETE", "/v3/content/scripts/"+uuid, ""); rec.Code != 204 {
+		t.Errorf("DELETE by uuid = %d", rec.Code)
+	}
+	if rec := serve(s, "GET", "/v3/content/scripts/"+uuid, ""); rec.Code != 404 {
+		t.Errorf("GET of deleted uuid = %d", rec.Code)
+	}
+}
@@ -0,0 +1,176 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// BrandService handles communication with the V2 and V3 brand endpoints
+type BrandService service
+
+// Brand describes a BigCommerce V3 Brand Object. The V2 representation is BCBrand.
+type Brand struct {
+	ID              int64             `json:"id,omitempty"`               // The unique numerical ID of the brand.
+	Name            string            `json:"name,omitempty"`             // The unique name of the brand.
+	PageTitle       string            `json:"page_title,omitempty"`       // Custom title for the brand page.
+	MetaKeywords    []string          `json:"meta_keywords,omitempty"`    // Keywords for the page's meta tags.
+	MetaDescription string            `json:"meta_description,omitempty"` // Description for the page's meta tags.
+	ImageURL        string            `json:"image_url,omitempty"`        // URL of the brand's image.
+	SearchKeywords  string            `json:"search_keywords,omitempty"`  // Keywords used to find the brand in storefront search.
+	CustomURL       *CatalogCustomURL `json:"custom_url,omitempty"`       // The brand's storefront URL.
+}
+
+// BrandListOptions specifies the optional parameters to BrandService.List
+type BrandListOptions struct {
+	ListOptions
+	IDs  []int64 `url:"id:in,omitempty"` // Filter by brand IDs.
+	Name string  `url:"name,omitempty"`  // Filter by exact name.
+}
+
+// List returns a page of brands
+func (s *BrandService) List(ctx context.Context, opts *BrandListOptions) ([]*Brand, *Response, error) {
+	path, err := addOptions("v3/catalog/brands", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var brands []*Brand
+	resp, err := s.client.call(ctx, "GET", path, nil, &brands)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brands, resp, nil
+}
+
+// Get returns a single brand
+func (s *BrandService) Get(ctx context.Context, id int64) (*Brand, *Response, error) {
+	brand := new(Brand)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/catalog/brands/%d", id), nil, brand)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brand, resp, nil
+}
+
+// Create adds a brand. Name is required.
+func (s *BrandService) Create(ctx context.Context, brand *Brand) (*Brand, *Response, error) {
+	created := new(Brand)
+	resp, err := s.client.call(ctx, "POST", "v3/catalog/brands", brand, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a brand
+func (s *BrandService) Update(ctx context.Context, id int64, brand *Brand) (*Brand, *Response, error) {
+	updated := new(Brand)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/catalog/brands/%d", id), brand, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a brand
+func (s *BrandService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/catalog/brands/%d", id), nil, nil)
+}
+
+// UploadImage sets a brand's image, streaming its content from r. It returns
+// the brand's new image URL.
+func (s *BrandService) UploadImage(ctx context.Context, id int64, filename string, r io.Reader) (string, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/brands/%d/image", id)
+	req, err := s.client.NewUploadRequest(ctx, "POST", path, "image_file", filename, r, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var image struct {
+		ImageURL string `json:"image_url"`
+	}
+	resp, err := s.client.Do(req, &envelope{Data: &image})
+	if err != nil {
+		return "", resp, err
+	}
+	return image.ImageURL, resp, nil
+}
+
+// UploadImageFile sets a brand's image from a local file
+func (s *BrandService) UploadImageFile(ctx context.Context, id int64, name string) (string, *Response, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	return s.UploadImage(ctx, id, filepath.Base(name), f)
+}
+
+// DeleteImage removes a brand's image
+func (s *BrandService) DeleteImage(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/catalog/brands/%d/image", id), nil, nil)
+}
+
+// ListMetafields returns the metafields of a brand. Use MetafieldService with
+// BrandMetafields for the other metafield operations.
+func (s *BrandService) ListMetafields(ctx context.Context, id int64, opts *MetafieldListOptions) ([]*Metafield, *Response, error) {
+	return s.client.Metafields.List(ctx, MetafieldOwnerOf(BrandMetafields, id), opts)
+}
+
+// CreateMetafield adds a metafield to a brand
+func (s *BrandService) CreateMetafield(ctx context.Context, id int64, metafield *Metafield) (*Metafield, *Response, error) {
+	return s.client.Metafields.Create(ctx, MetafieldOwnerOf(BrandMetafields, id), metafield)
+}
+
+// ListV2 returns a page of brands using the V2 API
+func (s *BrandService) ListV2(ctx context.Context, opts *ListOptions) ([]*BCBrand, *Response, error) {
+	path, err := addOptions("v2/brands", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var brands []*BCBrand
+	resp, err := s.client.call(ctx, "GET", path, nil, &brands)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brands, resp, nil
+}
+
+// GetV2 returns a single brand using the V2 API
+func (s *BrandService) GetV2(ctx context.Context, id int64) (*BCBrand, *Response, error) {
+	brand := new(BCBrand)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/brands/%d", id), nil, brand)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brand, resp, nil
+}
+
+// CreateV2 adds a brand using the V2 API
+func (s *BrandService) CreateV2(ctx context.Context, brand *BCBrand) (*BCBrand, *Response, error) {
+	created := new(BCBrand)
+	resp, err := s.client.call(ctx, "POST", "v2/brands", brand, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateV2 modifies a brand using the V2 API
+func (s *BrandService) UpdateV2(ctx context.Context, id int64, brand *BCBrand) (*BCBrand, *Response, error) {
+	updated := new(BCBrand)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/brands/%d", id), brand, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteV2 removes a brand using the V2 API
+func (s *BrandService) DeleteV2(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/brands/%d", id), nil, nil)
+}
@@ -0,0 +1,81 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBrandService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Brand{Name: "Acme", MetaKeywords: []string{"anvils"}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/brands", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Brand), input)
+		fmt.Fprint(w, `{"data":{"id":4,"name":"Acme","meta_keywords":["anvils"],"custom_url":{"url":"/acme/","is_customized":false}},"meta":{}}`)
+	})
+
+	brand, _, err := client.Brands.Create(context.Background(), input)
+	if err != nil || brand.ID != 4 || brand.CustomURL.URL != "/acme/" {
+		t.Errorf("Unexpected brand %+v, %v", brand, err)
+	}
+}
+
+func TestBrandService_UploadImage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/brands/4/image", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		file, header, err := r.FormFile("image_file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "logo.png" || string(content) != "logo" {
+			t.Errorf("Unexpected upload %v %q", header.Filename, content)
+		}
+		fmt.Fprint(w, `{"data":{"image_url":"https://cdn/logo.png"},"meta":{}}`)
+	})
+
+	url, _, err := client.Brands.UploadImage(context.Background(), 4, "logo.png", strings.NewReader("logo"))
+	if err != nil || url != "https://cdn/logo.png" {
+		t.Errorf("UploadImage = %q, %v", url, err)
+	}
+}
+
+func TestBrandService_ListMetafields(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/brands/4/metafields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":1,"key":"origin","value":"US","resource_type":"brand","resource_id":4}],"meta":{}}`)
+	})
+
+	metafields, _, err := client.Brands.ListMetafields(context.Background(), 4, nil)
+	if err != nil || len(metafields) != 1 || metafields[0].Value != "US" {
+		t.Errorf("Unexpected metafields %+v, %v", metafields, err)
+	}
+}
+
+func TestBrandService_V2(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/brands/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(BCBrand), &BCBrand{PageTitle: "Acme Anvils"})
+		fmt.Fprint(w, `{"id":4,"name":"Acme","page_title":"Acme Anvils","image_file":"a/123/logo.png"}`)
+	})
+
+	brand, _, err := client.Brands.UpdateV2(context.Background(), 4, &BCBrand{PageTitle: "Acme Anvils"})
+	if err != nil || brand.ID != 4 || brand.ImageFile != "a/123/logo.png" {
+		t.Errorf("Unexpected brand %+v, %v", brand, err)
+	}
+}
@@ -0,0 +1,223 @@
+package bigcommerce
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Retry guidance for bulk requests hitting the rate limit
+const (
+	bulkRetries          = 5
+	defaultRateLimitWait = time.Second
+)
+
+// BulkDeleteOptions configures the bulk delete helpers of the variant, product
+// image and metafield services, which send one DELETE per item since the API
+// has no bulk endpoint for them
+type BulkDeleteOptions struct {
+	Concurrency int // Number of deletes made at once, 4 if zero.
+}
+
+// DeleteResult describes the outcome of deleting a single item
+type DeleteResult struct {
+	ID  int64
+	Err error // Nil if the item was deleted, or was already gone.
+}
+
+// DeleteResults are the outcomes of a bulk delete, ordered by ID
+type DeleteResults []DeleteResult
+
+// Failed returns the results of the items that could not be deleted
+func (r DeleteResults) Failed() DeleteResults {
+	var failed DeleteResults
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// DeleteMany deletes variants of a product
+func (s *VariantService) DeleteMany(ctx context.Context, productID int64, variantIDs []int64, opts *BulkDeleteOptions) (DeleteResults, error) {
+	return deleteEach(ctx, variantIDs, opts, func(ctx context.Context, id int64) (*Response, error) {
+		return s.Delete(ctx, productID, id)
+	})
+}
+
+// DeleteMatching deletes the variants of a product for which match returns true
+func (s *VariantService) DeleteMatching(ctx context.Context, productID int64, match func(*Variant) bool, opts *BulkDeleteOptions) (DeleteResults, error) {
+	var ids []int64
+	list := &VariantListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}}
+	for {
+		variants, resp, err := s.List(ctx, productID, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range variants {
+			if match(v) {
+				ids = append(ids, v.ID)
+			}
+		}
+		if resp.Pagination == nil || int64(list.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		list.Page++
+	}
+	return s.DeleteMany(ctx, productID, ids, opts)
+}
+
+// DeleteMany deletes images of a product
+func (s *ProductImageService) DeleteMany(ctx context.Context, productID int64, imageIDs []int64, opts *BulkDeleteOptions) (DeleteResults, error) {
+	return deleteEach(ctx, imageIDs, opts, func(ctx context.Context, id int64) (*Response, error) {
+		return s.Delete(ctx, productID, id)
+	})
+}
+
+// DeleteMatching deletes the images of a product for which match returns true
+func (s *ProductImageService) DeleteMatching(ctx context.Context, productID int64, match func(*CatalogImage) bool, opts *BulkDeleteOptions) (DeleteResults, error) {
+	var ids []int64
+	list := &ListOptions{Page: 1, Limit: 250}
+	for {
+		images, resp, err := s.List(ctx, productID, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range images {
+			if match(image) {
+				ids = append(ids, image.ID)
+			}
+		}
+		if resp.Pagination == nil || int64(list.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		list.Page++
+	}
+	return s.DeleteMany(ctx, productID, ids, opts)
+}
+
+// DeleteMany deletes metafields of a single resource. Use DeleteBatch to
+// delete metafields across resources of a type in one request.
+func (s *MetafieldService) DeleteMany(ctx context.Context, owner MetafieldOwner, metafieldIDs []int64, opts *BulkDeleteOptions) (DeleteResults, error) {
+	return deleteEach(ctx, metafieldIDs, opts, func(ctx context.Context, id int64) (*Response, error) {
+		return s.Delete(ctx, owner, id)
+	})
+}
+
+// DeleteMatching deletes the metafields of a resource listed with filter, e.g.
+// a namespace, for which match returns true. A nil match deletes them all.
+func (s *MetafieldService) DeleteMatching(ctx context.Context, owner MetafieldOwner, filter *MetafieldListOptions, match func(*Metafield) bool, opts *BulkDeleteOptions) (DeleteResults, error) {
+	list := &MetafieldListOptions{}
+	if filter != nil {
+		*list = *filter
+	}
+	list.Page, list.Limit = 1, 250
+
+	var ids []int64
+	for {
+		metafields, resp, err := s.List(ctx, owner, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range metafields {
+			if match == nil || match(m) {
+				ids = append(ids, m.ID)
+			}
+		}
+		if resp.Pagination == nil || int64(list.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		list.Page++
+	}
+	return s.DeleteMany(ctx, owner, ids, opts)
+}
+
+// deleteEach deletes items concurrently. Deletes hitting the rate limit are
+// retried once the window resets, and items already gone count as deleted.
+// The error is only set when ctx is done.
+func deleteEach(ctx context.Context, ids []int64, opts *BulkDeleteOptions, del func(context.Context, int64) (*Response, error)) (DeleteResults, error) {
+	workers := 4
+	if opts != nil && opts.Concurrency > 0 {
+		workers = opts.Concurrency
+	}
+
+	results := make(DeleteResults, len(ids))
+	forEach(ctx, len(ids), workers, func(i int) {
+		results[i] = DeleteResult{ID: ids[i], Err: deleteWithRetry(ctx, ids[i], del)}
+	}, func(i int) {
+		results[i] = DeleteResult{ID: ids[i], Err: ctx.Err()}
+	})
+
+	sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+	return results, ctx.Err()
+}
+
+// forEach calls do with the indexes 0 to n-1 from the given number of
+// goroutines, and returns once every call returned. When ctx is done, the
+// indexes not yet handed to a goroutine are passed to unsent instead.
+func forEach(ctx context.Context, n, workers int, do, unsent func(int)) {
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				do(i)
+			}
+		}()
+	}
+
+	fed := 0
+feed:
+	for ; fed < n; fed++ {
+		select {
+		case jobs <- fed:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	for i := fed; i < n; i++ {
+		unsent(i)
+	}
+}
+
+func deleteWithRetry(ctx context.Context, id int64, del func(context.Context, int64) (*Response, error)) error {
+	err := retryRateLimited(ctx, func() error {
+		_, err := del(ctx, id)
+		return err
+	})
+	if Classify(err).Category == NotFoundError {
+		return nil
+	}
+	return err
+}
+
+// retryRateLimited calls fn until it succeeds or fails with an error other
+// than hitting the rate limit, waiting for the window to reset between
+// attempts
+func retryRateLimited(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		c := Classify(err)
+		if err == nil || c.Category != RateLimitError || attempt == bulkRetries {
+			return err
+		}
+
+		wait := c.RetryAfter
+		if wait <= 0 {
+			wait = defaultRateLimitWait
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestVariantService_DeleteMany(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/7/variants/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mu.Lock()
+		calls[id]++
+		n := calls[id]
+		mu.Unlock()
+
+		switch {
+		case id == "2" && n == 1:
+			w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case id == "3":
+			w.WriteHeader(http.StatusNotFound)
+		case id == "4":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	results, err := client.Variants.DeleteMany(context.Background(), 7, []int64{4, 3, 2, 1}, &BulkDeleteOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, r := range results {
+		ids = append(ids, r.ID)
+	}
+	if !reflect.DeepEqual(ids, []int64{1, 2, 3, 4}) {
+		t.Errorf("Results for %v, want ordered IDs", ids)
+	}
+	failed := results.Failed()
+	if len(failed) != 1 || failed[0].ID != 4 || Classify(failed[0].Err).Category != TransientError {
+		t.Errorf("Failed = %+v", failed)
+	}
+	if calls["2"] != 2 {
+		t.Errorf("Rate limited delete made %d calls, want 2", calls["2"])
+	}
+}
+
+func TestVariantService_DeleteMany_canceled(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := client.Variants.DeleteMany(ctx, 7, []int64{3, 1, 2}, &BulkDeleteOptions{Concurrency: 1})
+	if err != context.Canceled {
+		t.Errorf("DeleteMany returned %v, want context.Canceled", err)
+	}
+	if failed := results.Failed(); len(failed) != 3 || failed[0].ID != 1 || failed[2].ID != 3 {
+		t.Errorf("Failed = %+v, want every ID", failed)
+	}
+}
+
+func TestProductImageService_DeleteMatching(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/7/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"data":[{"id":1,"is_thumbnail":true},{"id":2}],"meta":{"pagination":{"total_pages":2}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":3}],"meta":{"pagination":{"total_pages":2}}}`)
+	})
+	var mu sync.Mutex
+	var deleted []string
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/7/images/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		mu.Lock()
+		deleted = append(deleted, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	notThumbnail := func(image *CatalogImage) bool { return !image.IsThumbnail }
+	results, err := client.ProductImages.DeleteMatching(context.Background(), 7, notThumbnail, nil)
+	if err != nil || len(results) != 2 || len(results.Failed()) != 0 || len(deleted) != 2 {
+		t.Errorf("DeleteMatching = %+v, %v; deleted %v", results, err, deleted)
+	}
+}
+
+func TestMetafieldService_DeleteMatching(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/7/metafields", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"namespace": "app", "page": "1", "limit": "250"})
+		fmt.Fprint(w, `{"data":[{"id":5,"key":"a"},{"id":6,"key":"b"}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/7/metafields/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	results, err := client.Metafields.DeleteMatching(context.Background(), MetafieldOwnerOf(ProductMetafields, 7),
+		&MetafieldListOptions{Namespace: "app"}, func(m *Metafield) bool { return m.Key == "b" }, nil)
+	if err != nil || !reflect.DeepEqual(results, DeleteResults{{ID: 6}}) {
+		t.Errorf("DeleteMatching = %+v, %v", results, err)
+	}
+}
@@ -0,0 +1,245 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Bundle describes a kit: a product sold under its own SKU but fulfilled as
+// component SKUs. Bundles are stored as JSON product metafields, so any app
+// can read the composition of a kit.
+type Bundle struct {
+	Version    int                `json:"version"`    // Schema version, set by Bundles.
+	ProductID  int64              `json:"product_id"` // The parent product.
+	SKU        string             `json:"sku"`        // The parent SKU, whose stock is derived from the components.
+	Components []*BundleComponent `json:"components"`
+}
+
+// BundleComponent is a SKU in a bundle
+type BundleComponent struct {
+	SKU      string `json:"sku"`
+	Quantity int64  `json:"quantity"` // Units of the SKU in one bundle.
+}
+
+// BundlePick is a SKU to pick for an order line; bundle lines produce one
+// pick per component
+type BundlePick struct {
+	OrderProductID int64
+	SKU            string
+	Quantity       int64  // Units to pick.
+	BundleSKU      string // The parent SKU, if the pick is a bundle component.
+}
+
+// bundleVersion is the current Bundle schema version
+const bundleVersion = 1
+
+// bundleKey is the metafield key of a bundle on its parent product
+const bundleKey = "components"
+
+// Bundles stores bundles as product metafields, keeps the stock of their
+// parent SKUs derived from their components and explodes order lines into
+// component picks
+type Bundles struct {
+	Client    *Client
+	Namespace string // Metafield namespace, "bundles" if empty.
+}
+
+func (b *Bundles) namespace() string {
+	if b.Namespace == "" {
+		return "bundles"
+	}
+	return b.Namespace
+}
+
+// Save records a bundle on its parent product, replacing its composition
+func (b *Bundles) Save(ctx context.Context, bundle *Bundle) error {
+	if bundle.ProductID == 0 || bundle.SKU == "" || len(bundle.Components) == 0 {
+		return fmt.Errorf("bigcommerce: bundle needs a product ID, a SKU and components")
+	}
+	for _, c := range bundle.Components {
+		if c.SKU == "" || c.SKU == bundle.SKU || c.Quantity < 1 {
+			return fmt.Errorf("bigcommerce: invalid component %q x %d in bundle %s", c.SKU, c.Quantity, bundle.SKU)
+		}
+	}
+	stored := *bundle
+	stored.Version = bundleVersion
+	value, err := json.Marshal(&stored)
+	if err != nil {
+		return err
+	}
+	if len(value) > maxMetafieldValue {
+		return fmt.Errorf("bigcommerce: bundle %s is %d bytes, over the %d byte metafield limit", bundle.SKU, len(value), maxMetafieldValue)
+	}
+
+	owner := MetafieldOwnerOf(ProductMetafields, bundle.ProductID)
+	existing, _, err := b.Client.Metafields.List(ctx, owner, &MetafieldListOptions{Namespace: b.namespace(), Key: bundleKey})
+	if err != nil {
+		return err
+	}
+	metafield := &Metafield{
+		Namespace:     b.namespace(),
+		Key:           bundleKey,
+		Value:         string(value),
+		PermissionSet: ReadMetafield,
+		Description:   "Bundle components",
+	}
+	if len(existing) > 0 {
+		_, _, err = b.Client.Metafields.Update(ctx, owner, existing[0].ID, metafield)
+	} else {
+		_, _, err = b.Client.Metafields.Create(ctx, owner, metafield)
+	}
+	return err
+}
+
+// Get returns the bundle of a product, or nil if it is not a bundle
+func (b *Bundles) Get(ctx context.Context, productID int64) (*Bundle, error) {
+	opts := &MetafieldListOptions{Namespace: b.namespace(), Key: bundleKey}
+	metafields, _, err := b.Client.Metafields.List(ctx, MetafieldOwnerOf(ProductMetafields, productID), opts)
+	if err != nil || len(metafields) == 0 {
+		return nil, err
+	}
+	return decodeBundle(metafields[0])
+}
+
+// Remove turns a bundle back into a plain product
+func (b *Bundles) Remove(ctx context.Context, productID int64) error {
+	owner := MetafieldOwnerOf(ProductMetafields, productID)
+	metafields, _, err := b.Client.Metafields.List(ctx, owner, &MetafieldListOptions{Namespace: b.namespace(), Key: bundleKey})
+	if err != nil {
+		return err
+	}
+	for _, m := range metafields {
+		if _, err := b.Client.Metafields.Delete(ctx, owner, m.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns the bundles of the given products, or of every product if
+// none are given, keyed by product ID
+func (b *Bundles) List(ctx context.Context, productIDs ...int64) (map[int64]*Bundle, error) {
+	bundles := map[int64]*Bundle{}
+	opts := &MetafieldListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, Namespace: b.namespace(), Key: bundleKey, ResourceIDs: productIDs}
+	for {
+		metafields, _, err := b.Client.Metafields.ListAll(ctx, ProductMetafields, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range metafields {
+			bundle, err := decodeBundle(m)
+			if err != nil {
+				return nil, err
+			}
+			bundles[bundle.ProductID] = bundle
+		}
+		if len(metafields) < opts.Limit {
+			return bundles, nil
+		}
+		opts.Page++
+	}
+}
+
+// SyncInventory sets the on-hand stock of a bundle's SKU at every location
+// holding its components to the number of complete bundles the components
+// make, with one relative adjustment. It returns the stock set per location.
+func (b *Bundles) SyncInventory(ctx context.Context, bundle *Bundle) (map[int64]int64, error) {
+	skus := []string{bundle.SKU}
+	for _, c := range bundle.Components {
+		skus = append(skus, c.SKU)
+	}
+	items, _, err := b.Client.Inventory.ListItems(ctx, &InventoryItemListOptions{ListOptions: ListOptions{Limit: 250}, SKUs: skus})
+	if err != nil {
+		return nil, err
+	}
+	stock := map[string]map[int64]int64{}
+	var locations []int64
+	for _, item := range items {
+		stock[item.Identity.SKU] = map[int64]int64{}
+		for _, l := range item.Locations {
+			stock[item.Identity.SKU][l.LocationID] = l.TotalInventoryOnhand
+			if !hasInt64(locations, l.LocationID) {
+				locations = append(locations, l.LocationID)
+			}
+		}
+	}
+	if stock[bundle.SKU] == nil {
+		return nil, fmt.Errorf("bigcommerce: no inventory item with SKU %q", bundle.SKU)
+	}
+	sort.Slice(locations, func(i, j int) bool { return locations[i] < locations[j] })
+
+	derived := map[int64]int64{}
+	adjustment := &InventoryAdjustment{Reason: "Bundle " + bundle.SKU + " stock derived from its components"}
+	for _, location := range locations {
+		n := int64(-1)
+		for _, c := range bundle.Components {
+			if available := stock[c.SKU][location] / c.Quantity; n < 0 || available < n {
+				n = available
+			}
+		}
+		if n < 0 {
+			n = 0
+		}
+		derived[location] = n
+		if delta := n - stock[bundle.SKU][location]; delta != 0 {
+			adjustment.Items = append(adjustment.Items, &InventoryAdjustmentItem{LocationID: location, SKU: bundle.SKU, Quantity: delta})
+		}
+	}
+	if len(adjustment.Items) > 0 {
+		if _, _, err := b.Client.Inventory.AdjustRelative(ctx, adjustment); err != nil {
+			return nil, err
+		}
+	}
+	return derived, nil
+}
+
+// Explode returns the SKUs to pick for the physical products of an order:
+// bundle lines are replaced by their components, multiplied by the quantity
+// ordered, and other lines are picked as they are
+func (b *Bundles) Explode(ctx context.Context, orderID int64) ([]*BundlePick, error) {
+	products, err := b.Client.Orders.ListAllProducts(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	var productIDs []int64
+	for _, p := range products {
+		if p.ProductID != 0 && !hasInt64(productIDs, p.ProductID) {
+			productIDs = append(productIDs, p.ProductID)
+		}
+	}
+	var bundles map[int64]*Bundle
+	if len(productIDs) > 0 {
+		if bundles, err = b.List(ctx, productIDs...); err != nil {
+			return nil, err
+		}
+	}
+
+	var picks []*BundlePick
+	for _, p := range products {
+		if p.Type == "digital" {
+			continue
+		}
+		bundle, ok := bundles[p.ProductID]
+		if !ok {
+			picks = append(picks, &BundlePick{OrderProductID: p.ID, SKU: p.SKU, Quantity: p.Quantity})
+			continue
+		}
+		for _, c := range bundle.Components {
+			picks = append(picks, &BundlePick{OrderProductID: p.ID, SKU: c.SKU, Quantity: c.Quantity * p.Quantity, BundleSKU: bundle.SKU})
+		}
+	}
+	return picks, nil
+}
+
+func decodeBundle(m *Metafield) (*Bundle, error) {
+	bundle := new(Bundle)
+	if err := json.Unmarshal([]byte(m.Value), bundle); err != nil {
+		return nil, fmt.Errorf("bigcommerce: decoding bundle metafield %d: %v", m.ID, err)
+	}
+	if bundle.Version > bundleVersion {
+		return nil, fmt.Errorf("bigcommerce: bundle metafield %d uses unsupported schema version %d", m.ID, bundle.Version)
+	}
+	return bundle, nil
+}
@@ -0,0 +1,131 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testBundleValue = `{\"version\":1,\"product_id\":5,\"sku\":\"KIT\",\"components\":[{\"sku\":\"A\",\"quantity\":2},{\"sku\":\"B\",\"quantity\":1}]}`
+
+func testBundle() *Bundle {
+	return &Bundle{Version: 1, ProductID: 5, SKU: "KIT", Components: []*BundleComponent{{SKU: "A", Quantity: 2}, {SKU: "B", Quantity: 1}}}
+}
+
+func TestBundles_Save(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var created bool
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/5/metafields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQuery(t, r, map[string]string{"namespace": "bundles", "key": "components"})
+			fmt.Fprint(w, `{"data":[],"meta":{}}`)
+		case "POST":
+			m := new(Metafield)
+			json.NewDecoder(r.Body).Decode(m)
+			var bundle Bundle
+			if err := json.Unmarshal([]byte(m.Value), &bundle); err != nil || !reflect.DeepEqual(&bundle, testBundle()) {
+				t.Errorf("Stored bundle %s", m.Value)
+			}
+			created = true
+			fmt.Fprint(w, `{"data":{"id":1},"meta":{}}`)
+		}
+	})
+
+	b := &Bundles{Client: client}
+	bundle := testBundle()
+	bundle.Version = 0
+	if err := b.Save(context.Background(), bundle); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if !created {
+		t.Error("Save did not create the metafield")
+	}
+
+	bundle.Components = append(bundle.Components, &BundleComponent{SKU: "KIT", Quantity: 1})
+	if err := b.Save(context.Background(), bundle); err == nil {
+		t.Error("Save of a bundle containing itself returned no error")
+	}
+}
+
+func TestBundles_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/5/metafields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":[{"id":1,"key":"components","value":"%s"}],"meta":{}}`, testBundleValue)
+	})
+
+	bundle, err := (&Bundles{Client: client}).Get(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !reflect.DeepEqual(bundle, testBundle()) {
+		t.Errorf("Get returned %+v", bundle)
+	}
+}
+
+func TestBundles_SyncInventory(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/inventory/items", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"sku:in": "KIT,A,B"})
+		fmt.Fprint(w, `{"data":[
+			{"identity":{"sku":"KIT"},"locations":[{"location_id":1,"total_inventory_onhand":0},{"location_id":2,"total_inventory_onhand":4}]},
+			{"identity":{"sku":"A"},"locations":[{"location_id":1,"total_inventory_onhand":7},{"location_id":2,"total_inventory_onhand":8}]},
+			{"identity":{"sku":"B"},"locations":[{"location_id":1,"total_inventory_onhand":10},{"location_id":2,"total_inventory_onhand":4}]}
+		],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/inventory/adjustments/relative", func(w http.ResponseWriter, r *http.Request) {
+		want := &InventoryAdjustment{
+			Reason: "Bundle KIT stock derived from its components",
+			Items:  []*InventoryAdjustmentItem{{LocationID: 1, SKU: "KIT", Quantity: 3}},
+		}
+		testBody(t, r, new(InventoryAdjustment), want)
+		fmt.Fprint(w, `{"transaction_id":"tx"}`)
+	})
+
+	derived, err := (&Bundles{Client: client}).SyncInventory(context.Background(), testBundle())
+	if err != nil {
+		t.Fatalf("SyncInventory returned error: %v", err)
+	}
+	if want := map[int64]int64{1: 3, 2: 4}; !reflect.DeepEqual(derived, want) {
+		t.Errorf("SyncInventory returned %v, want %v", derived, want)
+	}
+}
+
+func TestBundles_Explode(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/101/products", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id":1,"product_id":5,"sku":"KIT","type":"physical","quantity":3},
+			{"id":2,"product_id":6,"sku":"C","type":"physical","quantity":1},
+			{"id":3,"product_id":7,"sku":"EBOOK","type":"digital","quantity":1}
+		]`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/metafields", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"resource_id:in": "5,6,7", "namespace": "bundles", "key": "components"})
+		fmt.Fprintf(w, `{"data":[{"id":1,"resource_id":5,"key":"components","value":"%s"}],"meta":{}}`, testBundleValue)
+	})
+
+	picks, err := (&Bundles{Client: client}).Explode(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("Explode returned error: %v", err)
+	}
+	want := []*BundlePick{
+		{OrderProductID: 1, SKU: "A", Quantity: 6, BundleSKU: "KIT"},
+		{OrderProductID: 1, SKU: "B", Quantity: 3, BundleSKU: "KIT"},
+		{OrderProductID: 2, SKU: "C", Quantity: 1},
+	}
+	if !reflect.DeepEqual(picks, want) {
+		t.Errorf("Explode returned %+v, want %+v", picks, want)
+	}
+}
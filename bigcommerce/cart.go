@@ -0,0 +1,250 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CartService handles communication with the V3 server-to-server cart endpoints
+type CartService service
+
+// Cart describes a BigCommerce V3 Cart Object
+type Cart struct {
+	ID             string            `json:"id,omitempty"`              // The cart's UUID.
+	CustomerID     int64             `json:"customer_id,omitempty"`     // The customer, 0 for guests.
+	ChannelID      int64             `json:"channel_id,omitempty"`      // The channel the cart belongs to.
+	Email          string            `json:"email,omitempty"`           // The shopper's email, when known.
+	Currency       *CartCurrency     `json:"currency,omitempty"`        // The currency of the cart's amounts.
+	TaxIncluded    bool              `json:"tax_included,omitempty"`    // Whether amounts include tax.
+	BaseAmount     float64           `json:"base_amount,omitempty"`     // Sum of the items' list prices.
+	DiscountAmount float64           `json:"discount_amount,omitempty"` // Discounts applied to the cart.
+	CartAmount     float64           `json:"cart_amount,omitempty"`     // The cart total, after discounts.
+	LineItems      *CartLineItems    `json:"line_items,omitempty"`
+	RedirectURLs   *CartRedirectURLs `json:"redirect_urls,omitempty"` // With include=redirect_urls.
+	CreatedTime    string            `json:"created_time,omitempty"`  // ISO 8601 date. Read-only.
+	UpdatedTime    string            `json:"updated_time,omitempty"`  // ISO 8601 date. Read-only.
+}
+
+// CartRedirectURLs are the storefront URLs continuing a cart built by the
+// server, valid for one use within 30 days
+type CartRedirectURLs struct {
+	CartURL             string `json:"cart_url"`
+	CheckoutURL         string `json:"checkout_url"`
+	EmbeddedCheckoutURL string `json:"embedded_checkout_url"`
+}
+
+// CartCurrency describes the currency of a cart
+type CartCurrency struct {
+	Code string `json:"code"` // ISO 4217 code, e.g. USD.
+}
+
+// CartLineItems holds the items of a cart by kind
+type CartLineItems struct {
+	PhysicalItems    []*CartLineItem        `json:"physical_items,omitempty"`
+	DigitalItems     []*CartLineItem        `json:"digital_items,omitempty"`
+	GiftCertificates []*CartGiftCertificate `json:"gift_certificates,omitempty"`
+	CustomItems      []*CartCustomItem      `json:"custom_items,omitempty"`
+}
+
+// CartLineItem describes a catalog product in a cart
+type CartLineItem struct {
+	ID                string                `json:"id,omitempty"` // The line item's ID, unique within the cart.
+	ParentID          int64                 `json:"parent_id,omitempty"`
+	VariantID         int64                 `json:"variant_id,omitempty"`
+	ProductID         int64                 `json:"product_id,omitempty"`
+	SKU               string                `json:"sku,omitempty"`
+	Name              string                `json:"name,omitempty"`
+	URL               string                `json:"url,omitempty"`
+	Quantity          int64                 `json:"quantity"`
+	IsTaxable         bool                  `json:"is_taxable,omitempty"`
+	ImageURL          string                `json:"image_url,omitempty"`
+	DiscountAmount    float64               `json:"discount_amount,omitempty"`
+	CouponAmount      float64               `json:"coupon_amount,omitempty"`
+	ListPrice         float64               `json:"list_price,omitempty"`          // Price of one item before discounts.
+	SalePrice         float64               `json:"sale_price,omitempty"`          // Price of one item after discounts.
+	ExtendedListPrice float64               `json:"extended_list_price,omitempty"` // ListPrice times Quantity.
+	ExtendedSalePrice float64               `json:"extended_sale_price,omitempty"` // SalePrice times Quantity.
+	Options           []*CartLineItemOption `json:"options,omitempty"`             // With include=line_items.physical_items.options or line_items.digital_items.options.
+}
+
+// CartLineItemOption describes an option selected for a cart line item
+type CartLineItemOption struct {
+	Name    string `json:"name,omitempty"` // The option's name, e.g. "Size".
+	NameID  int64  `json:"nameId,omitempty"`
+	Value   string `json:"value,omitempty"` // The selected value, e.g. "Large".
+	ValueID int64  `json:"valueId,omitempty"`
+}
+
+// CartGiftCertificate describes a gift certificate bought in a cart
+type CartGiftCertificate struct {
+	ID        string       `json:"id,omitempty"`
+	Name      string       `json:"name,omitempty"`
+	Theme     string       `json:"theme,omitempty"` // The email template, e.g. Birthday, Celebration or General.
+	Amount    float64      `json:"amount"`
+	Quantity  int64        `json:"quantity,omitempty"`
+	Taxable   bool         `json:"taxable,omitempty"`
+	Sender    *CartContact `json:"sender,omitempty"`
+	Recipient *CartContact `json:"recipient,omitempty"`
+	Message   string       `json:"message,omitempty"`
+}
+
+// CartContact describes the sender or recipient of a gift certificate
+type CartContact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// CartCustomItem describes an item that is not in the catalog, added to a cart
+type CartCustomItem struct {
+	ID        string  `json:"id,omitempty"`
+	SKU       string  `json:"sku,omitempty"`
+	Name      string  `json:"name,omitempty"`
+	Quantity  int64   `json:"quantity"`
+	ListPrice float64 `json:"list_price"`
+}
+
+// CartRequest describes a cart to create
+type CartRequest struct {
+	CustomerID       int64                  `json:"customer_id,omitempty"` // The customer, 0 for a guest cart.
+	ChannelID        int64                  `json:"channel_id,omitempty"`  // The channel, 1 if unset.
+	Currency         *CartCurrency          `json:"currency,omitempty"`    // The transactional currency, the store's default if unset.
+	Locale           string                 `json:"locale,omitempty"`      // The shopper's locale, e.g. "en-US".
+	LineItems        []*CartLineItemRequest `json:"line_items,omitempty"`  // Physical and digital catalog items.
+	GiftCertificates []*CartGiftCertificate `json:"gift_certificates,omitempty"`
+	CustomItems      []*CartCustomItem      `json:"custom_items,omitempty"`
+}
+
+// CartItemsRequest describes items to add to a cart
+type CartItemsRequest struct {
+	LineItems        []*CartLineItemRequest `json:"line_items,omitempty"`
+	GiftCertificates []*CartGiftCertificate `json:"gift_certificates,omitempty"`
+	CustomItems      []*CartCustomItem      `json:"custom_items,omitempty"`
+}
+
+// CartLineItemRequest describes a catalog item to add to a cart
+type CartLineItemRequest struct {
+	Quantity         int64                  `json:"quantity"`
+	ProductID        int64                  `json:"product_id"`
+	VariantID        int64                  `json:"variant_id,omitempty"`        // Required for products with variants.
+	ListPrice        float64                `json:"list_price,omitempty"`        // Overrides the catalog price.
+	OptionSelections []*CartOptionSelection `json:"option_selections,omitempty"` // Modifier values.
+}
+
+// CartOptionSelection describes the value chosen for a product modifier
+type CartOptionSelection struct {
+	OptionID    int64       `json:"option_id"`
+	OptionValue interface{} `json:"option_value"` // A value ID, or the text of text and number modifiers.
+}
+
+// CartItemUpdate describes the change to a single cart item. Set LineItem for
+// catalog items or GiftCertificate for gift certificates.
+type CartItemUpdate struct {
+	LineItem        *CartLineItemRequest `json:"line_item,omitempty"`
+	GiftCertificate *CartGiftCertificate `json:"gift_certificate,omitempty"`
+}
+
+// CartGetOptions specifies the optional parameters to CartService.Get
+type CartGetOptions struct {
+	Include []string `url:"include,omitempty"` // redirect_urls, line_items.physical_items.options or line_items.digital_items.options.
+}
+
+// Get returns a single cart
+func (s *CartService) Get(ctx context.Context, id string) (*Cart, *Response, error) {
+	return s.GetWithOptions(ctx, id, nil)
+}
+
+// GetWithOptions returns a single cart with the sub-resources in opts
+func (s *CartService) GetWithOptions(ctx context.Context, id string, opts *CartGetOptions) (*Cart, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/carts/%s", id), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cart := new(Cart)
+	resp, err := s.client.call(ctx, "GET", path, nil, cart)
+	if err != nil {
+		return nil, resp, err
+	}
+	return cart, resp, nil
+}
+
+// Create adds a cart. At least one item is required.
+func (s *CartService) Create(ctx context.Context, cart *CartRequest) (*Cart, *Response, error) {
+	return s.create(ctx, "v3/carts", cart)
+}
+
+// CreateWithRedirectURLs adds a cart and returns it with its redirect URLs,
+// in a single request
+func (s *CartService) CreateWithRedirectURLs(ctx context.Context, cart *CartRequest) (*Cart, *Response, error) {
+	return s.create(ctx, "v3/carts?include=redirect_urls", cart)
+}
+
+func (s *CartService) create(ctx context.Context, path string, cart *CartRequest) (*Cart, *Response, error) {
+	created := new(Cart)
+	resp, err := s.client.call(ctx, "POST", path, cart, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// SetCustomer assigns a cart to a customer
+func (s *CartService) SetCustomer(ctx context.Context, id string, customerID int64) (*Cart, *Response, error) {
+	body := struct {
+		CustomerID int64 `json:"customer_id"`
+	}{customerID}
+	updated := new(Cart)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/carts/%s", id), body, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a cart
+func (s *CartService) Delete(ctx context.Context, id string) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/carts/%s", id), nil, nil)
+}
+
+// AddItems adds items to a cart and returns the updated cart
+func (s *CartService) AddItems(ctx context.Context, id string, items *CartItemsRequest) (*Cart, *Response, error) {
+	updated := new(Cart)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/carts/%s/items", id), items, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// UpdateItem changes an item of a cart, e.g. its quantity, and returns the
+// updated cart
+func (s *CartService) UpdateItem(ctx context.Context, id, itemID string, update *CartItemUpdate) (*Cart, *Response, error) {
+	updated := new(Cart)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/carts/%s/items/%s", id, itemID), update, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteItem removes an item from a cart and returns the updated cart.
+// Removing the last item deletes the cart, returning nil.
+func (s *CartService) DeleteItem(ctx context.Context, id, itemID string) (*Cart, *Response, error) {
+	var updated *Cart
+	resp, err := s.client.call(ctx, "DELETE", fmt.Sprintf("v3/carts/%s/items/%s", id, itemID), nil, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// CreateRedirectURLs returns URLs taking the shopper to the storefront cart
+// or checkout with the cart loaded
+func (s *CartService) CreateRedirectURLs(ctx context.Context, id string) (*CartRedirectURLs, *Response, error) {
+	urls := new(CartRedirectURLs)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/carts/%s/redirect_urls", id), nil, urls)
+	if err != nil {
+		return nil, resp, err
+	}
+	return urls, resp, nil
+}
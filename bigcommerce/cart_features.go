@@ -0,0 +1,184 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CartFeatures is a flat feature vector describing a cart, for merchants
+// scoring abandonment risk with their own models
+type CartFeatures struct {
+	CartID          string  `json:"cart_id"`
+	StoreID         string  `json:"store_id"`
+	Scope           string  `json:"scope"`       // The webhook scope that triggered the extraction.
+	CustomerID      int64   `json:"customer_id"` // 0 for guests.
+	IsGuest         bool    `json:"is_guest"`
+	Currency        string  `json:"currency"`
+	TotalValue      float64 `json:"total_value"`      // The cart amount, after discounts.
+	DiscountAmount  float64 `json:"discount_amount"`  // Discounts applied to the cart.
+	ItemCount       int64   `json:"item_count"`       // Units across all line items.
+	DistinctItems   int     `json:"distinct_items"`   // Number of line items.
+	DigitalItems    int64   `json:"digital_items"`    // Units of digital products.
+	CategoryIDs     []int64 `json:"category_ids"`     // Sorted categories of the products in the cart.
+	HourOfDay       int     `json:"hour_of_day"`      // Hour of the event, in the extractor's location.
+	DayOfWeek       int     `json:"day_of_week"`      // Day of the event, 0 is Sunday.
+	CartAgeMinutes  float64 `json:"cart_age_minutes"` // Time between the cart's creation and the event.
+	PreviousOrders  int64   `json:"previous_orders"`  // Orders placed by the customer, 0 for guests.
+	CustomerAgeDays float64 `json:"customer_age_days"`
+}
+
+// CartFeatureExtractor turns cart webhooks into CartFeatures, fetching the
+// cart, the categories of its products and the customer's order history
+type CartFeatureExtractor struct {
+	Client *Client
+
+	// Location is the time zone of HourOfDay and DayOfWeek; UTC if nil.
+	Location *time.Location
+
+	// Publisher receives the features extracted by Handle.
+	Publisher *EventPublisher
+}
+
+// Extract returns the features of the cart an event is about. It accepts
+// store/cart/* and store/cart/lineItem/* events.
+func (x *CartFeatureExtractor) Extract(ctx context.Context, e *Event) (*CartFeatures, error) {
+	cartID, err := eventCartID(e)
+	if err != nil {
+		return nil, err
+	}
+	cart, _, err := x.Client.Carts.Get(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Unix(e.CreatedAt, 0)
+	if e.CreatedAt == 0 {
+		at = time.Now()
+	}
+	loc := x.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := at.In(loc)
+
+	f := &CartFeatures{
+		CartID:         cart.ID,
+		StoreID:        e.StoreID,
+		Scope:          e.Scope,
+		CustomerID:     cart.CustomerID,
+		IsGuest:        cart.CustomerID == 0,
+		TotalValue:     cart.CartAmount,
+		DiscountAmount: cart.DiscountAmount,
+		HourOfDay:      local.Hour(),
+		DayOfWeek:      int(local.Weekday()),
+		CategoryIDs:    []int64{},
+	}
+	if cart.Currency != nil {
+		f.Currency = cart.Currency.Code
+	}
+	if created, err := time.Parse(time.RFC3339, cart.CreatedTime); err == nil {
+		f.CartAgeMinutes = at.Sub(created).Minutes()
+	}
+
+	var productIDs []int64
+	if items := cart.LineItems; items != nil {
+		for _, item := range items.PhysicalItems {
+			f.ItemCount += item.Quantity
+			productIDs = append(productIDs, item.ProductID)
+		}
+		for _, item := range items.DigitalItems {
+			f.ItemCount += item.Quantity
+			f.DigitalItems += item.Quantity
+			productIDs = append(productIDs, item.ProductID)
+		}
+		for _, item := range items.GiftCertificates {
+			f.ItemCount += item.Quantity
+		}
+		for _, item := range items.CustomItems {
+			f.ItemCount += item.Quantity
+		}
+		f.DistinctItems = len(items.PhysicalItems) + len(items.DigitalItems) + len(items.GiftCertificates) + len(items.CustomItems)
+	}
+	if f.CategoryIDs, err = x.categories(ctx, productIDs); err != nil {
+		return nil, err
+	}
+
+	if cart.CustomerID != 0 {
+		count, _, err := x.Client.Orders.Count(ctx, &OrderListOptions{CustomerID: cart.CustomerID})
+		if err != nil {
+			return nil, err
+		}
+		f.PreviousOrders = count
+		customer, _, err := x.Client.Customers.Get(ctx, cart.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		if created, err := time.Parse(rfc2822, customer.DateCreated); err == nil {
+			f.CustomerAgeDays = at.Sub(created).Hours() / 24
+		}
+	}
+	return f, nil
+}
+
+// Handle extracts the features of a cart event and publishes them. Events
+// other than cart events are ignored.
+func (x *CartFeatureExtractor) Handle(ctx context.Context, e *Event) error {
+	if !strings.HasPrefix(e.Scope, "store/cart/") {
+		return nil
+	}
+	f, err := x.Extract(ctx, e)
+	if err != nil {
+		return err
+	}
+	return x.Publisher.publish(ctx, f)
+}
+
+// categories returns the sorted, distinct categories of the products
+func (x *CartFeatureExtractor) categories(ctx context.Context, productIDs []int64) ([]int64, error) {
+	seen := map[int64]bool{}
+	ids := []int64{}
+	for start := 0; start < len(productIDs); start += maxProductBatch {
+		batch := productIDs[start:minInt(start+maxProductBatch, len(productIDs))]
+		products, _, err := x.Client.Catalog.ListProducts(ctx, &CatalogProductListOptions{
+			ListOptions:   ListOptions{Limit: maxProductBatch},
+			IDs:           batch,
+			IncludeFields: []string{"categories"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range products {
+			for _, id := range p.Categories {
+				if !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// eventCartID returns the cart a cart or cart line item event is about
+func eventCartID(e *Event) (string, error) {
+	if e.Data.Type == "cart" && e.Data.ID != "" {
+		return e.Data.ID, nil
+	}
+	var v struct {
+		CartID string `json:"cartId"`
+	}
+	if len(e.Data.Raw) > 0 {
+		if err := json.Unmarshal(e.Data.Raw, &v); err != nil {
+			return "", err
+		}
+	}
+	if v.CartID == "" {
+		return "", fmt.Errorf("bigcommerce: event %s does not reference a cart", e.Scope)
+	}
+	return v.CartID, nil
+}
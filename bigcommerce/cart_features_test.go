@@ -0,0 +1,101 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func cartFeaturesSetup(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/stores/abc123/v3/carts/09346904-4175-44fd-be53-f7e598531b6c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"id":"09346904-4175-44fd-be53-f7e598531b6c","customer_id":7,"currency":{"code":"USD"},
+			"cart_amount":90,"discount_amount":10,"created_time":"2019-06-25T16:05:35+00:00",
+			"line_items":{"physical_items":[{"product_id":1,"quantity":2},{"product_id":2,"quantity":1}],
+			"digital_items":[{"product_id":3,"quantity":1}],"gift_certificates":[{"amount":25,"quantity":1}]}},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"id:in": "1,2,3", "include_fields": "categories"})
+		fmt.Fprint(w, `{"data":[{"id":1,"categories":[23,18]},{"id":2,"categories":[18]},{"id":3,"categories":[40]}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"customer_id": "7"})
+		fmt.Fprint(w, `{"count":4}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":7,"date_created":"Tue, 25 Jun 2019 16:15:35 +0000"}`)
+	})
+}
+
+func TestCartFeatureExtractor_Extract(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	cartFeaturesSetup(t, mux)
+
+	event, _ := ParseEvent(strings.NewReader(cartEventJSON))
+	x := &CartFeatureExtractor{Client: client, Location: time.FixedZone("EST", -5*3600)}
+	f, err := x.Extract(context.Background(), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CartFeatures{
+		CartID:          "09346904-4175-44fd-be53-f7e598531b6c",
+		StoreID:         "1025646",
+		Scope:           "store/cart/created",
+		CustomerID:      7,
+		Currency:        "USD",
+		TotalValue:      90,
+		DiscountAmount:  10,
+		ItemCount:       5,
+		DistinctItems:   4,
+		DigitalItems:    1,
+		CategoryIDs:     []int64{18, 23, 40},
+		HourOfDay:       11,
+		DayOfWeek:       2,
+		CartAgeMinutes:  10,
+		PreviousOrders:  4,
+		CustomerAgeDays: 0,
+	}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("Extract returned %+v, want %+v", f, want)
+	}
+}
+
+func TestCartFeatureExtractor_Handle(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	cartFeaturesSetup(t, mux)
+
+	var published []*CartFeatures
+	x := &CartFeatureExtractor{Client: client, Publisher: &EventPublisher{
+		Publish: func(ctx context.Context, contentType string, payload []byte) error {
+			f := new(CartFeatures)
+			json.Unmarshal(payload, f)
+			published = append(published, f)
+			return nil
+		},
+	}}
+
+	lineItem := `{"scope":"store/cart/lineItem/updated","store_id":"1025646","data":{"type":"cart_line_item","id":"li-1","cartId":"09346904-4175-44fd-be53-f7e598531b6c"},"created_at":1561479335}`
+	for _, body := range []string{productEventJSON, lineItem} {
+		event, _ := ParseEvent(strings.NewReader(body))
+		if err := x.Handle(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(published) != 1 || published[0].CartID != "09346904-4175-44fd-be53-f7e598531b6c" || published[0].Scope != "store/cart/lineItem/updated" {
+		t.Errorf("published = %+v", published)
+	}
+}
+
+func TestEventCartID_missing(t *testing.T) {
+	event, _ := ParseEvent(strings.NewReader(productEventJSON))
+	if _, err := eventCartID(event); err == nil {
+		t.Error("eventCartID succeeded for a product event")
+	}
+}
@@ -0,0 +1,149 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCartService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"id":"abc-1","customer_id":7,"currency":{"code":"USD"},"cart_amount":25.5,"line_items":{"physical_items":[{"id":"li-1","product_id":3,"quantity":2}]}},"meta":{}}`)
+	})
+
+	cart, _, err := client.Carts.Get(context.Background(), "abc-1")
+	if err != nil {
+		t.Fatalf("Carts.Get returned error: %v", err)
+	}
+	want := &Cart{
+		ID:         "abc-1",
+		CustomerID: 7,
+		Currency:   &CartCurrency{Code: "USD"},
+		CartAmount: 25.5,
+		LineItems:  &CartLineItems{PhysicalItems: []*CartLineItem{{ID: "li-1", ProductID: 3, Quantity: 2}}},
+	}
+	if !reflect.DeepEqual(cart, want) {
+		t.Errorf("Carts.Get returned %+v, want %+v", cart, want)
+	}
+}
+
+func TestCartService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &CartRequest{
+		CustomerID: 7,
+		LineItems: []*CartLineItemRequest{{
+			Quantity: 2, ProductID: 3, VariantID: 4,
+			OptionSelections: []*CartOptionSelection{{OptionID: 5, OptionValue: "Engraved"}},
+		}},
+		GiftCertificates: []*CartGiftCertificate{{
+			Name: "Gift", Theme: "Birthday", Amount: 25, Quantity: 1,
+			Sender: &CartContact{Name: "Jane", Email: "jane@example.com"}, Recipient: &CartContact{Name: "Joe", Email: "joe@example.com"},
+		}},
+		CustomItems: []*CartCustomItem{{SKU: "ENGRAVE", Name: "Engraving", Quantity: 1, ListPrice: 5}},
+	}
+	mux.HandleFunc("/stores/abc123/v3/carts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testQuery(t, r, map[string]string{"include": "redirect_urls"})
+		testBody(t, r, new(CartRequest), input)
+		fmt.Fprint(w, `{"data":{"id":"abc-1","redirect_urls":{"cart_url":"https://example.com/cart.php?action=load&id=abc-1","checkout_url":"https://example.com/cart.php?action=loadInCheckout&id=abc-1"}},"meta":{}}`)
+	})
+
+	cart, _, err := client.Carts.CreateWithRedirectURLs(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Carts.Create returned error: %v", err)
+	}
+	if cart.ID != "abc-1" || cart.RedirectURLs == nil || cart.RedirectURLs.CheckoutURL == "" {
+		t.Errorf("Carts.Create returned %+v", cart)
+	}
+}
+
+func TestCartService_items(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(CartItemsRequest), &CartItemsRequest{LineItems: []*CartLineItemRequest{{Quantity: 1, ProductID: 3}}})
+		fmt.Fprint(w, `{"data":{"id":"abc-1","line_items":{"physical_items":[{"id":"li-1","product_id":3,"quantity":1},{"id":"li-2","product_id":3,"quantity":1}]}},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1/items/li-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testBody(t, r, new(CartItemUpdate), &CartItemUpdate{LineItem: &CartLineItemRequest{Quantity: 3, ProductID: 3}})
+			fmt.Fprint(w, `{"data":{"id":"abc-1"},"meta":{}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	cart, _, err := client.Carts.AddItems(ctx, "abc-1", &CartItemsRequest{LineItems: []*CartLineItemRequest{{Quantity: 1, ProductID: 3}}})
+	if err != nil || len(cart.LineItems.PhysicalItems) != 2 {
+		t.Errorf("Carts.AddItems returned %+v, %v", cart, err)
+	}
+	if _, _, err := client.Carts.UpdateItem(ctx, "abc-1", "li-1", &CartItemUpdate{LineItem: &CartLineItemRequest{Quantity: 3, ProductID: 3}}); err != nil {
+		t.Errorf("Carts.UpdateItem returned error: %v", err)
+	}
+	cart, _, err = client.Carts.DeleteItem(ctx, "abc-1", "li-1")
+	if err != nil || cart != nil {
+		t.Errorf("Carts.DeleteItem of the last item returned %+v, %v", cart, err)
+	}
+}
+
+func TestCartService_SetCustomer(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if !reflect.DeepEqual(body, map[string]interface{}{"customer_id": 7.0}) {
+			t.Errorf("body = %v", body)
+		}
+		fmt.Fprint(w, `{"data":{"id":"abc-1","customer_id":7},"meta":{}}`)
+	})
+
+	cart, _, err := client.Carts.SetCustomer(context.Background(), "abc-1", 7)
+	if err != nil || cart.CustomerID != 7 {
+		t.Errorf("Carts.SetCustomer returned %+v, %v", cart, err)
+	}
+}
+
+func TestCartService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Carts.Delete(context.Background(), "abc-1"); err != nil {
+		t.Errorf("Carts.Delete returned error: %v", err)
+	}
+}
+
+func TestCartService_CreateRedirectURLs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/carts/abc-1/redirect_urls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":{"cart_url":"c","checkout_url":"k","embedded_checkout_url":"e"},"meta":{}}`)
+	})
+
+	urls, _, err := client.Carts.CreateRedirectURLs(context.Background(), "abc-1")
+	if err != nil || !reflect.DeepEqual(urls, &CartRedirectURLs{CartURL: "c", CheckoutURL: "k", EmbeddedCheckoutURL: "e"}) {
+		t.Errorf("Carts.CreateRedirectURLs returned %+v, %v", urls, err)
+	}
+}
@@ -0,0 +1,162 @@
+package bigcommerce
+
+import (
+	"context"
+	"time"
+)
+
+// CatalogService handles communication with the V3 catalog-wide endpoints
+type CatalogService service
+
+// CatalogSummary describes the BigCommerce V3 Catalog Summary Object, a snapshot
+// of inventory and pricing across the whole catalog
+type CatalogSummary struct {
+	InventoryCount      int64   `json:"inventory_count"`       // Total units in stock across all tracked products and variants.
+	InventoryValue      float64 `json:"inventory_value"`       // Total value of the stock, at cost price.
+	PrimaryCategoryID   int64   `json:"primary_category_id"`   // The category with the most products.
+	PrimaryCategoryName string  `json:"primary_category_name"` // The name of the primary category.
+	VariantCount        int64   `json:"variant_count"`         // Total number of variants.
+	HighestVariantPrice float64 `json:"highest_variant_price"` // Price of the most expensive variant.
+	AverageVariantPrice float64 `json:"average_variant_price"` // Average variant price.
+	LowestVariantPrice  string  `json:"lowest_variant_price"`  // Price of the cheapest variant, returned by the API as a string.
+	OldestVariantDate   string  `json:"oldest_variant_date"`   // Creation date of the oldest variant.
+	NewestVariantDate   string  `json:"newest_variant_date"`   // Creation date of the newest variant.
+}
+
+// Summary returns the catalog summary
+func (s *CatalogService) Summary(ctx context.Context) (*CatalogSummary, *Response, error) {
+	summary := new(CatalogSummary)
+	resp, err := s.client.call(ctx, "GET", "v3/catalog/summary", nil, summary)
+	if err != nil {
+		return nil, resp, err
+	}
+	return summary, resp, nil
+}
+
+// CatalogProduct describes a BigCommerce V3 Product Object. Variants, images and
+// custom fields are only set when requested with Include.
+type CatalogProduct struct {
+	ID                int64              `json:"id,omitempty"`                 // The unique numerical ID of the product.
+	Name              string             `json:"name,omitempty"`               // The product name.
+	Type              ProductType        `json:"type,omitempty"`               // The product type.
+	SKU               string             `json:"sku,omitempty"`                // The product's stock keeping unit.
+	Description       string             `json:"description,omitempty"`        // Product description, which can include HTML.
+	Weight            float64            `json:"weight,omitempty"`             // Weight used to calculate shipping costs.
+	Width             float64            `json:"width,omitempty"`              // Width used to calculate shipping costs.
+	Depth             float64            `json:"depth,omitempty"`              // Depth used to calculate shipping costs.
+	Height            float64            `json:"height,omitempty"`             // Height used to calculate shipping costs.
+	Price             float64            `json:"price,omitempty"`              // The product's price.
+	CostPrice         float64            `json:"cost_price,omitempty"`         // The product's cost price, for reference only.
+	RetailPrice       float64            `json:"retail_price,omitempty"`       // The product's retail price.
+	SalePrice         float64            `json:"sale_price,omitempty"`         // Used instead of Price when set.
+	MapPrice          float64            `json:"map_price,omitempty"`          // Minimum advertised price.
+	CalculatedPrice   float64            `json:"calculated_price,omitempty"`   // Price as displayed to guests. Read-only.
+	Categories        []int64            `json:"categories,omitempty"`         // IDs of the categories the product appears in.
+	BrandID           int64              `json:"brand_id,omitempty"`           // The ID of the product's brand.
+	InventoryLevel    int64              `json:"inventory_level,omitempty"`    // Current inventory level, when tracked by product.
+	InventoryTracking InventoryType      `json:"inventory_tracking,omitempty"` // How inventory is tracked.
+	IsVisible         bool               `json:"is_visible,omitempty"`         // Whether the product is shown on the storefront.
+	Availability      string             `json:"availability,omitempty"`       // One of available, disabled or preorder.
+	CustomURL         *CatalogCustomURL  `json:"custom_url,omitempty"`         // The product's storefront URL.
+	DateCreated       string             `json:"date_created,omitempty"`       // Date the product was created.
+	DateModified      string             `json:"date_modified,omitempty"`      // Date the product was last modified.
+	Variants          []*Variant         `json:"variants,omitempty"`           // The product's variants, with include=variants.
+	Images            []*CatalogImage    `json:"images,omitempty"`             // The product's images, with include=images.
+	CustomFields      []*CustomField     `json:"custom_fields,omitempty"`      // The product's custom fields, with include=custom_fields.
+	BulkPricingRules  []*BulkPricingRule `json:"bulk_pricing_rules,omitempty"` // Quantity discounts, with include=bulk_pricing_rules.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// BulkPricingRule describes a quantity discount on a product
+type BulkPricingRule struct {
+	ID          int64           `json:"id,omitempty"`
+	QuantityMin int64           `json:"quantity_min"`           // The lowest quantity the rule applies to.
+	QuantityMax int64           `json:"quantity_max,omitempty"` // The highest quantity the rule applies to, 0 for no limit.
+	Type        BulkPricingType `json:"type"`                   // How Amount is applied.
+	Amount      float64         `json:"amount"`
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// BulkPricingType - How the amount of a bulk pricing rule is applied
+type BulkPricingType string
+
+const (
+	// PriceOffBulkPricing - takes the amount off the unit price
+	PriceOffBulkPricing BulkPricingType = "price"
+	// PercentBulkPricing - takes a percentage off the unit price
+	PercentBulkPricing BulkPricingType = "percent"
+	// FixedBulkPricing - sets the unit price to the amount
+	FixedBulkPricing BulkPricingType = "fixed"
+)
+
+// CatalogCustomURL describes the storefront URL of a catalog resource
+type CatalogCustomURL struct {
+	URL          string `json:"url"`           // The storefront path, e.g. /shirts/.
+	IsCustomized bool   `json:"is_customized"` // Whether the URL was set by the merchant rather than generated.
+}
+
+// CatalogProductListOptions specifies the optional parameters to CatalogService.ListProducts
+type CatalogProductListOptions struct {
+	ListOptions
+	IDs             []int64   `url:"id:in,omitempty"`             // Filter by product IDs.
+	Name            string    `url:"name,omitempty"`              // Filter by exact product name.
+	SKU             string    `url:"sku,omitempty"`               // Filter by SKU.
+	CategoryIDs     []int64   `url:"categories:in,omitempty"`     // Filter by category IDs.
+	BrandID         int64     `url:"brand_id,omitempty"`          // Filter by brand ID.
+	IsVisible       *bool     `url:"is_visible,omitempty"`        // Filter by storefront visibility.
+	MinDateModified time.Time `url:"date_modified:min,omitempty"` // Only return products modified on or after this date.
+	Include         []string  `url:"include,omitempty"`           // Sub-resources to include: variants, images, custom_fields, ...
+	IncludeFields   []string  `url:"include_fields,omitempty"`
+	ExcludeFields   []string  `url:"exclude_fields,omitempty"`
+	ExcludeArchived bool      `url:"-"` // Drop archived products from the page, which may then hold fewer than Limit.
+}
+
+// ListProducts returns a page of V3 products
+func (s *CatalogService) ListProducts(ctx context.Context, opts *CatalogProductListOptions) ([]*CatalogProduct, *Response, error) {
+	excludeArchived := opts != nil && opts.ExcludeArchived
+	if excludeArchived && len(opts.IncludeFields) > 0 {
+		o := *opts
+		o.IncludeFields = append(append([]string(nil), opts.IncludeFields...), "categories")
+		opts = &o
+	}
+	path, err := addOptions("v3/catalog/products", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []*CatalogProduct
+	resp, err := s.client.call(ctx, "GET", path, nil, &products)
+	if err != nil {
+		return nil, resp, err
+	}
+	if excludeArchived {
+		archived, err := s.client.Products.archivedFilter(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		kept := products[:0]
+		for _, p := range products {
+			if !archived(p.Categories) {
+				kept = append(kept, p)
+			}
+		}
+		products = kept
+	}
+	return products, resp, nil
+}
+
+// maxProductBatch is the number of products the batch endpoints accept at once
+const maxProductBatch = 10
+
+// UpdateProducts modifies up to 10 V3 products in one request. Every product
+// must set ID; only the fields set are changed.
+func (s *CatalogService) UpdateProducts(ctx context.Context, products []*CatalogProduct) ([]*CatalogProduct, *Response, error) {
+	var updated []*CatalogProduct
+	resp, err := s.client.call(ctx, "PUT", "v3/catalog/products", products, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
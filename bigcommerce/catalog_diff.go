@@ -0,0 +1,180 @@
+package bigcommerce
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// CatalogItem is the SKU-level view of a catalog compared by DiffCatalogs
+type CatalogItem struct {
+	SKU            string  `json:"sku"`
+	ProductID      int64   `json:"product_id"`
+	VariantID      int64   `json:"variant_id,omitempty"`
+	Price          float64 `json:"price"`
+	InventoryLevel int64   `json:"inventory_level"`
+}
+
+// CatalogSource streams the SKU-level items of a catalog
+type CatalogSource interface {
+	EachItem(ctx context.Context, fn func(*CatalogItem) error) error
+}
+
+// StoreCatalog is a CatalogSource reading every variant of a store, page by page
+type StoreCatalog struct {
+	Client   *Client
+	PageSize int // Variants per page, 250 if zero.
+}
+
+// EachItem calls fn for every variant in the store. Products without options
+// are included through their base variant.
+func (s *StoreCatalog) EachItem(ctx context.Context, fn func(*CatalogItem) error) error {
+	opts := &VariantListOptions{ListOptions: ListOptions{Page: 1, Limit: s.PageSize}}
+	if opts.Limit == 0 {
+		opts.Limit = 250
+	}
+	for {
+		variants, resp, err := s.Client.Variants.ListCatalog(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, v := range variants {
+			item := &CatalogItem{
+				SKU:            v.SKU,
+				ProductID:      v.ProductID,
+				VariantID:      v.ID,
+				Price:          v.CalculatedPrice,
+				InventoryLevel: v.InventoryLevel,
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+// SnapshotCatalog is a CatalogSource reading a snapshot written by WriteCatalogSnapshot
+type SnapshotCatalog struct {
+	r io.Reader
+}
+
+// NewSnapshotCatalog returns a CatalogSource reading JSON lines of CatalogItems from r.
+// The snapshot can only be read once.
+func NewSnapshotCatalog(r io.Reader) *SnapshotCatalog {
+	return &SnapshotCatalog{r: r}
+}
+
+// EachItem calls fn for every item in the snapshot
+func (s *SnapshotCatalog) EachItem(ctx context.Context, fn func(*CatalogItem) error) error {
+	dec := json.NewDecoder(bufio.NewReader(s.r))
+	for {
+		item := new(CatalogItem)
+		if err := dec.Decode(item); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
+
+// WriteCatalogSnapshot writes every item of source to w as JSON lines
+func WriteCatalogSnapshot(ctx context.Context, w io.Writer, source CatalogSource) error {
+	enc := json.NewEncoder(w)
+	return source.EachItem(ctx, func(item *CatalogItem) error {
+		return enc.Encode(item)
+	})
+}
+
+// CatalogDiff describes the differences between two catalogs, keyed by SKU.
+// Items without a SKU cannot be matched and are ignored.
+type CatalogDiff struct {
+	MissingInTarget []*CatalogItem  // Items of the source with no matching SKU in the target.
+	MissingInSource []*CatalogItem  // Items of the target with no matching SKU in the source.
+	PriceMismatches []PriceMismatch // SKUs priced differently.
+	StockDeltas     []StockDelta    // SKUs with different inventory levels.
+}
+
+// PriceMismatch describes a SKU priced differently in two catalogs
+type PriceMismatch struct {
+	SKU         string
+	SourcePrice float64
+	TargetPrice float64
+}
+
+// StockDelta describes a SKU with different inventory levels in two catalogs
+type StockDelta struct {
+	SKU         string
+	SourceLevel int64
+	TargetLevel int64
+	Delta       int64 // TargetLevel - SourceLevel.
+}
+
+// Empty reports whether the catalogs matched
+func (d *CatalogDiff) Empty() bool {
+	return len(d.MissingInTarget) == 0 && len(d.MissingInSource) == 0 && len(d.PriceMismatches) == 0 && len(d.StockDeltas) == 0
+}
+
+// DiffCatalogs compares two catalogs by SKU. The source is held in memory while
+// the target is streamed, so the smaller catalog should be passed as the source.
+func DiffCatalogs(ctx context.Context, source, target CatalogSource) (*CatalogDiff, error) {
+	items := map[string]*CatalogItem{}
+	err := source.EachItem(ctx, func(item *CatalogItem) error {
+		if item.SKU != "" {
+			items[item.SKU] = item
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diff := new(CatalogDiff)
+	err = target.EachItem(ctx, func(t *CatalogItem) error {
+		if t.SKU == "" {
+			return nil
+		}
+		s, ok := items[t.SKU]
+		if !ok {
+			diff.MissingInSource = append(diff.MissingInSource, t)
+			return nil
+		}
+		delete(items, t.SKU)
+
+		// Prices are compared to the cent, ignoring float rounding noise.
+		if int64(s.Price*100+0.5) != int64(t.Price*100+0.5) {
+			diff.PriceMismatches = append(diff.PriceMismatches, PriceMismatch{SKU: t.SKU, SourcePrice: s.Price, TargetPrice: t.Price})
+		}
+		if s.InventoryLevel != t.InventoryLevel {
+			diff.StockDeltas = append(diff.StockDeltas, StockDelta{
+				SKU:         t.SKU,
+				SourceLevel: s.InventoryLevel,
+				TargetLevel: t.InventoryLevel,
+				Delta:       t.InventoryLevel - s.InventoryLevel,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		diff.MissingInTarget = append(diff.MissingInTarget, item)
+	}
+	sort.Slice(diff.MissingInTarget, func(i, j int) bool {
+		return diff.MissingInTarget[i].SKU < diff.MissingInTarget[j].SKU
+	})
+	return diff, nil
+}
@@ -0,0 +1,72 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const catalogSnapshot = `{"sku":"A","product_id":1,"price":10,"inventory_level":5}
+{"sku":"B","product_id":2,"price":20,"inventory_level":1}
+{"sku":"C","product_id":3,"price":30,"inventory_level":0}
+`
+
+func TestDiffCatalogs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"data":[{"id":11,"product_id":1,"sku":"A","calculated_price":10,"inventory_level":5},
+				{"id":12,"product_id":2,"sku":"B","calculated_price":18.5,"inventory_level":4}],
+				"meta":{"pagination":{"total":3,"count":2,"per_page":2,"current_page":1,"total_pages":2}}}`)
+		case "2":
+			fmt.Fprint(w, `{"data":[{"id":14,"product_id":4,"sku":"D","calculated_price":40}],
+				"meta":{"pagination":{"total":3,"count":1,"per_page":2,"current_page":2,"total_pages":2}}}`)
+		default:
+			t.Errorf("Unexpected page %v", r.URL.Query().Get("page"))
+		}
+	})
+
+	store := &StoreCatalog{Client: client, PageSize: 2}
+	diff, err := DiffCatalogs(context.Background(), NewSnapshotCatalog(strings.NewReader(catalogSnapshot)), store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff.Empty() {
+		t.Fatal("Expected differences")
+	}
+	if len(diff.MissingInTarget) != 1 || diff.MissingInTarget[0].SKU != "C" {
+		t.Errorf("Unexpected MissingInTarget %+v", diff.MissingInTarget)
+	}
+	if len(diff.MissingInSource) != 1 || diff.MissingInSource[0].SKU != "D" {
+		t.Errorf("Unexpected MissingInSource %+v", diff.MissingInSource)
+	}
+	if len(diff.PriceMismatches) != 1 || diff.PriceMismatches[0].TargetPrice != 18.5 {
+		t.Errorf("Unexpected PriceMismatches %+v", diff.PriceMismatches)
+	}
+	if len(diff.StockDeltas) != 1 || diff.StockDeltas[0].Delta != 3 {
+		t.Errorf("Unexpected StockDeltas %+v", diff.StockDeltas)
+	}
+}
+
+func TestWriteCatalogSnapshot(t *testing.T) {
+	var buf bytes.Buffer
+	source := NewSnapshotCatalog(strings.NewReader(catalogSnapshot))
+	if err := WriteCatalogSnapshot(context.Background(), &buf, source); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := DiffCatalogs(context.Background(), NewSnapshotCatalog(&buf), NewSnapshotCatalog(strings.NewReader(catalogSnapshot)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("Expected round-tripped snapshot to match, got %+v", diff)
+	}
+}
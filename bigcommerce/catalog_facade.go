@@ -0,0 +1,310 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CategoryAPI is the category interface of CategoryService, implemented with
+// the V2 API by the facade returned by Client.CatalogFacade
+type CategoryAPI interface {
+	List(ctx context.Context, opts *CategoryListOptions) ([]*Category, *Response, error)
+	Get(ctx context.Context, id int64) (*Category, *Response, error)
+	Create(ctx context.Context, category *Category) (*Category, *Response, error)
+	Update(ctx context.Context, id int64, category *Category) (*Category, *Response, error)
+	Delete(ctx context.Context, id int64) (*Response, error)
+}
+
+// BrandAPI is the brand interface of BrandService, implemented with the V2
+// API by the facade returned by Client.CatalogFacade
+type BrandAPI interface {
+	List(ctx context.Context, opts *BrandListOptions) ([]*Brand, *Response, error)
+	Get(ctx context.Context, id int64) (*Brand, *Response, error)
+	Create(ctx context.Context, brand *Brand) (*Brand, *Response, error)
+	Update(ctx context.Context, id int64, brand *Brand) (*Brand, *Response, error)
+	Delete(ctx context.Context, id int64) (*Response, error)
+}
+
+// CatalogFacade exposes categories and brands through the V3 services, or
+// through V2 adapters for stores whose token cannot use the V3 catalog
+type CatalogFacade struct {
+	Categories CategoryAPI
+	Brands     BrandAPI
+	V2         bool // Whether the V2 adapters are used.
+}
+
+// CatalogFacade probes the V3 catalog and returns a facade backed by the V3
+// services if the token may use it, or by the V2 endpoints if it is refused
+// with a scope error, e.g. for legacy tokens or presets without V3 access
+func (c *Client) CatalogFacade(ctx context.Context) (*CatalogFacade, error) {
+	_, _, err := c.Categories.List(ctx, &CategoryListOptions{ListOptions: ListOptions{Limit: 1}})
+	if err == nil {
+		return &CatalogFacade{Categories: c.Categories, Brands: c.Brands}, nil
+	}
+	if Classify(err).Category != ScopeError {
+		return nil, err
+	}
+	return &CatalogFacade{Categories: &v2Categories{c}, Brands: &v2Brands{c}, V2: true}, nil
+}
+
+// v2Category is the V2 representation of a category
+type v2Category struct {
+	ID              int64  `json:"id,omitempty"`
+	ParentID        int64  `json:"parent_id"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	SortOrder       int64  `json:"sort_order,omitempty"`
+	PageTitle       string `json:"page_title,omitempty"`
+	MetaKeywords    string `json:"meta_keywords,omitempty"` // Comma-separated.
+	MetaDescription string `json:"meta_description,omitempty"`
+	LayoutFile      string `json:"layout_file,omitempty"`
+	ImageFile       string `json:"image_file,omitempty"`
+	IsVisible       bool   `json:"is_visible"`
+	SearchKeywords  string `json:"search_keywords,omitempty"`
+	URL             string `json:"url,omitempty"`
+}
+
+func newV2Category(c *Category) *v2Category {
+	v := &v2Category{
+		ID:              c.ID,
+		ParentID:        c.ParentID,
+		Name:            c.Name,
+		Description:     c.Description,
+		SortOrder:       c.SortOrder,
+		PageTitle:       c.PageTitle,
+		MetaKeywords:    strings.Join(c.MetaKeywords, ","),
+		MetaDescription: c.MetaDescription,
+		LayoutFile:      c.LayoutFile,
+		IsVisible:       c.IsVisible,
+		SearchKeywords:  c.SearchKeywords,
+	}
+	if c.CustomURL != nil {
+		v.URL = c.CustomURL.URL
+	}
+	return v
+}
+
+func (v *v2Category) category() *Category {
+	c := &Category{
+		ID:              v.ID,
+		ParentID:        v.ParentID,
+		Name:            v.Name,
+		Description:     v.Description,
+		SortOrder:       v.SortOrder,
+		PageTitle:       v.PageTitle,
+		MetaKeywords:    splitKeywords(v.MetaKeywords),
+		MetaDescription: v.MetaDescription,
+		LayoutFile:      v.LayoutFile,
+		ImageURL:        v.ImageFile,
+		IsVisible:       v.IsVisible,
+		SearchKeywords:  v.SearchKeywords,
+	}
+	if v.URL != "" {
+		c.CustomURL = &CatalogCustomURL{URL: v.URL}
+	}
+	return c
+}
+
+// v2Categories implements CategoryAPI with the V2 endpoints
+type v2Categories struct {
+	client *Client
+}
+
+// List returns a page of categories. V2 cannot filter by ID, so categories
+// filtered by IDs are fetched one at a time, skipping missing ones.
+func (s *v2Categories) List(ctx context.Context, opts *CategoryListOptions) ([]*Category, *Response, error) {
+	if opts != nil && len(opts.IDs) > 0 {
+		var categories []*Category
+		resp, err := getEach(opts.IDs, func(id int64) (*Response, error) {
+			category, resp, err := s.Get(ctx, id)
+			if err == nil {
+				categories = append(categories, category)
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		return categories, resp, nil
+	}
+	var v2opts struct {
+		ListOptions
+		ParentID  *int64 `url:"parent_id,omitempty"`
+		Name      string `url:"name,omitempty"`
+		IsVisible *bool  `url:"is_visible,omitempty"`
+	}
+	if opts != nil {
+		v2opts.ListOptions, v2opts.ParentID, v2opts.Name, v2opts.IsVisible = opts.ListOptions, opts.ParentID, opts.Name, opts.IsVisible
+	}
+	path, err := addOptions("v2/categories", &v2opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var v2 []*v2Category
+	resp, err := s.client.call(ctx, "GET", path, nil, &v2)
+	if err != nil {
+		return nil, resp, err
+	}
+	categories := make([]*Category, len(v2))
+	for i, v := range v2 {
+		categories[i] = v.category()
+	}
+	return categories, resp, nil
+}
+
+func (s *v2Categories) Get(ctx context.Context, id int64) (*Category, *Response, error) {
+	v := new(v2Category)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/categories/%d", id), nil, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return v.category(), resp, nil
+}
+
+func (s *v2Categories) Create(ctx context.Context, category *Category) (*Category, *Response, error) {
+	created := new(v2Category)
+	resp, err := s.client.call(ctx, "POST", "v2/categories", newV2Category(category), created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created.category(), resp, nil
+}
+
+func (s *v2Categories) Update(ctx context.Context, id int64, category *Category) (*Category, *Response, error) {
+	v := newV2Category(category)
+	v.ID = 0
+	updated := new(v2Category)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/categories/%d", id), v, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated.category(), resp, nil
+}
+
+func (s *v2Categories) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/categories/%d", id), nil, nil)
+}
+
+func newBCBrand(b *Brand) *BCBrand {
+	return &BCBrand{
+		ID:              int(b.ID),
+		Name:            b.Name,
+		PageTitle:       b.PageTitle,
+		MetaKeywords:    strings.Join(b.MetaKeywords, ","),
+		MetaDescription: b.MetaDescription,
+		SearchKeywords:  b.SearchKeywords,
+	}
+}
+
+func brandFromV2(b *BCBrand) *Brand {
+	return &Brand{
+		ID:              int64(b.ID),
+		Name:            b.Name,
+		PageTitle:       b.PageTitle,
+		MetaKeywords:    splitKeywords(b.MetaKeywords),
+		MetaDescription: b.MetaDescription,
+		ImageURL:        b.ImageFile,
+		SearchKeywords:  b.SearchKeywords,
+	}
+}
+
+// v2Brands implements BrandAPI with the V2 endpoints of BrandService
+type v2Brands struct {
+	client *Client
+}
+
+// List returns a page of brands. Brands filtered by IDs are fetched one at a
+// time, skipping missing ones.
+func (s *v2Brands) List(ctx context.Context, opts *BrandListOptions) ([]*Brand, *Response, error) {
+	if opts != nil && len(opts.IDs) > 0 {
+		var brands []*Brand
+		resp, err := getEach(opts.IDs, func(id int64) (*Response, error) {
+			brand, resp, err := s.Get(ctx, id)
+			if err == nil {
+				brands = append(brands, brand)
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		return brands, resp, nil
+	}
+	var v2opts struct {
+		ListOptions
+		Name string `url:"name,omitempty"`
+	}
+	if opts != nil {
+		v2opts.ListOptions, v2opts.Name = opts.ListOptions, opts.Name
+	}
+	path, err := addOptions("v2/brands", &v2opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var v2 []*BCBrand
+	resp, err := s.client.call(ctx, "GET", path, nil, &v2)
+	if err != nil {
+		return nil, resp, err
+	}
+	brands := make([]*Brand, len(v2))
+	for i, b := range v2 {
+		brands[i] = brandFromV2(b)
+	}
+	return brands, resp, nil
+}
+
+func (s *v2Brands) Get(ctx context.Context, id int64) (*Brand, *Response, error) {
+	b, resp, err := s.client.Brands.GetV2(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brandFromV2(b), resp, nil
+}
+
+func (s *v2Brands) Create(ctx context.Context, brand *Brand) (*Brand, *Response, error) {
+	b, resp, err := s.client.Brands.CreateV2(ctx, newBCBrand(brand))
+	if err != nil {
+		return nil, resp, err
+	}
+	return brandFromV2(b), resp, nil
+}
+
+func (s *v2Brands) Update(ctx context.Context, id int64, brand *Brand) (*Brand, *Response, error) {
+	v := newBCBrand(brand)
+	v.ID = 0
+	b, resp, err := s.client.Brands.UpdateV2(ctx, id, v)
+	if err != nil {
+		return nil, resp, err
+	}
+	return brandFromV2(b), resp, nil
+}
+
+func (s *v2Brands) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.Brands.DeleteV2(ctx, id)
+}
+
+// getEach calls get for each ID, skipping resources not found
+func getEach(ids []int64, get func(id int64) (*Response, error)) (*Response, error) {
+	var resp *Response
+	for _, id := range ids {
+		r, err := get(id)
+		resp = r
+		if err != nil && Classify(err).Category != NotFoundError {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+func splitKeywords(s string) []string {
+	var keywords []string
+	for _, k := range strings.Split(s, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keywords = append(keywords, k)
+		}
+	}
+	return keywords
+}
@@ -0,0 +1,103 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_CatalogFacade_v3(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"limit": "1"})
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	facade, err := client.CatalogFacade(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if facade.V2 || facade.Categories != CategoryAPI(client.Categories) || facade.Brands != BrandAPI(client.Brands) {
+		t.Errorf("CatalogFacade = %+v, want the V3 services", facade)
+	}
+}
+
+func TestClient_CatalogFacade_v2(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":403,"title":"You don't have a required scope to access the endpoint"}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("/stores/abc123/v2/categories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			testQuery(t, r, map[string]string{"parent_id": "0", "limit": "5"})
+			fmt.Fprint(w, `[{"id":18,"parent_id":0,"name":"Shirts","meta_keywords":"cotton, tees","is_visible":true,"url":"/shirts/"}]`)
+			return
+		}
+		testMethod(t, r, "POST")
+		testBody(t, r, new(v2Category), &v2Category{Name: "Hats", MetaKeywords: "caps,beanies"})
+		fmt.Fprint(w, `{"id":19,"name":"Hats","meta_keywords":"caps,beanies"}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/categories/20", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `[{"status":404,"message":"not found"}]`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/stores/abc123/v2/categories/18", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":18,"name":"Shirts"}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/brands/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(BCBrand), &BCBrand{Name: "Acme"})
+		fmt.Fprint(w, `{"id":3,"name":"Acme","image_file":"a.jpg"}`)
+	})
+
+	ctx := context.Background()
+	facade, err := client.CatalogFacade(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !facade.V2 {
+		t.Fatal("CatalogFacade did not fall back to V2")
+	}
+
+	categories, _, err := facade.Categories.List(ctx, &CategoryListOptions{ListOptions: ListOptions{Limit: 5}, ParentID: Int64(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Category{{ID: 18, Name: "Shirts", MetaKeywords: []string{"cotton", "tees"}, IsVisible: true, CustomURL: &CatalogCustomURL{URL: "/shirts/"}}}
+	if !reflect.DeepEqual(categories, want) {
+		t.Errorf("Categories.List returned %+v, want %+v", categories, want)
+	}
+
+	created, _, err := facade.Categories.Create(ctx, &Category{Name: "Hats", MetaKeywords: []string{"caps", "beanies"}})
+	if err != nil || created.ID != 19 {
+		t.Errorf("Categories.Create returned %+v, %v", created, err)
+	}
+
+	byID, _, err := facade.Categories.List(ctx, &CategoryListOptions{IDs: []int64{18, 20}})
+	if err != nil || len(byID) != 1 || byID[0].ID != 18 {
+		t.Errorf("Categories.List by ID returned %+v, %v", byID, err)
+	}
+
+	brand, _, err := facade.Brands.Update(ctx, 3, &Brand{ID: 3, Name: "Acme"})
+	if err != nil || !reflect.DeepEqual(brand, &Brand{ID: 3, Name: "Acme", ImageURL: "a.jpg"}) {
+		t.Errorf("Brands.Update returned %+v, %v", brand, err)
+	}
+}
+
+func TestClient_CatalogFacade_error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":401,"title":"Unauthorized"}`, http.StatusUnauthorized)
+	})
+
+	if _, err := client.CatalogFacade(context.Background()); Classify(err).Category != AuthError {
+		t.Errorf("CatalogFacade returned %v, want an auth error", err)
+	}
+}
@@ -0,0 +1,215 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Defaults of CatalogFreezer
+const (
+	DefaultFreezeNamespace = "bigcommerce-go-client.freeze"
+	freezeKey              = "availability"
+	maxMetafieldBatch      = 50
+)
+
+// ErrThawIncomplete is returned by Thaw when products were restored but their
+// freeze records could not be removed. Thawing them again removes the records,
+// restoring the recorded availability once more.
+var ErrThawIncomplete = errors.New("bigcommerce: thaw incomplete")
+
+// CatalogFreezer takes products off sale for planned maintenance or an
+// inventory count and puts them back afterwards. Freeze records the
+// availability of each product in a metafield before disabling it, so Thaw
+// restores it even from another process or after a crash.
+type CatalogFreezer struct {
+	Client    *Client
+	Namespace string // Namespace of the metafields recording prior state, DefaultFreezeNamespace if empty.
+}
+
+// FreezeResult lists the products affected by Freeze or Thaw
+type FreezeResult struct {
+	Changed []int64 // Products frozen or thawed.
+	Skipped []int64 // Products already frozen by Freeze, or not frozen by Thaw.
+}
+
+func (f *CatalogFreezer) namespace() string {
+	if f.Namespace == "" {
+		return DefaultFreezeNamespace
+	}
+	return f.Namespace
+}
+
+// Freeze disables the products. Products already frozen keep the availability
+// recorded by the first freeze, and are skipped unless that freeze failed
+// before disabling them.
+func (f *CatalogFreezer) Freeze(ctx context.Context, productIDs []int64) (*FreezeResult, error) {
+	if len(productIDs) == 0 {
+		return &FreezeResult{}, nil
+	}
+	frozen, err := f.frozen(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FreezeResult{}
+	var records []*Metafield
+	var updates []*CatalogProduct
+	for start := 0; start < len(productIDs); start += maxProductBatch {
+		ids := productIDs[start:minInt(start+maxProductBatch, len(productIDs))]
+		products, _, err := f.Client.Catalog.ListProducts(ctx, &CatalogProductListOptions{
+			ListOptions:   ListOptions{Limit: maxProductBatch},
+			IDs:           ids,
+			IncludeFields: []string{"availability"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range products {
+			if _, ok := frozen[p.ID]; ok {
+				// A freeze that failed after recording leaves products on
+				// sale; disable them, keeping the recorded availability.
+				if p.Availability != string(DisabledProduct) {
+					updates = append(updates, &CatalogProduct{ID: p.ID, Availability: string(DisabledProduct)})
+				} else {
+					result.Skipped = append(result.Skipped, p.ID)
+				}
+				continue
+			}
+			records = append(records, &Metafield{
+				Namespace:     f.namespace(),
+				Key:           freezeKey,
+				Value:         p.Availability,
+				PermissionSet: AppOnlyMetafield,
+				ResourceID:    p.ID,
+			})
+			updates = append(updates, &CatalogProduct{ID: p.ID, Availability: string(DisabledProduct)})
+		}
+	}
+
+	// Record every prior state before changing anything
+	for start := 0; start < len(records); start += maxMetafieldBatch {
+		batch := records[start:minInt(start+maxMetafieldBatch, len(records))]
+		if _, _, err := f.Client.Metafields.CreateBatch(ctx, ProductMetafields, batch); err != nil {
+			return result, err
+		}
+	}
+	for start := 0; start < len(updates); start += maxProductBatch {
+		batch := updates[start:minInt(start+maxProductBatch, len(updates))]
+		if _, _, err := f.Client.Catalog.UpdateProducts(ctx, batch); err != nil {
+			return result, err
+		}
+		for _, p := range batch {
+			result.Changed = append(result.Changed, p.ID)
+		}
+	}
+	return result, nil
+}
+
+// Thaw restores the availability of frozen products and removes their
+// records. A nil productIDs thaws every frozen product. If a record cannot be
+// removed after its product was restored, the error wraps ErrThawIncomplete
+// and the product is listed as changed.
+func (f *CatalogFreezer) Thaw(ctx context.Context, productIDs []int64) (*FreezeResult, error) {
+	frozen, err := f.frozen(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FreezeResult{}
+	var updates []*CatalogProduct
+	var records []int64
+	if productIDs == nil {
+		for id := range frozen {
+			productIDs = append(productIDs, id)
+		}
+		sort.Slice(productIDs, func(i, j int) bool { return productIDs[i] < productIDs[j] })
+	}
+	for _, id := range productIDs {
+		m, ok := frozen[id]
+		if !ok {
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+		updates = append(updates, &CatalogProduct{ID: id, Availability: m.Value})
+		records = append(records, m.ID)
+	}
+
+	for start := 0; start < len(updates); start += maxProductBatch {
+		end := minInt(start+maxProductBatch, len(updates))
+		if _, _, err := f.Client.Catalog.UpdateProducts(ctx, updates[start:end]); err != nil {
+			return result, err
+		}
+		var thawed []int64
+		for _, p := range updates[start:end] {
+			thawed = append(thawed, p.ID)
+		}
+		result.Changed = append(result.Changed, thawed...)
+		if _, err := f.Client.Metafields.DeleteBatch(ctx, ProductMetafields, records[start:end]); err != nil {
+			return result, fmt.Errorf("%w: freeze records of products %v left behind: %v", ErrThawIncomplete, thawed, err)
+		}
+	}
+	return result, nil
+}
+
+// Frozen returns the IDs of the products currently frozen
+func (f *CatalogFreezer) Frozen(ctx context.Context) ([]int64, error) {
+	frozen, err := f.frozen(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(frozen))
+	for id := range frozen {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// frozen returns the freeze records of the products, or of every product if
+// productIDs is nil, keyed by product ID
+func (f *CatalogFreezer) frozen(ctx context.Context, productIDs []int64) (map[int64]*Metafield, error) {
+	frozen := map[int64]*Metafield{}
+	if productIDs == nil {
+		return frozen, f.listFrozen(ctx, nil, frozen)
+	}
+	for start := 0; start < len(productIDs); start += maxProductBatch {
+		ids := productIDs[start:minInt(start+maxProductBatch, len(productIDs))]
+		if err := f.listFrozen(ctx, ids, frozen); err != nil {
+			return nil, err
+		}
+	}
+	return frozen, nil
+}
+
+// listFrozen adds the freeze records of the products, or of every product if
+// productIDs is nil, to frozen
+func (f *CatalogFreezer) listFrozen(ctx context.Context, productIDs []int64, frozen map[int64]*Metafield) error {
+	opts := &MetafieldListOptions{
+		ListOptions: ListOptions{Page: 1, Limit: 250},
+		Key:         freezeKey,
+		Namespace:   f.namespace(),
+		ResourceIDs: productIDs,
+	}
+	for {
+		metafields, resp, err := f.Client.Metafields.ListAll(ctx, ProductMetafields, opts)
+		if err != nil {
+			return err
+		}
+		for _, m := range metafields {
+			frozen[m.ResourceID] = m
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,257 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// freezeStore fakes the product and metafield endpoints used by CatalogFreezer
+type freezeStore struct {
+	mu           sync.Mutex
+	availability map[int64]string
+	metafields   map[int64]*Metafield
+	nextID       int64
+	failUpdates  bool // Whether product updates fail.
+	failDeletes  bool // Whether metafield deletes fail.
+}
+
+func (s *freezeStore) register(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.Method {
+		case "GET":
+			var products []*CatalogProduct
+			for _, id := range strings.Split(r.URL.Query().Get("id:in"), ",") {
+				n, _ := strconv.ParseInt(id, 10, 64)
+				if a, ok := s.availability[n]; ok {
+					products = append(products, &CatalogProduct{ID: n, Availability: a})
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": products})
+		case "PUT":
+			if s.failUpdates {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			var products []*CatalogProduct
+			json.NewDecoder(r.Body).Decode(&products)
+			if len(products) > maxProductBatch {
+				t.Errorf("batch of %d products", len(products))
+			}
+			for _, p := range products {
+				s.availability[p.ID] = p.Availability
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": products})
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/metafields", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.Method {
+		case "GET":
+			testQuery(t, r, map[string]string{"namespace": DefaultFreezeNamespace, "key": "availability"})
+			var metafields []*Metafield
+			if in := r.URL.Query().Get("resource_id:in"); in != "" {
+				ids := strings.Split(in, ",")
+				if len(ids) > maxProductBatch {
+					t.Errorf("query of %d products", len(ids))
+				}
+				for _, id := range ids {
+					n, _ := strconv.ParseInt(id, 10, 64)
+					if m, ok := s.metafields[n]; ok {
+						metafields = append(metafields, m)
+					}
+				}
+			} else {
+				for _, m := range s.metafields {
+					metafields = append(metafields, m)
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": metafields})
+		case "POST":
+			var metafields []*Metafield
+			json.NewDecoder(r.Body).Decode(&metafields)
+			for _, m := range metafields {
+				s.nextID++
+				m.ID = s.nextID
+				s.metafields[m.ResourceID] = m
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": metafields})
+		case "DELETE":
+			if s.failDeletes {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			var ids []int64
+			json.NewDecoder(r.Body).Decode(&ids)
+			for _, id := range ids {
+				for product, m := range s.metafields {
+					if m.ID == id {
+						delete(s.metafields, product)
+					}
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func TestCatalogFreezer(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	store := &freezeStore{availability: map[int64]string{}, metafields: map[int64]*Metafield{}}
+	var ids []int64
+	for id := int64(1); id <= 12; id++ {
+		store.availability[id] = "available"
+		ids = append(ids, id)
+	}
+	store.availability[3] = "preorder"
+	store.availability[4] = "disabled"
+	store.register(t, mux)
+
+	freezer := &CatalogFreezer{Client: client}
+	ctx := context.Background()
+
+	result, err := freezer.Freeze(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Changed, ids) || len(result.Skipped) != 0 {
+		t.Errorf("Freeze = %+v", result)
+	}
+	for id, a := range store.availability {
+		if a != "disabled" {
+			t.Errorf("product %d availability = %q after freeze", id, a)
+		}
+	}
+
+	// Freezing again keeps the first record
+	result, err = freezer.Freeze(ctx, []int64{3})
+	if err != nil || len(result.Changed) != 0 || !reflect.DeepEqual(result.Skipped, []int64{3}) {
+		t.Errorf("Freeze again = %+v, %v", result, err)
+	}
+
+	frozen, err := freezer.Frozen(ctx)
+	if err != nil || !reflect.DeepEqual(frozen, ids) {
+		t.Errorf("Frozen = %v, %v", frozen, err)
+	}
+
+	result, err = freezer.Thaw(ctx, nil)
+	if err != nil || !reflect.DeepEqual(result.Changed, ids) {
+		t.Errorf("Thaw = %+v, %v", result, err)
+	}
+	for id, a := range store.availability {
+		want := "available"
+		switch id {
+		case 3:
+			want = "preorder"
+		case 4:
+			want = "disabled"
+		}
+		if a != want {
+			t.Errorf("product %d availability = %q after thaw, want %q", id, a, want)
+		}
+	}
+	if len(store.metafields) != 0 {
+		t.Errorf("%d freeze records left after thaw", len(store.metafields))
+	}
+}
+
+func TestCatalogFreezer_retry(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	store := &freezeStore{availability: map[int64]string{1: "available", 2: "preorder"}, metafields: map[int64]*Metafield{}, failUpdates: true}
+	store.register(t, mux)
+	freezer := &CatalogFreezer{Client: client}
+	ctx := context.Background()
+
+	if _, err := freezer.Freeze(ctx, []int64{1, 2}); err == nil {
+		t.Fatal("Freeze returned no error")
+	}
+
+	// The retry disables the products recorded by the failed freeze
+	store.failUpdates = false
+	result, err := freezer.Freeze(ctx, []int64{1, 2})
+	if err != nil || !reflect.DeepEqual(result.Changed, []int64{1, 2}) {
+		t.Errorf("Freeze retry = %+v, %v", result, err)
+	}
+	if want := map[int64]string{1: "disabled", 2: "disabled"}; !reflect.DeepEqual(store.availability, want) {
+		t.Errorf("availability = %v after retry, want %v", store.availability, want)
+	}
+	if store.metafields[2].Value != "preorder" {
+		t.Errorf("record of product 2 = %q, want preorder", store.metafields[2].Value)
+	}
+}
+
+func TestCatalogFreezer_ThawIncomplete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	store := &freezeStore{availability: map[int64]string{1: "available"}, metafields: map[int64]*Metafield{}}
+	store.register(t, mux)
+	freezer := &CatalogFreezer{Client: client}
+	ctx := context.Background()
+
+	if _, err := freezer.Freeze(ctx, []int64{1}); err != nil {
+		t.Fatal(err)
+	}
+	store.failDeletes = true
+	result, err := freezer.Thaw(ctx, []int64{1})
+	if !errors.Is(err, ErrThawIncomplete) || !reflect.DeepEqual(result.Changed, []int64{1}) {
+		t.Errorf("Thaw = %+v, %v", result, err)
+	}
+	if store.availability[1] != "available" || len(store.metafields) != 1 {
+		t.Errorf("availability = %q with %d records after thaw", store.availability[1], len(store.metafields))
+	}
+
+	// Thawing again removes the record left behind
+	store.failDeletes = false
+	if _, err := freezer.Thaw(ctx, []int64{1}); err != nil || len(store.metafields) != 0 {
+		t.Errorf("Thaw again = %v with %d records left", err, len(store.metafields))
+	}
+}
+
+func TestCatalogFreezer_ThawSkipsUnfrozen(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var deleted []int64
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/metafields", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			json.NewDecoder(r.Body).Decode(&deleted)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testQuery(t, r, map[string]string{"resource_id:in": "5,6"})
+		fmt.Fprint(w, `{"data":[{"id":9,"resource_id":6,"key":"availability","value":"preorder"}],"meta":{}}`)
+	})
+	var restored []*CatalogProduct
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		json.NewDecoder(r.Body).Decode(&restored)
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	freezer := &CatalogFreezer{Client: client}
+	result, err := freezer.Thaw(context.Background(), []int64{5, 6})
+	if err != nil || !reflect.DeepEqual(result.Changed, []int64{6}) || !reflect.DeepEqual(result.Skipped, []int64{5}) {
+		t.Errorf("Thaw = %+v, %v", result, err)
+	}
+	if !reflect.DeepEqual(deleted, []int64{9}) {
+		t.Errorf("deleted records %v, want [9]", deleted)
+	}
+	if len(restored) != 1 || restored[0].ID != 6 || restored[0].Availability != "preorder" {
+		t.Errorf("restored %+v", restored)
+	}
+}
@@ -0,0 +1,165 @@
+package bigcommerce
+
+import (
+	"context"
+	"sort"
+)
+
+// CatalogProfiler streams a store's catalog once and reports the distributions
+// of its products, for capacity planning and spotting data pathologies before
+// a migration
+type CatalogProfiler struct {
+	Client   *Client
+	PageSize int // Products per page, 250 if zero.
+
+	// PriceBuckets are the upper bounds of the price histogram buckets, in
+	// ascending order. Prices above the last bound fall in a final open bucket.
+	// Defaults to DefaultPriceBuckets.
+	PriceBuckets []float64
+}
+
+// DefaultPriceBuckets are the price histogram bounds used when none are set
+var DefaultPriceBuckets = []float64{10, 25, 50, 100, 250, 500, 1000}
+
+// CatalogProfile reports the distributions found by CatalogProfiler
+type CatalogProfile struct {
+	Products            int              // Number of products.
+	Variants            int              // Number of variants, including base variants.
+	ProductsPerCategory map[int64]int    // Number of products in each category ID.
+	Uncategorized       int              // Products without a category.
+	VariantCounts       Distribution     // Variants per product.
+	DescriptionSizes    Distribution     // Description length per product, in bytes.
+	ImageCounts         Distribution     // Images per product.
+	Prices              []PriceBucket    // Histogram of product prices.
+	Largest             []*ProfileRecord // The products with the most variants, largest first.
+}
+
+// Distribution summarises a set of per-product values
+type Distribution struct {
+	Min  int
+	Max  int
+	Mean float64
+	P50  int
+	P95  int
+	P99  int
+}
+
+// PriceBucket counts the products priced in [Min, Max). Max is zero for the final open bucket.
+type PriceBucket struct {
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// ProfileRecord identifies a product noted by the profiler
+type ProfileRecord struct {
+	ProductID int64
+	Name      string
+	Variants  int
+}
+
+// profileLargest is the number of products kept in CatalogProfile.Largest
+const profileLargest = 10
+
+// Profile reads every product with its variants and images and returns the profile
+func (p *CatalogProfiler) Profile(ctx context.Context) (*CatalogProfile, error) {
+	bounds := p.PriceBuckets
+	if len(bounds) == 0 {
+		bounds = DefaultPriceBuckets
+	}
+	profile := &CatalogProfile{ProductsPerCategory: map[int64]int{}}
+	for i := range bounds {
+		b := PriceBucket{Max: bounds[i]}
+		if i > 0 {
+			b.Min = bounds[i-1]
+		}
+		profile.Prices = append(profile.Prices, b)
+	}
+	profile.Prices = append(profile.Prices, PriceBucket{Min: bounds[len(bounds)-1]})
+
+	var variants, descriptions, images []int
+	opts := &CatalogProductListOptions{ListOptions: ListOptions{Page: 1, Limit: p.PageSize}, Include: []string{"variants", "images"}}
+	if opts.Limit == 0 {
+		opts.Limit = 250
+	}
+	for {
+		products, resp, err := p.Client.Catalog.ListProducts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, product := range products {
+			profile.Products++
+			profile.Variants += len(product.Variants)
+			variants = append(variants, len(product.Variants))
+			descriptions = append(descriptions, len(product.Description))
+			images = append(images, len(product.Images))
+
+			if len(product.Categories) == 0 {
+				profile.Uncategorized++
+			}
+			for _, id := range product.Categories {
+				profile.ProductsPerCategory[id]++
+			}
+			i := sort.SearchFloat64s(bounds, product.Price)
+			if i < len(bounds) && bounds[i] == product.Price {
+				i++ // Bucket bounds are exclusive.
+			}
+			profile.Prices[i].Count++
+			profile.noteLargest(product)
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+
+	profile.VariantCounts = distribution(variants)
+	profile.DescriptionSizes = distribution(descriptions)
+	profile.ImageCounts = distribution(images)
+	return profile, nil
+}
+
+// noteLargest keeps the products with the most variants
+func (c *CatalogProfile) noteLargest(product *CatalogProduct) {
+	n := len(product.Variants)
+	if len(c.Largest) == profileLargest && n <= c.Largest[profileLargest-1].Variants {
+		return
+	}
+	record := &ProfileRecord{ProductID: product.ID, Name: product.Name, Variants: n}
+	i := sort.Search(len(c.Largest), func(i int) bool { return c.Largest[i].Variants < n })
+	c.Largest = append(c.Largest, nil)
+	copy(c.Largest[i+1:], c.Largest[i:])
+	c.Largest[i] = record
+	if len(c.Largest) > profileLargest {
+		c.Largest = c.Largest[:profileLargest]
+	}
+}
+
+func distribution(values []int) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+	sort.Ints(values)
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	rank := func(p float64) int {
+		i := int(p*float64(len(values))+0.5) - 1
+		if i < 0 {
+			i = 0
+		}
+		if i >= len(values) {
+			i = len(values) - 1
+		}
+		return values[i]
+	}
+	return Distribution{
+		Min:  values[0],
+		Max:  values[len(values)-1],
+		Mean: float64(sum) / float64(len(values)),
+		P50:  rank(0.5),
+		P95:  rank(0.95),
+		P99:  rank(0.99),
+	}
+}
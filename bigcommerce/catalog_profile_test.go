@@ -0,0 +1,62 @@
+package bigcommerce
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestCatalogProfiler_Profile(t *testing.T) {
+	server := bctest.NewServer()
+	server.Seed("v3/catalog/products",
+		&CatalogProduct{Name: "Mug", Price: 8, Categories: []int64{1}, Description: "Holds coffee",
+			Variants: []*Variant{{SKU: "MUG"}}, Images: []*CatalogImage{{ImageFile: "a.jpg"}}},
+		&CatalogProduct{Name: "Shirt", Price: 25, Categories: []int64{1, 2}, Description: strings.Repeat("x", 2000),
+			Variants: []*Variant{{SKU: "S"}, {SKU: "M"}, {SKU: "L"}}},
+		&CatalogProduct{Name: "Sofa", Price: 1500},
+	)
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+
+	profiler := &CatalogProfiler{Client: client, PageSize: 2, PriceBuckets: []float64{10, 100}}
+	profile, err := profiler.Profile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.Products != 3 || profile.Variants != 4 || profile.Uncategorized != 1 {
+		t.Errorf("Unexpected totals %+v", profile)
+	}
+	if profile.ProductsPerCategory[1] != 2 || profile.ProductsPerCategory[2] != 1 {
+		t.Errorf("ProductsPerCategory = %v", profile.ProductsPerCategory)
+	}
+	if got, want := profile.VariantCounts, (Distribution{Min: 0, Max: 3, Mean: 4.0 / 3, P50: 1, P95: 3, P99: 3}); got != want {
+		t.Errorf("VariantCounts = %+v, want %+v", got, want)
+	}
+	if profile.DescriptionSizes.Max != 2000 || profile.ImageCounts.Max != 1 {
+		t.Errorf("Unexpected distributions %+v %+v", profile.DescriptionSizes, profile.ImageCounts)
+	}
+	want := []PriceBucket{{0, 10, 1}, {10, 100, 1}, {100, 0, 1}}
+	for i, b := range want {
+		if profile.Prices[i] != b {
+			t.Errorf("Prices[%d] = %+v, want %+v", i, profile.Prices[i], b)
+		}
+	}
+	if len(profile.Largest) != 3 || profile.Largest[0].Name != "Shirt" || profile.Largest[2].Name != "Sofa" {
+		t.Errorf("Largest = %+v", profile.Largest)
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	if got := distribution(nil); got != (Distribution{}) {
+		t.Errorf("distribution(nil) = %+v", got)
+	}
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = 100 - i
+	}
+	if got := distribution(values); got.P50 != 50 || got.P95 != 95 || got.Min != 1 || got.Max != 100 {
+		t.Errorf("distribution = %+v", got)
+	}
+}
@@ -0,0 +1,50 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCatalogService_Summary(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/summary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"inventory_count":1204,"inventory_value":31200.5,"primary_category_id":23,"primary_category_name":"Shop All",
+			"variant_count":310,"highest_variant_price":225,"average_variant_price":41.3,"lowest_variant_price":"4.99",
+			"oldest_variant_date":"2018-08-15T14:48:46+00:00","newest_variant_date":"2021-03-01T09:00:00+00:00"},"meta":{}}`)
+	})
+
+	summary, _, err := client.Catalog.Summary(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CatalogSummary{
+		InventoryCount: 1204, InventoryValue: 31200.5, PrimaryCategoryID: 23, PrimaryCategoryName: "Shop All",
+		VariantCount: 310, HighestVariantPrice: 225, AverageVariantPrice: 41.3, LowestVariantPrice: "4.99",
+		OldestVariantDate: "2018-08-15T14:48:46+00:00", NewestVariantDate: "2021-03-01T09:00:00+00:00",
+	}
+	if *summary != *want {
+		t.Errorf("Summary = %+v, want %+v", summary, want)
+	}
+}
+
+func TestCatalogService_UpdateProducts(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*CatalogProduct{{ID: 1, Availability: "disabled"}, {ID: 2, Availability: "disabled"}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*CatalogProduct{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":1,"availability":"disabled"},{"id":2,"availability":"disabled"}],"meta":{}}`)
+	})
+
+	products, _, err := client.Catalog.UpdateProducts(context.Background(), input)
+	if err != nil || len(products) != 2 || products[1].Availability != "disabled" {
+		t.Errorf("UpdateProducts = %+v, %v", products, err)
+	}
+}
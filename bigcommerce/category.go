@@ -0,0 +1,239 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// CategoryService handles communication with the V3 category endpoints
+type CategoryService service
+
+// Category describes a BigCommerce V3 Category Object
+type Category struct {
+	ID                 int64             `json:"id,omitempty"`                   // The unique numerical ID of the category.
+	ParentID           int64             `json:"parent_id"`                      // The ID of the parent category, 0 for top-level categories.
+	Name               string            `json:"name,omitempty"`                 // The category name, unique among siblings.
+	Description        string            `json:"description,omitempty"`          // Category description, which can include HTML.
+	Views              int64             `json:"views,omitempty"`                // Number of times the category was viewed.
+	SortOrder          int64             `json:"sort_order,omitempty"`           // Order in which the category is displayed among its siblings.
+	PageTitle          string            `json:"page_title,omitempty"`           // Custom title for the category page.
+	MetaKeywords       []string          `json:"meta_keywords,omitempty"`        // Keywords for the page's meta tags.
+	MetaDescription    string            `json:"meta_description,omitempty"`     // Description for the page's meta tags.
+	LayoutFile         string            `json:"layout_file,omitempty"`          // The theme template used for the category page.
+	ImageURL           string            `json:"image_url,omitempty"`            // URL of the category's image.
+	IsVisible          bool              `json:"is_visible"`                     // Whether the category is shown on the storefront.
+	SearchKeywords     string            `json:"search_keywords,omitempty"`      // Keywords used to find the category in storefront search.
+	DefaultProductSort string            `json:"default_product_sort,omitempty"` // How products are sorted on the category page.
+	CustomURL          *CatalogCustomURL `json:"custom_url,omitempty"`           // The category's storefront URL.
+}
+
+// CategoryListOptions specifies the optional parameters to CategoryService.List
+type CategoryListOptions struct {
+	ListOptions
+	IDs       []int64 `url:"id:in,omitempty"`     // Filter by category IDs.
+	ParentID  *int64  `url:"parent_id,omitempty"` // Filter by parent; use Int64(0) for top-level categories.
+	Name      string  `url:"name,omitempty"`      // Filter by exact name.
+	IsVisible *bool   `url:"is_visible,omitempty"`
+}
+
+// List returns a page of categories
+func (s *CategoryService) List(ctx context.Context, opts *CategoryListOptions) ([]*Category, *Response, error) {
+	path, err := addOptions("v3/catalog/categories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []*Category
+	resp, err := s.client.call(ctx, "GET", path, nil, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+	return categories, resp, nil
+}
+
+// Get returns a single category
+func (s *CategoryService) Get(ctx context.Context, id int64) (*Category, *Response, error) {
+	category := new(Category)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/catalog/categories/%d", id), nil, category)
+	if err != nil {
+		return nil, resp, err
+	}
+	return category, resp, nil
+}
+
+// Create adds a category. Name and ParentID are required.
+func (s *CategoryService) Create(ctx context.Context, category *Category) (*Category, *Response, error) {
+	created := new(Category)
+	resp, err := s.client.call(ctx, "POST", "v3/catalog/categories", category, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a category
+func (s *CategoryService) Update(ctx context.Context, id int64, category *Category) (*Category, *Response, error) {
+	updated := new(Category)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/catalog/categories/%d", id), category, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a category
+func (s *CategoryService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/catalog/categories/%d", id), nil, nil)
+}
+
+// UploadImage sets a category's image, streaming its content from r. It returns
+// the category's new image URL.
+func (s *CategoryService) UploadImage(ctx context.Context, id int64, filename string, r io.Reader) (string, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/categories/%d/image", id)
+	req, err := s.client.NewUploadRequest(ctx, "POST", path, "image_file", filename, r, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var image struct {
+		ImageURL string `json:"image_url"`
+	}
+	resp, err := s.client.Do(req, &envelope{Data: &image})
+	if err != nil {
+		return "", resp, err
+	}
+	return image.ImageURL, resp, nil
+}
+
+// UploadImageFile sets a category's image from a local file
+func (s *CategoryService) UploadImageFile(ctx context.Context, id int64, name string) (string, *Response, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	return s.UploadImage(ctx, id, filepath.Base(name), f)
+}
+
+// DeleteImage removes a category's image
+func (s *CategoryService) DeleteImage(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/catalog/categories/%d/image", id), nil, nil)
+}
+
+// Tree fetches every category and assembles them into a CategoryTree
+func (s *CategoryService) Tree(ctx context.Context) (*CategoryTree, error) {
+	var all []*Category
+	opts := &CategoryListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}}
+	for {
+		categories, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, categories...)
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+	return NewCategoryTree(all), nil
+}
+
+// CategoryTree is an in-memory tree of categories, for building navigation
+type CategoryTree struct {
+	Roots []*CategoryNode // Top-level categories, ordered by sort order and name.
+	nodes map[int64]*CategoryNode
+}
+
+// CategoryNode is a category within a CategoryTree
+type CategoryNode struct {
+	*Category
+	Parent   *CategoryNode   // Nil for top-level categories.
+	Children []*CategoryNode // Ordered by sort order and name.
+}
+
+// NewCategoryTree assembles categories into a tree. Categories whose parent is
+// missing are treated as top-level categories.
+func NewCategoryTree(categories []*Category) *CategoryTree {
+	t := &CategoryTree{nodes: make(map[int64]*CategoryNode, len(categories))}
+	for _, c := range categories {
+		t.nodes[c.ID] = &CategoryNode{Category: c}
+	}
+	for _, c := range categories {
+		node := t.nodes[c.ID]
+		if parent, ok := t.nodes[c.ParentID]; ok && c.ParentID != c.ID {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		} else {
+			t.Roots = append(t.Roots, node)
+		}
+	}
+
+	sortCategoryNodes(t.Roots)
+	for _, node := range t.nodes {
+		sortCategoryNodes(node.Children)
+	}
+	return t
+}
+
+func sortCategoryNodes(nodes []*CategoryNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].SortOrder != nodes[j].SortOrder {
+			return nodes[i].SortOrder < nodes[j].SortOrder
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+}
+
+// Node returns the node of a category, or nil if the tree does not contain it
+func (t *CategoryTree) Node(id int64) *CategoryNode {
+	return t.nodes[id]
+}
+
+// Len returns the number of categories in the tree
+func (t *CategoryTree) Len() int {
+	return len(t.nodes)
+}
+
+// Path returns the categories from the top level down to the given category,
+// e.g. for breadcrumbs, or nil if the tree does not contain it
+func (t *CategoryTree) Path(id int64) []*Category {
+	var path []*Category
+	for node := t.nodes[id]; node != nil; node = node.Parent {
+		path = append([]*Category{node.Category}, path...)
+	}
+	return path
+}
+
+// Walk calls fn for every category in depth-first order, with the category's
+// depth (0 for top-level categories). Returning false skips the node's children.
+func (t *CategoryTree) Walk(fn func(node *CategoryNode, depth int) bool) {
+	var walk func(nodes []*CategoryNode, depth int)
+	walk = func(nodes []*CategoryNode, depth int) {
+		for _, node := range nodes {
+			if fn(node, depth) {
+				walk(node.Children, depth+1)
+			}
+		}
+	}
+	walk(t.Roots, 0)
+}
+
+// Descendants returns the IDs of every category below the given category
+func (t *CategoryTree) Descendants(id int64) []int64 {
+	var ids []int64
+	var walk func(node *CategoryNode)
+	walk = func(node *CategoryNode) {
+		for _, child := range node.Children {
+			ids = append(ids, child.ID)
+			walk(child)
+		}
+	}
+	if node := t.nodes[id]; node != nil {
+		walk(node)
+	}
+	return ids
+}
@@ -0,0 +1,120 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCategoryService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Category{Name: "Shirts", ParentID: 2, IsVisible: true}
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Category), input)
+		fmt.Fprint(w, `{"data":{"id":7,"parent_id":2,"name":"Shirts","is_visible":true,"custom_url":{"url":"/shirts/","is_customized":false}},"meta":{}}`)
+	})
+
+	category, _, err := client.Categories.Create(context.Background(), input)
+	if err != nil || category.ID != 7 || category.CustomURL.URL != "/shirts/" {
+		t.Errorf("Unexpected category %+v, %v", category, err)
+	}
+}
+
+func TestCategoryService_UploadImageFile(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories/7/image", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			file, header, err := r.FormFile("image_file")
+			if err != nil {
+				t.Fatal(err)
+			}
+			content, _ := io.ReadAll(file)
+			if header.Filename != "banner.png" || string(content) != "png-bytes" {
+				t.Errorf("Unexpected upload %v %q", header.Filename, content)
+			}
+			fmt.Fprint(w, `{"data":{"image_url":"https://cdn/banner.png"},"meta":{}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	name := filepath.Join(t.TempDir(), "banner.png")
+	if err := os.WriteFile(name, []byte("png-bytes"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	url, _, err := client.Categories.UploadImageFile(context.Background(), 7, name)
+	if err != nil || url != "https://cdn/banner.png" {
+		t.Errorf("UploadImageFile = %q, %v", url, err)
+	}
+	if _, err := client.Categories.DeleteImage(context.Background(), 7); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCategoryService_Tree(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"data":[{"id":1,"parent_id":0,"name":"Clothing","sort_order":1},{"id":2,"parent_id":1,"name":"Shirts"}],
+				"meta":{"pagination":{"total":4,"count":2,"per_page":2,"current_page":1,"total_pages":2}}}`)
+		case "2":
+			fmt.Fprint(w, `{"data":[{"id":3,"parent_id":0,"name":"Home"},{"id":4,"parent_id":2,"name":"Polos"}],
+				"meta":{"pagination":{"total":4,"count":2,"per_page":2,"current_page":2,"total_pages":2}}}`)
+		default:
+			t.Errorf("Unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	tree, err := client.Categories.Tree(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Len() != 4 || len(tree.Roots) != 2 || tree.Roots[0].Name != "Home" {
+		t.Errorf("Unexpected roots %+v", tree.Roots)
+	}
+
+	var names []string
+	for _, c := range tree.Path(4) {
+		names = append(names, c.Name)
+	}
+	if got := strings.Join(names, " > "); got != "Clothing > Shirts > Polos" {
+		t.Errorf("Path(4) = %q", got)
+	}
+	if got := tree.Descendants(1); !reflect.DeepEqual(got, []int64{2, 4}) {
+		t.Errorf("Descendants(1) = %v", got)
+	}
+
+	var outline []string
+	tree.Walk(func(node *CategoryNode, depth int) bool {
+		outline = append(outline, strings.Repeat("-", depth)+node.Name)
+		return node.ID != 2
+	})
+	if got := strings.Join(outline, ","); got != "Home,Clothing,-Shirts" {
+		t.Errorf("Walk = %q", got)
+	}
+}
+
+func TestNewCategoryTree_orphans(t *testing.T) {
+	tree := NewCategoryTree([]*Category{{ID: 5, ParentID: 99, Name: "Orphan"}, {ID: 6, ParentID: 6, Name: "Self"}})
+	if len(tree.Roots) != 2 || tree.Node(5).Parent != nil || tree.Path(6)[0].Name != "Self" {
+		t.Errorf("Unexpected tree %+v", tree.Roots)
+	}
+	if tree.Node(1) != nil || tree.Path(1) != nil {
+		t.Error("Expected unknown categories to be absent")
+	}
+}
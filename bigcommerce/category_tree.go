@@ -0,0 +1,165 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CategoryTreeService handles communication with the V3 multi-storefront
+// category tree endpoints. Each channel is assigned a tree; categories belong
+// to exactly one tree.
+type CategoryTreeService service
+
+// CatalogTree describes a BigCommerce V3 Category Tree Object
+type CatalogTree struct {
+	ID       int64   `json:"id,omitempty"`       // The unique numerical ID of the tree.
+	Name     string  `json:"name,omitempty"`     // The tree's name.
+	Channels []int64 `json:"channels,omitempty"` // IDs of the channels using the tree.
+}
+
+// TreeCategoryNode describes a category within the nested view of a tree
+type TreeCategoryNode struct {
+	ID        int64               `json:"id"`                 // The category ID.
+	ParentID  int64               `json:"parent_id"`          // The parent category ID, 0 at the top level.
+	Depth     int64               `json:"depth"`              // Depth within the tree, starting at 1.
+	Path      []int64             `json:"path"`               // IDs of the ancestor categories, from the top level.
+	Name      string              `json:"name"`               // The category name.
+	IsVisible bool                `json:"is_visible"`         // Whether the category is shown on the storefront.
+	URL       string              `json:"url,omitempty"`      // The category's storefront path.
+	Children  []*TreeCategoryNode `json:"children,omitempty"` // Child categories.
+}
+
+// TreeCategory describes a category of a tree, as used by the batch endpoints
+type TreeCategory struct {
+	CategoryID         int64            `json:"category_id,omitempty"`          // The category ID. Required on update.
+	CategoryUUID       string           `json:"category_uuid,omitempty"`        // The category's UUID. Read-only.
+	TreeID             int64            `json:"tree_id,omitempty"`              // The tree of a top-level category. Required on create unless ParentID is set.
+	ParentID           int64            `json:"parent_id,omitempty"`            // The parent category.
+	Name               string           `json:"name,omitempty"`                 // The category name, unique among siblings.
+	Description        string           `json:"description,omitempty"`          // Category description, which can include HTML.
+	Views              int64            `json:"views,omitempty"`                // Number of times the category was viewed.
+	SortOrder          int64            `json:"sort_order,omitempty"`           // Order in which the category is displayed among its siblings.
+	PageTitle          string           `json:"page_title,omitempty"`           // Custom title for the category page.
+	SearchKeywords     string           `json:"search_keywords,omitempty"`      // Keywords used to find the category in storefront search.
+	MetaKeywords       []string         `json:"meta_keywords,omitempty"`        // Keywords for the page's meta tags.
+	MetaDescription    string           `json:"meta_description,omitempty"`     // Description for the page's meta tags.
+	LayoutFile         string           `json:"layout_file,omitempty"`          // The theme template used for the category page.
+	IsVisible          *bool            `json:"is_visible,omitempty"`           // Whether the category is shown on the storefront.
+	DefaultProductSort string           `json:"default_product_sort,omitempty"` // How products are sorted on the category page.
+	URL                *TreeCategoryURL `json:"url,omitempty"`                  // The category's storefront URL.
+	ImageURL           string           `json:"image_url,omitempty"`            // URL of the category's image.
+}
+
+// TreeCategoryURL describes the storefront URL of a tree category
+type TreeCategoryURL struct {
+	Path         string `json:"path"`          // The storefront path, e.g. /shirts/.
+	IsCustomized bool   `json:"is_customized"` // Whether the path was set by the merchant rather than generated.
+}
+
+// CatalogTreeListOptions specifies the optional parameters to CategoryTreeService.List
+type CatalogTreeListOptions struct {
+	ListOptions
+	IDs        []int64 `url:"id:in,omitempty"`         // Filter by tree IDs.
+	ChannelIDs []int64 `url:"channel_id:in,omitempty"` // Filter by the channels using the tree.
+}
+
+// TreeCategoryListOptions specifies the optional parameters to CategoryTreeService.ListCategories
+type TreeCategoryListOptions struct {
+	ListOptions
+	CategoryIDs   []int64  `url:"category_id:in,omitempty"`
+	CategoryUUIDs []string `url:"category_uuid:in,omitempty"`
+	TreeIDs       []int64  `url:"tree_id:in,omitempty"`
+	ParentIDs     []int64  `url:"parent_id:in,omitempty"`
+	Name          string   `url:"name,omitempty"`
+	IsVisible     *bool    `url:"is_visible,omitempty"`
+}
+
+// List returns the store's category trees
+func (s *CategoryTreeService) List(ctx context.Context, opts *CatalogTreeListOptions) ([]*CatalogTree, *Response, error) {
+	path, err := addOptions("v3/catalog/trees", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var trees []*CatalogTree
+	resp, err := s.client.call(ctx, "GET", path, nil, &trees)
+	if err != nil {
+		return nil, resp, err
+	}
+	return trees, resp, nil
+}
+
+// Upsert creates the trees without an ID and updates the others
+func (s *CategoryTreeService) Upsert(ctx context.Context, trees []*CatalogTree) ([]*CatalogTree, *Response, error) {
+	var results []*CatalogTree
+	resp, err := s.client.call(ctx, "PUT", "v3/catalog/trees", trees, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+	return results, resp, nil
+}
+
+// Delete removes trees and their categories
+func (s *CategoryTreeService) Delete(ctx context.Context, ids []int64) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/catalog/trees", &CatalogTreeListOptions{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// Categories returns the categories of a tree as nested nodes
+func (s *CategoryTreeService) Categories(ctx context.Context, treeID int64) ([]*TreeCategoryNode, *Response, error) {
+	var nodes []*TreeCategoryNode
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/catalog/trees/%d/categories", treeID), nil, &nodes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return nodes, resp, nil
+}
+
+// ListCategories returns a page of categories across trees
+func (s *CategoryTreeService) ListCategories(ctx context.Context, opts *TreeCategoryListOptions) ([]*TreeCategory, *Response, error) {
+	path, err := addOptions("v3/catalog/trees/categories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []*TreeCategory
+	resp, err := s.client.call(ctx, "GET", path, nil, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+	return categories, resp, nil
+}
+
+// CreateCategories creates categories in one request. Each category must set
+// Name and either TreeID or ParentID.
+func (s *CategoryTreeService) CreateCategories(ctx context.Context, categories []*TreeCategory) ([]*TreeCategory, *Response, error) {
+	var created []*TreeCategory
+	resp, err := s.client.call(ctx, "POST", "v3/catalog/trees/categories", categories, &created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateCategories modifies categories in one request. Each category must set CategoryID.
+func (s *CategoryTreeService) UpdateCategories(ctx context.Context, categories []*TreeCategory) (*Response, error) {
+	return s.client.call(ctx, "PUT", "v3/catalog/trees/categories", categories, nil)
+}
+
+// DeleteCategories removes categories in one request
+func (s *CategoryTreeService) DeleteCategories(ctx context.Context, categoryIDs []int64) (*Response, error) {
+	if len(categoryIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/catalog/trees/categories", &TreeCategoryListOptions{CategoryIDs: categoryIDs})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,102 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCategoryTreeService_Upsert(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*CatalogTree{{Name: "Wholesale", Channels: []int64{2}}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*CatalogTree{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":3,"name":"Wholesale","channels":[2]}],"meta":{}}`)
+	})
+
+	trees, _, err := client.CategoryTrees.Upsert(context.Background(), input)
+	if err != nil || len(trees) != 1 || trees[0].ID != 3 {
+		t.Errorf("Unexpected trees %+v, %v", trees, err)
+	}
+}
+
+func TestCategoryTreeService_Categories(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/trees/3/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":10,"parent_id":0,"depth":1,"path":[],"name":"Clothing","is_visible":true,"url":"/clothing/",
+			"children":[{"id":11,"parent_id":10,"depth":2,"path":[10],"name":"Shirts","is_visible":true,"url":"/clothing/shirts/"}]}],"meta":{}}`)
+	})
+
+	nodes, _, err := client.CategoryTrees.Categories(context.Background(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 1 || len(nodes[0].Children) != 1 || nodes[0].Children[0].Path[0] != 10 {
+		t.Errorf("Unexpected nodes %+v", nodes)
+	}
+}
+
+func TestCategoryTreeService_batchCategories(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/trees/categories", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			testBody(t, r, &[]*TreeCategory{}, &[]*TreeCategory{{TreeID: 3, Name: "Sale", IsVisible: Bool(true)}})
+			fmt.Fprint(w, `{"data":[{"category_id":12,"tree_id":3,"name":"Sale","is_visible":true,"url":{"path":"/sale/","is_customized":false}}],"meta":{}}`)
+		case "PUT":
+			testBody(t, r, &[]*TreeCategory{}, &[]*TreeCategory{{CategoryID: 12, SortOrder: 5}})
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			testQuery(t, r, map[string]string{"category_id:in": "12,13"})
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			testQuery(t, r, map[string]string{"tree_id:in": "3"})
+			fmt.Fprint(w, `{"data":[{"category_id":12,"tree_id":3,"name":"Sale"}],"meta":{"pagination":{"total":1,"total_pages":1}}}`)
+		}
+	})
+
+	ctx := context.Background()
+	created, _, err := client.CategoryTrees.CreateCategories(ctx, []*TreeCategory{{TreeID: 3, Name: "Sale", IsVisible: Bool(true)}})
+	if err != nil || created[0].CategoryID != 12 || created[0].URL.Path != "/sale/" {
+		t.Errorf("Unexpected categories %+v, %v", created, err)
+	}
+	if _, err := client.CategoryTrees.UpdateCategories(ctx, []*TreeCategory{{CategoryID: 12, SortOrder: 5}}); err != nil {
+		t.Error(err)
+	}
+	list, _, err := client.CategoryTrees.ListCategories(ctx, &TreeCategoryListOptions{TreeIDs: []int64{3}})
+	if err != nil || len(list) != 1 {
+		t.Errorf("Unexpected categories %+v, %v", list, err)
+	}
+	if _, err := client.CategoryTrees.DeleteCategories(ctx, []int64{12, 13}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCategoryTreeService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CategoryTrees.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
+
+func TestCategoryTreeService_DeleteCategories_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CategoryTrees.DeleteCategories(ctx, nil); err != ErrNoIDs {
+		t.Errorf("DeleteCategories returned %v, want ErrNoIDs", err)
+	}
+}
@@ -0,0 +1,190 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ChangeDigest summarizes the changes made to a store over a period, e.g. for
+// an agency's monthly report to its client
+type ChangeDigest struct {
+	From, To        time.Time
+	Resources       map[string]*ResourceChanges // Keyed by resource type, e.g. "product", from webhook events.
+	SettingsChanged []*DigestEntry              // System log entries about settings.
+	ThemesActivated []*DigestEntry              // System log entries about theme activations.
+	Errors          []*DigestEntry              // System log entries of error severity.
+}
+
+// ResourceChanges lists the IDs of the resources of a type created, updated
+// and deleted, in the order first seen. A resource created and then updated
+// is only listed as created; one deleted is only listed as deleted.
+type ResourceChanges struct {
+	Created []string
+	Updated []string
+	Deleted []string
+}
+
+// DigestEntry is a system log entry of a ChangeDigest
+type DigestEntry struct {
+	Time    time.Time
+	Type    string // The log type, e.g. payment or design.
+	Summary string
+}
+
+// ChangeDigester builds change digests from the store's system log and the
+// webhook events an app received over the period, since past webhook events
+// cannot be read from the API
+type ChangeDigester struct {
+	Client *Client
+}
+
+// Digest summarizes the system log entries and events from from, inclusive,
+// to to, exclusive. Events outside the period are ignored.
+func (d *ChangeDigester) Digest(ctx context.Context, from, to time.Time, events []*Event) (*ChangeDigest, error) {
+	digest := &ChangeDigest{From: from, To: to, Resources: map[string]*ResourceChanges{}}
+
+	sorted := append([]*Event(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt < sorted[j].CreatedAt })
+	for _, e := range sorted {
+		t := time.Unix(e.CreatedAt, 0)
+		if t.Before(from) || !t.Before(to) {
+			continue
+		}
+		resource, action := digestScope(e.Scope)
+		if resource == "" {
+			continue
+		}
+		changes := digest.Resources[resource]
+		if changes == nil {
+			changes = &ResourceChanges{}
+			digest.Resources[resource] = changes
+		}
+		changes.add(e.Data.ID, action)
+	}
+
+	opts := &SystemLogListOptions{
+		ListOptions:    ListOptions{Page: 1, Limit: 250},
+		MinDateCreated: from,
+		MaxDateCreated: to,
+		Sort:           "date_created",
+		Direction:      "asc",
+	}
+	for {
+		logs, _, err := d.Client.SystemLogs.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			t, _ := time.Parse(time.RFC3339, l.DateCreated)
+			if !t.IsZero() && !t.Before(to) {
+				continue
+			}
+			entry := &DigestEntry{Time: t, Type: l.Type, Summary: l.Summary}
+			summary := strings.ToLower(l.Summary)
+			switch {
+			case l.Severity == ErrorLog:
+				digest.Errors = append(digest.Errors, entry)
+			case l.Type == "design" && strings.Contains(summary, "theme") && strings.Contains(summary, "activat"):
+				digest.ThemesActivated = append(digest.ThemesActivated, entry)
+			default:
+				digest.SettingsChanged = append(digest.SettingsChanged, entry)
+			}
+		}
+		if len(logs) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+	return digest, nil
+}
+
+// digestScope splits a webhook scope such as "store/product/created" into its
+// resource and action. Nested scopes such as "store/product/inventory/updated"
+// count as updates of the resource.
+func digestScope(scope string) (resource, action string) {
+	parts := strings.Split(scope, "/")
+	if len(parts) < 3 || parts[0] != "store" {
+		return "", ""
+	}
+	resource, action = parts[1], parts[len(parts)-1]
+	if len(parts) > 3 {
+		action = "updated"
+	}
+	return resource, action
+}
+
+func (c *ResourceChanges) add(id, action string) {
+	switch action {
+	case "created":
+		if !hasString(c.Created, id) {
+			c.Created = append(c.Created, id)
+		}
+	case "deleted":
+		c.Created = removeString(c.Created, id)
+		c.Updated = removeString(c.Updated, id)
+		if !hasString(c.Deleted, id) {
+			c.Deleted = append(c.Deleted, id)
+		}
+	default:
+		if !hasString(c.Created, id) && !hasString(c.Updated, id) && !hasString(c.Deleted, id) {
+			c.Updated = append(c.Updated, id)
+		}
+	}
+}
+
+// WriteMarkdown writes the digest as a Markdown report
+func (d *ChangeDigest) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Store changes %s to %s\n", d.From.Format("2006-01-02"), d.To.Format("2006-01-02"))
+
+	resources := make([]string, 0, len(d.Resources))
+	for r := range d.Resources {
+		resources = append(resources, r)
+	}
+	sort.Strings(resources)
+	if len(resources) > 0 {
+		b.WriteString("\n## Catalog and data\n\n")
+		for _, r := range resources {
+			c := d.Resources[r]
+			fmt.Fprintf(&b, "- %s: %d created, %d updated, %d deleted\n", r, len(c.Created), len(c.Updated), len(c.Deleted))
+		}
+	}
+	writeDigestEntries(&b, "Themes activated", d.ThemesActivated)
+	writeDigestEntries(&b, "Settings changed", d.SettingsChanged)
+	writeDigestEntries(&b, "Errors", d.Errors)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeDigestEntries(b *strings.Builder, title string, entries []*DigestEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+	for _, e := range entries {
+		fmt.Fprintf(b, "- %s [%s] %s\n", e.Time.Format("2006-01-02 15:04"), e.Type, e.Summary)
+	}
+}
+
+func hasString(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(s []string, v string) []string {
+	kept := s[:0]
+	for _, x := range s {
+		if x != v {
+			kept = append(kept, x)
+		}
+	}
+	return kept
+}
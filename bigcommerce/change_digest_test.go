@@ -0,0 +1,64 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChangeDigester_Digest(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/store/systemlogs", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"date_created:min": "2021-05-01T00:00:00Z", "date_created:max": "2021-06-01T00:00:00Z", "sort": "date_created"})
+		fmt.Fprint(w, `{"data":[
+			{"id":1,"type":"design","severity":2,"summary":"Theme Cornerstone activated","date_created":"2021-05-03T09:00:00Z"},
+			{"id":2,"type":"tax","severity":2,"summary":"Tax settings updated","date_created":"2021-05-04T09:00:00Z"},
+			{"id":3,"type":"shipping","severity":4,"summary":"Carrier quote failed","date_created":"2021-05-05T09:00:00Z"}
+		],"meta":{}}`)
+	})
+
+	from := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	at := func(day int) int64 { return from.AddDate(0, 0, day).Unix() }
+	events := []*Event{
+		{Scope: "store/product/updated", Data: EventData{Type: "product", ID: "11"}, CreatedAt: at(3)},
+		{Scope: "store/product/created", Data: EventData{Type: "product", ID: "10"}, CreatedAt: at(1)},
+		{Scope: "store/product/updated", Data: EventData{Type: "product", ID: "10"}, CreatedAt: at(2)},
+		{Scope: "store/product/inventory/updated", Data: EventData{Type: "product", ID: "12"}, CreatedAt: at(4)},
+		{Scope: "store/product/deleted", Data: EventData{Type: "product", ID: "11"}, CreatedAt: at(5)},
+		{Scope: "store/category/created", Data: EventData{Type: "category", ID: "3"}, CreatedAt: at(6)},
+		{Scope: "store/category/created", Data: EventData{Type: "category", ID: "4"}, CreatedAt: at(40)},
+	}
+
+	digest, err := (&ChangeDigester{Client: client}).Digest(context.Background(), from, to, events)
+	if err != nil {
+		t.Fatalf("Digest returned error: %v", err)
+	}
+	wantResources := map[string]*ResourceChanges{
+		"product":  {Created: []string{"10"}, Updated: []string{"12"}, Deleted: []string{"11"}},
+		"category": {Created: []string{"3"}},
+	}
+	if !reflect.DeepEqual(digest.Resources, wantResources) {
+		t.Errorf("Resources = %+v, want %+v", digest.Resources, wantResources)
+	}
+	if len(digest.ThemesActivated) != 1 || len(digest.SettingsChanged) != 1 || len(digest.Errors) != 1 || digest.Errors[0].Summary != "Carrier quote failed" {
+		t.Errorf("Digest = %+v", digest)
+	}
+
+	var buf bytes.Buffer
+	if err := digest.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown returned error: %v", err)
+	}
+	for _, want := range []string{"# Store changes 2021-05-01 to 2021-06-01", "- product: 1 created, 1 updated, 1 deleted", "## Themes activated", "[tax] Tax settings updated"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("WriteMarkdown output missing %q:\n%s", want, buf.String())
+		}
+	}
+}
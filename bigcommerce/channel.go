@@ -0,0 +1,118 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChannelService handles communication with the V3 channel endpoints
+type ChannelService service
+
+// Channel describes a BigCommerce V3 Channel Object, a place products are
+// sold: a storefront, a marketplace, a marketing channel or a point of sale
+type Channel struct {
+	ID               int64         `json:"id,omitempty"`                  // The unique numerical ID of the channel. Read-only.
+	Name             string        `json:"name,omitempty"`                // Required on create.
+	Type             ChannelType   `json:"type,omitempty"`                // Required on create; cannot be changed.
+	Platform         string        `json:"platform,omitempty"`            // e.g. bigcommerce, amazon or facebook. Required on create; cannot be changed.
+	Status           ChannelStatus `json:"status,omitempty"`              // Defaults to ActiveChannel on create.
+	ExternalID       string        `json:"external_id,omitempty"`         // The channel's ID on its platform.
+	IsListableFromUI bool          `json:"is_listable_from_ui,omitempty"` // Whether products can be listed on the channel from the control panel.
+	IsVisible        bool          `json:"is_visible,omitempty"`          // Whether the channel is shown in the control panel.
+	IconURL          string        `json:"icon_url,omitempty"`
+	DateCreated      string        `json:"date_created,omitempty"`  // Read-only.
+	DateModified     string        `json:"date_modified,omitempty"` // Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ChannelType - The kind of a channel
+type ChannelType string
+
+const (
+	// StorefrontChannel - a storefront, hosted by BigCommerce or headless
+	StorefrontChannel ChannelType = "storefront"
+	// MarketplaceChannel - a marketplace, e.g. Amazon or eBay
+	MarketplaceChannel ChannelType = "marketplace"
+	// MarketingChannel - an ads or social channel, e.g. Google Shopping
+	MarketingChannel ChannelType = "marketing"
+	// POSChannel - a point of sale
+	POSChannel ChannelType = "pos"
+)
+
+// ChannelStatus - Whether a channel is in use
+type ChannelStatus string
+
+const (
+	// ActiveChannel - the channel is live
+	ActiveChannel ChannelStatus = "active"
+	// PrelaunchChannel - the channel is being set up
+	PrelaunchChannel ChannelStatus = "prelaunch"
+	// InactiveChannel - the channel is turned off
+	InactiveChannel ChannelStatus = "inactive"
+	// ConnectedChannel - a marketplace or marketing channel linked to its platform
+	ConnectedChannel ChannelStatus = "connected"
+	// DisconnectedChannel - a marketplace or marketing channel unlinked from its platform
+	DisconnectedChannel ChannelStatus = "disconnected"
+	// ArchivedChannel - the channel is hidden and kept for its history
+	ArchivedChannel ChannelStatus = "archived"
+	// DeletedChannel - the channel is deleted
+	DeletedChannel ChannelStatus = "deleted"
+	// TerminatedChannel - the channel was shut down by BigCommerce
+	TerminatedChannel ChannelStatus = "terminated"
+)
+
+// ChannelListOptions specifies the optional parameters to ChannelService.List
+type ChannelListOptions struct {
+	ListOptions
+	Types     []ChannelType   `url:"type:in,omitempty"`
+	Platforms []string        `url:"platform:in,omitempty"`
+	Statuses  []ChannelStatus `url:"status:in,omitempty"`
+	Available *bool           `url:"available,omitempty"` // Only channels that are, or are not, in use.
+}
+
+// List returns a page of channels
+func (s *ChannelService) List(ctx context.Context, opts *ChannelListOptions) ([]*Channel, *Response, error) {
+	path, err := addOptions("v3/channels", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var channels []*Channel
+	resp, err := s.client.call(ctx, "GET", path, nil, &channels)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channels, resp, nil
+}
+
+// Get returns a single channel
+func (s *ChannelService) Get(ctx context.Context, id int64) (*Channel, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("v3/channels/%d", id), nil)
+}
+
+// Create registers a channel
+func (s *ChannelService) Create(ctx context.Context, channel *Channel) (*Channel, *Response, error) {
+	return s.do(ctx, "POST", "v3/channels", channel)
+}
+
+// Update modifies a channel. Fields left empty are unchanged.
+func (s *ChannelService) Update(ctx context.Context, id int64, channel *Channel) (*Channel, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("v3/channels/%d", id), channel)
+}
+
+// Delete marks a channel deleted. The API has no delete endpoint; deleted
+// channels are updated to DeletedChannel and can no longer be used.
+func (s *ChannelService) Delete(ctx context.Context, id int64) (*Response, error) {
+	_, resp, err := s.Update(ctx, id, &Channel{Status: DeletedChannel})
+	return resp, err
+}
+
+func (s *ChannelService) do(ctx context.Context, method, path string, body interface{}) (*Channel, *Response, error) {
+	channel := new(Channel)
+	resp, err := s.client.call(ctx, method, path, body, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
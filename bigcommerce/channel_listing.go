@@ -0,0 +1,198 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChannelListing describes a BigCommerce V3 Channel Listing Object, a product
+// as it is offered on a channel. Name and Description override the product's
+// on that channel; empty means the catalog's are used.
+type ChannelListing struct {
+	ListingID    int64                    `json:"listing_id,omitempty"` // The unique numerical ID of the listing. Required on update.
+	ProductID    int64                    `json:"product_id"`
+	ExternalID   string                   `json:"external_id,omitempty"` // The listing's ID on the channel's platform.
+	State        ListingState             `json:"state"`
+	Name         string                   `json:"name,omitempty"`
+	Description  string                   `json:"description,omitempty"`
+	DateCreated  string                   `json:"date_created,omitempty"`  // Read-only.
+	DateModified string                   `json:"date_modified,omitempty"` // Read-only.
+	Variants     []*ChannelListingVariant `json:"variants"`
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ChannelListingVariant describes a variant of a channel listing
+type ChannelListingVariant struct {
+	ProductID    int64        `json:"product_id"`
+	VariantID    int64        `json:"variant_id"`
+	ExternalID   string       `json:"external_id,omitempty"`
+	State        ListingState `json:"state"`
+	Name         string       `json:"name,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	DateCreated  string       `json:"date_created,omitempty"`  // Read-only.
+	DateModified string       `json:"date_modified,omitempty"` // Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ListingState - The state of a product or variant on a channel
+type ListingState string
+
+const (
+	// ActiveListing - the product is shown on the channel
+	ActiveListing ListingState = "active"
+	// DisabledListing - the product is hidden from the channel
+	DisabledListing ListingState = "disabled"
+	// ErrorListing - the channel could not list the product
+	ErrorListing ListingState = "error"
+	// PendingListing - the listing is waiting to be sent to the channel
+	PendingListing ListingState = "pending"
+	// PendingDisableListing - the listing is being disabled on the channel
+	PendingDisableListing ListingState = "pending_disable"
+	// PendingDeleteListing - the listing is being deleted from the channel
+	PendingDeleteListing ListingState = "pending_delete"
+	// PartiallyRejectedListing - the channel rejected some of the variants
+	PartiallyRejectedListing ListingState = "partially_rejected"
+	// QueuedListing - the listing is queued for the channel
+	QueuedListing ListingState = "queued"
+	// RejectedListing - the channel rejected the listing
+	RejectedListing ListingState = "rejected"
+	// SubmittedListing - the listing was sent and awaits the channel's review
+	SubmittedListing ListingState = "submitted"
+	// DeletedListing - the listing was removed
+	DeletedListing ListingState = "deleted"
+)
+
+// ChannelListingListOptions specifies the optional parameters to
+// ChannelService.ListListings. Listings are paged by cursor: set After to the
+// last ListingID of the previous page.
+type ChannelListingListOptions struct {
+	Limit      int     `url:"limit,omitempty"`
+	After      int64   `url:"after,omitempty"`
+	ProductIDs []int64 `url:"product_id:in,omitempty"`
+}
+
+// ListListings returns a page of a channel's listings
+func (s *ChannelService) ListListings(ctx context.Context, channelID int64, opts *ChannelListingListOptions) ([]*ChannelListing, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/channels/%d/listings", channelID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listings []*ChannelListing
+	resp, err := s.client.call(ctx, "GET", path, nil, &listings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listings, resp, nil
+}
+
+// GetListing returns a single listing of a channel
+func (s *ChannelService) GetListing(ctx context.Context, channelID, listingID int64) (*ChannelListing, *Response, error) {
+	listing := new(ChannelListing)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/channels/%d/listings/%d", channelID, listingID), nil, listing)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listing, resp, nil
+}
+
+// CreateListings lists products on a channel
+func (s *ChannelService) CreateListings(ctx context.Context, channelID int64, listings []*ChannelListing) ([]*ChannelListing, *Response, error) {
+	return s.listings(ctx, "POST", channelID, listings)
+}
+
+// UpdateListings modifies listings of a channel. Every listing must set
+// ListingID, and is replaced as a whole, variants included.
+func (s *ChannelService) UpdateListings(ctx context.Context, channelID int64, listings []*ChannelListing) ([]*ChannelListing, *Response, error) {
+	return s.listings(ctx, "PUT", channelID, listings)
+}
+
+func (s *ChannelService) listings(ctx context.Context, method string, channelID int64, listings []*ChannelListing) ([]*ChannelListing, *Response, error) {
+	var saved []*ChannelListing
+	resp, err := s.client.call(ctx, method, fmt.Sprintf("v3/channels/%d/listings", channelID), listings, &saved)
+	if err != nil {
+		return nil, resp, err
+	}
+	return saved, resp, nil
+}
+
+// listingBatch is how many products SetListingState looks up per request,
+// keeping the product_id:in filter a reasonable length
+const listingBatch = 50
+
+// SetListingState sets products and their variants to state on a channel,
+// updating their listings and listing the products that have none. It
+// implements ListingStateSetter, so ChannelService can drive a
+// VisibilityScheduler.
+func (s *ChannelService) SetListingState(ctx context.Context, channelID int64, productIDs []int64, state ListingState) error {
+	for start := 0; start < len(productIDs); start += listingBatch {
+		batch := productIDs[start:minInt(start+listingBatch, len(productIDs))]
+
+		var update []*ChannelListing
+		listed := map[int64]bool{}
+		opts := &ChannelListingListOptions{Limit: 250, ProductIDs: batch}
+		for {
+			listings, _, err := s.ListListings(ctx, channelID, opts)
+			if err != nil {
+				return err
+			}
+			for _, l := range listings {
+				listed[l.ProductID] = true
+				l.State = state
+				for _, v := range l.Variants {
+					v.State = state
+				}
+				update = append(update, l)
+			}
+			if len(listings) < opts.Limit {
+				break
+			}
+			opts.After = listings[len(listings)-1].ListingID
+		}
+		if len(update) > 0 {
+			if _, _, err := s.UpdateListings(ctx, channelID, update); err != nil {
+				return err
+			}
+		}
+
+		var missing []int64
+		for _, id := range batch {
+			if !listed[id] {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		create := map[int64]*ChannelListing{}
+		for _, id := range missing {
+			create[id] = &ChannelListing{ProductID: id, State: state, Variants: []*ChannelListingVariant{}}
+		}
+		vopts := &VariantListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, ProductIDs: missing}
+		for {
+			variants, resp, err := s.client.Variants.ListCatalog(ctx, vopts)
+			if err != nil {
+				return err
+			}
+			for _, v := range variants {
+				if l := create[v.ProductID]; l != nil {
+					l.Variants = append(l.Variants, &ChannelListingVariant{ProductID: v.ProductID, VariantID: v.ID, State: state})
+				}
+			}
+			if resp.Pagination == nil || int64(vopts.Page) >= resp.Pagination.TotalPages {
+				break
+			}
+			vopts.Page++
+		}
+		listings := make([]*ChannelListing, len(missing))
+		for i, id := range missing {
+			listings[i] = create[id]
+		}
+		if _, _, err := s.CreateListings(ctx, channelID, listings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestChannelService_ListListings(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/2/listings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"limit": "10", "after": "5", "product_id:in": "7,8"})
+		fmt.Fprint(w, `{"data":[{"listing_id":6,"product_id":7,"state":"active","name":"Boot (EU)","variants":[{"product_id":7,"variant_id":70,"state":"active"}]}],"meta":{}}`)
+	})
+
+	listings, _, err := client.Channels.ListListings(context.Background(), 2, &ChannelListingListOptions{Limit: 10, After: 5, ProductIDs: []int64{7, 8}})
+	if err != nil {
+		t.Fatalf("ListListings returned error: %v", err)
+	}
+	want := []*ChannelListing{{
+		ListingID: 6, ProductID: 7, State: ActiveListing, Name: "Boot (EU)",
+		Variants: []*ChannelListingVariant{{ProductID: 7, VariantID: 70, State: ActiveListing}},
+	}}
+	if !reflect.DeepEqual(listings, want) {
+		t.Errorf("ListListings returned %+v, want %+v", listings, want)
+	}
+}
+
+func TestChannelService_GetListing(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/2/listings/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"listing_id":6,"product_id":7,"state":"submitted","variants":[]},"meta":{}}`)
+	})
+
+	listing, _, err := client.Channels.GetListing(context.Background(), 2, 6)
+	if err != nil {
+		t.Fatalf("GetListing returned error: %v", err)
+	}
+	want := &ChannelListing{ListingID: 6, ProductID: 7, State: SubmittedListing, Variants: []*ChannelListingVariant{}}
+	if !reflect.DeepEqual(listing, want) {
+		t.Errorf("GetListing returned %+v, want %+v", listing, want)
+	}
+}
+
+func TestChannelService_UpdateListings(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*ChannelListing{{ListingID: 6, ProductID: 7, State: DisabledListing, Description: "Only in the EU", Variants: []*ChannelListingVariant{}}}
+	mux.HandleFunc("/stores/abc123/v3/channels/2/listings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*ChannelListing{}, &input)
+		fmt.Fprint(w, `{"data":[{"listing_id":6,"product_id":7,"state":"disabled","description":"Only in the EU","variants":[]}],"meta":{}}`)
+	})
+
+	listings, _, err := client.Channels.UpdateListings(context.Background(), 2, input)
+	if err != nil {
+		t.Fatalf("UpdateListings returned error: %v", err)
+	}
+	if !reflect.DeepEqual(listings, input) {
+		t.Errorf("UpdateListings returned %+v, want %+v", listings, input)
+	}
+}
+
+func TestChannelService_SetListingState(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var updated, created []*ChannelListing
+	mux.HandleFunc("/stores/abc123/v3/channels/2/listings", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQuery(t, r, map[string]string{"product_id:in": "7,8"})
+			fmt.Fprint(w, `{"data":[{"listing_id":6,"product_id":7,"state":"active","name":"Boot (EU)","variants":[{"product_id":7,"variant_id":70,"state":"active"}]}],"meta":{}}`)
+		case "PUT":
+			json.NewDecoder(r.Body).Decode(&updated)
+			fmt.Fprint(w, `{"data":[],"meta":{}}`)
+		case "POST":
+			json.NewDecoder(r.Body).Decode(&created)
+			fmt.Fprint(w, `{"data":[],"meta":{}}`)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"product_id:in": "8"})
+		fmt.Fprint(w, `{"data":[{"id":80,"product_id":8},{"id":81,"product_id":8}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+
+	var setter ListingStateSetter = client.Channels
+	if err := setter.SetListingState(context.Background(), 2, []int64{7, 8}, DisabledListing); err != nil {
+		t.Fatalf("SetListingState returned error: %v", err)
+	}
+
+	wantUpdated := []*ChannelListing{{
+		ListingID: 6, ProductID: 7, State: DisabledListing, Name: "Boot (EU)",
+		Variants: []*ChannelListingVariant{{ProductID: 7, VariantID: 70, State: DisabledListing}},
+	}}
+	if !reflect.DeepEqual(updated, wantUpdated) {
+		t.Errorf("SetListingState updated %+v, want %+v", updated, wantUpdated)
+	}
+	wantCreated := []*ChannelListing{{
+		ProductID: 8, State: DisabledListing,
+		Variants: []*ChannelListingVariant{{ProductID: 8, VariantID: 80, State: DisabledListing}, {ProductID: 8, VariantID: 81, State: DisabledListing}},
+	}}
+	if !reflect.DeepEqual(created, wantCreated) {
+		t.Errorf("SetListingState created %+v, want %+v", created, wantCreated)
+	}
+}
@@ -0,0 +1,60 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChannelMenus describes the navigation of a channel in the control panel:
+// which of the BigCommerce sections are shown, and the app's own sections
+type ChannelMenus struct {
+	ProtectedSections []ChannelMenuSection `json:"bigcommerce_protected_app_sections"` // BigCommerce sections to show.
+	CustomSections    []*CustomMenuSection `json:"custom_app_sections"`                // Sections rendered by the channel's app.
+}
+
+// ChannelMenuSection - A BigCommerce section of the channel navigation
+type ChannelMenuSection string
+
+const (
+	// StorefrontSettingsSection - the storefront's settings
+	StorefrontSettingsSection ChannelMenuSection = "storefront_settings"
+	// CurrenciesSection - the channel's currencies
+	CurrenciesSection ChannelMenuSection = "currencies"
+	// DomainsSection - the storefront's domains
+	DomainsSection ChannelMenuSection = "domains"
+	// NotificationsSection - the customer notifications
+	NotificationsSection ChannelMenuSection = "notifications"
+	// SocialSection - the social media links
+	SocialSection ChannelMenuSection = "social"
+)
+
+// CustomMenuSection is a navigation entry opening a page of the channel's app
+type CustomMenuSection struct {
+	Title     string `json:"title"`
+	QueryPath string `json:"query_path"` // Passed to the app's load callback to pick the page, e.g. "menus".
+}
+
+// GetMenus returns the navigation of a channel
+func (s *ChannelService) GetMenus(ctx context.Context, channelID int64) (*ChannelMenus, *Response, error) {
+	return s.menus(ctx, "GET", channelID, nil)
+}
+
+// SetMenus replaces the navigation of a channel
+func (s *ChannelService) SetMenus(ctx context.Context, channelID int64, menus *ChannelMenus) (*ChannelMenus, *Response, error) {
+	return s.menus(ctx, "POST", channelID, menus)
+}
+
+// DeleteMenus removes the navigation of a channel, which then shows the
+// default sections
+func (s *ChannelService) DeleteMenus(ctx context.Context, channelID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/channels/%d/channel-menus", channelID), nil, nil)
+}
+
+func (s *ChannelService) menus(ctx context.Context, method string, channelID int64, body interface{}) (*ChannelMenus, *Response, error) {
+	menus := new(ChannelMenus)
+	resp, err := s.client.call(ctx, method, fmt.Sprintf("v3/channels/%d/channel-menus", channelID), body, menus)
+	if err != nil {
+		return nil, resp, err
+	}
+	return menus, resp, nil
+}
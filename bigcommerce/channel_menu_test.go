@@ -0,0 +1,68 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestChannelService_GetMenus(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/2/channel-menus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"bigcommerce_protected_app_sections":["storefront_settings","domains"],"custom_app_sections":[{"title":"Menus","query_path":"menus"}]},"meta":{}}`)
+	})
+
+	menus, _, err := client.Channels.GetMenus(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("GetMenus returned error: %v", err)
+	}
+	want := &ChannelMenus{
+		ProtectedSections: []ChannelMenuSection{StorefrontSettingsSection, DomainsSection},
+		CustomSections:    []*CustomMenuSection{{Title: "Menus", QueryPath: "menus"}},
+	}
+	if !reflect.DeepEqual(menus, want) {
+		t.Errorf("GetMenus returned %+v, want %+v", menus, want)
+	}
+}
+
+func TestChannelService_SetMenus(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &ChannelMenus{
+		ProtectedSections: []ChannelMenuSection{CurrenciesSection},
+		CustomSections:    []*CustomMenuSection{{Title: "Header", QueryPath: "menus/header"}, {Title: "Footer", QueryPath: "menus/footer"}},
+	}
+	mux.HandleFunc("/stores/abc123/v3/channels/2/channel-menus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(ChannelMenus), input)
+		fmt.Fprint(w, `{"data":{"bigcommerce_protected_app_sections":["currencies"],"custom_app_sections":[{"title":"Header","query_path":"menus/header"},{"title":"Footer","query_path":"menus/footer"}]},"meta":{}}`)
+	})
+
+	menus, _, err := client.Channels.SetMenus(context.Background(), 2, input)
+	if err != nil {
+		t.Fatalf("SetMenus returned error: %v", err)
+	}
+	if !reflect.DeepEqual(menus, input) {
+		t.Errorf("SetMenus returned %+v, want %+v", menus, input)
+	}
+}
+
+func TestChannelService_DeleteMenus(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/2/channel-menus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Channels.DeleteMenus(context.Background(), 2); err != nil {
+		t.Errorf("DeleteMenus returned error: %v", err)
+	}
+}
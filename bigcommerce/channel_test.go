@@ -0,0 +1,68 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestChannelService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"type:in": "storefront,marketplace", "status:in": "active", "available": "true"})
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Main store","type":"storefront","platform":"bigcommerce","status":"active","is_listable_from_ui":true,"is_visible":true}],"meta":{}}`)
+	})
+
+	channels, _, err := client.Channels.List(context.Background(), &ChannelListOptions{
+		Types:     []ChannelType{StorefrontChannel, MarketplaceChannel},
+		Statuses:  []ChannelStatus{ActiveChannel},
+		Available: Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	want := []*Channel{{ID: 1, Name: "Main store", Type: StorefrontChannel, Platform: "bigcommerce", Status: ActiveChannel, IsListableFromUI: true, IsVisible: true}}
+	if !reflect.DeepEqual(channels, want) {
+		t.Errorf("List returned %+v, want %+v", channels, want)
+	}
+}
+
+func TestChannelService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Channel{Name: "Amazon US", Type: MarketplaceChannel, Platform: "amazon", ExternalID: "A1"}
+	mux.HandleFunc("/stores/abc123/v3/channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Channel), input)
+		fmt.Fprint(w, `{"data":{"id":7,"name":"Amazon US","type":"marketplace","platform":"amazon","status":"connected","external_id":"A1"},"meta":{}}`)
+	})
+
+	channel, _, err := client.Channels.Create(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if channel.ID != 7 || channel.Status != ConnectedChannel {
+		t.Errorf("Create returned %+v", channel)
+	}
+}
+
+func TestChannelService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Channel), &Channel{Status: DeletedChannel})
+		fmt.Fprint(w, `{"data":{"id":7,"status":"deleted"},"meta":{}}`)
+	})
+
+	if _, err := client.Channels.Delete(context.Background(), 7); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
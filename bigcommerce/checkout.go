@@ -0,0 +1,204 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CheckoutService handles communication with the V3 server-to-server checkout endpoints
+type CheckoutService service
+
+// Checkout describes a BigCommerce V3 Checkout Object. Every cart has a
+// checkout with the same ID.
+type Checkout struct {
+	ID                      string            `json:"id,omitempty"` // The ID of the checkout and its cart.
+	Cart                    *Cart             `json:"cart,omitempty"`
+	BillingAddress          *Address          `json:"billing_address,omitempty"`
+	Consignments            []*Consignment    `json:"consignments,omitempty"`
+	Coupons                 []*CheckoutCoupon `json:"coupons,omitempty"`
+	Taxes                   []*CheckoutTax    `json:"taxes,omitempty"`
+	OrderID                 string            `json:"order_id,omitempty"` // Set once the checkout was converted to an order.
+	ShippingCostTotalIncTax float64           `json:"shipping_cost_total_inc_tax,omitempty"`
+	ShippingCostTotalExTax  float64           `json:"shipping_cost_total_ex_tax,omitempty"`
+	HandlingCostTotalIncTax float64           `json:"handling_cost_total_inc_tax,omitempty"`
+	HandlingCostTotalExTax  float64           `json:"handling_cost_total_ex_tax,omitempty"`
+	TaxTotal                float64           `json:"tax_total,omitempty"`
+	SubtotalIncTax          float64           `json:"subtotal_inc_tax,omitempty"`
+	SubtotalExTax           float64           `json:"subtotal_ex_tax,omitempty"`
+	GrandTotal              float64           `json:"grand_total,omitempty"` // The amount to pay, including shipping and taxes.
+	CustomerMessage         string            `json:"customer_message,omitempty"`
+	CreatedTime             string            `json:"created_time,omitempty"`
+	UpdatedTime             string            `json:"updated_time,omitempty"`
+}
+
+// CheckoutCustomField is the value of a custom address form field
+type CheckoutCustomField struct {
+	FieldID    string      `json:"field_id"`
+	FieldValue interface{} `json:"field_value"` // A string, number or list of strings, depending on the field.
+}
+
+// Consignment describes the items of a checkout shipped to one address
+type Consignment struct {
+	ID                       string            `json:"id,omitempty"`
+	Address                  *Address          `json:"address,omitempty"`
+	LineItemIDs              []string          `json:"line_item_ids,omitempty"`              // The cart line items shipped.
+	SelectedShippingOption   *ShippingOption   `json:"selected_shipping_option,omitempty"`   // Nil until an option is selected.
+	AvailableShippingOptions []*ShippingOption `json:"available_shipping_options,omitempty"` // With include=consignments.available_shipping_options.
+	ShippingCostIncTax       float64           `json:"shipping_cost_inc_tax,omitempty"`
+	ShippingCostExTax        float64           `json:"shipping_cost_ex_tax,omitempty"`
+	HandlingCostIncTax       float64           `json:"handling_cost_inc_tax,omitempty"`
+	HandlingCostExTax        float64           `json:"handling_cost_ex_tax,omitempty"`
+}
+
+// ShippingOption describes a way of shipping a consignment
+type ShippingOption struct {
+	ID                    string  `json:"id"`
+	Type                  string  `json:"type,omitempty"` // The shipping method, e.g. shipping_flatrate or shipping_upsready.
+	Description           string  `json:"description,omitempty"`
+	ImageURL              string  `json:"image_url,omitempty"`
+	Cost                  float64 `json:"cost"`
+	TransitTime           string  `json:"transit_time,omitempty"`
+	AdditionalDescription string  `json:"additional_description,omitempty"`
+}
+
+// CheckoutCoupon describes a coupon applied to a checkout
+type CheckoutCoupon struct {
+	ID               int64   `json:"id,omitempty"`
+	Code             string  `json:"code"`
+	DisplayName      string  `json:"display_name,omitempty"`
+	CouponType       string  `json:"coupon_type,omitempty"` // The discount type, e.g. per_item_discount.
+	DiscountedAmount float64 `json:"discounted_amount,omitempty"`
+}
+
+// CheckoutTax describes a tax charged on a checkout
+type CheckoutTax struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// ConsignmentRequest describes a consignment to create
+type ConsignmentRequest struct {
+	Address   *Address               `json:"address"`
+	LineItems []*ConsignmentLineItem `json:"line_items"`
+}
+
+// ConsignmentLineItem assigns a quantity of a cart line item to a consignment
+type ConsignmentLineItem struct {
+	ItemID   string `json:"item_id"`
+	Quantity int64  `json:"quantity"`
+}
+
+// ConsignmentUpdate describes the change to a consignment: either its address
+// and items, or its shipping option
+type ConsignmentUpdate struct {
+	Address          *Address               `json:"address,omitempty"`
+	LineItems        []*ConsignmentLineItem `json:"line_items,omitempty"`
+	ShippingOptionID string                 `json:"shipping_option_id,omitempty"`
+}
+
+// CheckoutGetOptions specifies the optional parameters to CheckoutService.Get
+type CheckoutGetOptions struct {
+	Include []string `url:"include,omitempty"` // e.g. consignments.available_shipping_options or cart.line_items.physical_items.options.
+}
+
+// includeShippingOptions is the query string returning consignments with
+// their available shipping options
+const includeShippingOptions = "?include=consignments.available_shipping_options"
+
+// Get returns a single checkout
+func (s *CheckoutService) Get(ctx context.Context, id string, opts *CheckoutGetOptions) (*Checkout, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/checkouts/%s", id), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "GET", path, nil)
+}
+
+// AddBillingAddress sets the billing address of a checkout
+func (s *CheckoutService) AddBillingAddress(ctx context.Context, id string, address *Address) (*Checkout, *Response, error) {
+	if err := s.client.validateAddresses(ctx, address); err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "POST", fmt.Sprintf("v3/checkouts/%s/billing-address", id), address)
+}
+
+// UpdateBillingAddress changes the billing address of a checkout
+func (s *CheckoutService) UpdateBillingAddress(ctx context.Context, id, addressID string, address *Address) (*Checkout, *Response, error) {
+	if err := s.client.validateAddressUpdates(ctx, address); err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "PUT", fmt.Sprintf("v3/checkouts/%s/billing-address/%s", id, addressID), address)
+}
+
+// AddConsignments adds consignments to a checkout, returned with their
+// available shipping options so one can be selected
+func (s *CheckoutService) AddConsignments(ctx context.Context, id string, consignments []*ConsignmentRequest) (*Checkout, *Response, error) {
+	addrs := make([]*Address, len(consignments))
+	for i, c := range consignments {
+		addrs[i] = c.Address
+	}
+	if err := s.client.validateAddresses(ctx, addrs...); err != nil {
+		return nil, nil, err
+	}
+	return s.do(ctx, "POST", fmt.Sprintf("v3/checkouts/%s/consignments", id)+includeShippingOptions, consignments)
+}
+
+// UpdateConsignment changes the address and items of a consignment, or
+// selects its shipping option. Changing the address clears the selected
+// shipping option.
+func (s *CheckoutService) UpdateConsignment(ctx context.Context, id, consignmentID string, update *ConsignmentUpdate) (*Checkout, *Response, error) {
+	if update.Address != nil {
+		if err := s.client.validateAddressUpdates(ctx, update.Address); err != nil {
+			return nil, nil, err
+		}
+	}
+	return s.do(ctx, "PUT", fmt.Sprintf("v3/checkouts/%s/consignments/%s", id, consignmentID)+includeShippingOptions, update)
+}
+
+// SelectShippingOption selects the shipping option of a consignment, one of
+// its AvailableShippingOptions
+func (s *CheckoutService) SelectShippingOption(ctx context.Context, id, consignmentID, optionID string) (*Checkout, *Response, error) {
+	return s.UpdateConsignment(ctx, id, consignmentID, &ConsignmentUpdate{ShippingOptionID: optionID})
+}
+
+// DeleteConsignment removes a consignment from a checkout
+func (s *CheckoutService) DeleteConsignment(ctx context.Context, id, consignmentID string) (*Checkout, *Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("v3/checkouts/%s/consignments/%s", id, consignmentID), nil)
+}
+
+// ApplyCoupon applies a coupon code to a checkout
+func (s *CheckoutService) ApplyCoupon(ctx context.Context, id, code string) (*Checkout, *Response, error) {
+	body := struct {
+		CouponCode string `json:"coupon_code"`
+	}{code}
+	return s.do(ctx, "POST", fmt.Sprintf("v3/checkouts/%s/coupons", id), body)
+}
+
+// RemoveCoupon removes a coupon code from a checkout
+func (s *CheckoutService) RemoveCoupon(ctx context.Context, id, code string) (*Checkout, *Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("v3/checkouts/%s/coupons/%s", id, url.PathEscape(code)), nil)
+}
+
+// CreateOrder converts a checkout into an order awaiting payment and returns
+// the order's ID. The billing address and the shipping options of every
+// consignment must be set.
+func (s *CheckoutService) CreateOrder(ctx context.Context, id string) (int64, *Response, error) {
+	var order struct {
+		ID int64 `json:"id"`
+	}
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/checkouts/%s/orders", id), nil, &order)
+	if err != nil {
+		return 0, resp, err
+	}
+	return order.ID, resp, nil
+}
+
+func (s *CheckoutService) do(ctx context.Context, method, path string, body interface{}) (*Checkout, *Response, error) {
+	checkout := new(Checkout)
+	resp, err := s.client.call(ctx, method, path, body, checkout)
+	if err != nil {
+		return nil, resp, err
+	}
+	return checkout, resp, nil
+}
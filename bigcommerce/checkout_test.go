@@ -0,0 +1,117 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCheckoutService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"include": "consignments.available_shipping_options"})
+		fmt.Fprint(w, `{"data":{"id":"abc-1","cart":{"id":"abc-1"},"grand_total":42.5,
+			"consignments":[{"id":"c-1","line_item_ids":["li-1"],"available_shipping_options":[{"id":"opt-1","description":"Flat Rate","cost":5}]}]},"meta":{}}`)
+	})
+
+	checkout, _, err := client.Checkouts.Get(context.Background(), "abc-1", &CheckoutGetOptions{Include: []string{"consignments.available_shipping_options"}})
+	if err != nil {
+		t.Fatalf("Checkouts.Get returned error: %v", err)
+	}
+	if checkout.GrandTotal != 42.5 || len(checkout.Consignments) != 1 || checkout.Consignments[0].AvailableShippingOptions[0].ID != "opt-1" {
+		t.Errorf("Checkouts.Get returned %+v", checkout)
+	}
+}
+
+func TestCheckoutService_consignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	address := &Address{FirstName: "Jane", Address1: "1 Main St", City: "Austin", StateOrProvinceCode: "TX", CountryCode: "US", PostalCode: "78701"}
+	input := []*ConsignmentRequest{{Address: address, LineItems: []*ConsignmentLineItem{{ItemID: "li-1", Quantity: 2}}}}
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1/consignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testQuery(t, r, map[string]string{"include": "consignments.available_shipping_options"})
+		testBody(t, r, &[]*ConsignmentRequest{}, &input)
+		fmt.Fprint(w, `{"data":{"id":"abc-1","consignments":[{"id":"c-1"}]},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1/consignments/c-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(ConsignmentUpdate), &ConsignmentUpdate{ShippingOptionID: "opt-1"})
+		fmt.Fprint(w, `{"data":{"id":"abc-1","consignments":[{"id":"c-1","selected_shipping_option":{"id":"opt-1","cost":5}}]},"meta":{}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Checkouts.AddConsignments(ctx, "abc-1", input); err != nil {
+		t.Fatalf("Checkouts.AddConsignments returned error: %v", err)
+	}
+	checkout, _, err := client.Checkouts.SelectShippingOption(ctx, "abc-1", "c-1", "opt-1")
+	if err != nil || checkout.Consignments[0].SelectedShippingOption.ID != "opt-1" {
+		t.Errorf("Checkouts.SelectShippingOption returned %+v, %v", checkout, err)
+	}
+}
+
+func TestCheckoutService_AddBillingAddress_invalid(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	rejected := errors.New("rejected")
+	WithAddressValidator(AddressValidatorFunc(func(ctx context.Context, addr *Address) error {
+		if addr.StateOrProvinceCode != "TX" || addr.CountryCode != "US" {
+			t.Errorf("validated %+v", addr)
+		}
+		return rejected
+	}))(client)
+
+	address := &Address{Email: "jane@example.com", StateOrProvince: "Texas", StateOrProvinceCode: "TX", CountryCode: "US"}
+	if _, _, err := client.Checkouts.AddBillingAddress(context.Background(), "abc-1", address); err != rejected {
+		t.Errorf("Checkouts.AddBillingAddress returned %v, want the validation error", err)
+	}
+}
+
+func TestCheckoutService_coupons(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1/coupons", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(map[string]string), &map[string]string{"coupon_code": "SAVE 10"})
+		fmt.Fprint(w, `{"data":{"id":"abc-1","coupons":[{"id":3,"code":"SAVE 10","discounted_amount":10}]},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got, want := r.URL.EscapedPath(), "/stores/abc123/v3/checkouts/abc-1/coupons/SAVE%2010"; got != want {
+			t.Errorf("Request path = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"data":{"id":"abc-1"},"meta":{}}`)
+	})
+
+	ctx := context.Background()
+	checkout, _, err := client.Checkouts.ApplyCoupon(ctx, "abc-1", "SAVE 10")
+	if err != nil || checkout.Coupons[0].DiscountedAmount != 10 {
+		t.Errorf("Checkouts.ApplyCoupon returned %+v, %v", checkout, err)
+	}
+	if _, _, err := client.Checkouts.RemoveCoupon(ctx, "abc-1", "SAVE 10"); err != nil {
+		t.Errorf("Checkouts.RemoveCoupon returned error: %v", err)
+	}
+}
+
+func TestCheckoutService_CreateOrder(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/checkouts/abc-1/orders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":{"id":101},"meta":{}}`)
+	})
+
+	id, _, err := client.Checkouts.CreateOrder(context.Background(), "abc-1")
+	if err != nil || id != 101 {
+		t.Errorf("Checkouts.CreateOrder returned %d, %v", id, err)
+	}
+}
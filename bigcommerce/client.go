@@ -0,0 +1,479 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseURL     = "https://api.bigcommerce.com/"
+	defaultPaymentsURL = "https://payments.bigcommerce.com/"
+	userAgent          = "bigcommerce-go-client"
+)
+
+// Client manages communication with the BigCommerce API
+type Client struct {
+	client *http.Client // HTTP client used to communicate with the API.
+
+	BaseURL     *url.URL // Base URL for API requests, including the store path. Always ends in a slash.
+	PaymentsURL *url.URL // Base URL for payment processing, including the store path. Always ends in a slash.
+	StoreHash   string   // The store hash, as found in the store's API path.
+	ClientID    string   // The app's client ID, sent as X-Auth-Client.
+	AccessToken string   // The OAuth access token, sent as X-Auth-Token.
+	UserAgent   string   // User agent used when communicating with the API.
+
+	common  service         // Reuse a single struct instead of allocating one for each service.
+	stats   *statsCollector // Per-endpoint call statistics, see Stats.
+	limiter *RateLimiter    // Optional request throttling, see WithRateLimiter.
+
+	addressValidator AddressValidator // Optional address checks, see WithAddressValidator.
+	fixtureDir       string           // Optional directory for undecodable responses, see WithFixtureCapture.
+	enumMode         EnumMode         // How unknown enum values are handled, see WithEnumMode.
+	preset           *Preset          // Optional request restrictions, see WithPreset.
+	reference        referenceCache   // Reference data loaded by Warmup.
+
+	Brands             *BrandService
+	Carts              *CartService
+	Catalog            *CatalogService
+	Categories         *CategoryService
+	CategoryTrees      *CategoryTreeService
+	Channels           *ChannelService
+	Checkouts          *CheckoutService
+	ComplexRules       *ComplexRuleService
+	Countries          *CountryService
+	Currencies         *CurrencyService
+	CustomFields       *CustomFieldService
+	CustomTemplates    *CustomTemplateService
+	CustomerAddresses  *CustomerAddressService
+	CustomerAttributes *CustomerAttributeService
+	CustomerGroups     *CustomerGroupService
+	Customers          *CustomerService
+	FormFields         *FormFieldService
+	Inventory          *InventoryService
+	Metafields         *MetafieldService
+	Modifiers          *ModifierService
+	OptionSets         *OptionSetService
+	Orders             *OrderService
+	Payments           *PaymentService
+	PriceLists         *PriceListService
+	ProductOptions     *ProductOptionService
+	ProductImages      *ProductImageService
+	Products           *ProductService
+	Promotions         *PromotionService
+	Redirects          *RedirectService
+	Refunds            *RefundService
+	Scripts            *ScriptService
+	Settings           *SettingsService
+	Shipments          *ShipmentService
+	ShippingZones      *ShippingZoneService
+	SKUs               *SKUService
+	Store              *StoreService
+	StoreOptions       *StoreOptionService
+	StorefrontTokens   *StorefrontTokenService
+	Subscribers        *SubscriberService
+	SystemLogs         *SystemLogService
+	TaxClasses         *TaxClassService
+	Transactions       *TransactionService
+	Variants           *VariantService
+	Webhooks           *WebhookService
+	Widgets            *WidgetService
+}
+
+type service struct {
+	client *Client
+}
+
+// ClientOption configures a Client created by NewClient
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the http.Client used for requests. Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.client = httpClient
+	}
+}
+
+// WithBaseURL overrides the API base URL, which otherwise points at the store on api.bigcommerce.com
+func WithBaseURL(baseURL *url.URL) ClientOption {
+	return func(c *Client) {
+		u := *baseURL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.BaseURL = &u
+	}
+}
+
+// WithPaymentsURL overrides the payment processing base URL, which otherwise
+// points at the store on payments.bigcommerce.com
+func WithPaymentsURL(paymentsURL *url.URL) ClientOption {
+	return func(c *Client) {
+		u := *paymentsURL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.PaymentsURL = &u
+	}
+}
+
+// WithClientID sets the app's client ID, which some legacy endpoints still require
+func WithClientID(clientID string) ClientOption {
+	return func(c *Client) {
+		c.ClientID = clientID
+	}
+}
+
+// NewClient returns a new BigCommerce API client for the store identified by storeHash
+func NewClient(storeHash, accessToken string, opts ...ClientOption) *Client {
+	baseURL, _ := url.Parse(defaultBaseURL + "stores/" + storeHash + "/")
+	paymentsURL, _ := url.Parse(defaultPaymentsURL + "stores/" + storeHash + "/")
+
+	c := &Client{
+		client:      http.DefaultClient,
+		BaseURL:     baseURL,
+		PaymentsURL: paymentsURL,
+		StoreHash:   storeHash,
+		AccessToken: accessToken,
+		UserAgent:   userAgent,
+		stats:       newStatsCollector(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.limiter != nil {
+		c.limiter.init(storeHash)
+	}
+
+	c.common.client = c
+	c.Brands = (*BrandService)(&c.common)
+	c.Carts = (*CartService)(&c.common)
+	c.Catalog = (*CatalogService)(&c.common)
+	c.Categories = (*CategoryService)(&c.common)
+	c.CategoryTrees = (*CategoryTreeService)(&c.common)
+	c.Channels = (*ChannelService)(&c.common)
+	c.Checkouts = (*CheckoutService)(&c.common)
+	c.ComplexRules = (*ComplexRuleService)(&c.common)
+	c.Countries = (*CountryService)(&c.common)
+	c.Currencies = (*CurrencyService)(&c.common)
+	c.CustomFields = (*CustomFieldService)(&c.common)
+	c.CustomTemplates = (*CustomTemplateService)(&c.common)
+	c.CustomerAddresses = (*CustomerAddressService)(&c.common)
+	c.CustomerAttributes = (*CustomerAttributeService)(&c.common)
+	c.CustomerGroups = (*CustomerGroupService)(&c.common)
+	c.Customers = (*CustomerService)(&c.common)
+	c.FormFields = (*FormFieldService)(&c.common)
+	c.Inventory = (*InventoryService)(&c.common)
+	c.Metafields = (*MetafieldService)(&c.common)
+	c.Modifiers = (*ModifierService)(&c.common)
+	c.OptionSets = (*OptionSetService)(&c.common)
+	c.Orders = (*OrderService)(&c.common)
+	c.Payments = (*PaymentService)(&c.common)
+	c.PriceLists = (*PriceListService)(&c.common)
+	c.ProductOptions = (*ProductOptionService)(&c.common)
+	c.ProductImages = (*ProductImageService)(&c.common)
+	c.Products = (*ProductService)(&c.common)
+	c.Promotions = (*PromotionService)(&c.common)
+	c.Redirects = (*RedirectService)(&c.common)
+	c.Refunds = (*RefundService)(&c.common)
+	c.Scripts = (*ScriptService)(&c.common)
+	c.Settings = (*SettingsService)(&c.common)
+	c.Shipments = (*ShipmentService)(&c.common)
+	c.ShippingZones = (*ShippingZoneService)(&c.common)
+	c.SKUs = (*SKUService)(&c.common)
+	c.Store = (*StoreService)(&c.common)
+	c.StoreOptions = (*StoreOptionService)(&c.common)
+	c.StorefrontTokens = (*StorefrontTokenService)(&c.common)
+	c.Subscribers = (*SubscriberService)(&c.common)
+	c.SystemLogs = (*SystemLogService)(&c.common)
+	c.TaxClasses = (*TaxClassService)(&c.common)
+	c.Transactions = (*TransactionService)(&c.common)
+	c.Variants = (*VariantService)(&c.common)
+	c.Webhooks = (*WebhookService)(&c.common)
+	c.Widgets = (*WidgetService)(&c.common)
+	return c
+}
+
+// NewRequest creates an API request. The path is resolved relative to BaseURL and
+// includes the API version, e.g. "v3/catalog/variants". If body is not nil it is
+// encoded as JSON and sent as the request body.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPreset(method, strings.TrimPrefix(u.Path, c.BaseURL.Path)); err != nil {
+		return nil, err
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("X-Auth-Token", c.AccessToken)
+	if c.ClientID != "" {
+		req.Header.Set("X-Auth-Client", c.ClientID)
+	}
+	return req, nil
+}
+
+// NewUploadRequest creates a multipart/form-data API request. The content of r is
+// streamed as the file part named field, so large files are never held in
+// memory; the other form fields are written before the file.
+func (c *Client) NewUploadRequest(ctx context.Context, method, path, field, filename string, r io.Reader, fields map[string]string) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPreset(method, strings.TrimPrefix(u.Path, c.BaseURL.Path)); err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		for name, value := range fields {
+			if err := mw.WriteField(name, value); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		part, err := mw.CreateFormFile(field, filename)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequest(method, u.String(), pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("X-Auth-Token", c.AccessToken)
+	if c.ClientID != "" {
+		req.Header.Set("X-Auth-Client", c.ClientID)
+	}
+	return req, nil
+}
+
+// Response wraps an http.Response with the meta data returned by V3 endpoints
+type Response struct {
+	*http.Response
+	Pagination *Pagination // Set for V3 list endpoints.
+}
+
+// Pagination describes the pagination meta data of a V3 list response
+type Pagination struct {
+	Total       int64 `json:"total"`        // Total number of items in the result set.
+	Count       int64 `json:"count"`        // Number of items in this page.
+	PerPage     int64 `json:"per_page"`     // Number of items per page.
+	CurrentPage int64 `json:"current_page"` // The current page number.
+	TotalPages  int64 `json:"total_pages"`  // Total number of pages.
+}
+
+// envelope is the wrapper V3 endpoints use around request and response bodies
+type envelope struct {
+	Data interface{} `json:"data"`
+	Meta struct {
+		Pagination *Pagination `json:"pagination,omitempty"`
+	} `json:"meta"`
+}
+
+// Do sends an API request and decodes the JSON response into v. Pass an
+// *envelope to unwrap V3 responses and capture their pagination. Empty bodies,
+// such as V2's 204 for an empty list, leave v untouched.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	c.stats.record(req, resp, time.Since(start))
+	if err != nil {
+		// Prefer the context's error, which is more useful than the transport's.
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if c.limiter != nil {
+		c.limiter.observe(req.Context(), resp)
+	}
+
+	response := &Response{Response: resp}
+	if err := CheckResponse(resp); err != nil {
+		return response, err
+	}
+
+	if v == nil {
+		return response, nil
+	}
+	if w, ok := v.(io.Writer); ok {
+		_, err = io.Copy(w, resp.Body)
+		return response, err
+	}
+	body := io.Reader(resp.Body)
+	var captured *bytes.Buffer
+	if c.fixtureDir != "" {
+		captured = new(bytes.Buffer)
+		body = io.TeeReader(resp.Body, captured)
+	}
+	if err := json.NewDecoder(body).Decode(v); err != nil && err != io.EOF {
+		if captured != nil {
+			io.Copy(captured, resp.Body)
+			err = c.captureFixture(req, resp, captured.Bytes(), err)
+		}
+		return response, err
+	}
+	if env, ok := v.(*envelope); ok {
+		response.Pagination = env.Meta.Pagination
+	}
+	if c.enumMode != LenientEnums {
+		decoded := v
+		if env, ok := v.(*envelope); ok {
+			decoded = env.Data
+		}
+		if err := checkEnums(reflect.ValueOf(decoded), "", c.enumMode); err != nil {
+			if captured != nil {
+				io.Copy(captured, resp.Body)
+				err = c.captureFixture(req, resp, captured.Bytes(), err)
+			}
+			return response, err
+		}
+	}
+	return response, nil
+}
+
+// call creates and sends a request, decoding the response into out. Responses
+// from V3 paths are unwrapped from their data envelope.
+func (c *Client) call(ctx context.Context, method, path string, body, out interface{}) (*Response, error) {
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	if out != nil && strings.HasPrefix(path, "v3/") {
+		out = &envelope{Data: out}
+	}
+	return c.Do(req, out)
+}
+
+// ListOptions specifies the pagination parameters shared by list endpoints
+type ListOptions struct {
+	Page  int `url:"page,omitempty"`  // Page number to fetch, starting at 1.
+	Limit int `url:"limit,omitempty"` // Number of items per page.
+}
+
+// addOptions encodes the `url` tagged fields of opts into the query string of path.
+// Slices are joined with commas, as expected by filters like `id:in`.
+func addOptions(path string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+	qs := u.Query()
+	encodeValues(qs, reflect.Indirect(v))
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+func encodeValues(qs url.Values, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, fv := t.Field(i), v.Field(i)
+		if field.Anonymous {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			encodeValues(qs, fv)
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, omitEmpty := tag, false
+		if i := strings.Index(tag, ",omitempty"); i >= 0 {
+			name, omitEmpty = tag[:i], true
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		} else if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		if t, ok := fv.Interface().(time.Time); ok {
+			qs.Set(name, t.Format(time.RFC3339))
+			continue
+		}
+		if fv.Kind() == reflect.Slice {
+			parts := make([]string, fv.Len())
+			for j := range parts {
+				parts[j] = fmt.Sprint(fv.Index(j).Interface())
+			}
+			qs.Set(name, strings.Join(parts, ","))
+			continue
+		}
+		qs.Set(name, fmt.Sprint(fv.Interface()))
+	}
+}
+
+// Bool returns a pointer to v, for optional fields
+func Bool(v bool) *bool { return &v }
+
+// Int64 returns a pointer to v, for optional fields
+func Int64(v int64) *int64 { return &v }
+
+// Float64 returns a pointer to v, for optional fields
+func Float64(v float64) *float64 { return &v }
+
+// String returns a pointer to v, for optional fields
+func String(v string) *string { return &v }
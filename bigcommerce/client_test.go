@@ -0,0 +1,117 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// setup starts a test HTTP server and returns a client configured to talk to it
+func setup() (client *Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	baseURL, _ := url.Parse(server.URL + "/stores/abc123/")
+	client = NewClient("abc123", "token", WithBaseURL(baseURL), WithClientID("client"))
+	return client, mux, server.Close
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+func testBody(t *testing.T, r *http.Request, v interface{}, want interface{}) {
+	t.Helper()
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("Request body = %+v, want %+v", v, want)
+	}
+}
+
+func testQuery(t *testing.T, r *http.Request, want map[string]string) {
+	t.Helper()
+	q := r.URL.Query()
+	for k, v := range want {
+		if got := q.Get(k); got != v {
+			t.Errorf("Query %q = %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	c := NewClient("abc123", "token", WithClientID("client"))
+	req, err := c.NewRequest(context.Background(), "POST", "v3/catalog/variants", &Variant{SKU: "A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := req.URL.String(), "https://api.bigcommerce.com/stores/abc123/v3/catalog/variants"; got != want {
+		t.Errorf("URL = %v, want %v", got, want)
+	}
+	if req.Header.Get("X-Auth-Token") != "token" || req.Header.Get("X-Auth-Client") != "client" {
+		t.Error("Expected auth headers to be set", req.Header)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if string(body) != `{"sku":"A"}`+"\n" {
+		t.Error("Unexpected body", string(body))
+	}
+}
+
+func TestDo_errors(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/fail", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		io.WriteString(w, `{"status":422,"title":"Invalid","errors":{"sku":"required"}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/fail", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `[{"status":400,"message":"The field 'name' is invalid."}]`)
+	})
+
+	req, _ := client.NewRequest(context.Background(), "GET", "v3/fail", nil)
+	_, err := client.Do(req, nil)
+	e, ok := err.(*ErrorResponse)
+	if !ok || e.Status != 422 || e.Errors["sku"] != "required" {
+		t.Error("Unexpected V3 error", err)
+	}
+
+	req, _ = client.NewRequest(context.Background(), "GET", "v2/fail", nil)
+	_, err = client.Do(req, nil)
+	e, ok = err.(*ErrorResponse)
+	if !ok || e.Status != 400 || e.Message != "The field 'name' is invalid." {
+		t.Error("Unexpected V2 error", err)
+	}
+}
+
+func TestAddOptions(t *testing.T) {
+	since := time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)
+	opts := &struct {
+		ListOptions
+		IDs   []int64   `url:"id:in,omitempty"`
+		Name  string    `url:"name,omitempty"`
+		Since time.Time `url:"date_modified:min,omitempty"`
+		Flag  *bool     `url:"is_visible,omitempty"`
+	}{ListOptions{Page: 2}, []int64{1, 2}, "", since, Bool(false)}
+
+	got, err := addOptions("v3/catalog/products", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "v3/catalog/products?date_modified%3Amin=2017-01-02T03%3A04%3A05Z&id%3Ain=1%2C2&is_visible=false&page=2"
+	if got != want {
+		t.Errorf("addOptions = %v, want %v", got, want)
+	}
+}
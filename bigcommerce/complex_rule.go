@@ -0,0 +1,101 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ComplexRuleService handles communication with the V3 product complex rule endpoints
+type ComplexRuleService service
+
+// ComplexRule describes a BigCommerce V3 Complex Rule Object. A rule adjusts the
+// price, weight or image of a product, or disables purchasing, when all of its
+// conditions match the shopper's option and modifier selections.
+type ComplexRule struct {
+	ID                        int64                  `json:"id,omitempty"`                          // The unique numerical ID of the rule.
+	ProductID                 int64                  `json:"product_id,omitempty"`                  // The ID of the product the rule belongs to.
+	SortOrder                 int64                  `json:"sort_order,omitempty"`                  // Order in which rules are evaluated.
+	Enabled                   bool                   `json:"enabled"`                               // Whether the rule is applied.
+	Stop                      bool                   `json:"stop"`                                  // If true, rules after this one are not evaluated when it matches.
+	PurchasingDisabled        bool                   `json:"purchasing_disabled"`                   // Disables purchasing when the rule matches.
+	PurchasingDisabledMessage string                 `json:"purchasing_disabled_message,omitempty"` // Message shown when purchasing is disabled.
+	PurchasingHidden          bool                   `json:"purchasing_hidden"`                     // Hides the product when the rule matches.
+	ImageURL                  string                 `json:"image_url,omitempty"`                   // Image shown when the rule matches.
+	PriceAdjuster             *Adjuster              `json:"price_adjuster,omitempty"`              // Price adjustment applied when the rule matches.
+	WeightAdjuster            *Adjuster              `json:"weight_adjuster,omitempty"`             // Weight adjustment applied when the rule matches.
+	Conditions                []ComplexRuleCondition `json:"conditions,omitempty"`                  // The selections that must all be made for the rule to match.
+}
+
+// ComplexRuleCondition describes a selection a ComplexRule matches on. Set
+// either a modifier and one of its values, or a variant.
+type ComplexRuleCondition struct {
+	RuleID          int64 `json:"rule_id,omitempty"`           // The rule the condition belongs to. Read-only.
+	ModifierID      int64 `json:"modifier_id,omitempty"`       // The modifier to match.
+	ModifierValueID int64 `json:"modifier_value_id,omitempty"` // The modifier value that must be selected.
+	VariantID       int64 `json:"variant_id,omitempty"`        // The variant that must be selected.
+	CombinationID   int64 `json:"combination_id,omitempty"`    // Legacy V2 option combination ID. Read-only.
+}
+
+// ModifierCondition returns a condition matching a selected modifier value
+func ModifierCondition(modifierID, valueID int64) ComplexRuleCondition {
+	return ComplexRuleCondition{ModifierID: modifierID, ModifierValueID: valueID}
+}
+
+// VariantCondition returns a condition matching a selected variant
+func VariantCondition(variantID int64) ComplexRuleCondition {
+	return ComplexRuleCondition{VariantID: variantID}
+}
+
+// List returns the complex rules of a product
+func (s *ComplexRuleService) List(ctx context.Context, productID int64, opts *ListOptions) ([]*ComplexRule, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/complex-rules", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*ComplexRule
+	resp, err := s.client.call(ctx, "GET", path, nil, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
+
+// Get returns a single complex rule of a product
+func (s *ComplexRuleService) Get(ctx context.Context, productID, ruleID int64) (*ComplexRule, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/complex-rules/%d", productID, ruleID)
+	rule := new(ComplexRule)
+	resp, err := s.client.call(ctx, "GET", path, nil, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rule, resp, nil
+}
+
+// Create adds a complex rule to a product
+func (s *ComplexRuleService) Create(ctx context.Context, productID int64, rule *ComplexRule) (*ComplexRule, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/complex-rules", productID)
+	created := new(ComplexRule)
+	resp, err := s.client.call(ctx, "POST", path, rule, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a complex rule of a product
+func (s *ComplexRuleService) Update(ctx context.Context, productID, ruleID int64, rule *ComplexRule) (*ComplexRule, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/complex-rules/%d", productID, ruleID)
+	updated := new(ComplexRule)
+	resp, err := s.client.call(ctx, "PUT", path, rule, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a complex rule from a product
+func (s *ComplexRuleService) Delete(ctx context.Context, productID, ruleID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/complex-rules/%d", productID, ruleID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
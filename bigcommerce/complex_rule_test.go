@@ -0,0 +1,49 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestComplexRuleService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &ComplexRule{
+		Enabled:       true,
+		PriceAdjuster: &Adjuster{Adjuster: PercentageAdjuster, AdjusterValue: 10},
+		Conditions:    []ComplexRuleCondition{ModifierCondition(11, 20), VariantCondition(5)},
+	}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/complex-rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(ComplexRule), input)
+		fmt.Fprint(w, `{"data":{"id":7,"product_id":32,"enabled":true,"stop":false,"purchasing_disabled":false,"purchasing_hidden":false,
+			"price_adjuster":{"adjuster":"percentage","adjuster_value":10},
+			"conditions":[{"rule_id":7,"modifier_id":11,"modifier_value_id":20},{"rule_id":7,"variant_id":5}]},"meta":{}}`)
+	})
+
+	rule, _, err := client.ComplexRules.Create(context.Background(), 32, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ID != 7 || len(rule.Conditions) != 2 || rule.Conditions[1].VariantID != 5 || rule.PriceAdjuster.AdjusterValue != 10 {
+		t.Errorf("Unexpected rule %+v", rule)
+	}
+}
+
+func TestComplexRuleService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/complex-rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":7,"enabled":true,"weight_adjuster":{"adjuster":"relative","adjuster_value":-0.5}}],"meta":{}}`)
+	})
+
+	rules, _, err := client.ComplexRules.List(context.Background(), 32, nil)
+	if err != nil || len(rules) != 1 || rules[0].WeightAdjuster.AdjusterValue != -0.5 {
+		t.Errorf("Unexpected rules %+v, %v", rules, err)
+	}
+}
@@ -0,0 +1,55 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CountryService handles communication with the V2 country and state endpoints
+type CountryService service
+
+// Country describes a BigCommerce V2 Country Object
+type Country struct {
+	ID          int64  `json:"id"`           // The unique numerical ID of the country.
+	Country     string `json:"country"`      // The country's name.
+	CountryISO2 string `json:"country_iso2"` // The ISO 3166-1 alpha-2 code.
+	CountryISO3 string `json:"country_iso3"` // The ISO 3166-1 alpha-3 code.
+}
+
+// State describes a BigCommerce V2 State Object, a subdivision of a country
+type State struct {
+	ID                int64  `json:"id"`                 // The unique numerical ID of the state.
+	State             string `json:"state"`              // The state's name.
+	StateAbbreviation string `json:"state_abbreviation"` // The state's code, e.g. TX.
+	CountryID         int64  `json:"country_id"`         // The ID of the country.
+}
+
+// List returns a page of countries
+func (s *CountryService) List(ctx context.Context, opts *ListOptions) ([]*Country, *Response, error) {
+	path, err := addOptions("v2/countries", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var countries []*Country
+	resp, err := s.client.call(ctx, "GET", path, nil, &countries)
+	if err != nil {
+		return nil, resp, err
+	}
+	return countries, resp, nil
+}
+
+// ListStates returns a page of the states of a country
+func (s *CountryService) ListStates(ctx context.Context, countryID int64, opts *ListOptions) ([]*State, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/countries/%d/states", countryID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var states []*State
+	resp, err := s.client.call(ctx, "GET", path, nil, &states)
+	if err != nil {
+		return nil, resp, err
+	}
+	return states, resp, nil
+}
@@ -0,0 +1,48 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCountryService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/countries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"limit": "250"})
+		fmt.Fprint(w, `[{"id":226,"country":"United States","country_iso2":"US","country_iso3":"USA"}]`)
+	})
+
+	countries, _, err := client.Countries.List(context.Background(), &ListOptions{Limit: 250})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Country{{ID: 226, Country: "United States", CountryISO2: "US", CountryISO3: "USA"}}
+	if !reflect.DeepEqual(countries, want) {
+		t.Errorf("List = %+v, want %+v", countries, want)
+	}
+}
+
+func TestCountryService_ListStates(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/countries/226/states", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":57,"state":"Texas","state_abbreviation":"TX","country_id":226}]`)
+	})
+
+	states, _, err := client.Countries.ListStates(context.Background(), 226, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*State{{ID: 57, State: "Texas", StateAbbreviation: "TX", CountryID: 226}}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("ListStates = %+v, want %+v", states, want)
+	}
+}
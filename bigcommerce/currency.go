@@ -0,0 +1,190 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// CurrencyService handles communication with the V2 currency endpoints and
+// the V3 channel currency assignment endpoints
+type CurrencyService service
+
+// Currency describes a BigCommerce V2 Currency Object, a currency prices can
+// be shown or transacted in
+type Currency struct {
+	ID                     int64                 `json:"id,omitempty"`                        // The unique numerical ID of the currency. Read-only.
+	IsDefault              bool                  `json:"is_default,omitempty"`                // Whether this is the store's default currency. Read-only.
+	LastUpdated            string                `json:"last_updated,omitempty"`              // Read-only.
+	CountryISO2            string                `json:"country_iso2,omitempty"`              // The country the currency belongs to.
+	DefaultForCountryCodes []string              `json:"default_for_country_codes,omitempty"` // Countries whose shoppers see this currency first.
+	CurrencyCode           string                `json:"currency_code"`                       // The ISO 4217 code, e.g. EUR.
+	CurrencyExchangeRate   ExchangeRate          `json:"currency_exchange_rate"`              // Units of the currency per unit of the default currency.
+	Name                   string                `json:"name"`                                // The currency's name.
+	Token                  string                `json:"token,omitempty"`                     // The symbol, e.g. "€".
+	AutoUpdate             bool                  `json:"auto_update"`                         // Whether the exchange rate is updated automatically.
+	DecimalToken           string                `json:"decimal_token,omitempty"`             // Decimal separator.
+	ThousandsToken         string                `json:"thousands_token,omitempty"`           // Thousands separator.
+	DecimalPlaces          int                   `json:"decimal_places"`                      // Decimal places shown.
+	TokenLocation          CurrencyTokenLocation `json:"token_location,omitempty"`            // Where the symbol is placed.
+	Enabled                bool                  `json:"enabled"`                             // Whether shoppers can see prices in the currency.
+	IsTransactional        bool                  `json:"is_transactional"`                    // Whether shoppers can check out in the currency.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ExchangeRate is a currency exchange rate. The V2 API returns rates as
+// decimal strings; ExchangeRate decodes either strings or numbers.
+type ExchangeRate float64
+
+// UnmarshalJSON decodes a rate given as a string or a number
+func (r *ExchangeRate) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var f float64
+		if err := json.Unmarshal(data, &f); err != nil {
+			return fmt.Errorf("bigcommerce: invalid exchange rate %s", data)
+		}
+		*r = ExchangeRate(f)
+		return nil
+	}
+	if s == "" {
+		*r = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("bigcommerce: invalid exchange rate %q", s)
+	}
+	*r = ExchangeRate(f)
+	return nil
+}
+
+// CurrencyTokenLocation - Where a currency's symbol is placed
+type CurrencyTokenLocation string
+
+const (
+	// LeftToken - before the amount, e.g. $10.00
+	LeftToken CurrencyTokenLocation = "left"
+	// RightToken - after the amount, e.g. 10,00 €
+	RightToken CurrencyTokenLocation = "right"
+)
+
+// Convert converts an amount in the store's default currency to the currency
+func (c *Currency) Convert(amount float64) float64 {
+	return amount * float64(c.CurrencyExchangeRate)
+}
+
+// Formatter returns a Formatter rendering amounts in the currency. Only its
+// money settings are set.
+func (c *Currency) Formatter() *Formatter {
+	return &Formatter{
+		CurrencySymbol:     c.Token,
+		SymbolOnRight:      c.TokenLocation == RightToken,
+		DecimalSeparator:   defaultString(c.DecimalToken, "."),
+		ThousandsSeparator: c.ThousandsToken,
+		DecimalPlaces:      c.DecimalPlaces,
+	}
+}
+
+// List returns a page of currencies
+func (s *CurrencyService) List(ctx context.Context, opts *ListOptions) ([]*Currency, *Response, error) {
+	path, err := addOptions("v2/currencies", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var currencies []*Currency
+	resp, err := s.client.call(ctx, "GET", path, nil, &currencies)
+	if err != nil {
+		return nil, resp, err
+	}
+	return currencies, resp, nil
+}
+
+// Get returns a single currency
+func (s *CurrencyService) Get(ctx context.Context, id int64) (*Currency, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("v2/currencies/%d", id), nil)
+}
+
+// Create adds a currency. CurrencyCode, Name and CurrencyExchangeRate are
+// required.
+func (s *CurrencyService) Create(ctx context.Context, currency *Currency) (*Currency, *Response, error) {
+	return s.do(ctx, "POST", "v2/currencies", currency)
+}
+
+// Update modifies a currency. The flags, rate and decimal places are always
+// sent, so update a currency read with Get rather than a partial one.
+func (s *CurrencyService) Update(ctx context.Context, id int64, currency *Currency) (*Currency, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("v2/currencies/%d", id), currency)
+}
+
+// Delete removes a currency. The default currency and currencies used by a
+// channel cannot be removed.
+func (s *CurrencyService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/currencies/%d", id), nil, nil)
+}
+
+func (s *CurrencyService) do(ctx context.Context, method, path string, body interface{}) (*Currency, *Response, error) {
+	currency := new(Currency)
+	resp, err := s.client.call(ctx, method, path, body, currency)
+	if err != nil {
+		return nil, resp, err
+	}
+	return currency, resp, nil
+}
+
+// ChannelCurrencyAssignment describes the currencies of a channel
+type ChannelCurrencyAssignment struct {
+	ChannelID         int64    `json:"channel_id,omitempty"`
+	EnabledCurrencies []string `json:"enabled_currencies"` // The codes of the currencies shoppers can use.
+	DefaultCurrency   string   `json:"default_currency"`   // One of EnabledCurrencies.
+}
+
+// ListChannelAssignments returns the currencies of every channel
+func (s *CurrencyService) ListChannelAssignments(ctx context.Context) ([]*ChannelCurrencyAssignment, *Response, error) {
+	var assignments []*ChannelCurrencyAssignment
+	resp, err := s.client.call(ctx, "GET", "v3/channels/currency-assignments", nil, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignments, resp, nil
+}
+
+// GetChannelAssignment returns the currencies of a channel
+func (s *CurrencyService) GetChannelAssignment(ctx context.Context, channelID int64) (*ChannelCurrencyAssignment, *Response, error) {
+	assignment := new(ChannelCurrencyAssignment)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/channels/%d/currency-assignments", channelID), nil, assignment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignment, resp, nil
+}
+
+// CreateChannelAssignments sets the currencies of channels that have none.
+// Every assignment must set ChannelID.
+func (s *CurrencyService) CreateChannelAssignments(ctx context.Context, assignments []*ChannelCurrencyAssignment) ([]*ChannelCurrencyAssignment, *Response, error) {
+	return s.assign(ctx, "POST", assignments)
+}
+
+// UpdateChannelAssignments replaces the currencies of channels. Every
+// assignment must set ChannelID.
+func (s *CurrencyService) UpdateChannelAssignments(ctx context.Context, assignments []*ChannelCurrencyAssignment) ([]*ChannelCurrencyAssignment, *Response, error) {
+	return s.assign(ctx, "PUT", assignments)
+}
+
+// DeleteChannelAssignment removes the currencies of a channel, which then
+// uses the store's default currency
+func (s *CurrencyService) DeleteChannelAssignment(ctx context.Context, channelID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/channels/%d/currency-assignments", channelID), nil, nil)
+}
+
+func (s *CurrencyService) assign(ctx context.Context, method string, assignments []*ChannelCurrencyAssignment) ([]*ChannelCurrencyAssignment, *Response, error) {
+	var saved []*ChannelCurrencyAssignment
+	resp, err := s.client.call(ctx, method, "v3/channels/currency-assignments", assignments, &saved)
+	if err != nil {
+		return nil, resp, err
+	}
+	return saved, resp, nil
+}
@@ -0,0 +1,147 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCurrencyService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/currencies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"is_default":true,"currency_code":"USD","name":"US Dollar","currency_exchange_rate":"1.0000000000","token":"$","token_location":"left","decimal_places":2,"enabled":true}]`)
+	})
+
+	currencies, _, err := client.Currencies.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	want := []*Currency{{
+		ID:                   1,
+		IsDefault:            true,
+		CurrencyCode:         "USD",
+		Name:                 "US Dollar",
+		CurrencyExchangeRate: 1,
+		Token:                "$",
+		TokenLocation:        "left",
+		DecimalPlaces:        2,
+		Enabled:              true,
+	}}
+	if !reflect.DeepEqual(currencies, want) {
+		t.Errorf("List returned %+v, want %+v", currencies, want)
+	}
+}
+
+func TestCurrencyService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/currencies/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"currency_code":"EUR","name":"Euro"}`)
+	})
+
+	currency, _, err := client.Currencies.Get(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if want := (&Currency{ID: 2, CurrencyCode: "EUR", Name: "Euro"}); !reflect.DeepEqual(currency, want) {
+		t.Errorf("Get returned %+v, want %+v", currency, want)
+	}
+}
+
+func TestCurrencyService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/currencies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["currency_exchange_rate"] != 0.92 || body["token_location"] != "right" || body["enabled"] != true {
+			t.Errorf("Request body = %v", body)
+		}
+		fmt.Fprint(w, `{"id":3,"currency_code":"EUR","name":"Euro","currency_exchange_rate":"0.9200000000","token":"€","token_location":"right",
+			"decimal_token":",","thousands_token":".","decimal_places":2,"enabled":true}`)
+	})
+
+	currency, _, err := client.Currencies.Create(context.Background(), &Currency{
+		CurrencyCode:         "EUR",
+		Name:                 "Euro",
+		CurrencyExchangeRate: 0.92,
+		Token:                "€",
+		TokenLocation:        RightToken,
+		DecimalToken:         ",",
+		ThousandsToken:       ".",
+		DecimalPlaces:        2,
+		Enabled:              true,
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if currency.ID != 3 || currency.CurrencyExchangeRate != 0.92 {
+		t.Errorf("Create returned %+v", currency)
+	}
+	if got, want := currency.Formatter().Money(currency.Convert(1500)), "1.380,00 €"; got != want {
+		t.Errorf("Money = %q, want %q", got, want)
+	}
+}
+
+func TestCurrencyService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/currencies/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Currencies.Delete(context.Background(), 3); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestCurrencyService_ChannelAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/channels/currency-assignments", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":[{"channel_id":1,"enabled_currencies":["USD","EUR"],"default_currency":"USD"}],"meta":{}}`)
+		case "PUT":
+			want := []*ChannelCurrencyAssignment{{ChannelID: 2, EnabledCurrencies: []string{"EUR"}, DefaultCurrency: "EUR"}}
+			testBody(t, r, &[]*ChannelCurrencyAssignment{}, &want)
+			fmt.Fprint(w, `{"data":[{"channel_id":2,"enabled_currencies":["EUR"],"default_currency":"EUR"}],"meta":{}}`)
+		default:
+			t.Errorf("Unexpected %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/channels/2/currency-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assignments, _, err := client.Currencies.ListChannelAssignments(context.Background())
+	if err != nil {
+		t.Fatalf("ListChannelAssignments returned error: %v", err)
+	}
+	want := []*ChannelCurrencyAssignment{{ChannelID: 1, EnabledCurrencies: []string{"USD", "EUR"}, DefaultCurrency: "USD"}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("ListChannelAssignments returned %+v, want %+v", assignments, want)
+	}
+
+	updated, _, err := client.Currencies.UpdateChannelAssignments(context.Background(), []*ChannelCurrencyAssignment{{ChannelID: 2, EnabledCurrencies: []string{"EUR"}, DefaultCurrency: "EUR"}})
+	if err != nil || len(updated) != 1 || updated[0].DefaultCurrency != "EUR" {
+		t.Errorf("UpdateChannelAssignments returned %+v, %v", updated, err)
+	}
+	if _, err := client.Currencies.DeleteChannelAssignment(context.Background(), 2); err != nil {
+		t.Errorf("DeleteChannelAssignment returned error: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package bigcommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken is returned when a JWT is malformed or its signature
+	// does not match
+	ErrInvalidToken = errors.New("bigcommerce: invalid token")
+	// ErrTokenExpired is returned when a JWT's exp is in the past
+	ErrTokenExpired = errors.New("bigcommerce: token expired")
+	// ErrVerifierConfig is returned by CurrentCustomerVerifier when its client
+	// ID or secret is missing, which would let anyone sign tokens
+	ErrVerifierConfig = errors.New("bigcommerce: verifier needs a client ID and secret")
+)
+
+// CurrentCustomer identifies the customer logged in to the storefront
+type CurrentCustomer struct {
+	ID      int64  `json:"id"`
+	Email   string `json:"email"`
+	GroupID string `json:"group_id"` // The customer group, empty if none.
+}
+
+// CurrentCustomerClaims are the claims of a Current Customer API JWT
+type CurrentCustomerClaims struct {
+	Customer      CurrentCustomer `json:"customer"`
+	Issuer        string          `json:"iss"`     // "bc/apps".
+	Subject       string          `json:"sub"`     // The store hash.
+	Audience      string          `json:"aud"`     // The app's client ID.
+	IssuedAt      int64           `json:"iat"`     // Unix timestamp.
+	ExpiresAt     int64           `json:"exp"`     // Unix timestamp.
+	Version       int64           `json:"version"` // The version of the token format.
+	ApplicationID string          `json:"application_id"`
+	StoreHash     string          `json:"store_hash"`
+	Operation     string          `json:"operation"` // "current_customer".
+}
+
+// CurrentCustomerVerifier verifies the JWTs returned by the storefront's
+// /customer/current.jwt endpoint, which apps fetch from the browser to
+// identify the shopper without trusting the browser
+type CurrentCustomerVerifier struct {
+	ClientID     string
+	ClientSecret string // The app's client secret, signing the tokens.
+
+	// Leeway tolerates clock skew when checking the expiry.
+	Leeway time.Duration
+}
+
+// Verify checks the token's signature, issuer, operation, audience and expiry,
+// and returns its claims
+func (v *CurrentCustomerVerifier) Verify(token string) (*CurrentCustomerClaims, error) {
+	if v.ClientID == "" || v.ClientSecret == "" {
+		return nil, ErrVerifierConfig
+	}
+	claims := new(CurrentCustomerClaims)
+	if err := verifyJWT(token, v.ClientSecret, claims); err != nil {
+		return nil, err
+	}
+	if claims.Issuer != "bc/apps" || claims.Operation != "current_customer" {
+		return nil, ErrInvalidToken
+	}
+	if claims.Audience != v.ClientID {
+		return nil, fmt.Errorf("bigcommerce: token audience %q is not client %q", claims.Audience, v.ClientID)
+	}
+	if time.Now().Add(-v.Leeway).Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+// verifyJWT checks the HS256 signature of a JWT and decodes its claims into v
+func verifyJWT(token, secret string, v interface{}) error {
+	if secret == "" {
+		return ErrInvalidToken
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+	enc := base64.RawURLEncoding
+	header, err := enc.DecodeString(parts[0])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil || h.Alg != "HS256" {
+		return ErrInvalidToken
+	}
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return ErrInvalidToken
+	}
+	payload, err := enc.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package bigcommerce
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCurrentCustomerVerifier_Verify(t *testing.T) {
+	now := time.Now().Unix()
+	claims := &CurrentCustomerClaims{
+		Customer:  CurrentCustomer{ID: 7, Email: "jane@example.com", GroupID: "2"},
+		Issuer:    "bc/apps",
+		Subject:   "abc123",
+		Audience:  "client",
+		IssuedAt:  now,
+		ExpiresAt: now + 900,
+		Version:   1,
+		StoreHash: "abc123",
+		Operation: "current_customer",
+	}
+	token, _ := signJWT(claims, "secret")
+
+	v := &CurrentCustomerVerifier{ClientID: "client", ClientSecret: "secret"}
+	got, err := v.Verify(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *claims {
+		t.Errorf("Verify returned %+v, want %+v", got, claims)
+	}
+
+	if _, err := (&CurrentCustomerVerifier{ClientID: "client", ClientSecret: "other"}).Verify(token); err != ErrInvalidToken {
+		t.Errorf("Verify with the wrong secret returned %v", err)
+	}
+	if _, err := (&CurrentCustomerVerifier{ClientID: "other", ClientSecret: "secret"}).Verify(token); err == nil {
+		t.Error("Verify succeeded for another audience")
+	}
+	parts := strings.Split(token, ".")
+	if _, err := v.Verify(parts[0] + "." + parts[1] + "."); err != ErrInvalidToken {
+		t.Errorf("Verify without a signature returned %v", err)
+	}
+
+	if _, err := (&CurrentCustomerVerifier{}).Verify(token); err != ErrVerifierConfig {
+		t.Errorf("Verify without a client ID and secret returned %v", err)
+	}
+	unsigned, _ := signJWT(claims, "")
+	if _, err := (&CurrentCustomerVerifier{ClientID: "client"}).Verify(unsigned); err != ErrVerifierConfig {
+		t.Errorf("Verify without a secret returned %v", err)
+	}
+
+	claims.Operation = "other"
+	other, _ := signJWT(claims, "secret")
+	if _, err := v.Verify(other); err != ErrInvalidToken {
+		t.Errorf("Verify of another operation returned %v", err)
+	}
+	claims.Operation, claims.Issuer = "current_customer", "someone"
+	other, _ = signJWT(claims, "secret")
+	if _, err := v.Verify(other); err != ErrInvalidToken {
+		t.Errorf("Verify of another issuer returned %v", err)
+	}
+	claims.Issuer = "bc/apps"
+
+	claims.ExpiresAt = now - 10
+	expired, _ := signJWT(claims, "secret")
+	if _, err := v.Verify(expired); err != ErrTokenExpired {
+		t.Errorf("Verify of an expired token returned %v", err)
+	}
+	v.Leeway = time.Minute
+	if _, err := v.Verify(expired); err != nil {
+		t.Errorf("Verify within the leeway returned %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomFieldService handles communication with the V2 and V3 product custom field endpoints
+type CustomFieldService service
+
+// CustomField describes a BigCommerce Product Custom Field, a name/value pair
+// shown in the product's specification list
+type CustomField struct {
+	ID        int64  `json:"id,omitempty"`         // The unique numerical ID of the custom field.
+	ProductID int64  `json:"product_id,omitempty"` // The ID of the product the field belongs to. Only set by V2 endpoints.
+	Name      string `json:"name,omitempty"`       // The name of the field, shown on the storefront.
+	Value     string `json:"value,omitempty"`      // The value of the field.
+}
+
+// v2CustomField is the V2 representation of a CustomField, which names the value "text"
+type v2CustomField struct {
+	ID        int64  `json:"id,omitempty"`
+	ProductID int64  `json:"product_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Text      string `json:"text,omitempty"`
+}
+
+func (f *v2CustomField) customField() *CustomField {
+	return &CustomField{ID: f.ID, ProductID: f.ProductID, Name: f.Name, Value: f.Text}
+}
+
+// List returns the custom fields of a product
+func (s *CustomFieldService) List(ctx context.Context, productID int64, opts *ListOptions) ([]*CustomField, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/custom-fields", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*CustomField
+	resp, err := s.client.call(ctx, "GET", path, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// Get returns a single custom field of a product
+func (s *CustomFieldService) Get(ctx context.Context, productID, fieldID int64) (*CustomField, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/custom-fields/%d", productID, fieldID)
+	field := new(CustomField)
+	resp, err := s.client.call(ctx, "GET", path, nil, field)
+	if err != nil {
+		return nil, resp, err
+	}
+	return field, resp, nil
+}
+
+// Create adds a custom field to a product
+func (s *CustomFieldService) Create(ctx context.Context, productID int64, field *CustomField) (*CustomField, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/custom-fields", productID)
+	created := new(CustomField)
+	resp, err := s.client.call(ctx, "POST", path, &CustomField{Name: field.Name, Value: field.Value}, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a custom field of a product
+func (s *CustomFieldService) Update(ctx context.Context, productID, fieldID int64, field *CustomField) (*CustomField, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/custom-fields/%d", productID, fieldID)
+	updated := new(CustomField)
+	resp, err := s.client.call(ctx, "PUT", path, &CustomField{Name: field.Name, Value: field.Value}, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a custom field from a product
+func (s *CustomFieldService) Delete(ctx context.Context, productID, fieldID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/custom-fields/%d", productID, fieldID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListV2 returns the custom fields of a product using the V2 endpoint
+func (s *CustomFieldService) ListV2(ctx context.Context, productID int64, opts *ListOptions) ([]*CustomField, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/products/%d/customfields", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var v2Fields []*v2CustomField
+	resp, err := s.client.call(ctx, "GET", path, nil, &v2Fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	fields := make([]*CustomField, len(v2Fields))
+	for i, f := range v2Fields {
+		fields[i] = f.customField()
+	}
+	return fields, resp, nil
+}
+
+// CreateV2 adds a custom field to a product using the V2 endpoint
+func (s *CustomFieldService) CreateV2(ctx context.Context, productID int64, field *CustomField) (*CustomField, *Response, error) {
+	path := fmt.Sprintf("v2/products/%d/customfields", productID)
+	return s.doV2(ctx, "POST", path, field)
+}
+
+// UpdateV2 modifies a custom field of a product using the V2 endpoint
+func (s *CustomFieldService) UpdateV2(ctx context.Context, productID, fieldID int64, field *CustomField) (*CustomField, *Response, error) {
+	path := fmt.Sprintf("v2/products/%d/customfields/%d", productID, fieldID)
+	return s.doV2(ctx, "PUT", path, field)
+}
+
+// DeleteV2 removes a custom field from a product using the V2 endpoint
+func (s *CustomFieldService) DeleteV2(ctx context.Context, productID, fieldID int64) (*Response, error) {
+	path := fmt.Sprintf("v2/products/%d/customfields/%d", productID, fieldID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *CustomFieldService) doV2(ctx context.Context, method, path string, field *CustomField) (*CustomField, *Response, error) {
+	result := new(v2CustomField)
+	resp, err := s.client.call(ctx, method, path, &v2CustomField{Name: field.Name, Text: field.Value}, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.customField(), resp, nil
+}
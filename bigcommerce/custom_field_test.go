@@ -0,0 +1,66 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCustomFieldService_V3(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/custom-fields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":[{"id":1,"name":"material","value":"wool"}],"meta":{}}`)
+		case "POST":
+			testBody(t, r, new(CustomField), &CustomField{Name: "origin", Value: "Italy"})
+			fmt.Fprint(w, `{"data":{"id":2,"name":"origin","value":"Italy"},"meta":{}}`)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/custom-fields/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields, _, err := client.CustomFields.List(context.Background(), 32, nil)
+	if err != nil || len(fields) != 1 || fields[0].Value != "wool" {
+		t.Errorf("Unexpected fields %+v, %v", fields, err)
+	}
+
+	field, _, err := client.CustomFields.Create(context.Background(), 32, &CustomField{Name: "origin", Value: "Italy"})
+	if err != nil || field.ID != 2 {
+		t.Errorf("Unexpected field %+v, %v", field, err)
+	}
+
+	if _, err := client.CustomFields.Delete(context.Background(), 32, 2); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCustomFieldService_V2(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/products/32/customfields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"id":1,"product_id":32,"name":"material","text":"wool"}]`)
+		case "POST":
+			testBody(t, r, new(v2CustomField), &v2CustomField{Name: "origin", Text: "Italy"})
+			fmt.Fprint(w, `{"id":2,"product_id":32,"name":"origin","text":"Italy"}`)
+		}
+	})
+
+	fields, _, err := client.CustomFields.ListV2(context.Background(), 32, nil)
+	if err != nil || len(fields) != 1 || fields[0].Value != "wool" || fields[0].ProductID != 32 {
+		t.Errorf("Unexpected fields %+v, %v", fields, err)
+	}
+
+	field, _, err := client.CustomFields.CreateV2(context.Background(), 32, &CustomField{Name: "origin", Value: "Italy"})
+	if err != nil || field.ID != 2 || field.Value != "Italy" {
+		t.Errorf("Unexpected field %+v, %v", field, err)
+	}
+}
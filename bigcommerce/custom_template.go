@@ -0,0 +1,80 @@
+package bigcommerce
+
+import "context"
+
+// CustomTemplateService handles communication with the V3 custom template
+// association endpoints
+type CustomTemplateService service
+
+// CustomTemplateAssociation describes the custom theme template a product,
+// category, brand or page is rendered with on a channel
+type CustomTemplateAssociation struct {
+	ID           int64              `json:"id,omitempty"`            // The unique numerical ID of the association.
+	ChannelID    int64              `json:"channel_id"`              // The storefront channel.
+	EntityType   TemplateEntityType `json:"entity_type"`             // The kind of entity.
+	EntityID     int64              `json:"entity_id"`               // The ID of the entity.
+	File         string             `json:"file"`                    // The custom template file, e.g. "custom-product.html".
+	IsValid      bool               `json:"is_valid,omitempty"`      // Whether the file exists in the channel's active theme. Read-only.
+	DateCreated  string             `json:"date_created,omitempty"`  // Read-only.
+	DateModified string             `json:"date_modified,omitempty"` // Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// TemplateEntityType - The kind of entity rendered with a custom template
+type TemplateEntityType string
+
+const (
+	// ProductTemplate - a product page
+	ProductTemplate TemplateEntityType = "product"
+	// CategoryTemplate - a category page
+	CategoryTemplate TemplateEntityType = "category"
+	// BrandTemplate - a brand page
+	BrandTemplate TemplateEntityType = "brand"
+	// PageTemplate - a web page
+	PageTemplate TemplateEntityType = "page"
+)
+
+// CustomTemplateListOptions specifies the optional parameters to CustomTemplateService.List
+type CustomTemplateListOptions struct {
+	ListOptions
+	IDs        []int64            `url:"id:in,omitempty"`
+	ChannelID  int64              `url:"channel_id,omitempty"`
+	EntityIDs  []int64            `url:"entity_id:in,omitempty"`
+	EntityType TemplateEntityType `url:"type,omitempty"`
+	IsValid    *bool              `url:"is_valid,omitempty"`
+}
+
+// List returns a page of custom template associations
+func (s *CustomTemplateService) List(ctx context.Context, opts *CustomTemplateListOptions) ([]*CustomTemplateAssociation, *Response, error) {
+	path, err := addOptions("v3/storefront/custom-template-associations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var associations []*CustomTemplateAssociation
+	resp, err := s.client.call(ctx, "GET", path, nil, &associations)
+	if err != nil {
+		return nil, resp, err
+	}
+	return associations, resp, nil
+}
+
+// Upsert sets the custom templates of entities, replacing the association of
+// any entity that already has one on the channel
+func (s *CustomTemplateService) Upsert(ctx context.Context, associations []*CustomTemplateAssociation) (*Response, error) {
+	return s.client.call(ctx, "PUT", "v3/storefront/custom-template-associations", associations, nil)
+}
+
+// Delete removes custom template associations, so their entities are
+// rendered with the theme's default template again
+func (s *CustomTemplateService) Delete(ctx context.Context, ids []int64) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/storefront/custom-template-associations", &CustomTemplateListOptions{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,65 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCustomTemplateService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/custom-template-associations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"channel_id": "1", "type": "product", "entity_id:in": "4,5"})
+		fmt.Fprint(w, `{"data":[{"id":9,"channel_id":1,"entity_type":"product","entity_id":4,"file":"custom-sale.html","is_valid":true}],"meta":{}}`)
+	})
+
+	associations, _, err := client.CustomTemplates.List(context.Background(), &CustomTemplateListOptions{ChannelID: 1, EntityType: ProductTemplate, EntityIDs: []int64{4, 5}})
+	if err != nil || len(associations) != 1 || associations[0].File != "custom-sale.html" || !associations[0].IsValid {
+		t.Errorf("List = %+v, %v", associations, err)
+	}
+}
+
+func TestCustomTemplateService_Upsert(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*CustomTemplateAssociation{{ChannelID: 1, EntityType: CategoryTemplate, EntityID: 18, File: "custom-category.html"}}
+	mux.HandleFunc("/stores/abc123/v3/storefront/custom-template-associations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*CustomTemplateAssociation{}, &input)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomTemplates.Upsert(context.Background(), input); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCustomTemplateService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/custom-template-associations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "9,10"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomTemplates.Delete(context.Background(), []int64{9, 10}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCustomTemplateService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CustomTemplates.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
@@ -0,0 +1,169 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CustomerService handles communication with the V2 customer endpoints
+type CustomerService service
+
+// Customer describes a BigCommerce V2 Customer Object
+type Customer struct {
+	ID                    int64  `json:"id,omitempty"`                      // The unique numerical ID of the customer.
+	Company               string `json:"company,omitempty"`                 // The name of the company for which the customer works.
+	FirstName             string `json:"first_name,omitempty"`              // First name of the customer.
+	LastName              string `json:"last_name,omitempty"`               // Last name of the customer.
+	Email                 string `json:"email,omitempty"`                   // Email address of the customer.
+	Phone                 string `json:"phone,omitempty"`                   // Phone number of the customer.
+	DateCreated           string `json:"date_created,omitempty"`            // Date on which the customer was created.
+	DateModified          string `json:"date_modified,omitempty"`           // Date on which the customer was last updated.
+	StoreCredit           string `json:"store_credit,omitempty"`            // The amount of credit the customer has.
+	RegistrationIPAddress string `json:"registration_ip_address,omitempty"` // The customer's IP address when they signed up.
+	CustomerGroupID       int64  `json:"customer_group_id,omitempty"`       // The group to which the customer belongs.
+	Notes                 string `json:"notes,omitempty"`                   // Store-owner notes on the customer.
+	TaxExemptCategory     string `json:"tax_exempt_category,omitempty"`     // Used to identify customers who fall into special sales-tax categories.
+	AcceptsMarketing      bool   `json:"accepts_marketing,omitempty"`       // Whether the customer has opted in to marketing emails.
+
+	Authentication *CustomerAuthentication `json:"_authentication,omitempty"` // Sets the customer's password. Write-only.
+}
+
+// CustomerAuthentication sets the password of a customer on create or update
+type CustomerAuthentication struct {
+	Password             string `json:"password,omitempty"`              // The new password.
+	PasswordConfirmation string `json:"password_confirmation,omitempty"` // Must match Password when set.
+	ForceReset           bool   `json:"force_reset,omitempty"`           // Make the customer choose a new password on their next login.
+}
+
+// CustomerListOptions specifies the optional parameters to CustomerService.List and Count
+type CustomerListOptions struct {
+	ListOptions
+	MinID           int64     `url:"min_id,omitempty"`
+	MaxID           int64     `url:"max_id,omitempty"`
+	FirstName       string    `url:"first_name,omitempty"`
+	LastName        string    `url:"last_name,omitempty"`
+	Company         string    `url:"company,omitempty"`
+	Email           string    `url:"email,omitempty"` // Filter by exact email address.
+	Phone           string    `url:"phone,omitempty"`
+	CustomerGroupID *int64    `url:"customer_group_id,omitempty"` // A pointer, since 0 lists customers without a group.
+	MinDateCreated  time.Time `url:"min_date_created,omitempty"`
+	MaxDateCreated  time.Time `url:"max_date_created,omitempty"`
+	MinDateModified time.Time `url:"min_date_modified,omitempty"` // Only return customers modified on or after this time, for incremental syncs.
+	MaxDateModified time.Time `url:"max_date_modified,omitempty"`
+}
+
+// List returns a page of customers. The V2 API responds to pages past the end
+// with no content, returned as an empty list.
+func (s *CustomerService) List(ctx context.Context, opts *CustomerListOptions) ([]*Customer, *Response, error) {
+	path, err := addOptions("v2/customers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customers []*Customer
+	resp, err := s.client.call(ctx, "GET", path, nil, &customers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return customers, resp, nil
+}
+
+// Count returns the number of customers matching opts
+func (s *CustomerService) Count(ctx context.Context, opts *CustomerListOptions) (int64, *Response, error) {
+	path, err := addOptions("v2/customers/count", opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := s.client.call(ctx, "GET", path, nil, &count)
+	if err != nil {
+		return 0, resp, err
+	}
+	return count.Count, resp, nil
+}
+
+// Get returns a single customer
+func (s *CustomerService) Get(ctx context.Context, id int64) (*Customer, *Response, error) {
+	customer := new(Customer)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/customers/%d", id), nil, customer)
+	if err != nil {
+		return nil, resp, err
+	}
+	return customer, resp, nil
+}
+
+// Create adds a customer. FirstName, LastName and Email are required
+func (s *CustomerService) Create(ctx context.Context, customer *Customer) (*Customer, *Response, error) {
+	created := new(Customer)
+	resp, err := s.client.call(ctx, "POST", "v2/customers", customer, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a customer
+func (s *CustomerService) Update(ctx context.Context, id int64, customer *Customer) (*Customer, *Response, error) {
+	updated := new(Customer)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/customers/%d", id), customer, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a customer
+func (s *CustomerService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/customers/%d", id), nil, nil)
+}
+
+// CustomerCredentials are the login details checked by ValidateCredentials
+type CustomerCredentials struct {
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	ChannelID int64  `json:"channel_id,omitempty"` // The storefront channel, the default channel if zero.
+}
+
+// CredentialsValidation is the result of ValidateCredentials
+type CredentialsValidation struct {
+	IsValid    bool  `json:"is_valid"`
+	CustomerID int64 `json:"customer_id,omitempty"` // The customer the credentials belong to, when valid.
+}
+
+// ValidatePassword reports whether password is the customer's password, so
+// external login systems can verify shoppers against BigCommerce. Use
+// ValidateCredentials when only the shopper's email is known.
+func (s *CustomerService) ValidatePassword(ctx context.Context, id int64, password string) (bool, *Response, error) {
+	var result struct {
+		Success bool `json:"success"`
+	}
+	body := struct {
+		Password string `json:"password"`
+	}{password}
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/customers/%d/validate", id), body, &result)
+	if err != nil {
+		return false, resp, err
+	}
+	return result.Success, resp, nil
+}
+
+// ValidateCredentials checks an email and password against the customers of
+// a channel, returning the customer's ID when they match
+func (s *CustomerService) ValidateCredentials(ctx context.Context, credentials *CustomerCredentials) (*CredentialsValidation, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "v3/customers/validate-credentials", credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Unlike other V3 endpoints, the response has no data envelope
+	result := new(CredentialsValidation)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
@@ -0,0 +1,140 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomerAddressService handles communication with the V3 customer address
+// endpoints, which create, update and delete addresses of many customers in
+// one request
+type CustomerAddressService service
+
+// AddressListOptions specifies the optional parameters to CustomerAddressService.List
+type AddressListOptions struct {
+	ListOptions
+	IDs          []int64  `url:"id:in,omitempty"`           // Filter by address IDs.
+	CustomerIDs  []int64  `url:"customer_id:in,omitempty"`  // Filter by customer IDs.
+	Names        []string `url:"name:in,omitempty"`         // Filter by full names, first and last name joined by a space.
+	Companies    []string `url:"company:in,omitempty"`      // Filter by company names.
+	CountryCodes []string `url:"country_code:in,omitempty"` // Filter by ISO2 country codes.
+	Include      []string `url:"include,omitempty"`         // Sub-resources to include, e.g. formfields.
+}
+
+// ListAddresses returns a page of the addresses of a customer
+func (s *CustomerService) ListAddresses(ctx context.Context, customerID int64, opts *ListOptions) ([]*Address, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/customers/%d/addresses", customerID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addresses []*Address
+	resp, err := s.client.call(ctx, "GET", path, nil, &addresses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return addresses, resp, nil
+}
+
+// GetAddress returns a single address of a customer
+func (s *CustomerService) GetAddress(ctx context.Context, customerID, addressID int64) (*Address, *Response, error) {
+	address := new(Address)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/customers/%d/addresses/%d", customerID, addressID), nil, address)
+	if err != nil {
+		return nil, resp, err
+	}
+	return address, resp, nil
+}
+
+// CreateAddress adds an address to a customer. FirstName, LastName, Phone,
+// Address1, City, StateOrProvince, PostalCode and Country are required.
+func (s *CustomerService) CreateAddress(ctx context.Context, customerID int64, address *Address) (*Address, *Response, error) {
+	if err := s.client.validateAddresses(ctx, address); err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Address)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/customers/%d/addresses", customerID), (*v2Address)(address), created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateAddress modifies an address of a customer
+func (s *CustomerService) UpdateAddress(ctx context.Context, customerID, addressID int64, address *Address) (*Address, *Response, error) {
+	if err := s.client.validateAddressUpdates(ctx, address); err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Address)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/customers/%d/addresses/%d", customerID, addressID), (*v2Address)(address), updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteAddress removes an address from a customer
+func (s *CustomerService) DeleteAddress(ctx context.Context, customerID, addressID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/customers/%d/addresses/%d", customerID, addressID), nil, nil)
+}
+
+// List returns a page of addresses across customers
+func (s *CustomerAddressService) List(ctx context.Context, opts *AddressListOptions) ([]*Address, *Response, error) {
+	path, err := addOptions("v3/customers/addresses", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addresses []*Address
+	resp, err := s.client.call(ctx, "GET", path, nil, &addresses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return addresses, resp, nil
+}
+
+// Create adds addresses to customers in one request. Every address must set
+// CustomerID, FirstName, LastName, Address1, City, StateOrProvince, PostalCode
+// and CountryCode.
+func (s *CustomerAddressService) Create(ctx context.Context, addresses []*Address) ([]*Address, *Response, error) {
+	return s.batch(ctx, "POST", addresses)
+}
+
+// Update modifies addresses of customers in one request. Every address must
+// set ID.
+func (s *CustomerAddressService) Update(ctx context.Context, addresses []*Address) ([]*Address, *Response, error) {
+	return s.batch(ctx, "PUT", addresses)
+}
+
+// Delete removes addresses in one request
+func (s *CustomerAddressService) Delete(ctx context.Context, addressIDs []int64) (*Response, error) {
+	if len(addressIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/customers/addresses", &struct {
+		IDs []int64 `url:"id:in"`
+	}{addressIDs})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *CustomerAddressService) batch(ctx context.Context, method string, addresses []*Address) ([]*Address, *Response, error) {
+	validate := s.client.validateAddresses
+	if method == "PUT" {
+		validate = s.client.validateAddressUpdates
+	}
+	if err := validate(ctx, addresses...); err != nil {
+		return nil, nil, err
+	}
+
+	var results []*Address
+	resp, err := s.client.call(ctx, method, "v3/customers/addresses", addresses, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+	return results, resp, nil
+}
@@ -0,0 +1,201 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCustomerService_ListAddresses(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"page": "2"})
+		fmt.Fprint(w, `[{"id":3,"customer_id":7,"street_1":"1 Main St","city":"Austin","state":"Texas","zip":"78701",
+			"country":"United States","country_iso2":"US","address_type":"commercial"}]`)
+	})
+
+	addresses, _, err := client.Customers.ListAddresses(context.Background(), 7, &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Address{{ID: 3, CustomerID: 7, Address1: "1 Main St", City: "Austin", StateOrProvince: "Texas", PostalCode: "78701",
+		Country: "United States", CountryCode: "US", AddressType: CommercialAddress}}
+	if !reflect.DeepEqual(addresses, want) {
+		t.Errorf("ListAddresses = %+v, want %+v", addresses[0], want[0])
+	}
+}
+
+func TestCustomerService_GetAddress(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,"customer_id":7,"address_type":"residential"}`)
+	})
+
+	address, _, err := client.Customers.GetAddress(context.Background(), 7, 3)
+	if err != nil || address.ID != 3 || address.AddressType != ResidentialAddress {
+		t.Errorf("GetAddress = %+v, %v", address, err)
+	}
+}
+
+func TestCustomerService_CreateAddress(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Address{FirstName: "Jane", LastName: "Doe", Phone: "555-0100", Address1: "1 Main St", City: "Austin",
+		StateOrProvince: "Texas", PostalCode: "78701", Country: "United States", AddressType: ResidentialAddress}
+	mux.HandleFunc("/stores/abc123/v2/customers/7/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(map[string]interface{}), &map[string]interface{}{
+			"first_name": "Jane", "last_name": "Doe", "phone": "555-0100", "street_1": "1 Main St", "city": "Austin",
+			"state": "Texas", "zip": "78701", "country": "United States", "address_type": "residential",
+		})
+		fmt.Fprint(w, `{"id":4,"customer_id":7}`)
+	})
+
+	address, _, err := client.Customers.CreateAddress(context.Background(), 7, input)
+	if err != nil || address.ID != 4 {
+		t.Errorf("CreateAddress = %+v, %v", address, err)
+	}
+}
+
+func TestCustomerService_CreateAddress_invalid(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	invalid := errors.New("invalid")
+	WithAddressValidator(AddressValidatorFunc(func(ctx context.Context, addr *Address) error {
+		if addr.CountryCode != "US" {
+			return invalid
+		}
+		return nil
+	}))(client)
+
+	_, _, err := client.Customers.CreateAddress(context.Background(), 7, &Address{CountryCode: "XX"})
+	if err != invalid {
+		t.Errorf("CreateAddress error = %v, want %v", err, invalid)
+	}
+}
+
+func TestCustomerService_UpdateAddress(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Address), &Address{Phone: "555-0199"})
+		fmt.Fprint(w, `{"id":3,"phone":"555-0199"}`)
+	})
+
+	address, _, err := client.Customers.UpdateAddress(context.Background(), 7, 3, &Address{Phone: "555-0199"})
+	if err != nil || address.Phone != "555-0199" {
+		t.Errorf("UpdateAddress = %+v, %v", address, err)
+	}
+}
+
+func TestCustomerService_DeleteAddress(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7/addresses/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Customers.DeleteAddress(context.Background(), 7, 3); err != nil {
+		t.Errorf("DeleteAddress returned error: %v", err)
+	}
+}
+
+func TestCustomerAddressService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"customer_id:in": "7,8", "country_code:in": "US"})
+		fmt.Fprint(w, `{"data":[{"id":3,"customer_id":7,"address1":"1 Main St","state_or_province":"Texas","postal_code":"78701",
+			"country":"United States","country_code":"US","address_type":"residential"}],"meta":{}}`)
+	})
+
+	opts := &AddressListOptions{CustomerIDs: []int64{7, 8}, CountryCodes: []string{"US"}}
+	addresses, _, err := client.CustomerAddresses.List(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Address{{ID: 3, CustomerID: 7, Address1: "1 Main St", StateOrProvince: "Texas", PostalCode: "78701",
+		Country: "United States", CountryCode: "US", AddressType: ResidentialAddress}}
+	if !reflect.DeepEqual(addresses, want) {
+		t.Errorf("List = %+v, want %+v", addresses[0], want[0])
+	}
+}
+
+func TestCustomerAddressService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*Address{
+		{CustomerID: 7, FirstName: "Jane", LastName: "Doe", Address1: "1 Main St", City: "Austin", StateOrProvince: "Texas", PostalCode: "78701", CountryCode: "US"},
+		{CustomerID: 8, FirstName: "John", LastName: "Roe", Address1: "2 High St", City: "Leeds", PostalCode: "LS1 1AA", CountryCode: "GB", AddressType: CommercialAddress},
+	}
+	mux.HandleFunc("/stores/abc123/v3/customers/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, &[]*Address{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":11,"customer_id":7},{"id":12,"customer_id":8}],"meta":{}}`)
+	})
+
+	addresses, _, err := client.CustomerAddresses.Create(context.Background(), input)
+	if err != nil || len(addresses) != 2 || addresses[1].ID != 12 {
+		t.Errorf("Create = %+v, %v", addresses, err)
+	}
+}
+
+func TestCustomerAddressService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*Address{{ID: 11, Phone: "555-0100"}}
+	mux.HandleFunc("/stores/abc123/v3/customers/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*Address{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":11,"phone":"555-0100"}],"meta":{}}`)
+	})
+
+	addresses, _, err := client.CustomerAddresses.Update(context.Background(), input)
+	if err != nil || len(addresses) != 1 || addresses[0].Phone != "555-0100" {
+		t.Errorf("Update = %+v, %v", addresses, err)
+	}
+}
+
+func TestCustomerAddressService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "11,12"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomerAddresses.Delete(context.Background(), []int64{11, 12}); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerAddressService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CustomerAddresses.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
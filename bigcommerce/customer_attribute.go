@@ -0,0 +1,148 @@
+package bigcommerce
+
+import (
+	"context"
+)
+
+// CustomerAttributeService handles communication with the V3 customer
+// attribute endpoints. Attributes are store-wide definitions of custom data,
+// such as loyalty points or an external ID, whose values are set per customer.
+type CustomerAttributeService service
+
+// CustomerAttribute describes a BigCommerce V3 Customer Attribute Object
+type CustomerAttribute struct {
+	ID           int64         `json:"id,omitempty"`   // The unique numerical ID of the attribute. Required on update.
+	Name         string        `json:"name,omitempty"` // The name of the attribute, unique in the store.
+	Type         AttributeType `json:"type,omitempty"` // The type of the values. Required on create, and cannot be changed.
+	DateCreated  string        `json:"date_created,omitempty"`
+	DateModified string        `json:"date_modified,omitempty"`
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// AttributeValue describes a BigCommerce V3 Customer Attribute Value Object,
+// the value of an attribute for a customer
+type AttributeValue struct {
+	ID           int64  `json:"id,omitempty"`           // The unique numerical ID of the value. Read-only.
+	AttributeID  int64  `json:"attribute_id,omitempty"` // The attribute. Required.
+	CustomerID   int64  `json:"customer_id,omitempty"`  // The customer. Required.
+	Value        string `json:"attribute_value"`        // The value, formatted for the attribute's type, e.g. "120" or "2021-03-01".
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// AttributeType - The type of the values of a customer attribute
+type AttributeType string
+
+const (
+	// StringAttribute - values are text
+	StringAttribute AttributeType = "string"
+	// NumberAttribute - values are numbers
+	NumberAttribute AttributeType = "number"
+	// DateAttribute - values are dates, formatted as YYYY-MM-DD
+	DateAttribute AttributeType = "date"
+)
+
+// CustomerAttributeListOptions specifies the optional parameters to CustomerAttributeService.List
+type CustomerAttributeListOptions struct {
+	ListOptions
+	Name  string        `url:"name,omitempty"`    // Filter by exact attribute name.
+	Names []string      `url:"name:in,omitempty"` // Filter by attribute names.
+	Type  AttributeType `url:"type,omitempty"`    // Filter by type.
+}
+
+// AttributeValueListOptions specifies the optional parameters to CustomerAttributeService.ListValues
+type AttributeValueListOptions struct {
+	ListOptions
+	CustomerIDs  []int64 `url:"customer_id:in,omitempty"`  // Filter by customer IDs.
+	AttributeIDs []int64 `url:"attribute_id:in,omitempty"` // Filter by attribute IDs.
+}
+
+// List returns a page of customer attributes
+func (s *CustomerAttributeService) List(ctx context.Context, opts *CustomerAttributeListOptions) ([]*CustomerAttribute, *Response, error) {
+	path, err := addOptions("v3/customers/attributes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attributes []*CustomerAttribute
+	resp, err := s.client.call(ctx, "GET", path, nil, &attributes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return attributes, resp, nil
+}
+
+// Create adds customer attributes in one request. Name and Type are required.
+func (s *CustomerAttributeService) Create(ctx context.Context, attributes []*CustomerAttribute) ([]*CustomerAttribute, *Response, error) {
+	var created []*CustomerAttribute
+	resp, err := s.client.call(ctx, "POST", "v3/customers/attributes", attributes, &created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update renames customer attributes in one request. ID is required.
+func (s *CustomerAttributeService) Update(ctx context.Context, attributes []*CustomerAttribute) ([]*CustomerAttribute, *Response, error) {
+	var updated []*CustomerAttribute
+	resp, err := s.client.call(ctx, "PUT", "v3/customers/attributes", attributes, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes customer attributes, along with their values for every customer
+func (s *CustomerAttributeService) Delete(ctx context.Context, attributeIDs []int64) (*Response, error) {
+	if len(attributeIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/customers/attributes", &struct {
+		IDs []int64 `url:"id:in"`
+	}{attributeIDs})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListValues returns a page of attribute values
+func (s *CustomerAttributeService) ListValues(ctx context.Context, opts *AttributeValueListOptions) ([]*AttributeValue, *Response, error) {
+	path, err := addOptions("v3/customers/attribute-values", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []*AttributeValue
+	resp, err := s.client.call(ctx, "GET", path, nil, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// UpsertValues sets attribute values of customers in one request, creating
+// them or replacing the values of the same attribute and customer
+func (s *CustomerAttributeService) UpsertValues(ctx context.Context, values []*AttributeValue) ([]*AttributeValue, *Response, error) {
+	var upserted []*AttributeValue
+	resp, err := s.client.call(ctx, "PUT", "v3/customers/attribute-values", values, &upserted)
+	if err != nil {
+		return nil, resp, err
+	}
+	return upserted, resp, nil
+}
+
+// DeleteValues removes attribute values
+func (s *CustomerAttributeService) DeleteValues(ctx context.Context, valueIDs []int64) (*Response, error) {
+	if len(valueIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/customers/attribute-values", &struct {
+		IDs []int64 `url:"id:in"`
+	}{valueIDs})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
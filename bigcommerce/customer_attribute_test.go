@@ -0,0 +1,151 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCustomerAttributeService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/attributes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"type": "number"})
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Loyalty points","type":"number","date_created":"2021-03-01T00:00:00Z"}],"meta":{}}`)
+	})
+
+	attributes, _, err := client.CustomerAttributes.List(context.Background(), &CustomerAttributeListOptions{Type: NumberAttribute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*CustomerAttribute{{ID: 1, Name: "Loyalty points", Type: NumberAttribute, DateCreated: "2021-03-01T00:00:00Z"}}
+	if !reflect.DeepEqual(attributes, want) {
+		t.Errorf("List = %+v, want %+v", attributes[0], want[0])
+	}
+}
+
+func TestCustomerAttributeService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*CustomerAttribute{{Name: "ERP ID", Type: StringAttribute}, {Name: "Joined", Type: DateAttribute}}
+	mux.HandleFunc("/stores/abc123/v3/customers/attributes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, &[]*CustomerAttribute{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":2,"name":"ERP ID","type":"string"},{"id":3,"name":"Joined","type":"date"}],"meta":{}}`)
+	})
+
+	attributes, _, err := client.CustomerAttributes.Create(context.Background(), input)
+	if err != nil || len(attributes) != 2 || attributes[1].ID != 3 {
+		t.Errorf("Create = %+v, %v", attributes, err)
+	}
+}
+
+func TestCustomerAttributeService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*CustomerAttribute{{ID: 2, Name: "NetSuite ID"}}
+	mux.HandleFunc("/stores/abc123/v3/customers/attributes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*CustomerAttribute{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":2,"name":"NetSuite ID","type":"string"}],"meta":{}}`)
+	})
+
+	attributes, _, err := client.CustomerAttributes.Update(context.Background(), input)
+	if err != nil || len(attributes) != 1 || attributes[0].Name != "NetSuite ID" {
+		t.Errorf("Update = %+v, %v", attributes, err)
+	}
+}
+
+func TestCustomerAttributeService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/attributes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "2,3"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomerAttributes.Delete(context.Background(), []int64{2, 3}); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerAttributeService_ListValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/attribute-values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"customer_id:in": "7", "attribute_id:in": "1,2"})
+		fmt.Fprint(w, `{"data":[{"id":9,"attribute_id":1,"customer_id":7,"attribute_value":"120"}],"meta":{}}`)
+	})
+
+	opts := &AttributeValueListOptions{CustomerIDs: []int64{7}, AttributeIDs: []int64{1, 2}}
+	values, _, err := client.CustomerAttributes.ListValues(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*AttributeValue{{ID: 9, AttributeID: 1, CustomerID: 7, Value: "120"}}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("ListValues = %+v, want %+v", values[0], want[0])
+	}
+}
+
+func TestCustomerAttributeService_UpsertValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*AttributeValue{{AttributeID: 1, CustomerID: 7, Value: "150"}, {AttributeID: 2, CustomerID: 7, Value: ""}}
+	mux.HandleFunc("/stores/abc123/v3/customers/attribute-values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*AttributeValue{}, &input)
+		fmt.Fprint(w, `{"data":[{"id":9,"attribute_id":1,"customer_id":7,"attribute_value":"150"},{"id":10,"attribute_id":2,"customer_id":7,"attribute_value":""}],"meta":{}}`)
+	})
+
+	values, _, err := client.CustomerAttributes.UpsertValues(context.Background(), input)
+	if err != nil || len(values) != 2 || values[0].Value != "150" {
+		t.Errorf("UpsertValues = %+v, %v", values, err)
+	}
+}
+
+func TestCustomerAttributeService_DeleteValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/attribute-values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "9"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomerAttributes.DeleteValues(context.Background(), []int64{9}); err != nil {
+		t.Errorf("DeleteValues returned error: %v", err)
+	}
+}
+
+func TestCustomerAttributeService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CustomerAttributes.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
+
+func TestCustomerAttributeService_DeleteValues_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.CustomerAttributes.DeleteValues(ctx, nil); err != ErrNoIDs {
+		t.Errorf("DeleteValues returned %v, want ErrNoIDs", err)
+	}
+}
@@ -0,0 +1,157 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomerGroupService handles communication with the V2 customer group endpoints
+type CustomerGroupService service
+
+// CustomerGroup describes a BigCommerce V2 Customer Group Object. Groups
+// restrict the categories their customers see and give them discounts, e.g.
+// wholesale pricing.
+type CustomerGroup struct {
+	ID             int64                `json:"id,omitempty"`              // The unique numerical ID of the group. Read-only.
+	Name           string               `json:"name,omitempty"`            // The name of the group. Required on create.
+	IsDefault      bool                 `json:"is_default,omitempty"`      // Whether new customers are added to the group.
+	CategoryAccess *CategoryAccess      `json:"category_access,omitempty"` // The categories the group's customers can see.
+	DiscountRules  []*GroupDiscountRule `json:"discount_rules,omitempty"`  // Discounts applied to the group's customers, replaced as a whole on update.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// CategoryAccess describes the categories a customer group can see
+type CategoryAccess struct {
+	Type       CategoryAccessType `json:"type"`                 // All, specific or no categories.
+	Categories []int64            `json:"categories,omitempty"` // The visible categories, with SpecificCategoryAccess.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// GroupDiscountRule describes a discount given to a customer group, on the
+// whole store, a category, a product or through a price list
+type GroupDiscountRule struct {
+	Type        DiscountRuleType `json:"type"`                    // What the discount applies to.
+	Method      DiscountMethod   `json:"method,omitempty"`        // How Amount is applied. Not used by price list rules.
+	Amount      string           `json:"amount,omitempty"`        // The discount, e.g. "5.0000".
+	CategoryID  int64            `json:"category_id,omitempty"`   // The category discounted, with CategoryDiscount.
+	ProductID   int64            `json:"product_id,omitempty"`    // The product discounted, with ProductDiscount.
+	PriceListID int64            `json:"price_list_id,omitempty"` // The price list used, with PriceListDiscount.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// CategoryAccessType - Which categories a customer group can see
+type CategoryAccessType string
+
+// DiscountRuleType - What a customer group discount applies to
+type DiscountRuleType string
+
+// DiscountMethod - How the amount of a customer group discount is applied
+type DiscountMethod string
+
+const (
+	// AllCategoryAccess - the group sees every category
+	AllCategoryAccess CategoryAccessType = "all"
+	// SpecificCategoryAccess - the group only sees the listed categories
+	SpecificCategoryAccess CategoryAccessType = "specific"
+	// NoCategoryAccess - the group sees no categories
+	NoCategoryAccess CategoryAccessType = "none"
+
+	// StoreDiscount - discounts every product
+	StoreDiscount DiscountRuleType = "all"
+	// CategoryDiscount - discounts the products of a category
+	CategoryDiscount DiscountRuleType = "category"
+	// ProductDiscount - discounts a single product
+	ProductDiscount DiscountRuleType = "product"
+	// PriceListDiscount - prices products from a price list
+	PriceListDiscount DiscountRuleType = "price_list"
+
+	// PercentDiscount - takes a percentage off the price
+	PercentDiscount DiscountMethod = "percent"
+	// FixedDiscount - takes a fixed amount off the price
+	FixedDiscount DiscountMethod = "fixed"
+	// PriceDiscount - sets the price to the amount
+	PriceDiscount DiscountMethod = "price"
+)
+
+// CustomerGroupListOptions specifies the optional parameters to CustomerGroupService.List and Count
+type CustomerGroupListOptions struct {
+	ListOptions
+	Name      string `url:"name,omitempty"`       // Filter by exact group name.
+	IsDefault *bool  `url:"is_default,omitempty"` // Filter by whether the group is the default.
+}
+
+// List returns a page of customer groups
+func (s *CustomerGroupService) List(ctx context.Context, opts *CustomerGroupListOptions) ([]*CustomerGroup, *Response, error) {
+	path, err := addOptions("v2/customer_groups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*CustomerGroup
+	resp, err := s.client.call(ctx, "GET", path, nil, &groups)
+	if err != nil {
+		return nil, resp, err
+	}
+	return groups, resp, nil
+}
+
+// Count returns the number of customer groups matching opts
+func (s *CustomerGroupService) Count(ctx context.Context, opts *CustomerGroupListOptions) (int64, *Response, error) {
+	path, err := addOptions("v2/customer_groups/count", opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := s.client.call(ctx, "GET", path, nil, &count)
+	if err != nil {
+		return 0, resp, err
+	}
+	return count.Count, resp, nil
+}
+
+// Get returns a single customer group
+func (s *CustomerGroupService) Get(ctx context.Context, id int64) (*CustomerGroup, *Response, error) {
+	group := new(CustomerGroup)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/customer_groups/%d", id), nil, group)
+	if err != nil {
+		return nil, resp, err
+	}
+	return group, resp, nil
+}
+
+// Create adds a customer group. Name is required.
+func (s *CustomerGroupService) Create(ctx context.Context, group *CustomerGroup) (*CustomerGroup, *Response, error) {
+	created := new(CustomerGroup)
+	resp, err := s.client.call(ctx, "POST", "v2/customer_groups", group, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a customer group. When DiscountRules is set, it replaces
+// every rule of the group, so include the rules to keep.
+func (s *CustomerGroupService) Update(ctx context.Context, id int64, group *CustomerGroup) (*CustomerGroup, *Response, error) {
+	updated := new(CustomerGroup)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/customer_groups/%d", id), group, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a customer group. Its customers are left without a group.
+func (s *CustomerGroupService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/customer_groups/%d", id), nil, nil)
+}
+
+// Assign moves a customer into a customer group
+func (s *CustomerGroupService) Assign(ctx context.Context, groupID, customerID int64) (*Customer, *Response, error) {
+	return s.client.Customers.Update(ctx, customerID, &Customer{CustomerGroupID: groupID})
+}
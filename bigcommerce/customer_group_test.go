@@ -0,0 +1,137 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCustomerGroupService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customer_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"is_default": "false"})
+		fmt.Fprint(w, `[{"id":2,"name":"Wholesale","is_default":false,
+			"category_access":{"type":"specific","categories":[18,23]},
+			"discount_rules":[{"type":"all","method":"percent","amount":"10.0000"},{"type":"price_list","price_list_id":3},
+				{"type":"category","method":"fixed","amount":"2.0000","category_id":18}]}]`)
+	})
+
+	groups, _, err := client.CustomerGroups.List(context.Background(), &CustomerGroupListOptions{IsDefault: Bool(false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*CustomerGroup{{
+		ID: 2, Name: "Wholesale",
+		CategoryAccess: &CategoryAccess{Type: SpecificCategoryAccess, Categories: []int64{18, 23}},
+		DiscountRules: []*GroupDiscountRule{
+			{Type: StoreDiscount, Method: PercentDiscount, Amount: "10.0000"},
+			{Type: PriceListDiscount, PriceListID: 3},
+			{Type: CategoryDiscount, Method: FixedDiscount, Amount: "2.0000", CategoryID: 18},
+		},
+	}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("List = %+v, want %+v", groups[0], want[0])
+	}
+}
+
+func TestCustomerGroupService_Count(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customer_groups/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count":4}`)
+	})
+
+	count, _, err := client.CustomerGroups.Count(context.Background(), nil)
+	if err != nil || count != 4 {
+		t.Errorf("Count = %d, %v", count, err)
+	}
+}
+
+func TestCustomerGroupService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customer_groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"Wholesale","category_access":{"type":"all"},"discount_rules":[]}`)
+	})
+
+	group, _, err := client.CustomerGroups.Get(context.Background(), 2)
+	if err != nil || group.Name != "Wholesale" || group.CategoryAccess.Type != AllCategoryAccess {
+		t.Errorf("Get = %+v, %v", group, err)
+	}
+}
+
+func TestCustomerGroupService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &CustomerGroup{
+		Name:           "Trade",
+		CategoryAccess: &CategoryAccess{Type: NoCategoryAccess},
+		DiscountRules:  []*GroupDiscountRule{{Type: ProductDiscount, Method: PriceDiscount, Amount: "9.99", ProductID: 77}},
+	}
+	mux.HandleFunc("/stores/abc123/v2/customer_groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(CustomerGroup), input)
+		fmt.Fprint(w, `{"id":5,"name":"Trade"}`)
+	})
+
+	group, _, err := client.CustomerGroups.Create(context.Background(), input)
+	if err != nil || group.ID != 5 {
+		t.Errorf("Create = %+v, %v", group, err)
+	}
+}
+
+func TestCustomerGroupService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customer_groups/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(CustomerGroup), &CustomerGroup{Name: "Trade+"})
+		fmt.Fprint(w, `{"id":5,"name":"Trade+"}`)
+	})
+
+	group, _, err := client.CustomerGroups.Update(context.Background(), 5, &CustomerGroup{Name: "Trade+"})
+	if err != nil || group.Name != "Trade+" {
+		t.Errorf("Update = %+v, %v", group, err)
+	}
+}
+
+func TestCustomerGroupService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customer_groups/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.CustomerGroups.Delete(context.Background(), 5); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerGroupService_Assign(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Customer), &Customer{CustomerGroupID: 5})
+		fmt.Fprint(w, `{"id":7,"customer_group_id":5}`)
+	})
+
+	customer, _, err := client.CustomerGroups.Assign(context.Background(), 5, 7)
+	if err != nil || customer.CustomerGroupID != 5 {
+		t.Errorf("Assign = %+v, %v", customer, err)
+	}
+}
@@ -0,0 +1,57 @@
+package bigcommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCustomerLogin_URL(t *testing.T) {
+	login := &CustomerLogin{ClientID: "client", ClientSecret: "secret", StoreHash: "abc123", StoreURL: "https://example.com/"}
+	u, err := login.URL(7, &CustomerLoginOptions{RedirectTo: "/cart.php", ChannelID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(u, "https://example.com/login/token/") {
+		t.Fatalf("URL = %q", u)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u, "https://example.com/login/token/"), ".")
+	if len(parts) != 3 {
+		t.Fatalf("token has %d parts", len(parts))
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil)); sig != parts[2] {
+		t.Errorf("signature = %q, want %q", parts[2], sig)
+	}
+
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims CustomerLoginClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims.Issuer != "client" || claims.Operation != "customer_login" || claims.StoreHash != "abc123" ||
+		claims.CustomerID != 7 || claims.RedirectTo != "/cart.php" || claims.ChannelID != 2 || len(claims.JTI) != 32 {
+		t.Errorf("claims = %+v", claims)
+	}
+	if d := time.Since(time.Unix(claims.IssuedAt, 0)); d < 0 || d > time.Minute {
+		t.Errorf("iat = %d", claims.IssuedAt)
+	}
+
+	other, _ := login.Token(7, nil)
+	if strings.Split(other, ".")[1] == parts[1] {
+		t.Error("tokens share a jti")
+	}
+}
+
+func TestCustomerLogin_missingSecret(t *testing.T) {
+	login := &CustomerLogin{ClientID: "client", StoreHash: "abc123", StoreURL: "https://example.com"}
+	if _, err := login.URL(7, nil); err == nil {
+		t.Error("URL succeeded without a client secret")
+	}
+}
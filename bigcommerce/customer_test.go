@@ -0,0 +1,152 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCustomerService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":7,"email":"jane@example.com","customer_group_id":2}`)
+	})
+
+	customer, _, err := client.Customers.Get(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if customer.Email != "jane@example.com" || customer.CustomerGroupID != 2 {
+		t.Errorf("Get = %+v", customer)
+	}
+}
+
+func TestCustomerService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Customer), &Customer{Email: "new@example.com"})
+		fmt.Fprint(w, `{"id":7,"email":"new@example.com"}`)
+	})
+
+	customer, _, err := client.Customers.Update(context.Background(), 7, &Customer{Email: "new@example.com"})
+	if err != nil || customer.Email != "new@example.com" {
+		t.Errorf("Update = %+v, %v", customer, err)
+	}
+}
+
+func TestCustomerService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"email": "jane@example.com", "customer_group_id": "0", "min_date_modified": "2021-03-01T00:00:00Z"})
+		fmt.Fprint(w, `[{"id":7,"email":"jane@example.com","store_credit":"12.5000","customer_group_id":0}]`)
+	})
+
+	opts := &CustomerListOptions{Email: "jane@example.com", CustomerGroupID: Int64(0), MinDateModified: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)}
+	customers, _, err := client.Customers.List(context.Background(), opts)
+	if err != nil || len(customers) != 1 || customers[0].StoreCredit != "12.5000" {
+		t.Errorf("List = %+v, %v", customers, err)
+	}
+}
+
+func TestCustomerService_Count(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"customer_group_id": "2"})
+		fmt.Fprint(w, `{"count":42}`)
+	})
+
+	count, _, err := client.Customers.Count(context.Background(), &CustomerListOptions{CustomerGroupID: Int64(2)})
+	if err != nil || count != 42 {
+		t.Errorf("Count = %d, %v", count, err)
+	}
+}
+
+func TestCustomerService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Customer{
+		FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", CustomerGroupID: 2, StoreCredit: "10.00",
+		Authentication: &CustomerAuthentication{Password: "s3cret-Pass", PasswordConfirmation: "s3cret-Pass", ForceReset: true},
+	}
+	mux.HandleFunc("/stores/abc123/v2/customers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Customer), input)
+		fmt.Fprint(w, `{"id":8,"first_name":"Jane","last_name":"Doe","email":"jane@example.com","customer_group_id":2,"store_credit":"10.0000"}`)
+	})
+
+	customer, _, err := client.Customers.Create(context.Background(), input)
+	if err != nil || customer.ID != 8 || customer.Authentication != nil {
+		t.Errorf("Create = %+v, %v", customer, err)
+	}
+}
+
+func TestCustomerService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Customers.Delete(context.Background(), 7); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerService_ValidatePassword(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7/validate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body struct {
+			Password string `json:"password"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprintf(w, `{"success":%t}`, body.Password == "s3cret")
+	})
+
+	for password, want := range map[string]bool{"s3cret": true, "guess": false} {
+		ok, _, err := client.Customers.ValidatePassword(context.Background(), 7, password)
+		if err != nil || ok != want {
+			t.Errorf("ValidatePassword(%q) = %v, %v, want %v", password, ok, err, want)
+		}
+	}
+}
+
+func TestCustomerService_ValidateCredentials(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &CustomerCredentials{Email: "jane@example.com", Password: "s3cret", ChannelID: 2}
+	mux.HandleFunc("/stores/abc123/v3/customers/validate-credentials", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(CustomerCredentials), input)
+		fmt.Fprint(w, `{"is_valid":true,"customer_id":7}`)
+	})
+
+	result, _, err := client.Customers.ValidateCredentials(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (CredentialsValidation{IsValid: true, CustomerID: 7}); *result != want {
+		t.Errorf("ValidateCredentials = %+v, want %+v", result, want)
+	}
+}
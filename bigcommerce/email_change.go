@@ -0,0 +1,151 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/journal"
+)
+
+// EmailChanger changes a customer's email address everywhere the store
+// records it: the customer, the newsletter subscriber list, and customer
+// metafields mapping the address onto external system IDs. The API has no
+// transactions, so every change is undone if a later one fails.
+type EmailChanger struct {
+	Client *Client
+
+	// Namespaces lists the customer metafield namespaces holding email-based
+	// external ID maps. Metafields in them whose value is the old address are
+	// rewritten to the new one.
+	Namespaces []string
+}
+
+// EmailChangeError describes a failed email change
+type EmailChangeError struct {
+	CustomerID     int64
+	Step           string  // The change that failed, e.g. "update subscriber 12".
+	Err            error   // Why it failed.
+	RollbackErrors []error // Changes that could not be undone, leaving the store inconsistent.
+}
+
+func (e *EmailChangeError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: changing email of customer %d: %s: %v", e.CustomerID, e.Step, e.Err)
+	if len(e.RollbackErrors) > 0 {
+		msg += fmt.Sprintf(" (rollback failed: %v)", e.RollbackErrors)
+	}
+	return msg
+}
+
+func (e *EmailChangeError) Unwrap() error {
+	return e.Err
+}
+
+// ChangeEmail sets the email address of a customer to email, updating its
+// subscriber and metafields to match. If the new address already has a
+// subscriber, the old address's subscriber is removed instead of renamed, so
+// the customer stays subscribed exactly once. Nothing is changed when the
+// address is unchanged apart from case.
+func (e *EmailChanger) ChangeEmail(ctx context.Context, customerID int64, email string) error {
+	customer, _, err := e.Client.Customers.Get(ctx, customerID)
+	if err != nil {
+		return &EmailChangeError{CustomerID: customerID, Step: "get customer", Err: err}
+	}
+	old := customer.Email
+	if strings.EqualFold(old, email) {
+		return nil
+	}
+
+	// Read everything before changing anything, so failed reads need no rollback.
+	oldSubscribers, _, err := e.Client.Subscribers.List(ctx, &SubscriberListOptions{Email: old})
+	if err != nil {
+		return &EmailChangeError{CustomerID: customerID, Step: "list subscribers", Err: err}
+	}
+	newSubscribers, _, err := e.Client.Subscribers.List(ctx, &SubscriberListOptions{Email: email})
+	if err != nil {
+		return &EmailChangeError{CustomerID: customerID, Step: "list subscribers", Err: err}
+	}
+	metafields, err := e.metafields(ctx, customerID, old)
+	if err != nil {
+		return &EmailChangeError{CustomerID: customerID, Step: "list metafields", Err: err}
+	}
+
+	change := new(journal.Journal)
+	fail := func(step string, err error) error {
+		return &EmailChangeError{CustomerID: customerID, Step: step, Err: err, RollbackErrors: change.Rollback()}
+	}
+
+	if _, _, err := e.Client.Customers.Update(ctx, customerID, &Customer{Email: email}); err != nil {
+		return fail("update customer", err)
+	}
+	change.Done("update customer", func(ctx context.Context) error {
+		_, _, err := e.Client.Customers.Update(ctx, customerID, &Customer{Email: old})
+		return err
+	})
+
+	for _, sub := range oldSubscribers {
+		sub := sub
+		if len(newSubscribers) > 0 {
+			step := fmt.Sprintf("delete subscriber %d", sub.ID)
+			if _, err := e.Client.Subscribers.Delete(ctx, sub.ID); err != nil {
+				return fail(step, err)
+			}
+			change.Done(step, func(ctx context.Context) error {
+				restored := *sub
+				restored.ID = 0
+				_, _, err := e.Client.Subscribers.Create(ctx, &restored)
+				return err
+			})
+			continue
+		}
+
+		step := fmt.Sprintf("update subscriber %d", sub.ID)
+		if _, _, err := e.Client.Subscribers.Update(ctx, sub.ID, &Subscriber{Email: email}); err != nil {
+			return fail(step, err)
+		}
+		change.Done(step, func(ctx context.Context) error {
+			_, _, err := e.Client.Subscribers.Update(ctx, sub.ID, &Subscriber{Email: old})
+			return err
+		})
+	}
+
+	owner := MetafieldOwnerOf(CustomerMetafields, customerID)
+	for _, m := range metafields {
+		m := m
+		step := fmt.Sprintf("update metafield %s/%s", m.Namespace, m.Key)
+		if _, _, err := e.Client.Metafields.Update(ctx, owner, m.ID, &Metafield{Value: email}); err != nil {
+			return fail(step, err)
+		}
+		change.Done(step, func(ctx context.Context) error {
+			_, _, err := e.Client.Metafields.Update(ctx, owner, m.ID, &Metafield{Value: m.Value})
+			return err
+		})
+	}
+	return nil
+}
+
+// metafields returns the customer's metafields in e.Namespaces holding email
+func (e *EmailChanger) metafields(ctx context.Context, customerID int64, email string) ([]*Metafield, error) {
+	if len(e.Namespaces) == 0 {
+		return nil, nil
+	}
+	owner := MetafieldOwnerOf(CustomerMetafields, customerID)
+	opts := &MetafieldListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, Namespaces: e.Namespaces}
+
+	var matched []*Metafield
+	for {
+		page, resp, err := e.Client.Metafields.List(ctx, owner, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range page {
+			if strings.EqualFold(m.Value, email) {
+				matched = append(matched, m)
+			}
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return matched, nil
+		}
+		opts.Page++
+	}
+}
@@ -0,0 +1,113 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+// failingTransport fails requests matching a method and path suffix
+type failingTransport struct {
+	next   http.RoundTripper
+	method string
+	suffix string
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == t.method && strings.HasSuffix(req.URL.Path, t.suffix) {
+		return nil, errors.New("connection reset")
+	}
+	return t.next.RoundTrip(req)
+}
+
+func newEmailSandbox(t *testing.T) *bctest.Server {
+	t.Helper()
+	server := bctest.NewServer()
+	seeds := []struct {
+		path    string
+		objects []interface{}
+	}{
+		{"v2/customers", []interface{}{&Customer{ID: 7, Email: "old@example.com"}}},
+		{"v3/customers/subscribers", []interface{}{&Subscriber{ID: 3, Email: "old@example.com", FirstName: "Jane", Source: "storefront"}}},
+		{"v3/customers/7/metafields", []interface{}{
+			&Metafield{ID: 1, Namespace: "crm", Key: "contact", Value: "old@example.com"},
+			&Metafield{ID: 2, Namespace: "crm", Key: "tier", Value: "gold"},
+			&Metafield{ID: 3, Namespace: "other", Key: "contact", Value: "old@example.com"},
+		}},
+	}
+	for _, s := range seeds {
+		if err := server.Seed(s.path, s.objects...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return server
+}
+
+func emails(server *bctest.Server, path string) []string {
+	var out []string
+	for _, o := range server.Objects(path) {
+		if v, ok := o["email"]; ok {
+			out = append(out, v.(string))
+		} else {
+			out = append(out, o["value"].(string))
+		}
+	}
+	return out
+}
+
+func TestEmailChanger_ChangeEmail(t *testing.T) {
+	server := newEmailSandbox(t)
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+	changer := &EmailChanger{Client: client, Namespaces: []string{"crm"}}
+
+	if err := changer.ChangeEmail(context.Background(), 7, "new@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]string{
+		"v2/customers":              "new@example.com",
+		"v3/customers/subscribers":  "new@example.com",
+		"v3/customers/7/metafields": "new@example.com gold old@example.com",
+	} {
+		if got := strings.Join(emails(server, path), " "); got != want {
+			t.Errorf("%s = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestEmailChanger_ExistingSubscriber(t *testing.T) {
+	server := newEmailSandbox(t)
+	if err := server.Seed("v3/customers/subscribers", &Subscriber{ID: 4, Email: "new@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+
+	if err := (&EmailChanger{Client: client}).ChangeEmail(context.Background(), 7, "new@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if got := emails(server, "v3/customers/subscribers"); len(got) != 1 || got[0] != "new@example.com" {
+		t.Errorf("Subscribers = %v, want only new@example.com", got)
+	}
+}
+
+func TestEmailChanger_Rollback(t *testing.T) {
+	server := newEmailSandbox(t)
+	transport := &failingTransport{next: server.Transport(), method: "PUT", suffix: "/metafields/1"}
+	client := NewClient("abc123", "token", WithHTTPClient(&http.Client{Transport: transport}))
+	changer := &EmailChanger{Client: client, Namespaces: []string{"crm"}}
+
+	err := changer.ChangeEmail(context.Background(), 7, "new@example.com")
+	var cerr *EmailChangeError
+	if !errors.As(err, &cerr) || cerr.Step != "update metafield crm/contact" || len(cerr.RollbackErrors) != 0 {
+		t.Fatalf("ChangeEmail error = %v, want a metafield failure", err)
+	}
+	if got := emails(server, "v2/customers"); got[0] != "old@example.com" {
+		t.Errorf("Customer email = %v, want it restored", got)
+	}
+	if got := emails(server, "v3/customers/subscribers"); got[0] != "old@example.com" {
+		t.Errorf("Subscriber email = %v, want it restored", got)
+	}
+}
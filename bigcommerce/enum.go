@@ -0,0 +1,168 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EnumMode - How the client handles enum values it does not know, such as a
+// product type BigCommerce introduced after this client was released
+type EnumMode int
+
+const (
+	// LenientEnums - unknown values are decoded like known ones. The default.
+	LenientEnums EnumMode = iota
+	// StrictEnums - responses holding unknown values fail with an
+	// *UnknownEnumError, after being decoded
+	StrictEnums
+	// CaptureEnums - unknown values are decoded and also recorded in the
+	// UnknownValues map of the struct holding them, keyed by JSON field name
+	CaptureEnums
+)
+
+// WithEnumMode sets how enum values unknown to the client are handled in
+// responses. With WithFixtureCapture, responses rejected by StrictEnums are
+// saved as fixtures too.
+func WithEnumMode(mode EnumMode) ClientOption {
+	return func(c *Client) {
+		c.enumMode = mode
+	}
+}
+
+// UnknownEnumError is returned with StrictEnums for a response holding an enum
+// value unknown to the client
+type UnknownEnumError struct {
+	Field string // The JSON path of the value, e.g. "[2].type".
+	Type  string // The enum type, e.g. "ProductType".
+	Value string
+}
+
+func (e *UnknownEnumError) Error() string {
+	return fmt.Sprintf("bigcommerce: unknown %s %q at %s", e.Type, e.Value, e.Field)
+}
+
+// knownEnums holds the values of the enums found in responses
+var knownEnums = enumValues(
+	PhysicalProduct, DigitalProduct,
+	NoInventory, SimpleInventory, SKUInventory,
+	NoEventDateField, AfterEventDateField, BeforeEventDateField, RangeEventDateField,
+	AvailableProduct, DisabledProduct, PreorderProduct,
+	ProductTemplate, CategoryTemplate, BrandTemplate, PageTemplate,
+	AccountForm, AddressForm,
+	TextField, MultilineField, NumberField, DateField, CheckboxField, RadioField, DropdownField, PasswordField,
+	AppOnlyMetafield, ReadMetafield, WriteMetafield, ReadStorefrontMetafield, WriteStorefrontMetafield,
+	DateModifier, CheckboxModifier, FileModifier, TextModifier, MultiLineTextModifier, NumbersOnlyTextModifier,
+	RadioButtonsModifier, RectanglesModifier, DropdownModifier, ProductListModifier, ProductListWithImagesModifier, SwatchModifier,
+	RelativeAdjuster, PercentageAdjuster,
+	CustomerMessage, OwnerMessage,
+	ReadMessage, UnreadMessage,
+	SwatchOption, DropdownOption, RadioButtonsOption, RectanglesOption, ProductListOption, ProductListWithImagesOption,
+	ProductRedirect, BrandRedirect, CategoryRedirect, PageRedirect, URLRedirect,
+	DefaultLoad, AsyncLoad, DeferLoad,
+	HeadScript, FooterScript,
+	StorefrontScript, AllPagesScript, CheckoutScript, OrderConfirmationScript,
+	SrcScript, ScriptTagScript,
+	EssentialScript, FunctionalScript, AnalyticsScript, TargetingScript,
+	AustraliaPostProvider, CanadaPostProvider, EndiciaProvider, FedExProvider, RoyalMailProvider, UPSProvider, USPSProvider, CustomProvider,
+	ZipZone, CountryZone, StateZone, GlobalZone,
+	CheckboxStoreOption, DateStoreOption, FileStoreOption, NumbersOnlyStoreOption, TextStoreOption, MultiLineTextStoreOption,
+	ProductListStoreOption, ProductListWithImagesStoreOption, RadioButtonsStoreOption, RectanglesStoreOption, SelectStoreOption, SwatchStoreOption,
+	ActivePlacement, InactivePlacement,
+	ResidentialAddress, CommercialAddress,
+	AllCategoryAccess, SpecificCategoryAccess, NoCategoryAccess,
+	StoreDiscount, CategoryDiscount, ProductDiscount, PriceListDiscount,
+	PercentDiscount, FixedDiscount, PriceDiscount,
+	StringAttribute, NumberAttribute, DateAttribute,
+	PriceOffBulkPricing, PercentBulkPricing, FixedBulkPricing,
+	AutomaticPromotion, CouponPromotion,
+	EnabledPromotion, DisabledPromotion, InvalidPromotion,
+	LeastExpensiveItems, MostExpensiveItems,
+	LeftToken, RightToken,
+	StorefrontChannel, MarketplaceChannel, MarketingChannel, POSChannel,
+	ActiveChannel, PrelaunchChannel, InactiveChannel, ConnectedChannel, DisconnectedChannel, ArchivedChannel, DeletedChannel, TerminatedChannel,
+	ActiveListing, DisabledListing, ErrorListing, PendingListing, PendingDisableListing, PendingDeleteListing,
+	PartiallyRejectedListing, QueuedListing, RejectedListing, SubmittedListing, DeletedListing,
+	StorefrontSettingsSection, CurrenciesSection, DomainsSection, NotificationsSection, SocialSection,
+)
+
+func enumValues(values ...interface{}) map[reflect.Type]map[string]bool {
+	known := map[reflect.Type]map[string]bool{}
+	for _, v := range values {
+		t := reflect.TypeOf(v)
+		if known[t] == nil {
+			known[t] = map[string]bool{}
+		}
+		known[t][reflect.ValueOf(v).String()] = true
+	}
+	return known
+}
+
+// checkEnums walks a decoded response for unknown enum values, returning an
+// *UnknownEnumError for the first one with StrictEnums and recording them in
+// UnknownValues with CaptureEnums
+func checkEnums(v reflect.Value, path string, mode EnumMode) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkEnums(v.Elem(), path, mode)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkEnums(v.Index(i), fmt.Sprintf("%s[%d]", path, i), mode); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkEnums(iter.Value(), joinPath(path, fmt.Sprint(iter.Key())), mode); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if field.PkgPath != "" || name == "-" {
+				continue
+			}
+			if name == "" && !field.Anonymous {
+				name = field.Name
+			}
+
+			fv := v.Field(i)
+			if fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			known, ok := knownEnums[fv.Type()]
+			if !ok {
+				if err := checkEnums(fv, joinPath(path, name), mode); err != nil {
+					return err
+				}
+				continue
+			}
+			if value := fv.String(); value != "" && !known[value] {
+				if mode == StrictEnums {
+					return &UnknownEnumError{Field: joinPath(path, name), Type: fv.Type().Name(), Value: value}
+				}
+				if unknown := v.FieldByName("UnknownValues"); unknown.CanSet() {
+					if unknown.IsNil() {
+						unknown.Set(reflect.MakeMap(unknown.Type()))
+					}
+					unknown.SetMapIndex(reflect.ValueOf(name), reflect.ValueOf(value))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" || name == "" {
+		return path + name
+	}
+	return path + "." + name
+}
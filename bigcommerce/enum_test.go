@@ -0,0 +1,85 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testScriptsWithUnknownEnums = `{"data":[
+	{"uuid":"a","name":"Analytics","location":"head","kind":"src","consent_category":"essential"},
+	{"uuid":"b","name":"Chat","location":"body","kind":"module","consent_category":"essential"}
+],"meta":{}}`
+
+func TestWithEnumMode_strict(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	WithEnumMode(StrictEnums)(client)
+
+	mux.HandleFunc("/stores/abc123/v3/content/scripts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testScriptsWithUnknownEnums)
+	})
+
+	_, _, err := client.Scripts.List(context.Background(), nil)
+	want := &UnknownEnumError{Field: "[1].location", Type: "ScriptLocation", Value: "body"}
+	var enumErr *UnknownEnumError
+	if !errors.As(err, &enumErr) || !reflect.DeepEqual(enumErr, want) {
+		t.Fatalf("List returned %v, want %v", err, want)
+	}
+	if err.Error() != `bigcommerce: unknown ScriptLocation "body" at [1].location` {
+		t.Errorf("Error() = %s", err)
+	}
+
+	// Rejected responses are captured as fixtures.
+	WithFixtureCapture(t.TempDir())(client)
+	_, _, err = client.Scripts.List(context.Background(), nil)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || !errors.As(err, &enumErr) || filepath.Ext(decodeErr.Fixture) != ".json" {
+		t.Errorf("List with fixture capture returned %v", err)
+	}
+}
+
+func TestWithEnumMode_capture(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	WithEnumMode(CaptureEnums)(client)
+
+	mux.HandleFunc("/stores/abc123/v3/content/scripts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testScriptsWithUnknownEnums)
+	})
+
+	scripts, _, err := client.Scripts.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scripts[0].UnknownValues != nil {
+		t.Errorf("UnknownValues of a known script = %v", scripts[0].UnknownValues)
+	}
+	want := map[string]string{"location": "body", "kind": "module"}
+	if scripts[1].Location != "body" || !reflect.DeepEqual(scripts[1].UnknownValues, want) {
+		t.Errorf("Script = %+v, want UnknownValues %v", scripts[1], want)
+	}
+}
+
+func TestCheckEnums_nested(t *testing.T) {
+	inventory := InventoryType("bundle")
+	products := []*Product{
+		{Type: PhysicalProduct, InventoryTracking: &inventory},
+	}
+	err := checkEnums(reflect.ValueOf(products), "", StrictEnums)
+	if err == nil || err.(*UnknownEnumError).Field != "[0].inventory_tracking" {
+		t.Errorf("checkEnums returned %v", err)
+	}
+
+	modifiers := []*Modifier{{OptionValues: []ModifierValue{{Adjusters: &ModifierAdjusters{Price: &Adjuster{Adjuster: "fixed"}}}}}}
+	if err := checkEnums(reflect.ValueOf(modifiers), "", CaptureEnums); err != nil {
+		t.Fatal(err)
+	}
+	if got := modifiers[0].OptionValues[0].Adjusters.Price.UnknownValues; !reflect.DeepEqual(got, map[string]string{"adjuster": "fixed"}) {
+		t.Errorf("Adjuster UnknownValues = %v", got)
+	}
+}
@@ -0,0 +1,316 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoIDs is returned by deletes taking a list of IDs when the list is empty.
+// The IDs filter the request, which would otherwise delete every resource.
+var ErrNoIDs = errors.New("bigcommerce: no IDs to delete")
+
+// ErrorResponse describes an error returned by the BigCommerce API. V3 endpoints
+// return a single object with a title and per-field errors; V2 endpoints return a
+// list of status/message pairs, the first of which is kept in Message.
+type ErrorResponse struct {
+	Response *http.Response    `json:"-"`                 // The HTTP response that caused this error.
+	Status   int               `json:"status,omitempty"`  // The HTTP status code reported by the API.
+	Title    string            `json:"title,omitempty"`   // V3 summary of the error.
+	Type     string            `json:"type,omitempty"`    // V3 link to the error type documentation.
+	Detail   string            `json:"detail,omitempty"`  // V3 detailed description, when provided.
+	Errors   map[string]string `json:"errors,omitempty"`  // V3 per-field validation errors.
+	Message  string            `json:"message,omitempty"` // V2 error message.
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Title
+	if msg == "" {
+		msg = r.Message
+	}
+	if r.Detail != "" {
+		msg += ": " + r.Detail
+	}
+	if len(r.Errors) > 0 {
+		fields := make([]string, 0, len(r.Errors))
+		for field, e := range r.Errors {
+			fields = append(fields, field+": "+e)
+		}
+		sort.Strings(fields)
+		msg += " (" + strings.Join(fields, ", ") + ")"
+	}
+	// Errors built by hand, e.g. in tests, may have no response or request
+	if r.Response == nil {
+		return fmt.Sprintf("%d %v", r.Status, msg)
+	}
+	if r.Response.Request == nil {
+		return fmt.Sprintf("%d %v", r.Response.StatusCode, msg)
+	}
+	return fmt.Sprintf("%v %v: %d %v", r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, msg)
+}
+
+// CheckResponse returns an *ErrorResponse for any response outside the 2xx
+// range, or a *StoreUnavailableError if the store is suspended or in maintenance
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r, Status: r.StatusCode}
+	data, err := io.ReadAll(r.Body)
+	if err != nil || len(data) == 0 {
+		errorResponse.Title = http.StatusText(r.StatusCode)
+		return checkStoreUnavailable(errorResponse)
+	}
+
+	var v2 []ErrorResponse
+	if data[0] == '[' && json.Unmarshal(data, &v2) == nil && len(v2) > 0 {
+		errorResponse.Message = v2[0].Message
+		return checkStoreUnavailable(errorResponse)
+	}
+	if json.Unmarshal(data, errorResponse) != nil {
+		// V3 occasionally nests error details; fall back to the raw body.
+		errorResponse.Title = strings.TrimSpace(string(data))
+	}
+	errorResponse.Response = r
+	if errorResponse.Status == 0 {
+		errorResponse.Status = r.StatusCode
+	}
+	return checkStoreUnavailable(errorResponse)
+}
+
+// StoreUnavailableReason - Why a store is not serving API requests
+type StoreUnavailableReason string
+
+const (
+	// StoreSuspended - the store is suspended, usually for billing reasons, and
+	// needs the merchant to act before it comes back
+	StoreSuspended StoreUnavailableReason = "suspended"
+	// StoreMaintenance - the store is temporarily down for maintenance
+	StoreMaintenance StoreUnavailableReason = "maintenance"
+)
+
+// Retry guidance used when the API does not send a Retry-After header
+const (
+	defaultSuspendedRetry   = time.Hour
+	defaultMaintenanceRetry = 10 * time.Minute
+)
+
+// StoreUnavailableError is returned instead of an ErrorResponse when the store
+// itself is suspended or in maintenance mode, so multi-tenant apps can pause the
+// store's jobs rather than retrying each call. The underlying ErrorResponse is
+// available through errors.As.
+type StoreUnavailableError struct {
+	*ErrorResponse
+	Reason     StoreUnavailableReason
+	RetryAfter time.Duration // When to check the store again, from Retry-After or a default for the reason.
+}
+
+func (e *StoreUnavailableError) Error() string {
+	return fmt.Sprintf("store %s: %v", e.Reason, e.ErrorResponse.Error())
+}
+
+// Unwrap returns the underlying ErrorResponse
+func (e *StoreUnavailableError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// checkStoreUnavailable recognises the responses of suspended stores (402, or a
+// 403 or 503 mentioning suspension) and stores in maintenance mode (a 503
+// mentioning maintenance, often an HTML page)
+func checkStoreUnavailable(r *ErrorResponse) error {
+	text := strings.ToLower(r.Title + " " + r.Detail + " " + r.Message)
+	var reason StoreUnavailableReason
+	switch code := r.Response.StatusCode; {
+	case code == http.StatusPaymentRequired:
+		reason = StoreSuspended
+	case (code == http.StatusForbidden || code == http.StatusServiceUnavailable) && (strings.Contains(text, "suspended") || strings.Contains(text, "inactive")):
+		reason = StoreSuspended
+	case code == http.StatusServiceUnavailable && strings.Contains(text, "maintenance"):
+		reason = StoreMaintenance
+	default:
+		return r
+	}
+
+	e := &StoreUnavailableError{ErrorResponse: r, Reason: reason, RetryAfter: retryAfter(r.Response.Header.Get("Retry-After"))}
+	if e.RetryAfter <= 0 {
+		e.RetryAfter = defaultSuspendedRetry
+		if reason == StoreMaintenance {
+			e.RetryAfter = defaultMaintenanceRetry
+		}
+	}
+	return e
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// ErrorCategory - A broad class of failure, used to triage errors without
+// inspecting status codes
+type ErrorCategory string
+
+const (
+	// UnknownError - the error could not be classified
+	UnknownError ErrorCategory = "unknown"
+	// AuthError - the access token is invalid or was revoked, usually because the app was uninstalled
+	AuthError ErrorCategory = "auth"
+	// ScopeError - the token lacks the OAuth scope the endpoint requires
+	ScopeError ErrorCategory = "scope"
+	// ValidationError - the request was rejected as malformed or invalid
+	ValidationError ErrorCategory = "validation"
+	// RateLimitError - the store's API quota is exhausted
+	RateLimitError ErrorCategory = "rate_limit"
+	// NotFoundError - the resource does not exist
+	NotFoundError ErrorCategory = "not_found"
+	// ConflictError - the request conflicts with existing data, e.g. a duplicate SKU or URL
+	ConflictError ErrorCategory = "conflict"
+	// StoreSuspendedError - the store is suspended, unpaid or otherwise unavailable
+	StoreSuspendedError ErrorCategory = "store_suspended"
+	// TransientError - a network failure, timeout or server error that may succeed on retry
+	TransientError ErrorCategory = "transient"
+)
+
+// ErrorClassification describes the category of an error and how to handle it
+type ErrorClassification struct {
+	Category   ErrorCategory
+	Retry      bool          // Whether retrying the same request may succeed.
+	RetryAfter time.Duration // How long to wait before retrying, when known.
+	PauseStore bool          // Whether further calls to the store should stop until an operator intervenes.
+	Advice     string        // Recommended handling, suitable for logs and dashboards.
+}
+
+// Classify categorises an error returned by the client, so apps managing many
+// stores can triage failures automatically. It returns a zero
+// ErrorClassification for a nil error.
+func Classify(err error) ErrorClassification {
+	if err == nil {
+		return ErrorClassification{}
+	}
+
+	var unavailable *StoreUnavailableError
+	if errors.As(err, &unavailable) {
+		c := classification(StoreSuspendedError)
+		c.RetryAfter = unavailable.RetryAfter
+		if unavailable.Reason == StoreMaintenance {
+			c.Retry, c.PauseStore = true, false
+			c.Advice = "the store is in maintenance mode; retry after RetryAfter"
+		}
+		return c
+	}
+	var r *ErrorResponse
+	if errors.As(err, &r) {
+		return classifyStatus(r)
+	}
+	var preset *PresetError
+	if errors.As(err, &preset) {
+		c := classification(ScopeError)
+		c.Advice = "the request was not sent; use a client with a preset allowing it"
+		return c
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return classification(TransientError)
+	}
+	if errors.Is(err, context.Canceled) {
+		return classification(UnknownError)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return classification(TransientError)
+	}
+	return classification(UnknownError)
+}
+
+// rejected reports whether err is a 4xx response, meaning the API refused the
+// request rather than possibly applying it before failing
+func rejected(err error) bool {
+	var r *ErrorResponse
+	if !errors.As(err, &r) {
+		return false
+	}
+	status := r.Status
+	if r.Response != nil {
+		status = r.Response.StatusCode
+	}
+	return status >= 400 && status < 500
+}
+
+func classifyStatus(r *ErrorResponse) ErrorClassification {
+	status := r.Status
+	if r.Response != nil {
+		status = r.Response.StatusCode
+	}
+
+	switch {
+	case status == http.StatusUnauthorized:
+		return classification(AuthError)
+	case status == http.StatusForbidden:
+		return classification(ScopeError)
+	case status == http.StatusPaymentRequired:
+		return classification(StoreSuspendedError)
+	case status == http.StatusNotFound:
+		return classification(NotFoundError)
+	case status == http.StatusConflict:
+		return classification(ConflictError)
+	case status == http.StatusTooManyRequests:
+		c := classification(RateLimitError)
+		if r.Response != nil {
+			if ms, err := strconv.ParseInt(r.Response.Header.Get("X-Rate-Limit-Time-Reset-Ms"), 10, 64); err == nil && ms > 0 {
+				c.RetryAfter = time.Duration(ms) * time.Millisecond
+			}
+		}
+		return c
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity || status == http.StatusRequestEntityTooLarge:
+		return classification(ValidationError)
+	case status >= 500:
+		return classification(TransientError)
+	}
+	return classification(UnknownError)
+}
+
+func classification(category ErrorCategory) ErrorClassification {
+	c := ErrorClassification{Category: category}
+	switch category {
+	case AuthError:
+		c.PauseStore = true
+		c.Advice = "stop calling the store and ask the merchant to reinstall or reauthorize the app"
+	case ScopeError:
+		c.Advice = "request the missing OAuth scope; the call will fail until the app is reauthorized"
+	case ValidationError:
+		c.Advice = "fix the request payload; retrying unchanged will fail again"
+	case RateLimitError:
+		c.Retry = true
+		c.Advice = "retry after the rate limit window resets"
+	case NotFoundError:
+		c.Advice = "the resource was deleted or never existed; drop or resync the local reference"
+	case ConflictError:
+		c.Advice = "reconcile with the existing resource, e.g. update it instead of creating a duplicate"
+	case StoreSuspendedError:
+		c.PauseStore = true
+		c.Advice = "pause jobs for the store until it is reactivated"
+	case TransientError:
+		c.Retry = true
+		c.Advice = "retry with exponential backoff"
+	default:
+		c.Advice = "inspect the error; no automatic handling is recommended"
+	}
+	return c
+}
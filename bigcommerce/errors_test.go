@@ -0,0 +1,118 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	response := func(status int) *ErrorResponse {
+		req, _ := http.NewRequest("GET", "https://api.bigcommerce.com/", nil)
+		return &ErrorResponse{Response: &http.Response{StatusCode: status, Header: http.Header{}, Request: req}, Status: status}
+	}
+	limited := response(http.StatusTooManyRequests)
+	limited.Response.Header.Set("X-Rate-Limit-Time-Reset-Ms", "1500")
+
+	tests := []struct {
+		err   error
+		want  ErrorCategory
+		retry bool
+		pause bool
+	}{
+		{response(401), AuthError, false, true},
+		{response(403), ScopeError, false, false},
+		{response(422), ValidationError, false, false},
+		{response(404), NotFoundError, false, false},
+		{response(409), ConflictError, false, false},
+		{response(402), StoreSuspendedError, false, true},
+		{response(502), TransientError, true, false},
+		{limited, RateLimitError, true, false},
+		{fmt.Errorf("wrapped: %w", response(404)), NotFoundError, false, false},
+		{context.DeadlineExceeded, TransientError, true, false},
+		{&net.OpError{Op: "dial", Err: errors.New("refused")}, TransientError, true, false},
+		{errors.New("boom"), UnknownError, false, false},
+	}
+	for _, tt := range tests {
+		got := Classify(tt.err)
+		if got.Category != tt.want || got.Retry != tt.retry || got.PauseStore != tt.pause || got.Advice == "" {
+			t.Errorf("Classify(%v) = %+v, want %v (retry %v, pause %v)", tt.err, got, tt.want, tt.retry, tt.pause)
+		}
+	}
+
+	if got := Classify(limited).RetryAfter; got != 1500*time.Millisecond {
+		t.Errorf("RetryAfter = %v, want 1.5s", got)
+	}
+	if got := Classify(nil); got.Category != "" {
+		t.Errorf("Classify(nil) = %+v, want zero", got)
+	}
+}
+
+func TestCheckResponse_storeUnavailable(t *testing.T) {
+	tests := []struct {
+		status int
+		header string
+		body   string
+		reason StoreUnavailableReason
+		retry  time.Duration
+	}{
+		{402, "", ``, StoreSuspended, defaultSuspendedRetry},
+		{403, "", `[{"status":403,"message":"This store has been suspended."}]`, StoreSuspended, defaultSuspendedRetry},
+		{503, "120", `<html><h1>Down for Maintenance</h1></html>`, StoreMaintenance, 2 * time.Minute},
+		{503, "", `{"status":503,"title":"Service Unavailable","detail":"Store is in maintenance mode"}`, StoreMaintenance, defaultMaintenanceRetry},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest("GET", "https://api.bigcommerce.com/stores/abc123/v3/catalog/products", nil)
+		resp := &http.Response{StatusCode: tt.status, Header: http.Header{}, Request: req, Body: io.NopCloser(strings.NewReader(tt.body))}
+		if tt.header != "" {
+			resp.Header.Set("Retry-After", tt.header)
+		}
+
+		err := CheckResponse(resp)
+		var e *StoreUnavailableError
+		if !errors.As(err, &e) {
+			t.Errorf("CheckResponse(%d %s) = %v, want a StoreUnavailableError", tt.status, tt.body, err)
+			continue
+		}
+		if e.Reason != tt.reason || e.RetryAfter != tt.retry {
+			t.Errorf("CheckResponse(%d %s) = %v (retry after %v), want %v after %v", tt.status, tt.body, e.Reason, e.RetryAfter, tt.reason, tt.retry)
+		}
+		var r *ErrorResponse
+		if !errors.As(err, &r) || r.Status != tt.status {
+			t.Errorf("Expected the ErrorResponse to be unwrapped, got %v", r)
+		}
+	}
+
+	// A plain outage is not attributed to the store.
+	req, _ := http.NewRequest("GET", "https://api.bigcommerce.com/", nil)
+	err := CheckResponse(&http.Response{StatusCode: 503, Request: req, Body: io.NopCloser(strings.NewReader(""))})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("CheckResponse(503) = %T, want *ErrorResponse", err)
+	}
+	if c := Classify(err); c.Category != TransientError {
+		t.Errorf("Classify(503) = %v, want transient", c.Category)
+	}
+}
+
+func TestErrorResponse_Error(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://api.bigcommerce.com/stores/abc123/v3/catalog/products", nil)
+	tests := []struct {
+		err  *ErrorResponse
+		want string
+	}{
+		{&ErrorResponse{Response: &http.Response{StatusCode: 422, Request: req}, Status: 422, Title: "Invalid"}, "GET https://api.bigcommerce.com/stores/abc123/v3/catalog/products: 422 Invalid"},
+		{&ErrorResponse{Response: &http.Response{StatusCode: 422}, Status: 422, Title: "Invalid"}, "422 Invalid"},
+		{&ErrorResponse{Status: 503, Message: "Unavailable"}, "503 Unavailable"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// Event describes a webhook event delivered by BigCommerce
+type Event struct {
+	Scope     string    `json:"scope"`      // The event's scope, e.g. "store/product/updated".
+	StoreID   string    `json:"store_id"`   // The ID of the store that produced the event.
+	Data      EventData `json:"data"`       // The resource the event is about.
+	Hash      string    `json:"hash"`       // A hash of the payload, identical for redelivered events.
+	CreatedAt int64     `json:"created_at"` // Unix timestamp of when the event was created.
+	Producer  string    `json:"producer"`   // The producer, e.g. "stores/abc123".
+}
+
+// EventData describes the resource referenced by an Event. Most resources have
+// numeric IDs, but carts and some others use strings, so ID is kept as a string.
+type EventData struct {
+	Type string          `json:"type"` // The resource type, e.g. "product".
+	ID   string          `json:"id"`   // The resource ID.
+	Raw  json.RawMessage `json:"-"`    // The complete data object, including scope-specific fields.
+}
+
+// UnmarshalJSON decodes event data with either a numeric or a string ID
+func (d *EventData) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Type string          `json:"type"`
+		ID   json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	d.Type = v.Type
+	d.Raw = append(json.RawMessage(nil), data...)
+	if id := bytes.TrimSpace(v.ID); len(id) > 0 && id[0] == '"' {
+		return json.Unmarshal(id, &d.ID)
+	}
+	d.ID = string(bytes.TrimSpace(v.ID))
+	return nil
+}
+
+// MarshalJSON encodes the complete data object when available
+func (d EventData) MarshalJSON() ([]byte, error) {
+	if len(d.Raw) > 0 {
+		return d.Raw, nil
+	}
+	v := map[string]interface{}{"type": d.Type, "id": d.ID}
+	if id, err := strconv.ParseInt(d.ID, 10, 64); err == nil {
+		v["id"] = id
+	}
+	return json.Marshal(v)
+}
+
+// IntID returns the resource ID as a number, or zero if it is not numeric
+func (d EventData) IntID() int64 {
+	id, _ := strconv.ParseInt(d.ID, 10, 64)
+	return id
+}
+
+// ParseEvent decodes a webhook request body
+func ParseEvent(r io.Reader) (*Event, error) {
+	event := new(Event)
+	if err := json.NewDecoder(r).Decode(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// Encoder serializes events and other records for downstream pipelines. JSON
+// is the default; adapters for protobuf, Avro or a schema registry's wire
+// format can be plugged in wherever an Encoder is accepted.
+type Encoder interface {
+	// ContentType returns the MIME type of the encoded output.
+	ContentType() string
+	// Encode writes the serialized form of v to w.
+	Encode(w io.Writer, v interface{}) error
+}
+
+// JSONEncoder is the default Encoder, writing one JSON document per value
+type JSONEncoder struct{}
+
+// ContentType implements Encoder
+func (JSONEncoder) ContentType() string { return "application/json" }
+
+// Encode implements Encoder
+func (JSONEncoder) Encode(w io.Writer, v interface{}) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// EventPublisher encodes events and hands them to a downstream sink
+type EventPublisher struct {
+	Encoder Encoder // JSONEncoder if nil.
+
+	// Publish delivers an encoded event, e.g. to a message queue.
+	Publish func(ctx context.Context, contentType string, payload []byte) error
+}
+
+// PublishEvent encodes e and publishes it
+func (p *EventPublisher) PublishEvent(ctx context.Context, e *Event) error {
+	return p.publish(ctx, e)
+}
+
+func (p *EventPublisher) publish(ctx context.Context, v interface{}) error {
+	enc := p.Encoder
+	if enc == nil {
+		enc = JSONEncoder{}
+	}
+	var buf bytes.Buffer
+	if err := enc.Encode(&buf, v); err != nil {
+		return err
+	}
+	return p.Publish(ctx, enc.ContentType(), buf.Bytes())
+}
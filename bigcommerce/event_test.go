@@ -0,0 +1,67 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+const productEventJSON = `{"scope":"store/product/updated","store_id":"1025646","data":{"type":"product","id":32},"hash":"352e4afc6dd3fc85ea26bfdf3f91852604d57528","created_at":1561479335,"producer":"stores/abc123"}`
+const cartEventJSON = `{"scope":"store/cart/created","store_id":"1025646","data":{"type":"cart","id":"09346904-4175-44fd-be53-f7e598531b6c"},"hash":"a","created_at":1561479335,"producer":"stores/abc123"}`
+
+func TestParseEvent(t *testing.T) {
+	event, err := ParseEvent(strings.NewReader(productEventJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Scope != "store/product/updated" || event.Data.Type != "product" || event.Data.IntID() != 32 {
+		t.Errorf("Unexpected event %+v", event)
+	}
+
+	event, err = ParseEvent(strings.NewReader(cartEventJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Data.ID != "09346904-4175-44fd-be53-f7e598531b6c" || event.Data.IntID() != 0 {
+		t.Errorf("Unexpected event %+v", event)
+	}
+}
+
+// upperEncoder is a stand-in for a non-JSON wire format
+type upperEncoder struct{}
+
+func (upperEncoder) ContentType() string { return "text/plain" }
+
+func (upperEncoder) Encode(w io.Writer, v interface{}) error {
+	e := v.(*Event)
+	_, err := fmt.Fprintf(w, "%s %s", strings.ToUpper(e.Scope), e.Data.ID)
+	return err
+}
+
+func TestEventPublisher(t *testing.T) {
+	event, _ := ParseEvent(strings.NewReader(productEventJSON))
+
+	var contentType string
+	var payload []byte
+	p := &EventPublisher{Publish: func(ctx context.Context, ct string, b []byte) error {
+		contentType, payload = ct, b
+		return nil
+	}}
+
+	if err := p.PublishEvent(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Event
+	if err := json.Unmarshal(payload, &decoded); err != nil || contentType != "application/json" || decoded.Data.IntID() != 32 {
+		t.Errorf("Unexpected JSON payload %s (%s), %v", payload, contentType, err)
+	}
+
+	p.Encoder = upperEncoder{}
+	p.PublishEvent(context.Background(), event)
+	if contentType != "text/plain" || string(payload) != "STORE/PRODUCT/UPDATED 32" {
+		t.Errorf("Unexpected custom payload %s (%s)", payload, contentType)
+	}
+}
@@ -0,0 +1,387 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// AmazonTitleLimit is the maximum length of an Amazon item_name
+	AmazonTitleLimit = 200
+	// AmazonBulletLimit is the maximum length of a single Amazon bullet_point
+	AmazonBulletLimit = 500
+	// AmazonBulletCount is the number of bullet_point columns in an Amazon flat-file
+	AmazonBulletCount = 5
+	// EbayTitleLimit is the maximum length of an eBay listing title
+	EbayTitleLimit = 80
+)
+
+var (
+	// ErrMissingGTIN is returned when a marketplace requires a GTIN (UPC/EAN/ISBN) but the product has none
+	ErrMissingGTIN = errors.New("bigcommerce: product has no GTIN")
+	// ErrInvalidGTIN is returned when a GTIN has the wrong length or check digit
+	ErrInvalidGTIN = errors.New("bigcommerce: invalid GTIN")
+	// ErrUnsupportedGTIN is returned when a marketplace has no field for a valid GTIN, e.g. an eBay case pack GTIN-14
+	ErrUnsupportedGTIN = errors.New("bigcommerce: GTIN not supported by the marketplace")
+)
+
+// AmazonFlatFileHeader lists the Amazon inventory flat-file columns, in the order produced by AmazonFlatFileRow.Record
+var AmazonFlatFileHeader = []string{
+	"item_sku",
+	"external_product_id",
+	"external_product_id_type",
+	"item_name",
+	"brand_name",
+	"product_description",
+	"bullet_point1",
+	"bullet_point2",
+	"bullet_point3",
+	"bullet_point4",
+	"bullet_point5",
+	"standard_price",
+	"quantity",
+	"condition_type",
+	"main_image_url",
+}
+
+// AmazonFlatFileRow describes a single row of an Amazon inventory flat-file feed
+type AmazonFlatFileRow struct {
+	SKU                string   // item_sku
+	ProductID          string   // external_product_id, the product's GTIN
+	ProductIDType      string   // external_product_id_type: UPC, EAN or GTIN
+	ItemName           string   // item_name, truncated to AmazonTitleLimit
+	BrandName          string   // brand_name
+	ProductDescription string   // product_description, as plain text
+	BulletPoints       []string // bullet_point1..5, extracted from the description
+	StandardPrice      string   // standard_price
+	Quantity           int64    // quantity
+	ConditionType      string   // condition_type
+	MainImageURL       string   // main_image_url
+}
+
+// Record returns the row as a slice of values matching AmazonFlatFileHeader
+func (r *AmazonFlatFileRow) Record() []string {
+	record := []string{r.SKU, r.ProductID, r.ProductIDType, r.ItemName, r.BrandName, r.ProductDescription}
+	for i := 0; i < AmazonBulletCount; i++ {
+		if i < len(r.BulletPoints) {
+			record = append(record, r.BulletPoints[i])
+		} else {
+			record = append(record, "")
+		}
+	}
+	return append(record, r.StandardPrice, strconv.FormatInt(r.Quantity, 10), r.ConditionType, r.MainImageURL)
+}
+
+// EbayInventoryItem describes the payload of eBay's createOrReplaceInventoryItem call
+type EbayInventoryItem struct {
+	SKU          string            `json:"-"` // The SKU is part of the eBay request path, not the body.
+	Availability EbayAvailability  `json:"availability"`
+	Condition    string            `json:"condition"`
+	Product      EbayProductDetail `json:"product"`
+}
+
+// EbayAvailability describes the quantity available for an eBay inventory item
+type EbayAvailability struct {
+	ShipToLocationAvailability EbayQuantity `json:"shipToLocationAvailability"`
+}
+
+// EbayQuantity describes an eBay quantity value
+type EbayQuantity struct {
+	Quantity int64 `json:"quantity"`
+}
+
+// EbayProductDetail describes the product section of an eBay inventory item
+type EbayProductDetail struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	Brand       string              `json:"brand,omitempty"`
+	Aspects     map[string][]string `json:"aspects,omitempty"`
+	UPC         []string            `json:"upc,omitempty"`
+	EAN         []string            `json:"ean,omitempty"`
+	ImageURLs   []string            `json:"imageUrls,omitempty"`
+}
+
+// ToAmazonFlatFile maps a Product to an Amazon flat-file row. Amazon requires a
+// valid GTIN unless the brand is exempt, so ErrMissingGTIN is returned when the
+// product has no UPC and requireGTIN is set.
+func ToAmazonFlatFile(p *Product, brand string, requireGTIN bool) (*AmazonFlatFileRow, error) {
+	row := &AmazonFlatFileRow{
+		SKU:                p.SKU,
+		ItemName:           TruncateTitle(p.Name, AmazonTitleLimit),
+		BrandName:          brand,
+		ProductDescription: StripHTML(p.Description),
+		BulletPoints:       ExtractBullets(p.Description, AmazonBulletCount, AmazonBulletLimit),
+		StandardPrice:      feedPrice(p),
+		Quantity:           p.InventoryLevel,
+		ConditionType:      amazonCondition(p.Condition),
+	}
+	if p.PrimaryImage != nil {
+		row.MainImageURL = p.PrimaryImage.ZoomURL
+	}
+
+	kind, err := gtinFor(p, requireGTIN)
+	if err != nil {
+		return nil, err
+	}
+	row.ProductID, row.ProductIDType = p.UPC, kind
+	return row, nil
+}
+
+// ToEbayInventoryItem maps a Product to an eBay inventory item payload. eBay
+// takes UPC and EAN codes only: a GTIN-8 is listed as an EAN-8, and a GTIN-14
+// with a leading zero as the EAN-13 it contains. Other GTIN-14 codes return
+// ErrUnsupportedGTIN.
+func ToEbayInventoryItem(p *Product, brand string, requireGTIN bool) (*EbayInventoryItem, error) {
+	item := &EbayInventoryItem{
+		SKU:       p.SKU,
+		Condition: ebayCondition(p.Condition),
+		Product: EbayProductDetail{
+			Title:       TruncateTitle(p.Name, EbayTitleLimit),
+			Description: p.Description,
+			Brand:       brand,
+		},
+	}
+	item.Availability.ShipToLocationAvailability.Quantity = p.InventoryLevel
+	if brand != "" {
+		item.Product.Aspects = map[string][]string{"Brand": {brand}}
+	}
+	if p.PrimaryImage != nil && p.PrimaryImage.ZoomURL != "" {
+		item.Product.ImageURLs = []string{p.PrimaryImage.ZoomURL}
+	}
+
+	kind, err := gtinFor(p, requireGTIN)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case kind == "UPC":
+		item.Product.UPC = []string{p.UPC}
+	case kind == "EAN" || len(p.UPC) == 8:
+		item.Product.EAN = []string{p.UPC}
+	case len(p.UPC) == 14 && p.UPC[0] == '0':
+		item.Product.EAN = []string{p.UPC[1:]}
+	case kind != "":
+		return nil, fmt.Errorf("%w: %q on product %d", ErrUnsupportedGTIN, p.UPC, p.ID)
+	}
+	return item, nil
+}
+
+// FeedVariant describes a variant of a product for the marketplace feeds
+type FeedVariant struct {
+	SKU            string
+	GTIN           string       // The variant's UPC, EAN or GTIN code.
+	Price          *float64     // Overrides the product's price if not nil.
+	SalePrice      *float64     // Overrides the product's sale price if not nil.
+	InventoryLevel int64        // Used when the product tracks inventory by variant.
+	ImageURL       string       // Overrides the product's image if set.
+	Options        []FeedOption // The option values identifying the variant.
+}
+
+// FeedOption is an option value of a FeedVariant, e.g. Color: Red
+type FeedOption struct {
+	Name  string // The option's display name.
+	Value string // The value's label.
+}
+
+// ToAmazonFlatFileVariant maps a variant of a Product to an Amazon flat-file
+// row. The variant's SKU, GTIN, price, stock and image replace the product's,
+// and its option values are appended to the title.
+func ToAmazonFlatFileVariant(p *Product, v *FeedVariant, brand string, requireGTIN bool) (*AmazonFlatFileRow, error) {
+	return ToAmazonFlatFile(variantProduct(p, v), brand, requireGTIN)
+}
+
+// ToEbayInventoryItemVariant maps a variant of a Product to an eBay inventory
+// item payload. The variant's option values are also listed as aspects.
+func ToEbayInventoryItemVariant(p *Product, v *FeedVariant, brand string, requireGTIN bool) (*EbayInventoryItem, error) {
+	item, err := ToEbayInventoryItem(variantProduct(p, v), brand, requireGTIN)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range v.Options {
+		if o.Name == "" || o.Value == "" {
+			continue
+		}
+		if item.Product.Aspects == nil {
+			item.Product.Aspects = map[string][]string{}
+		}
+		item.Product.Aspects[o.Name] = []string{o.Value}
+	}
+	return item, nil
+}
+
+// variantProduct returns a copy of p with the fields a variant overrides
+// replaced by the variant's
+func variantProduct(p *Product, v *FeedVariant) *Product {
+	vp := *p
+	vp.SKU = v.SKU
+	vp.UPC = v.GTIN
+	if p.InventoryTracking != nil && *p.InventoryTracking == SKUInventory {
+		vp.InventoryLevel = v.InventoryLevel
+	}
+	if v.Price != nil {
+		vp.Price = strconv.FormatFloat(*v.Price, 'f', -1, 64)
+	}
+	if v.SalePrice != nil {
+		vp.SalePrice = strconv.FormatFloat(*v.SalePrice, 'f', -1, 64)
+	}
+	if v.ImageURL != "" {
+		vp.PrimaryImage = &ProductImage{ZoomURL: v.ImageURL}
+	}
+
+	var labels []string
+	for _, o := range v.Options {
+		if o.Value != "" {
+			labels = append(labels, o.Value)
+		}
+	}
+	if len(labels) > 0 {
+		vp.Name = p.Name + " - " + strings.Join(labels, ", ")
+	}
+	return &vp
+}
+
+// ValidateGTIN checks the length and check digit of a GTIN-8, UPC-A (GTIN-12), EAN-13 or GTIN-14 code
+func ValidateGTIN(code string) error {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return ErrInvalidGTIN
+	}
+
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		d := int(code[i] - '0')
+		if d < 0 || d > 9 {
+			return ErrInvalidGTIN
+		}
+		// Digits are weighted 3,1,3,1... moving left from the check digit.
+		if (len(code)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	if int(code[len(code)-1]-'0') != (10-sum%10)%10 {
+		return ErrInvalidGTIN
+	}
+	return nil
+}
+
+// TruncateTitle shortens a title to at most limit characters, cutting on a word boundary where possible
+func TruncateTitle(title string, limit int) string {
+	title = strings.TrimSpace(title)
+	runes := []rune(title)
+	if len(runes) <= limit {
+		return title
+	}
+	cut := runes[:limit]
+	if runes[limit] != ' ' {
+		for i := limit - 1; i > limit/2; i-- {
+			if cut[i] == ' ' {
+				cut = cut[:i]
+				break
+			}
+		}
+	}
+	return strings.TrimSpace(string(cut))
+}
+
+var (
+	listItemPattern = regexp.MustCompile(`(?is)<li[^>]*>(.*?)</li>`)
+	tagPattern      = regexp.MustCompile(`(?s)<[^>]*>`)
+	spacePattern    = regexp.MustCompile(`\s+`)
+	// Sentences end with punctuation followed by a space, so "$19.99" is not split
+	sentencePattern = regexp.MustCompile(`(?s).+?(?:[.!?]+\s|$)`)
+)
+
+// StripHTML removes tags from an HTML description and collapses whitespace
+func StripHTML(s string) string {
+	s = tagPattern.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	return strings.TrimSpace(spacePattern.ReplaceAllString(s, " "))
+}
+
+// ExtractBullets pulls up to max bullet points from a product description. List
+// items are used when the description contains any; otherwise the plain text is
+// split into sentences. Each bullet is truncated to limit characters.
+func ExtractBullets(description string, max, limit int) []string {
+	var candidates []string
+	for _, m := range listItemPattern.FindAllStringSubmatch(description, -1) {
+		candidates = append(candidates, StripHTML(m[1]))
+	}
+	if len(candidates) == 0 {
+		candidates = sentencePattern.FindAllString(StripHTML(description), -1)
+	}
+
+	var bullets []string
+	for _, c := range candidates {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		bullets = append(bullets, TruncateTitle(c, limit))
+		if len(bullets) == max {
+			break
+		}
+	}
+	return bullets
+}
+
+// gtinFor validates the product's UPC field and reports which kind of GTIN it is
+func gtinFor(p *Product, required bool) (string, error) {
+	if p.UPC == "" {
+		if required {
+			return "", ErrMissingGTIN
+		}
+		return "", nil
+	}
+	if err := ValidateGTIN(p.UPC); err != nil {
+		return "", fmt.Errorf("%w: %q on product %d", err, p.UPC, p.ID)
+	}
+	switch len(p.UPC) {
+	case 12:
+		return "UPC", nil
+	case 13:
+		return "EAN", nil
+	default:
+		return "GTIN", nil
+	}
+}
+
+// feedPrice returns the product's active price formatted with two decimals
+func feedPrice(p *Product) string {
+	price := p.Price
+	if sale, err := strconv.ParseFloat(p.SalePrice, 64); err == nil && sale > 0 {
+		price = p.SalePrice
+	}
+	f, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return price
+	}
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+func amazonCondition(condition string) string {
+	switch condition {
+	case "Used":
+		return "UsedGood"
+	case "Refurbished":
+		return "Refurbished"
+	default:
+		return "New"
+	}
+}
+
+func ebayCondition(condition string) string {
+	switch condition {
+	case "Used":
+		return "USED_GOOD"
+	case "Refurbished":
+		return "SELLER_REFURBISHED"
+	default:
+		return "NEW"
+	}
+}
@@ -0,0 +1,141 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateGTIN(t *testing.T) {
+	valid := []string{"036000291452", "4006381333931", "96385074", "10614141000415"}
+	for _, code := range valid {
+		if err := ValidateGTIN(code); err != nil {
+			t.Error("Expected", code, "to be valid, got", err)
+		}
+	}
+
+	invalid := []string{"036000291453", "12345", "03600029145a", ""}
+	for _, code := range invalid {
+		if err := ValidateGTIN(code); err != ErrInvalidGTIN {
+			t.Error("Expected", code, "to be invalid")
+		}
+	}
+}
+
+func TestExtractBullets(t *testing.T) {
+	description := `<p>Intro</p><ul><li>Soft &amp; warm</li><li><b>100%</b> wool</li></ul>`
+	bullets := ExtractBullets(description, AmazonBulletCount, AmazonBulletLimit)
+	if len(bullets) != 2 || bullets[0] != "Soft & warm" || bullets[1] != "100% wool" {
+		t.Error("Unexpected bullets", bullets)
+	}
+
+	bullets = ExtractBullets("First sentence. Second one! Third?", 2, AmazonBulletLimit)
+	if len(bullets) != 2 || bullets[1] != "Second one!" {
+		t.Error("Unexpected bullets", bullets)
+	}
+
+	bullets = ExtractBullets("Now only $19.99. Ships in 1.5 days", AmazonBulletCount, AmazonBulletLimit)
+	if len(bullets) != 2 || bullets[0] != "Now only $19.99." || bullets[1] != "Ships in 1.5 days" {
+		t.Error("Unexpected bullets", bullets)
+	}
+}
+
+func TestTruncateTitle(t *testing.T) {
+	title := TruncateTitle("[Sample] Tomorrow is today, Red printed scarf", 20)
+	if title != "[Sample] Tomorrow is" {
+		t.Error("Unexpected title", title)
+	}
+
+	// Only a space in the second half of the limit, counted in characters, is a cut
+	title = TruncateTitle("Шап кашемировая", 10)
+	if title != "Шап кашеми" {
+		t.Error("Unexpected title", title)
+	}
+}
+
+func TestMarketplaceFeeds(t *testing.T) {
+	var p Product
+	if err := json.NewDecoder(strings.NewReader(ProductData)).Decode(&p); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ToAmazonFlatFile(&p, "Sample", true); err != ErrMissingGTIN {
+		t.Error("Expected ErrMissingGTIN, got", err)
+	}
+
+	p.UPC = "036000291452"
+	row, err := ToAmazonFlatFile(&p, "Sample", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.ProductIDType != "UPC" || row.StandardPrice != "89.00" || row.ConditionType != "New" {
+		t.Error("Unexpected row", row)
+	}
+	if len(row.Record()) != len(AmazonFlatFileHeader) {
+		t.Error("Expected record to match header length")
+	}
+
+	item, err := ToEbayInventoryItem(&p, "Sample", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len([]rune(item.Product.Title)) > EbayTitleLimit || item.Product.UPC[0] != p.UPC {
+		t.Error("Unexpected item", item)
+	}
+
+	p.UPC = "036000291453"
+	if _, err := ToEbayInventoryItem(&p, "Sample", false); err == nil {
+		t.Error("Expected invalid GTIN error")
+	}
+
+	for code, ean := range map[string]string{"96385074": "96385074", "04006381333931": "4006381333931"} {
+		p.UPC = code
+		if item, err := ToEbayInventoryItem(&p, "Sample", true); err != nil || len(item.Product.EAN) != 1 || item.Product.EAN[0] != ean {
+			t.Errorf("ToEbayInventoryItem with GTIN %s = %+v, %v", code, item, err)
+		}
+	}
+	p.UPC = "10614141000415"
+	if _, err := ToEbayInventoryItem(&p, "Sample", true); !errors.Is(err, ErrUnsupportedGTIN) {
+		t.Error("Expected ErrUnsupportedGTIN, got", err)
+	}
+}
+
+func TestMarketplaceFeeds_variant(t *testing.T) {
+	var p Product
+	if err := json.NewDecoder(strings.NewReader(ProductData)).Decode(&p); err != nil {
+		t.Fatal(err)
+	}
+	tracking := SKUInventory
+	p.InventoryTracking = &tracking
+	price := 95.5
+	v := &FeedVariant{
+		SKU:            "SHIRT-RED",
+		Price:          &price,
+		GTIN:           "036000291452",
+		InventoryLevel: 3,
+		ImageURL:       "https://cdn.example.com/red.jpg",
+		Options:        []FeedOption{{Name: "Color", Value: "Red"}},
+	}
+
+	row, err := ToAmazonFlatFileVariant(&p, v, "Sample", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.SKU != "SHIRT-RED" || row.ProductID != v.GTIN || row.StandardPrice != "95.50" || row.Quantity != 3 ||
+		row.MainImageURL != v.ImageURL || !strings.HasSuffix(row.ItemName, " - Red") {
+		t.Error("Unexpected row", row)
+	}
+
+	item, err := ToEbayInventoryItemVariant(&p, v, "Sample", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.SKU != "SHIRT-RED" || item.Product.Aspects["Color"][0] != "Red" || item.Product.UPC[0] != v.GTIN {
+		t.Error("Unexpected item", item)
+	}
+
+	if _, err := ToAmazonFlatFileVariant(&p, &FeedVariant{SKU: "SHIRT-BLUE"}, "Sample", true); err != ErrMissingGTIN {
+		t.Error("Expected ErrMissingGTIN, got", err)
+	}
+}
@@ -0,0 +1,168 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the string values of sensitive fields in fixtures
+const redacted = "REDACTED"
+
+// sensitiveFields are the response fields whose values are redacted from
+// fixtures. Fields containing one of sensitiveParts are redacted too.
+var sensitiveFields = map[string]bool{
+	"first_name": true, "last_name": true, "company": true, "phone": true, "street_1": true, "street_2": true,
+	"address1": true, "address2": true, "zip": true, "customer_message": true, "staff_notes": true,
+	"authentication": true, "notes": true,
+}
+
+var sensitiveParts = []string{"email", "ip_address", "password", "token", "secret", "credential"}
+
+var emailPattern = regexp.MustCompile(`[^\s@"]+@[^\s@"]+\.[a-zA-Z]{2,}`)
+
+// Fixture is a sanitized response that failed to decode, as written by
+// WithFixtureCapture. Attach it to bug reports: decoding Body into the type
+// the endpoint returns reproduces the failure.
+type Fixture struct {
+	Endpoint string          `json:"endpoint"` // Method and path template, e.g. "GET v3/catalog/products/{id}".
+	Status   int             `json:"status"`   // The HTTP status code.
+	Error    string          `json:"error"`    // The decoding error.
+	Body     json.RawMessage `json:"body"`     // The sanitized response body. Invalid JSON is kept as a string.
+}
+
+// DecodeError is returned by clients created WithFixtureCapture when a
+// response does not decode into the type of its endpoint, e.g. because of an
+// unexpected type or enum value; the response is saved to Fixture. Other
+// clients return the decoding error itself, and keep no copy of the response.
+type DecodeError struct {
+	Endpoint   string
+	Err        error
+	Fixture    string // The fixture file, empty if capturing failed.
+	CaptureErr error  // Why the fixture could not be written.
+}
+
+func (e *DecodeError) Error() string {
+	msg := fmt.Sprintf("decoding %s: %v", e.Endpoint, e.Err)
+	if e.Fixture != "" {
+		msg += " (response saved to " + e.Fixture + ")"
+	}
+	return msg
+}
+
+// Unwrap returns the decoding error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WithFixtureCapture saves the responses that fail to decode to fixture files
+// in dir, with customer details, emails and secrets redacted, and returns a
+// *DecodeError naming the file. Identical failures share a file.
+func WithFixtureCapture(dir string) ClientOption {
+	return func(c *Client) {
+		c.fixtureDir = dir
+	}
+}
+
+// ReadFixture reads a fixture file written by WithFixtureCapture
+func ReadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := new(Fixture)
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// captureFixture saves a response that failed to decode with err
+func (c *Client) captureFixture(req *http.Request, resp *http.Response, body []byte, err error) error {
+	e := &DecodeError{Endpoint: endpointName(req), Err: err}
+
+	f := &Fixture{Endpoint: e.Endpoint, Status: resp.StatusCode, Error: err.Error(), Body: sanitizeBody(body)}
+	var data []byte
+	data, e.CaptureErr = json.MarshalIndent(f, "", "  ")
+	if e.CaptureErr != nil {
+		return e
+	}
+	sum := sha256.Sum256(append([]byte(f.Endpoint+"\n"), f.Body...))
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, f.Endpoint)
+	path := filepath.Join(c.fixtureDir, fmt.Sprintf("%s_%x.json", name, sum[:4]))
+
+	if e.CaptureErr = os.MkdirAll(c.fixtureDir, 0755); e.CaptureErr != nil {
+		return e
+	}
+	if e.CaptureErr = os.WriteFile(path, append(data, '\n'), 0644); e.CaptureErr == nil {
+		e.Fixture = path
+	}
+	return e
+}
+
+// sanitizeBody redacts a response body, keeping its structure and the types
+// of its values so it still fails to decode the same way
+func sanitizeBody(body []byte) json.RawMessage {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		v = emailPattern.ReplaceAllString(string(body), redacted)
+	} else {
+		v = sanitize(v, false)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false) // Keep HTML error pages readable.
+	enc.Encode(v)
+	return bytes.TrimSpace(buf.Bytes())
+}
+
+func sanitize(v interface{}, sensitive bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = sanitize(value, sensitive || isSensitive(key))
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = sanitize(value, sensitive)
+		}
+		return v
+	case string:
+		if sensitive && v != "" {
+			return redacted
+		}
+		return emailPattern.ReplaceAllString(v, redacted)
+	case json.Number:
+		if sensitive {
+			return json.Number("0")
+		}
+	}
+	return v
+}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	if sensitiveFields[field] {
+		return true
+	}
+	for _, part := range sensitiveParts {
+		if strings.Contains(field, part) {
+			return true
+		}
+	}
+	return false
+}
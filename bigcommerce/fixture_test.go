@@ -0,0 +1,94 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWithFixtureCapture(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	WithFixtureCapture(dir)(client)
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":7,"first_name":"Jane","email":"jane@example.com","phone":"555-0100","registration_ip_address":"10.0.0.1",
+			"notes":"Call jane.doe@example.org first","store_credit":12.5,"addresses":{"url":"https://x/v2/customers/7/addresses"}}`)
+	})
+
+	_, _, err := client.Customers.Get(context.Background(), 7)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Get returned %v, want a DecodeError", err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) || typeErr.Field != "store_credit" {
+		t.Errorf("DecodeError wraps %v, want the store_credit type error", decodeErr.Err)
+	}
+	if decodeErr.Endpoint != "GET v2/customers/{id}" || decodeErr.CaptureErr != nil || filepath.Dir(decodeErr.Fixture) != dir {
+		t.Fatalf("Unexpected DecodeError %+v", decodeErr)
+	}
+
+	path := decodeErr.Fixture
+	fixture, err := ReadFixture(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixture.Endpoint != "GET v2/customers/{id}" || fixture.Status != 200 || fixture.Error != typeErr.Error() {
+		t.Errorf("Unexpected fixture %+v", fixture)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(fixture.Body, &body)
+	want := map[string]interface{}{
+		"id": 7.0, "first_name": "REDACTED", "email": "REDACTED", "phone": "REDACTED", "registration_ip_address": "REDACTED",
+		"notes": "REDACTED", "store_credit": 12.5, "addresses": map[string]interface{}{"url": "https://x/v2/customers/7/addresses"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("Fixture body = %v, want %v", body, want)
+	}
+
+	// The fixture reproduces the failure.
+	if err := json.Unmarshal(fixture.Body, new(Customer)); err == nil || err.Error() != fixture.Error {
+		t.Errorf("Decoding the fixture returned %v, want %s", err, fixture.Error)
+	}
+
+	// Identical failures share a fixture.
+	_, _, err = client.Customers.Get(context.Background(), 7)
+	if !errors.As(err, &decodeErr) || decodeErr.Fixture != path {
+		t.Errorf("Second failure saved to %s", decodeErr.Fixture)
+	}
+}
+
+func TestDecodeError_withoutCapture(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/customers/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":7,"store_credit":12.5}`)
+	})
+
+	_, _, err := client.Customers.Get(context.Background(), 7)
+	var decodeErr *DecodeError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &decodeErr) || !errors.As(err, &typeErr) {
+		t.Errorf("Get returned %#v, want the unwrapped type error", err)
+	}
+}
+
+func TestSanitizeBody(t *testing.T) {
+	for body, want := range map[string]string{
+		`[{"customer_email":"a@b.co","count":2}]`:           `[{"count":2,"customer_email":"REDACTED"}]`,
+		`{"billing_address":{"zip":12345,"city":"Austin"}}`: `{"billing_address":{"city":"Austin","zip":0}}`,
+		`<html>contact admin@shop.com</html>`:               `"<html>contact REDACTED</html>"`,
+	} {
+		if got := string(sanitizeBody([]byte(body))); got != want {
+			t.Errorf("sanitizeBody(%s) = %s, want %s", body, got, want)
+		}
+	}
+}
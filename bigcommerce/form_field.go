@@ -0,0 +1,313 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormFieldService handles communication with the V3 form field endpoints
+type FormFieldService service
+
+// FormField describes a field of the store's account signup or address form
+type FormField struct {
+	Name      string         `json:"name"`                 // The field's label, used as the name of its values.
+	Label     string         `json:"label,omitempty"`      // The label shown to shoppers.
+	Form      FormType       `json:"form"`                 // The form the field belongs to.
+	Type      FormFieldType  `json:"type"`                 // The kind of input.
+	PrivateID string         `json:"private_id,omitempty"` // Built-in fields only, e.g. "FirstName".
+	IsBuiltIn bool           `json:"is_built_in"`          // Whether the field is part of BigCommerce's default form.
+	Required  bool           `json:"required"`             // Whether a value must be given.
+	Options   *FormFieldSpec `json:"options,omitempty"`    // Type-specific configuration.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// FormFieldSpec describes the type-specific configuration of a FormField
+type FormFieldSpec struct {
+	Items        []string `json:"items,omitempty"`         // The choices of checkbox, radio and dropdown fields.
+	MaxLength    int      `json:"maxlength,omitempty"`     // Maximum length of text fields.
+	Min          *float64 `json:"min,omitempty"`           // Lowest value of number fields.
+	Max          *float64 `json:"max,omitempty"`           // Highest value of number fields.
+	IntegerOnly  bool     `json:"integer_only,omitempty"`  // Whether number fields accept integers only.
+	DefaultValue string   `json:"default_value,omitempty"` // The value preselected on the storefront.
+}
+
+// FormType - The form a FormField belongs to
+type FormType string
+
+// FormFieldType - The kind of input of a FormField
+type FormFieldType string
+
+// FormFieldFilter - Whether FormFieldService.List returns built-in or custom fields
+type FormFieldFilter string
+
+const (
+	// AccountForm - the account signup form, whose values belong to customers
+	AccountForm FormType = "account"
+	// AddressForm - the address form, whose values belong to customer addresses
+	AddressForm FormType = "address"
+
+	// BuiltInFormFields - only the fields of BigCommerce's default forms
+	BuiltInFormFields FormFieldFilter = "builtin"
+	// ExtraFormFields - only the fields added by the merchant
+	ExtraFormFields FormFieldFilter = "extra"
+
+	// TextField - a single-line text input
+	TextField FormFieldType = "text"
+	// MultilineField - a multi-line text area
+	MultilineField FormFieldType = "multiline"
+	// NumberField - a numeric input
+	NumberField FormFieldType = "number"
+	// DateField - a date picker, with values formatted as YYYY-MM-DD
+	DateField FormFieldType = "date"
+	// CheckboxField - a set of checkboxes, whose value lists the checked items
+	CheckboxField FormFieldType = "checkbox"
+	// RadioField - a set of radio buttons
+	RadioField FormFieldType = "radio"
+	// DropdownField - a drop-down list
+	DropdownField FormFieldType = "dropdown"
+	// PasswordField - a password input
+	PasswordField FormFieldType = "password"
+)
+
+// FormFieldValue describes the value of a form field for a customer or a
+// customer address. Exactly one of CustomerID and AddressID is set.
+type FormFieldValue struct {
+	Name       string      `json:"name"`                  // The name of the form field.
+	Value      interface{} `json:"value"`                 // A string, number, or list of strings for checkboxes.
+	CustomerID int64       `json:"customer_id,omitempty"` // Set for account form values.
+	AddressID  int64       `json:"address_id,omitempty"`  // Set for address form values.
+}
+
+// FormFieldListOptions specifies the optional parameters to FormFieldService.List
+type FormFieldListOptions struct {
+	Form   FormType        `url:"type,omitempty"`   // Only return the fields of a form.
+	Filter FormFieldFilter `url:"filter,omitempty"` // Only return built-in, or only custom, fields.
+}
+
+// FormFieldValueListOptions specifies the optional parameters to FormFieldService.ListValues
+type FormFieldValueListOptions struct {
+	ListOptions
+	CustomerID int64  `url:"customer_id,omitempty"`
+	AddressID  int64  `url:"address_id,omitempty"`
+	FieldName  string `url:"field_name,omitempty"`
+	FieldType  string `url:"field_type,omitempty"` // Either "account" or "address".
+}
+
+// List returns the store's form fields
+func (s *FormFieldService) List(ctx context.Context, opts *FormFieldListOptions) (FormFields, *Response, error) {
+	path, err := addOptions("v3/form-fields", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields FormFields
+	resp, err := s.client.call(ctx, "GET", path, nil, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return fields, resp, nil
+}
+
+// ListValues returns a page of form field values
+func (s *FormFieldService) ListValues(ctx context.Context, opts *FormFieldValueListOptions) ([]*FormFieldValue, *Response, error) {
+	path, err := addOptions("v3/customers/form-field-values", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []*FormFieldValue
+	resp, err := s.client.call(ctx, "GET", path, nil, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// UpsertValues sets form field values of customers and customer addresses
+func (s *FormFieldService) UpsertValues(ctx context.Context, values []*FormFieldValue) ([]*FormFieldValue, *Response, error) {
+	var upserted []*FormFieldValue
+	resp, err := s.client.call(ctx, "PUT", "v3/customers/form-field-values", values, &upserted)
+	if err != nil {
+		return nil, resp, err
+	}
+	return upserted, resp, nil
+}
+
+// FormValuesError describes why form field values were rejected
+type FormValuesError struct {
+	Problems []FormFieldProblem
+}
+
+// FormFieldProblem describes a problem with the value of a single form field
+type FormFieldProblem struct {
+	Name    string // The name of the form field.
+	Message string
+}
+
+func (e *FormValuesError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = fmt.Sprintf("%q: %s", p.Name, p.Message)
+	}
+	return "bigcommerce: invalid form field values: " + strings.Join(msgs, "; ")
+}
+
+// FormFields is the set of fields of a form, as returned by FormFieldService.List
+type FormFields []*FormField
+
+// Field returns the field with the given name, or nil
+func (f FormFields) Field(name string) *FormField {
+	for _, field := range f {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// Values checks raw values, keyed by field name, against the custom fields of
+// form and converts them to the shape the API expects: numbers for number
+// fields, YYYY-MM-DD dates and lists of strings for checkboxes. Built-in
+// fields are set through the customer or address itself, so naming one is an
+// error, as is an unknown name or a missing required value. The returned
+// values have no CustomerID or AddressID set.
+func (f FormFields) Values(form FormType, raw map[string]interface{}) ([]*FormFieldValue, error) {
+	verr := new(FormValuesError)
+	problem := func(name, format string, args ...interface{}) {
+		verr.Problems = append(verr.Problems, FormFieldProblem{Name: name, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var values []*FormFieldValue
+	for _, field := range f {
+		if field.Form != form || field.IsBuiltIn {
+			continue
+		}
+		v, ok := raw[field.Name]
+		if !ok || v == nil || v == "" {
+			if field.Required {
+				problem(field.Name, "is required")
+			}
+			continue
+		}
+		shaped, err := field.shape(v)
+		if err != nil {
+			problem(field.Name, "%v", err)
+			continue
+		}
+		values = append(values, &FormFieldValue{Name: field.Name, Value: shaped})
+	}
+
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field := f.Field(name)
+		switch {
+		case field == nil || field.Form != form:
+			problem(name, "is not a field of the %s form", form)
+		case field.IsBuiltIn:
+			problem(name, "is a built-in field")
+		}
+	}
+
+	if len(verr.Problems) > 0 {
+		return values, verr
+	}
+	return values, nil
+}
+
+// shape converts v to the type of value expected for the field
+func (field *FormField) shape(v interface{}) (interface{}, error) {
+	spec := field.Options
+	if spec == nil {
+		spec = new(FormFieldSpec)
+	}
+
+	switch field.Type {
+	case NumberField:
+		var n float64
+		switch x := v.(type) {
+		case float64:
+			n = x
+		case int:
+			n = float64(x)
+		case int64:
+			n = float64(x)
+		case string:
+			var err error
+			if n, err = strconv.ParseFloat(strings.TrimSpace(x), 64); err != nil {
+				return nil, fmt.Errorf("%q is not a number", x)
+			}
+		default:
+			return nil, fmt.Errorf("%v is not a number", v)
+		}
+		if spec.IntegerOnly && n != float64(int64(n)) {
+			return nil, fmt.Errorf("%v is not an integer", n)
+		}
+		if spec.Min != nil && n < *spec.Min {
+			return nil, fmt.Errorf("%v is less than %v", n, *spec.Min)
+		}
+		if spec.Max != nil && n > *spec.Max {
+			return nil, fmt.Errorf("%v is greater than %v", n, *spec.Max)
+		}
+		return n, nil
+
+	case DateField:
+		switch x := v.(type) {
+		case time.Time:
+			return x.Format("2006-01-02"), nil
+		case string:
+			t, err := time.Parse("2006-01-02", x)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a YYYY-MM-DD date", x)
+			}
+			return t.Format("2006-01-02"), nil
+		}
+		return nil, fmt.Errorf("%v is not a date", v)
+
+	case CheckboxField:
+		var checked []string
+		switch x := v.(type) {
+		case string:
+			checked = []string{x}
+		case []string:
+			checked = x
+		default:
+			return nil, fmt.Errorf("%v is not a list of strings", v)
+		}
+		for _, c := range checked {
+			if !contains(spec.Items, c) {
+				return nil, fmt.Errorf("%q is not one of %q", c, spec.Items)
+			}
+		}
+		return checked, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		s = fmt.Sprint(v)
+	}
+	if (field.Type == RadioField || field.Type == DropdownField) && !contains(spec.Items, s) {
+		return nil, fmt.Errorf("%q is not one of %q", s, spec.Items)
+	}
+	if spec.MaxLength > 0 && len([]rune(s)) > spec.MaxLength {
+		return nil, fmt.Errorf("is longer than %d characters", spec.MaxLength)
+	}
+	return s, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
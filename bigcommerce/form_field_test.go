@@ -0,0 +1,102 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFormFieldService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/form-fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"type": "account", "filter": "extra"})
+		fmt.Fprint(w, `{"data":[{"name":"Shoe size","label":"Shoe size","form":"account","type":"number","is_built_in":false,"required":true,"options":{"min":1,"max":20}}],"meta":{}}`)
+	})
+
+	fields, _, err := client.FormFields.List(context.Background(), &FormFieldListOptions{Form: AccountForm, Filter: ExtraFormFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	min, max := 1.0, 20.0
+	want := FormFields{{Name: "Shoe size", Label: "Shoe size", Form: AccountForm, Type: NumberField, Required: true, Options: &FormFieldSpec{Min: &min, Max: &max}}}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("List = %+v, want %+v", fields, want)
+	}
+}
+
+func TestFormFieldService_UpsertValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*FormFieldValue{{Name: "Shoe size", Value: 9.5, CustomerID: 7}}
+	mux.HandleFunc("/stores/abc123/v3/customers/form-field-values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*FormFieldValue{}, &input)
+		fmt.Fprint(w, `{"data":[{"name":"Shoe size","value":9.5,"customer_id":7}],"meta":{}}`)
+	})
+
+	values, _, err := client.FormFields.UpsertValues(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, input) {
+		t.Errorf("UpsertValues = %+v, want %+v", values, input)
+	}
+}
+
+func TestFormFields_Values(t *testing.T) {
+	min := 1.0
+	fields := FormFields{
+		{Name: "First Name", Form: AccountForm, Type: TextField, IsBuiltIn: true, Required: true},
+		{Name: "Shoe size", Form: AccountForm, Type: NumberField, Required: true, Options: &FormFieldSpec{Min: &min}},
+		{Name: "Birthday", Form: AccountForm, Type: DateField},
+		{Name: "Interests", Form: AccountForm, Type: CheckboxField, Options: &FormFieldSpec{Items: []string{"Running", "Hiking"}}},
+		{Name: "Referrer", Form: AccountForm, Type: DropdownField, Options: &FormFieldSpec{Items: []string{"Friend", "Search"}}},
+		{Name: "Gate code", Form: AddressForm, Type: TextField, Required: true},
+	}
+
+	values, err := fields.Values(AccountForm, map[string]interface{}{
+		"Shoe size": "9.5",
+		"Birthday":  "1990-04-01",
+		"Interests": "Hiking",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*FormFieldValue{
+		{Name: "Shoe size", Value: 9.5},
+		{Name: "Birthday", Value: "1990-04-01"},
+		{Name: "Interests", Value: []string{"Hiking"}},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Values = %+v, want %+v", values, want)
+	}
+
+	_, err = fields.Values(AccountForm, map[string]interface{}{
+		"First Name": "Jane",
+		"Shoe size":  0,
+		"Birthday":   "April 1st",
+		"Referrer":   "Billboard",
+		"Gate code":  "1234",
+	})
+	var verr *FormValuesError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Values error = %v, want *FormValuesError", err)
+	}
+	wantProblems := []FormFieldProblem{
+		{Name: "Shoe size", Message: "0 is less than 1"},
+		{Name: "Birthday", Message: `"April 1st" is not a YYYY-MM-DD date`},
+		{Name: "Referrer", Message: `"Billboard" is not one of ["Friend" "Search"]`},
+		{Name: "First Name", Message: "is a built-in field"},
+		{Name: "Gate code", Message: "is not a field of the account form"},
+	}
+	if !reflect.DeepEqual(verr.Problems, wantProblems) {
+		t.Errorf("Problems = %+v, want %+v", verr.Problems, wantProblems)
+	}
+}
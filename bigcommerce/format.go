@@ -0,0 +1,214 @@
+package bigcommerce
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatter renders prices, weights, dimensions and dates the way a store
+// displays them, for emails and documents generated outside the storefront
+type Formatter struct {
+	CurrencySymbol          string
+	SymbolOnRight           bool // Whether the currency symbol follows the amount.
+	DecimalSeparator        string
+	ThousandsSeparator      string
+	DecimalPlaces           int
+	WeightUnit              string // Abbreviated weight unit, e.g. kg.
+	DimensionUnit           string // Abbreviated dimension unit, e.g. cm.
+	DimensionDecimalPlaces  int
+	DimensionDecimalToken   string
+	DimensionThousandsToken string
+	DateFormat              string         // PHP date() format used by Date.
+	DateTimeFormat          string         // PHP date() format used by DateTime.
+	Location                *time.Location // The store's time zone.
+}
+
+// NewFormatter returns a Formatter for a store's display settings. Missing
+// settings default to US conventions.
+func NewFormatter(store *Store) *Formatter {
+	f := &Formatter{
+		CurrencySymbol:          store.CurrencySymbol,
+		SymbolOnRight:           store.CurrencySymbolLocation == "right",
+		DecimalSeparator:        defaultString(store.DecimalSeparator, "."),
+		ThousandsSeparator:      store.ThousandsSeparator,
+		DecimalPlaces:           store.DecimalPlaces,
+		WeightUnit:              weightUnits[store.WeightUnits],
+		DimensionUnit:           dimensionUnits[store.DimensionUnits],
+		DimensionDecimalPlaces:  store.DimensionDecimalPlaces,
+		DimensionDecimalToken:   defaultString(store.DimensionDecimalToken, "."),
+		DimensionThousandsToken: store.DimensionThousandsToken,
+		DateFormat:              defaultString(store.Timezone.DateFormat.Display, "jS M Y"),
+		DateTimeFormat:          defaultString(store.Timezone.DateFormat.ExtendedDisplay, "M jS Y @ g:i A"),
+		Location:                StoreLocation(store),
+	}
+	if store.DecimalPlaces == 0 && store.DecimalSeparator == "" {
+		f.DecimalPlaces = 2
+	}
+	return f
+}
+
+var weightUnits = map[string]string{"LBS": "lbs", "Ounces": "oz", "KGS": "kg", "Grams": "g", "Tonnes": "t"}
+
+var dimensionUnits = map[string]string{"Inches": "in", "Centimeters": "cm"}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// Money formats an amount in the store's currency, e.g. "$1,234.50" or "1.234,50 €"
+func (f *Formatter) Money(amount float64) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	n := formatNumber(amount, f.DecimalPlaces, f.DecimalSeparator, f.ThousandsSeparator)
+	if f.SymbolOnRight {
+		return sign + n + " " + f.CurrencySymbol
+	}
+	return sign + f.CurrencySymbol + n
+}
+
+// Weight formats a weight in the store's weight unit, e.g. "1.50 kg"
+func (f *Formatter) Weight(weight float64) string {
+	return f.measure(weight, f.WeightUnit)
+}
+
+// Dimension formats a length in the store's dimension unit, e.g. "30.00 cm"
+func (f *Formatter) Dimension(length float64) string {
+	return f.measure(length, f.DimensionUnit)
+}
+
+func (f *Formatter) measure(v float64, unit string) string {
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	s := sign + formatNumber(v, f.DimensionDecimalPlaces, f.DimensionDecimalToken, f.DimensionThousandsToken)
+	if unit != "" {
+		s += " " + unit
+	}
+	return s
+}
+
+// Date formats the date of t in the store's time zone and display format
+func (f *Formatter) Date(t time.Time) string {
+	return formatPHPDate(t.In(f.Location), f.DateFormat)
+}
+
+// DateTime formats t in the store's time zone and extended display format
+func (f *Formatter) DateTime(t time.Time) string {
+	return formatPHPDate(t.In(f.Location), f.DateTimeFormat)
+}
+
+// formatNumber formats a non-negative number with the given decimal places and separators
+func formatNumber(v float64, places int, decimal, thousands string) string {
+	s := strconv.FormatFloat(math.Round(v*math.Pow10(places))/math.Pow10(places), 'f', places, 64)
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+
+	var b strings.Builder
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(thousands)
+		}
+		b.WriteRune(r)
+	}
+	if frac != "" {
+		b.WriteString(decimal)
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// formatPHPDate formats t using the PHP date() syntax stores configure their
+// date formats in. Unsupported characters are copied as-is; a backslash escapes
+// the next character.
+func formatPHPDate(t time.Time, format string) string {
+	var b strings.Builder
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				b.WriteRune(runes[i])
+			}
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'D':
+			b.WriteString(t.Format("Mon"))
+		case 'j':
+			b.WriteString(strconv.Itoa(t.Day()))
+		case 'l':
+			b.WriteString(t.Format("Monday"))
+		case 'N':
+			b.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'S':
+			b.WriteString(ordinalSuffix(t.Day()))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'F':
+			b.WriteString(t.Format("January"))
+		case 'M':
+			b.WriteString(t.Format("Jan"))
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'n':
+			b.WriteString(strconv.Itoa(int(t.Month())))
+		case 'Y':
+			b.WriteString(strconv.Itoa(t.Year()))
+		case 'y':
+			b.WriteString(t.Format("06"))
+		case 'a':
+			b.WriteString(t.Format("pm"))
+		case 'A':
+			b.WriteString(t.Format("PM"))
+		case 'g':
+			b.WriteString(t.Format("3"))
+		case 'G':
+			b.WriteString(strconv.Itoa(t.Hour()))
+		case 'h':
+			b.WriteString(t.Format("03"))
+		case 'H':
+			b.WriteString(t.Format("15"))
+		case 'i':
+			b.WriteString(t.Format("04"))
+		case 's':
+			b.WriteString(t.Format("05"))
+		case 'T':
+			b.WriteString(t.Format("MST"))
+		case 'O':
+			b.WriteString(t.Format("-0700"))
+		case 'P':
+			b.WriteString(t.Format("-07:00"))
+		case 'U':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
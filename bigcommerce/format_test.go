@@ -0,0 +1,61 @@
+package bigcommerce
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatter_Money(t *testing.T) {
+	us := NewFormatter(&Store{CurrencySymbol: "$", CurrencySymbolLocation: "left", DecimalSeparator: ".", ThousandsSeparator: ",", DecimalPlaces: 2})
+	tests := []struct {
+		amount float64
+		want   string
+	}{
+		{0, "$0.00"},
+		{9.999, "$10.00"},
+		{1234567.891, "$1,234,567.89"},
+		{-42.5, "-$42.50"},
+	}
+	for _, tt := range tests {
+		if got := us.Money(tt.amount); got != tt.want {
+			t.Errorf("Money(%v) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+
+	yen := NewFormatter(&Store{CurrencySymbol: "¥", DecimalSeparator: ".", ThousandsSeparator: ",", DecimalPlaces: 0})
+	if got, want := yen.Money(1500.4), "¥1,500"; got != want {
+		t.Errorf("Money = %q, want %q", got, want)
+	}
+}
+
+func TestFormatter_measures(t *testing.T) {
+	f := NewFormatter(&Store{WeightUnits: "LBS", DimensionUnits: "Inches", DimensionDecimalPlaces: 2})
+	if got, want := f.Weight(1.5), "1.50 lbs"; got != want {
+		t.Errorf("Weight = %q, want %q", got, want)
+	}
+	if got, want := f.Dimension(12), "12.00 in"; got != want {
+		t.Errorf("Dimension = %q, want %q", got, want)
+	}
+}
+
+func TestFormatter_dates(t *testing.T) {
+	store := &Store{Timezone: StoreTimezone{Name: "Fixed/Test", RawOffset: 10 * 3600, DateFormat: StoreDateFormat{
+		Display:         "jS M Y",
+		ExtendedDisplay: `l, F jS Y \a\t g:i A`,
+	}}}
+	f := NewFormatter(store)
+	ts := time.Date(2021, 3, 1, 23, 5, 0, 0, time.UTC) // March 2nd, 9:05 AM in the store's time zone.
+
+	if got, want := f.Date(ts), "2nd Mar 2021"; got != want {
+		t.Errorf("Date = %q, want %q", got, want)
+	}
+	if got, want := f.DateTime(ts), "Tuesday, March 2nd 2021 at 9:05 AM"; got != want {
+		t.Errorf("DateTime = %q, want %q", got, want)
+	}
+
+	for day, want := range map[int]string{1: "st", 11: "th", 12: "th", 22: "nd", 23: "rd", 30: "th"} {
+		if got := ordinalSuffix(day); got != want {
+			t.Errorf("ordinalSuffix(%d) = %q, want %q", day, got, want)
+		}
+	}
+}
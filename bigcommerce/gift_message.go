@@ -0,0 +1,185 @@
+package bigcommerce
+
+import "strings"
+
+// GiftOptions are the gift details a shopper entered as free text, in the
+// order comments or in product options and configurable fields
+type GiftOptions struct {
+	To       string // The recipient.
+	From     string // The sender.
+	Message  string // The message to enclose, lines joined by "\n".
+	Wrapping string // The gift wrapping chosen.
+	Comment  string // Text that is not a gift detail, such as delivery instructions.
+}
+
+// IsGift reports whether any gift detail was found
+func (g *GiftOptions) IsGift() bool {
+	return g.To != "" || g.From != "" || g.Message != "" || g.Wrapping != ""
+}
+
+// OrderProductGiftOptions are the gift details of an order product
+type OrderProductGiftOptions struct {
+	OrderProductID int64
+	GiftOptions
+}
+
+// OrderGiftOptions are the gift details of an order and its products
+type OrderGiftOptions struct {
+	OrderID int64
+	GiftOptions
+	Products []*OrderProductGiftOptions // Only the products with gift details.
+}
+
+// IsGift reports whether the order or any of its products has a gift detail
+func (g *OrderGiftOptions) IsGift() bool {
+	return g.GiftOptions.IsGift() || len(g.Products) > 0
+}
+
+// GiftField - A gift detail a label refers to
+type GiftField string
+
+const (
+	// GiftTo - the recipient
+	GiftTo GiftField = "to"
+	// GiftFrom - the sender
+	GiftFrom GiftField = "from"
+	// GiftMessage - the message to enclose
+	GiftMessage GiftField = "message"
+	// GiftWrapping - the gift wrapping chosen
+	GiftWrapping GiftField = "wrapping"
+)
+
+// DefaultGiftLabels are the labels GiftParser recognizes when it has none,
+// lowercase
+var DefaultGiftLabels = map[string]GiftField{
+	"to":            GiftTo,
+	"recipient":     GiftTo,
+	"gift to":       GiftTo,
+	"from":          GiftFrom,
+	"sender":        GiftFrom,
+	"gift from":     GiftFrom,
+	"gift message":  GiftMessage,
+	"gift note":     GiftMessage,
+	"gift card":     GiftMessage,
+	"message":       GiftMessage,
+	"gift wrap":     GiftWrapping,
+	"gift wrapping": GiftWrapping,
+	"wrapping":      GiftWrapping,
+}
+
+// GiftParser extracts gift details from the free-text fields of orders. In
+// order comments, details are lines of the form "Label: value", e.g.
+//
+//	To: Sam
+//	Gift message: Happy birthday!
+//	Love from all of us
+//
+// where unlabeled lines continue a message up to the next blank line, and any
+// other text is kept as the comment. In products, details are product options
+// and configurable fields whose names are labels, along with gift wrapping.
+type GiftParser struct {
+	Labels map[string]GiftField // Labels by lowercase name, DefaultGiftLabels if nil.
+}
+
+func (p *GiftParser) field(label string) (GiftField, bool) {
+	labels := p.Labels
+	if labels == nil {
+		labels = DefaultGiftLabels
+	}
+	f, ok := labels[strings.ToLower(strings.Join(strings.Fields(label), " "))]
+	return f, ok
+}
+
+// Message extracts the gift details of an order comment
+func (p *GiftParser) Message(text string) GiftOptions {
+	var g GiftOptions
+	var message, comment []string
+	inMessage := false
+	for _, line := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			inMessage = false
+			continue
+		}
+		if i := strings.Index(line, ":"); i > 0 {
+			if f, ok := p.field(line[:i]); ok {
+				value := strings.TrimSpace(line[i+1:])
+				inMessage = f == GiftMessage
+				if inMessage {
+					if value != "" {
+						message = append(message, value)
+					}
+				} else {
+					g.set(f, value)
+				}
+				continue
+			}
+		}
+		if inMessage {
+			message = append(message, line)
+		} else {
+			comment = append(comment, line)
+		}
+	}
+	g.Message = strings.Join(message, "\n")
+	g.Comment = strings.Join(comment, "\n")
+	return g
+}
+
+// Product extracts the gift details of an order product
+func (p *GiftParser) Product(product *OrderProduct) GiftOptions {
+	g := GiftOptions{Wrapping: product.WrappingName, Message: strings.TrimSpace(product.WrappingMessage)}
+	for _, o := range product.ProductOptions {
+		if f, ok := p.field(o.DisplayName); ok {
+			g.set(f, strings.TrimSpace(o.DisplayValue))
+		}
+	}
+	for _, c := range product.ConfigurableFields {
+		if f, ok := p.field(c.Name); ok {
+			g.set(f, strings.TrimSpace(c.Value))
+		}
+	}
+	return g
+}
+
+// Order extracts the gift details of an order's comment and products
+func (p *GiftParser) Order(order *Order, products []*OrderProduct) *OrderGiftOptions {
+	g := &OrderGiftOptions{OrderID: order.ID, GiftOptions: p.Message(order.CustomerMessage)}
+	for _, product := range products {
+		if options := p.Product(product); options.IsGift() {
+			g.Products = append(g.Products, &OrderProductGiftOptions{OrderProductID: product.ID, GiftOptions: options})
+		}
+	}
+	return g
+}
+
+// set fills in a detail, keeping the first non-empty value found
+func (g *GiftOptions) set(f GiftField, value string) {
+	var dst *string
+	switch f {
+	case GiftTo:
+		dst = &g.To
+	case GiftFrom:
+		dst = &g.From
+	case GiftMessage:
+		dst = &g.Message
+	case GiftWrapping:
+		dst = &g.Wrapping
+	default:
+		return
+	}
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+// GiftOptions returns the gift details of the order comment, with
+// DefaultGiftLabels
+func (o *Order) GiftOptions() GiftOptions {
+	return new(GiftParser).Message(o.CustomerMessage)
+}
+
+// GiftOptions returns the gift details of the product, with DefaultGiftLabels
+func (p *OrderProduct) GiftOptions() GiftOptions {
+	return new(GiftParser).Product(p)
+}
@@ -0,0 +1,55 @@
+package bigcommerce
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGiftParser_Message(t *testing.T) {
+	text := "Please leave at the back door\r\n\r\nTO: Sam\r\nGift  Message: Happy birthday!\r\nLove from all of us\r\n\r\nFrom: The Smiths\r\nDelivery: after 5pm"
+	got := new(GiftParser).Message(text)
+	want := GiftOptions{
+		To:      "Sam",
+		From:    "The Smiths",
+		Message: "Happy birthday!\nLove from all of us",
+		Comment: "Please leave at the back door\nDelivery: after 5pm",
+	}
+	if got != want {
+		t.Errorf("Message returned %+v, want %+v", got, want)
+	}
+	if !got.IsGift() {
+		t.Error("IsGift = false, want true")
+	}
+
+	plain := (&Order{CustomerMessage: "Ring the bell"}).GiftOptions()
+	if plain.IsGift() || plain.Comment != "Ring the bell" {
+		t.Errorf("GiftOptions returned %+v", plain)
+	}
+}
+
+func TestGiftParser_Order(t *testing.T) {
+	products := []*OrderProduct{
+		{ID: 1, WrappingName: "Red paper", ProductOptions: []OrderProductOption{
+			{DisplayName: "Size", DisplayValue: "L"},
+			{DisplayName: "Recipient", DisplayValue: " Alex "},
+		}},
+		{ID: 2, ConfigurableFields: []OrderProductField{{Name: "Card", Value: "Congrats"}}},
+		{ID: 3, ProductOptions: []OrderProductOption{{DisplayName: "Size", DisplayValue: "M"}}},
+	}
+	p := &GiftParser{Labels: map[string]GiftField{"recipient": GiftTo, "card": GiftMessage}}
+	got := p.Order(&Order{ID: 7, CustomerMessage: "From: Jo"}, products)
+	want := &OrderGiftOptions{
+		OrderID:     7,
+		GiftOptions: GiftOptions{Comment: "From: Jo"},
+		Products: []*OrderProductGiftOptions{
+			{OrderProductID: 1, GiftOptions: GiftOptions{To: "Alex", Wrapping: "Red paper"}},
+			{OrderProductID: 2, GiftOptions: GiftOptions{Message: "Congrats"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Order returned %+v, want %+v", got, want)
+	}
+	if !got.IsGift() {
+		t.Error("IsGift = false, want true")
+	}
+}
@@ -0,0 +1,217 @@
+// Package graphql builds GraphQL operations and decodes their responses. It is
+// the runtime of the query builders generated by cmd/bcgen, which wrap a
+// Selection in types mirroring the schema, but can also be used directly.
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Operation is a GraphQL query or mutation. Field arguments are sent as
+// variables, so the query text does not depend on their values.
+type Operation struct {
+	kind string
+	root *Selection
+	vars []variable
+}
+
+type variable struct {
+	name  string
+	typ   string
+	value interface{}
+}
+
+// Selection is the set of fields selected from an object
+type Selection struct {
+	op     *Operation
+	fields []*field
+}
+
+type field struct {
+	name string // The field name, or "... on Type" for inline fragments.
+	args []string
+	sub  *Selection
+}
+
+// Arg is an argument of a field
+type Arg struct {
+	Name  string      // The argument's name.
+	Type  string      // The argument's GraphQL type, e.g. "[ID!]!".
+	Value interface{} // The argument's value. Arguments with nil values are omitted.
+}
+
+// NewQuery returns an empty query and the selection of its root fields
+func NewQuery() (*Operation, *Selection) {
+	return newOperation("query")
+}
+
+// NewMutation returns an empty mutation and the selection of its root fields
+func NewMutation() (*Operation, *Selection) {
+	return newOperation("mutation")
+}
+
+func newOperation(kind string) (*Operation, *Selection) {
+	op := &Operation{kind: kind}
+	op.root = &Selection{op: op}
+	return op, op.root
+}
+
+// Field selects a field of a scalar or enum type
+func (s *Selection) Field(name string, args ...Arg) {
+	s.add(name, args, nil)
+}
+
+// Object selects a field of an object, interface or union type, with the
+// fields selected from it by fn
+func (s *Selection) Object(name string, args []Arg, fn func(*Selection)) {
+	sub := &Selection{op: s.op}
+	fn(sub)
+	s.add(name, args, sub)
+}
+
+// On selects fields only present when the object is of the named type
+func (s *Selection) On(typeName string, fn func(*Selection)) {
+	sub := &Selection{op: s.op}
+	fn(sub)
+	s.fields = append(s.fields, &field{name: "... on " + typeName, sub: sub})
+}
+
+func (s *Selection) add(name string, args []Arg, sub *Selection) {
+	f := &field{name: name, sub: sub}
+	for _, a := range args {
+		if isNil(a.Value) {
+			continue
+		}
+		v := variable{name: fmt.Sprintf("v%d", len(s.op.vars)+1), typ: a.Type, value: a.Value}
+		s.op.vars = append(s.op.vars, v)
+		f.args = append(f.args, a.Name+": $"+v.name)
+	}
+	s.fields = append(s.fields, f)
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// Query returns the operation's text
+func (op *Operation) Query() string {
+	var b strings.Builder
+	b.WriteString(op.kind)
+	if len(op.vars) > 0 {
+		defs := make([]string, len(op.vars))
+		for i, v := range op.vars {
+			defs[i] = "$" + v.name + ": " + v.typ
+		}
+		b.WriteString("(" + strings.Join(defs, ", ") + ")")
+	}
+	b.WriteString(" ")
+	op.root.write(&b)
+	return b.String()
+}
+
+func (s *Selection) write(b *strings.Builder) {
+	b.WriteString("{")
+	for i, f := range s.fields {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(f.name)
+		if len(f.args) > 0 {
+			b.WriteString("(" + strings.Join(f.args, ", ") + ")")
+		}
+		if f.sub != nil {
+			b.WriteString(" ")
+			f.sub.write(b)
+		}
+	}
+	b.WriteString("}")
+}
+
+// Variables returns the values of the operation's variables
+func (op *Operation) Variables() map[string]interface{} {
+	vars := make(map[string]interface{}, len(op.vars))
+	for _, v := range op.vars {
+		vars[v.name] = v.value
+	}
+	return vars
+}
+
+// MarshalJSON encodes the operation as a GraphQL request body
+func (op *Operation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{op.Query(), op.Variables()})
+}
+
+// Error describes an error reported in a GraphQL response
+type Error struct {
+	Message   string        `json:"message"`
+	Path      []interface{} `json:"path,omitempty"` // The field that failed, as names and list indexes.
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"` // Server-specific details, e.g. an error code.
+}
+
+// Errors lists the errors reported in a GraphQL response
+type Errors []*Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+		if len(err.Path) > 0 {
+			path := make([]string, len(err.Path))
+			for j, p := range err.Path {
+				path[j] = fmt.Sprint(p)
+			}
+			msgs[i] = strings.Join(path, ".") + ": " + err.Message
+		}
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// Response is a GraphQL response body
+type Response struct {
+	Data       json.RawMessage            `json:"data"`
+	Errors     Errors                     `json:"errors,omitempty"`
+	Extensions map[string]json.RawMessage `json:"extensions,omitempty"` // Server-specific details, e.g. the query's cost.
+}
+
+// Decode decodes the data of a GraphQL response body into v. If the response
+// reports errors, they are returned as Errors after decoding whatever data
+// was returned alongside them.
+func Decode(body []byte, v interface{}) error {
+	_, err := DecodeResponse(body, v)
+	return err
+}
+
+// DecodeResponse is like Decode, also returning the response so its
+// extensions can be inspected
+func DecodeResponse(body []byte, v interface{}) (*Response, error) {
+	resp := new(Response)
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if err := json.Unmarshal(resp.Data, v); err != nil {
+			return resp, err
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return resp, resp.Errors
+	}
+	return resp, nil
+}
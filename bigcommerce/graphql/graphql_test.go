@@ -0,0 +1,85 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOperation(t *testing.T) {
+	op, root := NewQuery()
+	var after *string
+	root.Object("site", nil, func(site *Selection) {
+		site.Object("product", []Arg{{Name: "entityId", Type: "Int", Value: 42}, {Name: "sku", Type: "String", Value: after}}, func(p *Selection) {
+			p.Field("name")
+			p.Object("prices", nil, func(prices *Selection) {
+				prices.Object("price", nil, func(m *Selection) {
+					m.Field("value")
+					m.Field("currencyCode")
+				})
+			})
+		})
+		site.Object("route", []Arg{{Name: "path", Type: "String!", Value: "/shirts"}}, func(r *Selection) {
+			r.Object("node", nil, func(n *Selection) {
+				n.Field("__typename")
+				n.On("Category", func(c *Selection) { c.Field("entityId") })
+			})
+		})
+	})
+
+	want := `query($v1: Int, $v2: String!) {site {product(entityId: $v1) {name prices {price {value currencyCode}}} route(path: $v2) {node {__typename ... on Category {entityId}}}}}`
+	if got := op.Query(); got != want {
+		t.Errorf("Query =\n%s\nwant\n%s", got, want)
+	}
+	if vars := op.Variables(); !reflect.DeepEqual(vars, map[string]interface{}{"v1": 42, "v2": "/shirts"}) {
+		t.Errorf("Variables = %v", vars)
+	}
+
+	body, err := json.Marshal(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req map[string]interface{}
+	json.Unmarshal(body, &req)
+	if req["query"] != want || req["variables"].(map[string]interface{})["v2"] != "/shirts" {
+		t.Errorf("Request body = %s", body)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var data struct {
+		Site struct {
+			Product *struct {
+				Name string `json:"name"`
+			} `json:"product"`
+			Route *struct{} `json:"route"`
+		} `json:"site"`
+	}
+
+	err := Decode([]byte(`{"data":{"site":{"product":{"name":"Shirt"},"route":null}},
+		"errors":[{"message":"Route not found","path":["site","route"],"locations":[{"line":1,"column":20}]}]}`), &data)
+	var gqlErrs Errors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) != 1 || err.Error() != "graphql: site.route: Route not found" {
+		t.Errorf("Decode error = %v", err)
+	}
+	if data.Site.Product == nil || data.Site.Product.Name != "Shirt" {
+		t.Errorf("Decoded %+v despite errors", data)
+	}
+
+	if err := Decode([]byte(`{"data":{"site":{}}}`), &data); err != nil {
+		t.Errorf("Decode = %v", err)
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	var data struct{}
+	resp, err := DecodeResponse([]byte(`{"data":{},"errors":[{"message":"Too complex","extensions":{"code":"COMPLEXITY"}}],
+		"extensions":{"cost":{"requested":12}}}`), &data)
+	if err == nil || resp.Errors[0].Extensions["code"] != "COMPLEXITY" {
+		t.Errorf("DecodeResponse = %+v, %v", resp, err)
+	}
+	if string(resp.Extensions["cost"]) != `{"requested":12}` {
+		t.Errorf("Extensions = %s", resp.Extensions["cost"])
+	}
+}
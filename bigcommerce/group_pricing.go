@@ -0,0 +1,184 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// GroupPriceResolver computes the price a customer group pays for a SKU, for
+// quotes and feeds that must match the storefront
+type GroupPriceResolver struct {
+	Client   *Client
+	Currency string // The price list currency, "usd" if empty.
+}
+
+// GroupPrice is the effective price of a SKU for a customer group
+type GroupPrice struct {
+	SKU             string
+	ProductID       int64
+	VariantID       int64
+	CustomerGroupID int64
+	Quantity        int64
+	CatalogPrice    float64  // The variant's price, or the product's if the variant has none.
+	Price           float64  // The unit price paid, rounded to cents.
+	Total           float64  // Price times Quantity.
+	Adjustments     []string // The adjustments applied, in order, e.g. "sale price".
+}
+
+// Resolve returns the unit price of quantity items of a SKU for a customer
+// group, 0 for guests. Adjustments are applied the way the storefront does:
+//
+//   - the sale price, if lower than the price;
+//   - the group's price list record for the variant, replacing the catalog price;
+//   - the group's most specific discount rule, for the product, one of its
+//     categories (the best one for the customer) or the whole store;
+//   - the product's bulk pricing rule for the quantity.
+func (r *GroupPriceResolver) Resolve(ctx context.Context, sku string, groupID, quantity int64) (*GroupPrice, error) {
+	variants, _, err := r.Client.Variants.ListCatalog(ctx, &VariantListOptions{SKU: sku})
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("bigcommerce: no variant with SKU %q", sku)
+	}
+	variant := variants[0]
+	products, _, err := r.Client.Catalog.ListProducts(ctx, &CatalogProductListOptions{
+		IDs:           []int64{variant.ProductID},
+		Include:       []string{"bulk_pricing_rules"},
+		IncludeFields: []string{"price", "sale_price", "categories"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, fmt.Errorf("bigcommerce: product %d of SKU %q not found", variant.ProductID, sku)
+	}
+	product := products[0]
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	p := &GroupPrice{SKU: sku, ProductID: product.ID, VariantID: variant.ID, CustomerGroupID: groupID, Quantity: quantity}
+	p.CatalogPrice, p.Price = product.Price, product.Price
+	if variant.Price != nil {
+		p.CatalogPrice, p.Price = *variant.Price, *variant.Price
+	}
+	sale := product.SalePrice
+	if variant.SalePrice != nil {
+		sale = *variant.SalePrice
+	}
+	if sale > 0 && sale < p.Price {
+		p.adjust("sale price", sale)
+	}
+
+	if groupID != 0 {
+		group, _, err := r.Client.CustomerGroups.Get(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.applyGroup(ctx, p, group, product); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rule := range product.BulkPricingRules {
+		if quantity >= rule.QuantityMin && (rule.QuantityMax == 0 || quantity <= rule.QuantityMax) {
+			p.adjust(fmt.Sprintf("bulk pricing from %d", rule.QuantityMin), applyBulkPricing(p.Price, rule))
+			break
+		}
+	}
+
+	p.Price = math.Round(p.Price*100) / 100
+	p.Total = math.Round(p.Price*float64(quantity)*100) / 100
+	return p, nil
+}
+
+// applyGroup applies the price list and most specific discount rule of a group
+func (r *GroupPriceResolver) applyGroup(ctx context.Context, p *GroupPrice, group *CustomerGroup, product *CatalogProduct) error {
+	var productRule, storeRule *GroupDiscountRule
+	var categoryRules []*GroupDiscountRule
+	for _, rule := range group.DiscountRules {
+		switch {
+		case rule.Type == PriceListDiscount:
+			if err := r.applyPriceList(ctx, p, rule.PriceListID); err != nil {
+				return err
+			}
+		case rule.Type == ProductDiscount && rule.ProductID == product.ID:
+			productRule = rule
+		case rule.Type == CategoryDiscount && hasInt64(product.Categories, rule.CategoryID):
+			categoryRules = append(categoryRules, rule)
+		case rule.Type == StoreDiscount:
+			storeRule = rule
+		}
+	}
+
+	switch {
+	case productRule != nil:
+		p.adjust(fmt.Sprintf("group product discount %s %s", productRule.Method, productRule.Amount), applyGroupDiscount(p.Price, productRule))
+	case len(categoryRules) > 0:
+		best, price := categoryRules[0], applyGroupDiscount(p.Price, categoryRules[0])
+		for _, rule := range categoryRules[1:] {
+			if v := applyGroupDiscount(p.Price, rule); v < price {
+				best, price = rule, v
+			}
+		}
+		p.adjust(fmt.Sprintf("group category %d discount %s %s", best.CategoryID, best.Method, best.Amount), price)
+	case storeRule != nil:
+		p.adjust(fmt.Sprintf("group store discount %s %s", storeRule.Method, storeRule.Amount), applyGroupDiscount(p.Price, storeRule))
+	}
+	return nil
+}
+
+// applyPriceList replaces the price with the variant's price list record, if
+// it has one
+func (r *GroupPriceResolver) applyPriceList(ctx context.Context, p *GroupPrice, priceListID int64) error {
+	currency := r.Currency
+	if currency == "" {
+		currency = "usd"
+	}
+	records, _, err := r.Client.PriceLists.ListRecords(ctx, priceListID, &PriceRecordListOptions{VariantIDs: []int64{p.VariantID}, Currency: currency})
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	price := records[0].Price
+	if sale := records[0].SalePrice; sale != nil && *sale > 0 && *sale < price {
+		price = *sale
+	}
+	p.adjust(fmt.Sprintf("price list %d", priceListID), price)
+	return nil
+}
+
+func (p *GroupPrice) adjust(description string, price float64) {
+	p.Price = math.Max(price, 0)
+	p.Adjustments = append(p.Adjustments, description)
+}
+
+func applyGroupDiscount(price float64, rule *GroupDiscountRule) float64 {
+	amount, _ := strconv.ParseFloat(rule.Amount, 64)
+	switch rule.Method {
+	case PercentDiscount:
+		return price * (1 - amount/100)
+	case FixedDiscount:
+		return price - amount
+	case PriceDiscount:
+		return amount
+	}
+	return price
+}
+
+func applyBulkPricing(price float64, rule *BulkPricingRule) float64 {
+	switch rule.Type {
+	case PriceOffBulkPricing:
+		return price - rule.Amount
+	case PercentBulkPricing:
+		return price * (1 - rule.Amount/100)
+	case FixedBulkPricing:
+		return rule.Amount
+	}
+	return price
+}
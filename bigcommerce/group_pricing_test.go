@@ -0,0 +1,84 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func groupPricingSetup(t *testing.T, mux *http.ServeMux, group string) {
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"sku": "SHIRT-M"})
+		fmt.Fprint(w, `{"data":[{"id":11,"product_id":1,"sku":"SHIRT-M","price":null,"sale_price":18}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"id:in": "1", "include": "bulk_pricing_rules"})
+		fmt.Fprint(w, `{"data":[{"id":1,"price":20,"categories":[18,23],"bulk_pricing_rules":[
+			{"quantity_min":10,"quantity_max":49,"type":"percent","amount":10},
+			{"quantity_min":50,"type":"fixed","amount":9}]}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/customer_groups/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, group)
+	})
+	mux.HandleFunc("/stores/abc123/v3/pricelists/5/records", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"variant_id:in": "11", "currency": "usd"})
+		fmt.Fprint(w, `{"data":[{"variant_id":11,"currency":"usd","price":16}],"meta":{}}`)
+	})
+}
+
+func TestGroupPriceResolver_Resolve(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	groupPricingSetup(t, mux, `{"id":3,"discount_rules":[
+		{"type":"all","method":"percent","amount":"50.0000"},
+		{"type":"category","category_id":18,"method":"fixed","amount":"1.0000"},
+		{"type":"category","category_id":23,"method":"percent","amount":"25.0000"},
+		{"type":"category","category_id":99,"method":"price","amount":"1.0000"}]}`)
+
+	resolver := &GroupPriceResolver{Client: client}
+	got, err := resolver.Resolve(context.Background(), "SHIRT-M", 3, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &GroupPrice{
+		SKU: "SHIRT-M", ProductID: 1, VariantID: 11, CustomerGroupID: 3, Quantity: 10,
+		CatalogPrice: 20,
+		Price:        12.15, // 18 sale, 25% category discount, 10% bulk.
+		Total:        121.5,
+		Adjustments:  []string{"sale price", "group category 23 discount percent 25.0000", "bulk pricing from 10"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve returned %+v, want %+v", got, want)
+	}
+}
+
+func TestGroupPriceResolver_Resolve_priceList(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	groupPricingSetup(t, mux, `{"id":3,"discount_rules":[
+		{"type":"price_list","price_list_id":5},
+		{"type":"product","product_id":1,"method":"price","amount":"15.0000"},
+		{"type":"all","method":"percent","amount":"50.0000"}]}`)
+
+	resolver := &GroupPriceResolver{Client: client}
+	got, err := resolver.Resolve(context.Background(), "SHIRT-M", 3, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Price != 9 || len(got.Adjustments) != 4 || got.Adjustments[1] != "price list 5" || got.Adjustments[2] != "group product discount price 15.0000" {
+		t.Errorf("Resolve returned %+v", got)
+	}
+}
+
+func TestGroupPriceResolver_Resolve_guest(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	groupPricingSetup(t, mux, `{}`)
+
+	got, err := (&GroupPriceResolver{Client: client}).Resolve(context.Background(), "SHIRT-M", 0, 1)
+	if err != nil || got.Price != 18 || got.Total != 18 {
+		t.Errorf("Resolve returned %+v, %v", got, err)
+	}
+}
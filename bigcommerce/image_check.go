@@ -0,0 +1,193 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// ImageChecker verifies that product images can be fetched from the CDN, a
+// frequent quality check after migrating a catalog
+type ImageChecker struct {
+	Client      *Client      // Used to list the catalog's products and images.
+	HTTPClient  *http.Client // Used to fetch images, http.DefaultClient if nil.
+	Concurrency int          // Number of images checked at once, 8 if zero.
+
+	// Limiter throttles requests to the CDN. It is independent of the client's
+	// API rate limiter; nil means no throttling.
+	Limiter *RateLimiter
+}
+
+// ImageCheckReport lists the products with broken or missing images
+type ImageCheckReport struct {
+	Checked  int                   // Number of images checked.
+	Products []*ProductImageReport // Products with problems, ordered by ID.
+}
+
+// ProductImageReport describes the image problems of a product
+type ProductImageReport struct {
+	ProductID int64
+	Name      string
+	NoImages  bool           // The product has no images at all.
+	Broken    []*BrokenImage // Images that could not be fetched.
+}
+
+// BrokenImage describes an image that could not be fetched
+type BrokenImage struct {
+	ImageID    int64
+	URL        string
+	StatusCode int    // The CDN's response status, zero if the request failed.
+	Err        string // The request error, if any.
+}
+
+type imageJob struct {
+	product *CatalogProduct
+	image   *CatalogImage
+}
+
+// CheckCatalog checks the images of every product in the store
+func (c *ImageChecker) CheckCatalog(ctx context.Context) (*ImageCheckReport, error) {
+	var products []*CatalogProduct
+	opts := &CatalogProductListOptions{
+		ListOptions:   ListOptions{Page: 1, Limit: 250},
+		Include:       []string{"images"},
+		IncludeFields: []string{"name"},
+	}
+	for {
+		page, resp, err := c.Client.Catalog.ListProducts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, page...)
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+	return c.Check(ctx, products)
+}
+
+// Check checks the images of the given products, which must include their Images
+func (c *ImageChecker) Check(ctx context.Context, products []*CatalogProduct) (*ImageCheckReport, error) {
+	if c.Limiter != nil {
+		c.Limiter.init("cdn")
+	}
+	workers := c.Concurrency
+	if workers < 1 {
+		workers = 8
+	}
+
+	var (
+		mu       sync.Mutex
+		report   = new(ImageCheckReport)
+		problems = map[int64]*ProductImageReport{}
+		jobs     = make(chan imageJob)
+		wg       sync.WaitGroup
+	)
+	problem := func(p *CatalogProduct) *ProductImageReport {
+		r, ok := problems[p.ID]
+		if !ok {
+			r = &ProductImageReport{ProductID: p.ID, Name: p.Name}
+			problems[p.ID] = r
+		}
+		return r
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				broken := c.checkImage(ctx, job.image)
+				mu.Lock()
+				report.Checked++
+				if broken != nil {
+					r := problem(job.product)
+					r.Broken = append(r.Broken, broken)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, p := range products {
+		if len(p.Images) == 0 {
+			mu.Lock()
+			problem(p).NoImages = true
+			mu.Unlock()
+			continue
+		}
+		for _, image := range p.Images {
+			select {
+			case jobs <- imageJob{p, image}:
+			case <-ctx.Done():
+				break feed
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, r := range problems {
+		sort.Slice(r.Broken, func(i, j int) bool { return r.Broken[i].ImageID < r.Broken[j].ImageID })
+		report.Products = append(report.Products, r)
+	}
+	sort.Slice(report.Products, func(i, j int) bool { return report.Products[i].ProductID < report.Products[j].ProductID })
+	return report, nil
+}
+
+// checkImage fetches the largest URL of an image, returning nil if it exists.
+// CDNs that reject HEAD are retried with a single-byte ranged GET.
+func (c *ImageChecker) checkImage(ctx context.Context, image *CatalogImage) *BrokenImage {
+	urls := image.URLs()
+	if len(urls) == 0 {
+		return &BrokenImage{ImageID: image.ID, Err: "image has no URL"}
+	}
+	broken := &BrokenImage{ImageID: image.ID, URL: urls[0]}
+
+	status, err := c.fetch(ctx, "HEAD", broken.URL)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusForbidden) {
+		status, err = c.fetch(ctx, "GET", broken.URL)
+	}
+	if err != nil {
+		broken.Err = err.Error()
+		return broken
+	}
+	if status >= 200 && status <= 299 {
+		return nil
+	}
+	broken.StatusCode = status
+	return broken
+}
+
+func (c *ImageChecker) fetch(ctx context.Context, method, url string) (int, error) {
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return 0, err
+		}
+	}
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	if method == "GET" {
+		req.Header.Set("Range", "bytes=0-0")
+	}
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
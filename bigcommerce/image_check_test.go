@@ -0,0 +1,61 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestImageChecker_Check(t *testing.T) {
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok.jpg":
+		case "/no-head.jpg":
+			if r.Method == "HEAD" {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			} else if r.Header.Get("Range") != "bytes=0-0" {
+				t.Errorf("Expected a ranged GET, got Range %q", r.Header.Get("Range"))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer cdn.Close()
+
+	products := []*CatalogProduct{
+		{ID: 2, Name: "Shirt", Images: []*CatalogImage{
+			{ID: 11, URLZoom: cdn.URL + "/missing.jpg"},
+			{ID: 10, URLStandard: cdn.URL + "/ok.jpg"},
+		}},
+		{ID: 1, Name: "Mug", Images: []*CatalogImage{{ID: 12, URLZoom: cdn.URL + "/no-head.jpg"}}},
+		{ID: 3, Name: "Sofa"},
+	}
+	checker := &ImageChecker{Concurrency: 2, Limiter: &RateLimiter{Rate: 1000, Burst: 10}}
+	report, err := checker.Check(context.Background(), products)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Checked != 3 || len(report.Products) != 2 {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+	shirt, sofa := report.Products[0], report.Products[1]
+	if shirt.ProductID != 2 || len(shirt.Broken) != 1 || shirt.Broken[0].ImageID != 11 || shirt.Broken[0].StatusCode != 404 {
+		t.Errorf("Unexpected shirt report %+v", shirt)
+	}
+	if sofa.ProductID != 3 || !sofa.NoImages {
+		t.Errorf("Unexpected sofa report %+v", sofa)
+	}
+}
+
+func TestImageChecker_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	checker := &ImageChecker{}
+	_, err := checker.Check(ctx, []*CatalogProduct{{ID: 1, Images: []*CatalogImage{{ID: 1, URLZoom: "http://127.0.0.1:1/a.jpg"}}}})
+	if err != context.Canceled {
+		t.Errorf("Check returned %v, want context.Canceled", err)
+	}
+}
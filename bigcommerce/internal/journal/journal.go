@@ -0,0 +1,41 @@
+// Package journal records the changes of operations spanning several API
+// calls, which the API cannot make atomically, so they can be undone when a
+// later call fails
+package journal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RollbackTimeout bounds how long undoing changes may take. Rollbacks do not
+// use the caller's context, which may be what failed.
+const RollbackTimeout = 30 * time.Second
+
+// Journal records the changes made so far, and how to undo them
+type Journal struct {
+	steps []string
+	undos []func(context.Context) error
+}
+
+// Done records a change made by step, undone by undo
+func (j *Journal) Done(step string, undo func(context.Context) error) {
+	j.steps = append(j.steps, step)
+	j.undos = append(j.undos, undo)
+}
+
+// Rollback undoes the changes made so far, most recent first, and returns the
+// changes that could not be undone
+func (j *Journal) Rollback() []error {
+	ctx, cancel := context.WithTimeout(context.Background(), RollbackTimeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(j.undos) - 1; i >= 0; i-- {
+		if err := j.undos[i](ctx); err != nil {
+			errs = append(errs, fmt.Errorf("undo %s: %v", j.steps[i], err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,34 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestJournal_Rollback(t *testing.T) {
+	var undone []string
+	j := new(Journal)
+	for _, step := range []string{"a", "b", "c"} {
+		step := step
+		j.Done(step, func(ctx context.Context) error {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Errorf("undo %s has no deadline", step)
+			}
+			undone = append(undone, step)
+			if step == "b" {
+				return errors.New("failed")
+			}
+			return nil
+		})
+	}
+
+	errs := j.Rollback()
+	if want := []string{"c", "b", "a"}; !reflect.DeepEqual(undone, want) {
+		t.Errorf("undone %v, want %v", undone, want)
+	}
+	if len(errs) != 1 || errs[0].Error() != "undo b: failed" {
+		t.Errorf("Rollback returned %v", errs)
+	}
+}
@@ -0,0 +1,63 @@
+// Package jsondiff compares desired resources with those in a store, for code
+// applying declarative configuration
+package jsondiff
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Differs reports whether any field set in desired has a different value in
+// current. Both are compared through their JSON encodings, so fields omitted
+// from desired are left alone, at every level of nesting. Server-assigned ids
+// and uuids are ignored at every level too, e.g. those of zone locations.
+func Differs(desired, current interface{}) bool {
+	want, err1 := toMap(desired)
+	have, err2 := toMap(current)
+	if err1 != nil || err2 != nil {
+		return true
+	}
+	return valueDiffers(want, have)
+}
+
+func valueDiffers(want, have interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		h, ok := have.(map[string]interface{})
+		if !ok {
+			return true
+		}
+		for k, v := range w {
+			switch k {
+			case "id", "uuid":
+				continue
+			}
+			if valueDiffers(v, h[k]) {
+				return true
+			}
+		}
+		return false
+	case []interface{}:
+		h, ok := have.([]interface{})
+		if !ok || len(w) != len(h) {
+			return true
+		}
+		for i := range w {
+			if valueDiffers(w[i], h[i]) {
+				return true
+			}
+		}
+		return false
+	}
+	return !reflect.DeepEqual(want, have)
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
@@ -0,0 +1,36 @@
+package jsondiff
+
+import "testing"
+
+type location struct {
+	ID      int64  `json:"id,omitempty"`
+	Country string `json:"country_iso2,omitempty"`
+}
+
+type zone struct {
+	ID        int64      `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Enabled   *bool      `json:"enabled,omitempty"`
+	Locations []location `json:"locations,omitempty"`
+}
+
+func TestDiffers(t *testing.T) {
+	desired := &zone{Name: "US", Locations: []location{{Country: "US"}}}
+	current := &zone{ID: 3, Name: "US", Locations: []location{{ID: 9, Country: "US"}}}
+	if Differs(desired, current) {
+		t.Error("Differs reported a zone differing only by server ids")
+	}
+	enabled := true
+	current.Enabled = &enabled
+	if Differs(desired, current) {
+		t.Error("Differs reported a field omitted from desired")
+	}
+	current.Locations[0].Country = "CA"
+	if !Differs(desired, current) {
+		t.Error("Differs missed a changed location")
+	}
+	current.Locations = []location{{ID: 9, Country: "US"}, {ID: 10, Country: "US"}}
+	if !Differs(desired, current) {
+		t.Error("Differs missed an extra location")
+	}
+}
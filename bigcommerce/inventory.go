@@ -0,0 +1,164 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// InventoryService handles communication with the V3 multi-location inventory endpoints
+type InventoryService service
+
+// Location describes a BigCommerce V3 Inventory Location Object
+type Location struct {
+	ID                      int64    `json:"id,omitempty"`                         // The unique numerical ID of the location.
+	Code                    string   `json:"code,omitempty"`                       // The merchant's unique code for the location.
+	Label                   string   `json:"label,omitempty"`                      // The location's display name.
+	Description             string   `json:"description,omitempty"`                // Description of the location.
+	ManagedByExternalSource bool     `json:"managed_by_external_source,omitempty"` // Whether inventory is managed by another system.
+	TypeID                  string   `json:"type_id,omitempty"`                    // One of PHYSICAL or VIRTUAL.
+	Enabled                 bool     `json:"enabled"`                              // Whether the location is in use.
+	StorefrontVisibility    bool     `json:"storefront_visibility,omitempty"`      // Whether the location is shown on the storefront.
+	TimeZone                string   `json:"time_zone,omitempty"`                  // The location's IANA time zone.
+	Address                 *Address `json:"address,omitempty"`                    // The location's address.
+}
+
+// MarshalJSON encodes the location with the address in the naming of the
+// inventory API, which like V2 uses state and zip
+func (l Location) MarshalJSON() ([]byte, error) {
+	type location Location
+	return json.Marshal(&struct {
+		*location
+		Address *locationAddress `json:"address,omitempty"`
+	}{(*location)(&l), (*locationAddress)(l.Address)})
+}
+
+// locationAddress is an Address in the naming of inventory locations
+type locationAddress Address
+
+// MarshalJSON encodes the address with inventory location field names
+func (a *locationAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Address1       string          `json:"address1,omitempty"`
+		Address2       string          `json:"address2,omitempty"`
+		City           string          `json:"city,omitempty"`
+		State          string          `json:"state,omitempty"`
+		Zip            string          `json:"zip,omitempty"`
+		CountryCode    string          `json:"country_code,omitempty"`
+		Email          string          `json:"email,omitempty"`
+		Phone          string          `json:"phone,omitempty"`
+		GeoCoordinates *GeoCoordinates `json:"geo_coordinates,omitempty"`
+	}{
+		a.Address1, a.Address2, a.City, a.StateOrProvince, a.PostalCode, a.CountryCode, a.Email, a.Phone, a.GeoCoordinates,
+	})
+}
+
+// GeoCoordinates describes a latitude and longitude
+type GeoCoordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// InventoryItem describes the stock of a product or variant across locations
+type InventoryItem struct {
+	Identity  InventoryIdentity   `json:"identity"`  // The product or variant.
+	Locations []InventoryLocation `json:"locations"` // Stock at each location.
+}
+
+// InventoryIdentity identifies the product or variant of an InventoryItem
+type InventoryIdentity struct {
+	SKU       string `json:"sku,omitempty"`
+	VariantID int64  `json:"variant_id,omitempty"`
+	ProductID int64  `json:"product_id,omitempty"`
+	SKUID     int64  `json:"sku_id,omitempty"`
+}
+
+// InventoryLocation describes the stock of an item at a location
+type InventoryLocation struct {
+	LocationID           int64  `json:"location_id"`
+	LocationCode         string `json:"location_code,omitempty"`
+	LocationName         string `json:"location_name,omitempty"`
+	LocationEnabled      bool   `json:"location_enabled"`
+	AvailableToSell      int64  `json:"available_to_sell"`      // On-hand stock less safety stock and reservations.
+	TotalInventoryOnhand int64  `json:"total_inventory_onhand"` // Units physically at the location.
+}
+
+// LocationListOptions specifies the optional parameters to InventoryService.ListLocations
+type LocationListOptions struct {
+	ListOptions
+	IDs     []int64  `url:"location_id:in,omitempty"`
+	Codes   []string `url:"location_code:in,omitempty"`
+	Enabled *bool    `url:"is_active,omitempty"`
+}
+
+// InventoryItemListOptions specifies the optional parameters to InventoryService.ListItems
+type InventoryItemListOptions struct {
+	ListOptions
+	SKUs        []string `url:"sku:in,omitempty"`
+	VariantIDs  []int64  `url:"variant_id:in,omitempty"`
+	ProductIDs  []int64  `url:"product_id:in,omitempty"`
+	LocationIDs []int64  `url:"location_id:in,omitempty"`
+}
+
+// ListLocations returns the store's inventory locations
+func (s *InventoryService) ListLocations(ctx context.Context, opts *LocationListOptions) ([]*Location, *Response, error) {
+	path, err := addOptions("v3/inventory/locations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locations []*Location
+	resp, err := s.client.call(ctx, "GET", path, nil, &locations)
+	if err != nil {
+		return nil, resp, err
+	}
+	return locations, resp, nil
+}
+
+// ListItems returns a page of inventory items with their stock at each location
+func (s *InventoryService) ListItems(ctx context.Context, opts *InventoryItemListOptions) ([]*InventoryItem, *Response, error) {
+	path, err := addOptions("v3/inventory/items", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []*InventoryItem
+	resp, err := s.client.call(ctx, "GET", path, nil, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+	return items, resp, nil
+}
+
+// InventoryAdjustment describes a change to the stock of items at locations
+type InventoryAdjustment struct {
+	Reason string                     `json:"reason,omitempty"` // Shown in the store's inventory history.
+	Items  []*InventoryAdjustmentItem `json:"items"`
+}
+
+// InventoryAdjustmentItem is the change to the stock of one item at one
+// location. The item is identified by one of SKU, VariantID or ProductID.
+type InventoryAdjustmentItem struct {
+	LocationID int64  `json:"location_id"`
+	SKU        string `json:"sku,omitempty"`
+	VariantID  int64  `json:"variant_id,omitempty"`
+	ProductID  int64  `json:"product_id,omitempty"`
+	Quantity   int64  `json:"quantity"` // The units to add, negative to remove.
+}
+
+// AdjustRelative adds to or removes from the on-hand stock of items and
+// returns the ID of the inventory transaction
+func (s *InventoryService) AdjustRelative(ctx context.Context, adjustment *InventoryAdjustment) (string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "v3/inventory/adjustments/relative", adjustment)
+	if err != nil {
+		return "", nil, err
+	}
+	// The response is not wrapped in a data envelope.
+	var result struct {
+		TransactionID string `json:"transaction_id"`
+	}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return "", resp, err
+	}
+	return result.TransactionID, resp, nil
+}
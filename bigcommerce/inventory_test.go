@@ -0,0 +1,98 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInventoryService_ListLocations(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/inventory/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"location_id:in": "1,2"})
+		fmt.Fprint(w, `{"data":[{"id":1,"label":"Warehouse","enabled":true,"address":{"city":"Austin","country_code":"US","geo_coordinates":{"latitude":30.27,"longitude":-97.74}}}],"meta":{}}`)
+	})
+
+	locations, _, err := client.Inventory.ListLocations(context.Background(), &LocationListOptions{IDs: []int64{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Location{{ID: 1, Label: "Warehouse", Enabled: true, Address: &Address{
+		City: "Austin", CountryCode: "US", GeoCoordinates: &GeoCoordinates{Latitude: 30.27, Longitude: -97.74},
+	}}}
+	if !reflect.DeepEqual(locations, want) {
+		t.Errorf("ListLocations = %+v, want %+v", locations, want)
+	}
+}
+
+func TestLocation_MarshalJSON(t *testing.T) {
+	location := &Location{ID: 1, Label: "Warehouse", Address: &Address{
+		Address1: "1 Main St", City: "Austin", StateOrProvince: "Texas", PostalCode: "78701", CountryCode: "US",
+	}}
+	data, err := json.Marshal(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"address1": "1 Main St", "city": "Austin", "state": "Texas", "zip": "78701", "country_code": "US"}
+	if !reflect.DeepEqual(got["address"], want) {
+		t.Errorf("address = %v, want %v", got["address"], want)
+	}
+
+	var decoded Location
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(&decoded, location) {
+		t.Errorf("round trip = %+v, %v", decoded, err)
+	}
+}
+
+func TestInventoryService_ListItems(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/inventory/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"sku:in": "A,B", "location_id:in": "1"})
+		fmt.Fprint(w, `{"data":[{"identity":{"sku":"A","product_id":5},"locations":[{"location_id":1,"location_enabled":true,"available_to_sell":3,"total_inventory_onhand":4}]}],"meta":{}}`)
+	})
+
+	items, _, err := client.Inventory.ListItems(context.Background(), &InventoryItemListOptions{SKUs: []string{"A", "B"}, LocationIDs: []int64{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*InventoryItem{{
+		Identity:  InventoryIdentity{SKU: "A", ProductID: 5},
+		Locations: []InventoryLocation{{LocationID: 1, LocationEnabled: true, AvailableToSell: 3, TotalInventoryOnhand: 4}},
+	}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("ListItems = %+v, want %+v", items, want)
+	}
+}
+
+func TestInventoryService_AdjustRelative(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	adjustment := &InventoryAdjustment{
+		Reason: "Stock count",
+		Items:  []*InventoryAdjustmentItem{{LocationID: 1, VariantID: 100, Quantity: -2}},
+	}
+	mux.HandleFunc("/stores/abc123/v3/inventory/adjustments/relative", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(InventoryAdjustment), adjustment)
+		fmt.Fprint(w, `{"transaction_id":"tx-1"}`)
+	})
+
+	id, _, err := client.Inventory.AdjustRelative(context.Background(), adjustment)
+	if err != nil || id != "tx-1" {
+		t.Errorf("AdjustRelative returned %q, %v", id, err)
+	}
+}
@@ -0,0 +1,140 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/journal"
+)
+
+var (
+	// ErrInsufficientStock is returned when a location has fewer units on
+	// hand than a transfer moves
+	ErrInsufficientStock = errors.New("bigcommerce: insufficient stock")
+	// ErrTransferUnverified is returned when the stock read back after a
+	// transfer differs from the expected levels, e.g. because of concurrent
+	// orders
+	ErrTransferUnverified = errors.New("bigcommerce: transfer not verified")
+	// ErrTransferIncomplete is returned when a transfer failed half way and
+	// the stock removed from the source location could not be restored, or
+	// when it is unknown whether the source or the destination was adjusted
+	ErrTransferIncomplete = errors.New("bigcommerce: transfer incomplete")
+)
+
+// InventoryTransfer is the audit record of a transfer between locations
+type InventoryTransfer struct {
+	SKU            string
+	FromLocationID int64
+	ToLocationID   int64
+	Quantity       int64
+	Reason         string // The reason of the adjustments, in the store's inventory history.
+
+	FromBefore, FromAfter int64 // On-hand stock at the source, before and after.
+	ToBefore, ToAfter     int64 // On-hand stock at the destination, before and after.
+
+	TransactionIDs []string // The inventory transactions, in order, including any rollback.
+	RolledBack     bool     // Whether the removal from the source was reverted.
+	Verified       bool     // Whether the stock read back matched the expected levels.
+
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Transfer moves qty units of a SKU from one location to another with two
+// relative adjustments: removing them from the source, then adding them to the
+// destination. If the API refuses the addition the removal is reverted; if
+// either adjustment fails otherwise, ErrTransferIncomplete is returned without
+// reverting. Stock levels are read before and after to verify the transfer.
+// The audit record is returned even when the transfer fails.
+func (s *InventoryService) Transfer(ctx context.Context, sku string, fromLocation, toLocation, qty int64) (*InventoryTransfer, error) {
+	t := &InventoryTransfer{
+		SKU:            sku,
+		FromLocationID: fromLocation,
+		ToLocationID:   toLocation,
+		Quantity:       qty,
+		Reason:         fmt.Sprintf("Transfer of %d x %s from location %d to %d", qty, sku, fromLocation, toLocation),
+		StartedAt:      time.Now(),
+	}
+	if qty <= 0 || fromLocation == toLocation {
+		return t, fmt.Errorf("bigcommerce: invalid transfer of %d units from location %d to %d", qty, fromLocation, toLocation)
+	}
+
+	var err error
+	if t.FromBefore, t.ToBefore, err = s.transferStock(ctx, sku, fromLocation, toLocation); err != nil {
+		return t, err
+	}
+	if t.FromBefore < qty {
+		return t, fmt.Errorf("%w: %d units of %s at location %d, %d requested", ErrInsufficientStock, t.FromBefore, sku, fromLocation, qty)
+	}
+
+	if err := s.transferAdjust(ctx, t, fromLocation, -qty); err != nil {
+		// A refused removal changed nothing; otherwise the units may be gone.
+		if !rejected(err) {
+			return t, fmt.Errorf("%w: removal of %d units of %s from location %d unknown: %v", ErrTransferIncomplete, qty, sku, fromLocation, err)
+		}
+		return t, err
+	}
+	if err := s.transferAdjust(ctx, t, toLocation, qty); err != nil {
+		// Only a refused addition is rolled back: after a timeout or a server
+		// error the destination may have the units, and restoring the source
+		// would duplicate them.
+		if !rejected(err) {
+			return t, fmt.Errorf("%w: %d units of %s removed from location %d, addition to location %d unknown: %v", ErrTransferIncomplete, qty, sku, fromLocation, toLocation, err)
+		}
+		// Restore the source even if ctx was canceled, so the units are not lost.
+		rollbackCtx, cancel := context.WithTimeout(context.Background(), journal.RollbackTimeout)
+		defer cancel()
+		if rollbackErr := s.transferAdjust(rollbackCtx, t, fromLocation, qty); rollbackErr != nil {
+			return t, fmt.Errorf("%w: %d units of %s removed from location %d: %v (rollback: %v)", ErrTransferIncomplete, qty, sku, fromLocation, err, rollbackErr)
+		}
+		t.RolledBack = true
+		return t, err
+	}
+
+	if t.FromAfter, t.ToAfter, err = s.transferStock(ctx, sku, fromLocation, toLocation); err != nil {
+		return t, err
+	}
+	t.CompletedAt = time.Now()
+	t.Verified = t.FromAfter == t.FromBefore-qty && t.ToAfter == t.ToBefore+qty
+	if !t.Verified {
+		return t, fmt.Errorf("%w: location %d has %d units of %s, location %d has %d", ErrTransferUnverified, fromLocation, t.FromAfter, sku, toLocation, t.ToAfter)
+	}
+	return t, nil
+}
+
+// transferAdjust applies a relative adjustment of a transfer, recording its
+// transaction
+func (s *InventoryService) transferAdjust(ctx context.Context, t *InventoryTransfer, locationID, qty int64) error {
+	id, _, err := s.AdjustRelative(ctx, &InventoryAdjustment{
+		Reason: t.Reason,
+		Items:  []*InventoryAdjustmentItem{{LocationID: locationID, SKU: t.SKU, Quantity: qty}},
+	})
+	if err != nil {
+		return err
+	}
+	t.TransactionIDs = append(t.TransactionIDs, id)
+	return nil
+}
+
+// transferStock returns the on-hand stock of a SKU at two locations
+func (s *InventoryService) transferStock(ctx context.Context, sku string, from, to int64) (int64, int64, error) {
+	items, _, err := s.ListItems(ctx, &InventoryItemListOptions{SKUs: []string{sku}, LocationIDs: []int64{from, to}})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(items) == 0 {
+		return 0, 0, fmt.Errorf("bigcommerce: no inventory item with SKU %q", sku)
+	}
+	var fromStock, toStock int64
+	for _, l := range items[0].Locations {
+		switch l.LocationID {
+		case from:
+			fromStock = l.TotalInventoryOnhand
+		case to:
+			toStock = l.TotalInventoryOnhand
+		}
+	}
+	return fromStock, toStock, nil
+}
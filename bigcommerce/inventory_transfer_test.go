@@ -0,0 +1,132 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// fakeInventory serves the inventory items and relative adjustments of one
+// SKU, failing adjustments at the locations in fail with their status. Server
+// errors are returned after applying the adjustment.
+func fakeInventory(t *testing.T, mux *http.ServeMux, stock map[int64]int64, fail map[int64]int) {
+	var transactions int
+	mux.HandleFunc("/stores/abc123/v3/inventory/items", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"sku:in": "SKU-1", "location_id:in": "1,2"})
+		fmt.Fprintf(w, `{"data":[{"identity":{"sku":"SKU-1"},"locations":[
+			{"location_id":1,"total_inventory_onhand":%d},{"location_id":2,"total_inventory_onhand":%d}]}],"meta":{}}`, stock[1], stock[2])
+	})
+	mux.HandleFunc("/stores/abc123/v3/inventory/adjustments/relative", func(w http.ResponseWriter, r *http.Request) {
+		var adjustment InventoryAdjustment
+		json.NewDecoder(r.Body).Decode(&adjustment)
+		item := adjustment.Items[0]
+		if item.SKU != "SKU-1" || adjustment.Reason != "Transfer of 3 x SKU-1 from location 1 to 2" {
+			t.Errorf("Unexpected adjustment %+v", adjustment)
+		}
+		if status := fail[item.LocationID]; status != 0 {
+			if status >= 500 {
+				stock[item.LocationID] += item.Quantity
+			}
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `{"status":%d,"title":"Location is disabled"}`, status)
+			return
+		}
+		stock[item.LocationID] += item.Quantity
+		transactions++
+		fmt.Fprintf(w, `{"transaction_id":"tx-%d"}`, transactions)
+	})
+}
+
+func TestInventoryService_Transfer(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stock := map[int64]int64{1: 10, 2: 1}
+	fakeInventory(t, mux, stock, nil)
+
+	transfer, err := client.Inventory.Transfer(context.Background(), "SKU-1", 1, 2, 3)
+	if err != nil {
+		t.Fatalf("Transfer returned error: %v", err)
+	}
+	if want := map[int64]int64{1: 7, 2: 4}; !reflect.DeepEqual(stock, want) {
+		t.Errorf("Stock after transfer is %v, want %v", stock, want)
+	}
+	if transfer.FromBefore != 10 || transfer.FromAfter != 7 || transfer.ToBefore != 1 || transfer.ToAfter != 4 {
+		t.Errorf("Transfer recorded %+v", transfer)
+	}
+	if !transfer.Verified || transfer.RolledBack || transfer.CompletedAt.IsZero() {
+		t.Errorf("Transfer recorded %+v", transfer)
+	}
+	if want := []string{"tx-1", "tx-2"}; !reflect.DeepEqual(transfer.TransactionIDs, want) {
+		t.Errorf("Transfer recorded transactions %v, want %v", transfer.TransactionIDs, want)
+	}
+}
+
+func TestInventoryService_Transfer_rollback(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stock := map[int64]int64{1: 10, 2: 1}
+	fakeInventory(t, mux, stock, map[int64]int{2: http.StatusUnprocessableEntity})
+
+	transfer, err := client.Inventory.Transfer(context.Background(), "SKU-1", 1, 2, 3)
+	if err == nil {
+		t.Fatal("Transfer returned no error")
+	}
+	if want := map[int64]int64{1: 10, 2: 1}; !reflect.DeepEqual(stock, want) {
+		t.Errorf("Stock after rollback is %v, want %v", stock, want)
+	}
+	if !transfer.RolledBack || len(transfer.TransactionIDs) != 2 {
+		t.Errorf("Transfer recorded %+v", transfer)
+	}
+}
+
+func TestInventoryService_Transfer_serverError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stock := map[int64]int64{1: 10, 2: 1}
+	fakeInventory(t, mux, stock, map[int64]int{2: http.StatusBadGateway})
+
+	transfer, err := client.Inventory.Transfer(context.Background(), "SKU-1", 1, 2, 3)
+	if !errors.Is(err, ErrTransferIncomplete) {
+		t.Errorf("Transfer returned %v, want ErrTransferIncomplete", err)
+	}
+	if want := map[int64]int64{1: 7, 2: 4}; !reflect.DeepEqual(stock, want) {
+		t.Errorf("Stock after a server error is %v, want %v", stock, want)
+	}
+	if transfer.RolledBack {
+		t.Error("Transfer rolled back an addition that may have been applied")
+	}
+}
+
+func TestInventoryService_Transfer_sourceError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stock := map[int64]int64{1: 10, 2: 1}
+	fakeInventory(t, mux, stock, map[int64]int{1: http.StatusBadGateway})
+
+	// The source may have lost the units, and the destination was not adjusted
+	if _, err := client.Inventory.Transfer(context.Background(), "SKU-1", 1, 2, 3); !errors.Is(err, ErrTransferIncomplete) {
+		t.Errorf("Transfer returned %v, want ErrTransferIncomplete", err)
+	}
+	if want := map[int64]int64{1: 7, 2: 1}; !reflect.DeepEqual(stock, want) {
+		t.Errorf("Stock after a server error is %v, want %v", stock, want)
+	}
+}
+
+func TestInventoryService_Transfer_insufficientStock(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stock := map[int64]int64{1: 2, 2: 0}
+	fakeInventory(t, mux, stock, nil)
+
+	_, err := client.Inventory.Transfer(context.Background(), "SKU-1", 1, 2, 3)
+	if !errors.Is(err, ErrInsufficientStock) {
+		t.Errorf("Transfer returned %v, want ErrInsufficientStock", err)
+	}
+	if want := map[int64]int64{1: 2, 2: 0}; !reflect.DeepEqual(stock, want) {
+		t.Errorf("Stock changed to %v", stock)
+	}
+}
@@ -0,0 +1,185 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/journal"
+)
+
+// InvoiceCounter issues gapless numbers from named sequences. Implementations
+// must persist their state and be safe for concurrent use; the numbers are only
+// gapless if every process issuing invoices shares the same counter.
+type InvoiceCounter interface {
+	// Next issues the next number of the sequence.
+	Next(ctx context.Context, sequence string) (int64, error)
+
+	// Release takes back n if it is still the last number issued, so it is
+	// issued again. It returns false if a later number was issued since.
+	Release(ctx context.Context, sequence string, n int64) (bool, error)
+}
+
+// ErrInvoiceGap is returned when a number was issued but could neither be
+// recorded on the order nor released, leaving a gap in the sequence, or when
+// the API failed in a way that may have recorded it. Check the order's Number
+// before assigning again.
+var ErrInvoiceGap = errors.New("bigcommerce: invoice number issued but not recorded")
+
+// InvoiceNumberer assigns invoice numbers from an external gapless sequence to
+// orders, for jurisdictions requiring gapless invoice numbering. The number is
+// recorded as an order metafield, and optionally in the order's staff notes.
+//
+// Assign must not run concurrently for the same order, e.g. run it from a
+// single worker consuming order webhooks.
+type InvoiceNumberer struct {
+	Client     *Client
+	Counter    InvoiceCounter
+	Sequence   string             // Counter sequence name, "invoice" if empty.
+	Namespace  string             // Metafield namespace, "invoice" if empty. The key is "number".
+	Format     func(int64) string // Formats numbers, as INV-000001 if nil.
+	StaffNotes bool               // Whether to also append the number to the order's staff notes.
+}
+
+const invoiceMetafieldKey = "number"
+
+func (n *InvoiceNumberer) namespace() string {
+	if n.Namespace == "" {
+		return "invoice"
+	}
+	return n.Namespace
+}
+
+// Number returns the invoice number already assigned to an order, or "" if none is
+func (n *InvoiceNumberer) Number(ctx context.Context, orderID int64) (string, error) {
+	opts := &MetafieldListOptions{Namespace: n.namespace(), Key: invoiceMetafieldKey}
+	metafields, _, err := n.Client.Metafields.List(ctx, MetafieldOwnerOf(OrderMetafields, orderID), opts)
+	if err != nil {
+		return "", err
+	}
+	if len(metafields) == 0 {
+		return "", nil
+	}
+	return metafields[0].Value, nil
+}
+
+// Assign returns the order's invoice number, issuing and recording the next
+// number of the sequence if the order has none yet
+func (n *InvoiceNumberer) Assign(ctx context.Context, orderID int64) (string, error) {
+	if number, err := n.Number(ctx, orderID); err != nil || number != "" {
+		return number, err
+	}
+
+	sequence := n.Sequence
+	if sequence == "" {
+		sequence = "invoice"
+	}
+	next, err := n.Counter.Next(ctx, sequence)
+	if err != nil {
+		return "", err
+	}
+	number := fmt.Sprintf("INV-%06d", next)
+	if n.Format != nil {
+		number = n.Format(next)
+	}
+
+	metafield := &Metafield{
+		Namespace:     n.namespace(),
+		Key:           invoiceMetafieldKey,
+		Value:         number,
+		PermissionSet: ReadMetafield,
+		Description:   "Invoice number",
+	}
+	if _, _, err := n.Client.Metafields.Create(ctx, MetafieldOwnerOf(OrderMetafields, orderID), metafield); err != nil {
+		// After a timeout or a server error the metafield may have been
+		// written, so only a refused request releases the number.
+		if !rejected(err) {
+			return "", fmt.Errorf("%w: %s on order %d: %v", ErrInvoiceGap, number, orderID, err)
+		}
+		// Release even if ctx was canceled, so the number is not lost.
+		releaseCtx, cancel := context.WithTimeout(context.Background(), journal.RollbackTimeout)
+		defer cancel()
+		released, releaseErr := n.Counter.Release(releaseCtx, sequence, next)
+		if releaseErr != nil || !released {
+			return "", fmt.Errorf("%w: %s on order %d: %v", ErrInvoiceGap, number, orderID, err)
+		}
+		return "", err
+	}
+
+	// The metafield is the record of the number; staff notes are a convenience
+	// for merchants, so a failure there does not release the number.
+	if n.StaffNotes {
+		if err := n.appendStaffNote(ctx, orderID, "Invoice number: "+number); err != nil {
+			return number, err
+		}
+	}
+	return number, nil
+}
+
+// orderStaffNotes is the part of a V2 order updated by InvoiceNumberer
+type orderStaffNotes struct {
+	StaffNotes string `json:"staff_notes"`
+}
+
+func (n *InvoiceNumberer) appendStaffNote(ctx context.Context, orderID int64, note string) error {
+	path := fmt.Sprintf("v2/orders/%d", orderID)
+	order := new(orderStaffNotes)
+	if _, err := n.Client.call(ctx, "GET", path, nil, order); err != nil {
+		return err
+	}
+	if strings.Contains(order.StaffNotes, note) {
+		return nil
+	}
+	order.StaffNotes = strings.TrimSpace(order.StaffNotes + "\n" + note)
+	_, err := n.Client.call(ctx, "PUT", path, order, nil)
+	return err
+}
+
+// MemoryInvoiceCounter is an InvoiceCounter kept in memory, for tests and
+// single-process tools that persist Values themselves
+type MemoryInvoiceCounter struct {
+	mu     sync.Mutex
+	values map[string]int64
+}
+
+// NewMemoryInvoiceCounter returns a counter whose sequences continue from the
+// given last issued numbers
+func NewMemoryInvoiceCounter(last map[string]int64) *MemoryInvoiceCounter {
+	c := &MemoryInvoiceCounter{values: map[string]int64{}}
+	for k, v := range last {
+		c.values[k] = v
+	}
+	return c
+}
+
+// Next implements InvoiceCounter
+func (c *MemoryInvoiceCounter) Next(ctx context.Context, sequence string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[sequence]++
+	return c.values[sequence], nil
+}
+
+// Release implements InvoiceCounter
+func (c *MemoryInvoiceCounter) Release(ctx context.Context, sequence string, n int64) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values[sequence] != n {
+		return false, nil
+	}
+	c.values[sequence]--
+	return true, nil
+}
+
+// Values returns the last number issued by each sequence
+func (c *MemoryInvoiceCounter) Values() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	values := make(map[string]int64, len(c.values))
+	for k, v := range c.values {
+		values[k] = v
+	}
+	return values
+}
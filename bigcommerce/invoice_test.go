@@ -0,0 +1,127 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestInvoiceNumberer_Assign(t *testing.T) {
+	server := bctest.NewServer()
+	server.Seed("v2/orders", map[string]interface{}{"id": 100, "staff_notes": "Gift wrap"})
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+
+	counter := NewMemoryInvoiceCounter(map[string]int64{"invoice": 41})
+	numberer := &InvoiceNumberer{Client: client, Counter: counter, StaffNotes: true}
+	ctx := context.Background()
+
+	number, err := numberer.Assign(ctx, 100)
+	if err != nil || number != "INV-000042" {
+		t.Fatalf("Assign = %q, %v, want INV-000042", number, err)
+	}
+	// Assigning again returns the recorded number without issuing another.
+	if again, err := numberer.Assign(ctx, 100); err != nil || again != number {
+		t.Errorf("Assign again = %q, %v, want %q", again, err, number)
+	}
+	if got := counter.Values()["invoice"]; got != 42 {
+		t.Errorf("Counter = %d, want 42", got)
+	}
+
+	orders := server.Objects("v2/orders")
+	if got := orders[0]["staff_notes"]; got != "Gift wrap\nInvoice number: INV-000042" {
+		t.Errorf("staff_notes = %q", got)
+	}
+	metafields := server.Objects("v3/orders/100/metafields")
+	if len(metafields) != 1 || metafields[0]["namespace"] != "invoice" || metafields[0]["value"] != "INV-000042" {
+		t.Errorf("Unexpected metafields %v", metafields)
+	}
+}
+
+func TestInvoiceNumberer_releasesOnFailure(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	status := http.StatusUnprocessableEntity
+	mux.HandleFunc("/stores/abc123/v3/orders/5/metafields", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	counter := NewMemoryInvoiceCounter(nil)
+	numberer := &InvoiceNumberer{Client: client, Counter: counter, Format: func(n int64) string { return fmt.Sprint("A", n) }}
+	if _, err := numberer.Assign(context.Background(), 5); err == nil || errors.Is(err, ErrInvoiceGap) {
+		t.Errorf("Assign returned %v, want a plain API error", err)
+	}
+	if got := counter.Values()["invoice"]; got != 0 {
+		t.Errorf("Counter = %d, want the number released", got)
+	}
+
+	// A server error may have recorded the number, which is kept.
+	status = http.StatusInternalServerError
+	if _, err := numberer.Assign(context.Background(), 5); !errors.Is(err, ErrInvoiceGap) {
+		t.Errorf("Assign after a server error returned %v, want ErrInvoiceGap", err)
+	}
+	if got := counter.Values()["invoice"]; got != 1 {
+		t.Errorf("Counter = %d, want the number kept", got)
+	}
+	status = http.StatusUnprocessableEntity
+
+	// A number issued concurrently since prevents the release.
+	counter.Next(context.Background(), "invoice")
+	numberer.Counter = &racingCounter{counter}
+	if _, err := numberer.Assign(context.Background(), 5); !errors.Is(err, ErrInvoiceGap) {
+		t.Errorf("Assign returned %v, want ErrInvoiceGap", err)
+	}
+}
+
+// racingCounter issues an extra number after each Next, as another process would
+type racingCounter struct {
+	*MemoryInvoiceCounter
+}
+
+func (c *racingCounter) Next(ctx context.Context, sequence string) (int64, error) {
+	n, err := c.MemoryInvoiceCounter.Next(ctx, sequence)
+	c.MemoryInvoiceCounter.Next(ctx, sequence)
+	return n, err
+}
+
+// boundedCounter fails releases made without a deadline
+type boundedCounter struct {
+	*MemoryInvoiceCounter
+}
+
+func (c *boundedCounter) Release(ctx context.Context, sequence string, n int64) (bool, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return false, errors.New("release without a deadline")
+	}
+	return c.MemoryInvoiceCounter.Release(ctx, sequence, n)
+}
+
+func TestInvoiceNumberer_releaseIsBounded(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/5/metafields", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	counter := NewMemoryInvoiceCounter(nil)
+	numberer := &InvoiceNumberer{Client: client, Counter: &boundedCounter{counter}}
+	if _, err := numberer.Assign(context.Background(), 5); err == nil || errors.Is(err, ErrInvoiceGap) {
+		t.Errorf("Assign returned %v, want a plain API error", err)
+	}
+	if got := counter.Values()["invoice"]; got != 0 {
+		t.Errorf("Counter = %d, want the number released", got)
+	}
+}
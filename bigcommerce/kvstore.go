@@ -0,0 +1,276 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// KVStore persists the state of the client's stateful helpers, so apps wire a
+// single storage backend for all of them. Keys are namespaced by each helper,
+// e.g. "invoice/" for KVInvoiceCounter. Implementations must be safe for
+// concurrent use; CompareAndSwap must be atomic across every process sharing
+// the store.
+type KVStore interface {
+	// Get returns the value of key, and false if it is not set.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Put sets the value of key.
+	Put(ctx context.Context, key string, value []byte) error
+	// Delete removes key. Removing a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+	// CompareAndSwap sets key to new if its value is old, where a nil old
+	// means the key must not be set. It returns false if the value differed.
+	CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error)
+	// Keys returns the sorted keys starting with prefix.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// GetJSON decodes the JSON document stored at key into v. It returns false if
+// the key is not set.
+func GetJSON(ctx context.Context, s KVStore, key string, v interface{}) (bool, error) {
+	data, ok, err := s.Get(ctx, key)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, json.Unmarshal(data, v)
+}
+
+// PutJSON stores v at key as a JSON document
+func PutJSON(ctx context.Context, s KVStore, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.Put(ctx, key, data)
+}
+
+// MemoryKVStore is a KVStore kept in memory, for tests and single-process apps
+type MemoryKVStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+// NewMemoryKVStore returns an empty in-memory KVStore
+func NewMemoryKVStore() *MemoryKVStore {
+	return &MemoryKVStore{values: map[string][]byte{}}
+}
+
+// Get implements KVStore
+func (s *MemoryKVStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	return append([]byte(nil), v...), ok, nil
+}
+
+// Put implements KVStore
+func (s *MemoryKVStore) Put(ctx context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = append([]byte{}, value...)
+	return nil
+}
+
+// Delete implements KVStore
+func (s *MemoryKVStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+// CompareAndSwap implements KVStore
+func (s *MemoryKVStore) CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.values[key]
+	if (old == nil) == ok || (ok && !bytes.Equal(current, old)) {
+		return false, nil
+	}
+	s.values[key] = append([]byte{}, new...)
+	return true, nil
+}
+
+// Keys implements KVStore
+func (s *MemoryKVStore) Keys(ctx context.Context, prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for k := range s.values {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// SQLKVStore is a KVStore in a SQL database table, created by the app with a
+// text primary key and a binary value, e.g. for PostgreSQL:
+//
+//	CREATE TABLE bigcommerce_kv (k VARCHAR(255) PRIMARY KEY, v BYTEA NOT NULL)
+//
+// Only portable statements are used, so any database/sql driver works.
+type SQLKVStore struct {
+	DB     *sql.DB
+	Table  string // The table's name, "bigcommerce_kv" if empty.
+	Dollar bool   // Whether the driver uses $1 placeholders, as PostgreSQL's do, instead of ?.
+}
+
+func (s *SQLKVStore) query(q string) string {
+	table := s.Table
+	if table == "" {
+		table = "bigcommerce_kv"
+	}
+	q = strings.ReplaceAll(q, "TABLE", table)
+	if !s.Dollar {
+		return q
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range q {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Get implements KVStore
+func (s *SQLKVStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	var v []byte
+	err := s.DB.QueryRowContext(ctx, s.query("SELECT v FROM TABLE WHERE k = ?"), key).Scan(&v)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// Put implements KVStore. It updates the key, or inserts it if missing; when a
+// concurrent Put inserts it first, the update is made again.
+func (s *SQLKVStore) Put(ctx context.Context, key string, value []byte) error {
+	if n, err := s.update(ctx, key, value); err != nil || n > 0 {
+		return err
+	}
+	_, err := s.DB.ExecContext(ctx, s.query("INSERT INTO TABLE (k, v) VALUES (?, ?)"), key, value)
+	if err == nil {
+		return nil
+	}
+	if _, ok, getErr := s.Get(ctx, key); getErr != nil || !ok {
+		return err
+	}
+	_, err = s.update(ctx, key, value)
+	return err
+}
+
+func (s *SQLKVStore) update(ctx context.Context, key string, value []byte) (int64, error) {
+	res, err := s.DB.ExecContext(ctx, s.query("UPDATE TABLE SET v = ? WHERE k = ?"), value, key)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Delete implements KVStore
+func (s *SQLKVStore) Delete(ctx context.Context, key string) error {
+	_, err := s.DB.ExecContext(ctx, s.query("DELETE FROM TABLE WHERE k = ?"), key)
+	return err
+}
+
+// CompareAndSwap implements KVStore. When old is nil, an insert failing
+// because the key exists, e.g. after a concurrent insert, returns false.
+func (s *SQLKVStore) CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error) {
+	if old == nil {
+		if _, ok, err := s.Get(ctx, key); err != nil || ok {
+			return false, err
+		}
+		if _, err := s.DB.ExecContext(ctx, s.query("INSERT INTO TABLE (k, v) VALUES (?, ?)"), key, new); err != nil {
+			// Drivers report unique violations differently, so the conflict
+			// is recognized by the key now existing.
+			if _, ok, getErr := s.Get(ctx, key); getErr == nil && ok {
+				return false, nil
+			}
+			return false, fmt.Errorf("bigcommerce: inserting %s: %w", key, err)
+		}
+		return true, nil
+	}
+	res, err := s.DB.ExecContext(ctx, s.query("UPDATE TABLE SET v = ? WHERE k = ? AND v = ?"), new, key, old)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// Keys implements KVStore. The database narrows the keys with LIKE, and they
+// are filtered and sorted here, as collations may ignore case or sort
+// differently than bytes.
+func (s *SQLKVStore) Keys(ctx context.Context, prefix string) ([]string, error) {
+	pattern := strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(prefix) + "%"
+	rows, err := s.DB.QueryContext(ctx, s.query("SELECT k FROM TABLE WHERE k LIKE ? ESCAPE '!'"), pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var keys []string
+	for rows.Next() {
+		var k string
+		if err := rows.Scan(&k); err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, rows.Err()
+}
+
+// KVInvoiceCounter is an InvoiceCounter keeping its sequences in a KVStore,
+// under "invoice/" followed by the sequence name
+type KVInvoiceCounter struct {
+	Store KVStore
+}
+
+// Next implements InvoiceCounter
+func (c *KVInvoiceCounter) Next(ctx context.Context, sequence string) (int64, error) {
+	key := "invoice/" + sequence
+	for {
+		old, ok, err := c.Store.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		var n int64
+		if ok {
+			if n, err = strconv.ParseInt(string(old), 10, 64); err != nil {
+				return 0, fmt.Errorf("bigcommerce: invalid invoice counter %s: %v", key, err)
+			}
+		} else {
+			old = nil
+		}
+		next := []byte(strconv.FormatInt(n+1, 10))
+		if swapped, err := c.Store.CompareAndSwap(ctx, key, old, next); err != nil || swapped {
+			return n + 1, err
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Release implements InvoiceCounter
+func (c *KVInvoiceCounter) Release(ctx context.Context, sequence string, n int64) (bool, error) {
+	return c.Store.CompareAndSwap(ctx, "invoice/"+sequence, []byte(strconv.FormatInt(n, 10)), []byte(strconv.FormatInt(n-1, 10)))
+}
@@ -0,0 +1,252 @@
+package bigcommerce
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func testKVStore(t *testing.T, s KVStore) {
+	ctx := context.Background()
+	if _, ok, err := s.Get(ctx, "a/1"); err != nil || ok {
+		t.Fatalf("Get of missing key returned ok %v, error %v", ok, err)
+	}
+	if err := s.Put(ctx, "a/1", []byte("x")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	if err := s.Put(ctx, "a/1", []byte("y")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	if v, ok, err := s.Get(ctx, "a/1"); err != nil || !ok || string(v) != "y" {
+		t.Errorf("Get returned %q, %v, %v, want y", v, ok, err)
+	}
+
+	if ok, err := s.CompareAndSwap(ctx, "a/1", nil, []byte("z")); err != nil || ok {
+		t.Errorf("CompareAndSwap of set key from nil returned %v, %v, want false", ok, err)
+	}
+	if ok, err := s.CompareAndSwap(ctx, "a/1", []byte("x"), []byte("z")); err != nil || ok {
+		t.Errorf("CompareAndSwap with stale value returned %v, %v, want false", ok, err)
+	}
+	if ok, err := s.CompareAndSwap(ctx, "a/1", []byte("y"), []byte("z")); err != nil || !ok {
+		t.Errorf("CompareAndSwap returned %v, %v, want true", ok, err)
+	}
+	if ok, err := s.CompareAndSwap(ctx, "a/2", nil, []byte("w")); err != nil || !ok {
+		t.Errorf("CompareAndSwap of missing key returned %v, %v, want true", ok, err)
+	}
+	if err := s.Put(ctx, "b/1", []byte("v")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+
+	keys, err := s.Keys(ctx, "a/")
+	if err != nil {
+		t.Fatalf("Keys returned error: %v", err)
+	}
+	if want := []string{"a/1", "a/2"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys returned %v, want %v", keys, want)
+	}
+
+	if err := s.Delete(ctx, "a/1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := s.Delete(ctx, "a/1"); err != nil {
+		t.Errorf("Delete of missing key returned error: %v", err)
+	}
+	if _, ok, _ := s.Get(ctx, "a/1"); ok {
+		t.Errorf("Get returned deleted key")
+	}
+}
+
+func TestMemoryKVStore(t *testing.T) {
+	testKVStore(t, NewMemoryKVStore())
+}
+
+func TestSQLKVStore(t *testing.T) {
+	db := sql.OpenDB(&fakeKVDriver{rows: map[string][]byte{}})
+	defer db.Close()
+	testKVStore(t, &SQLKVStore{DB: db})
+}
+
+func TestSQLKVStore_races(t *testing.T) {
+	d := &fakeKVDriver{rows: map[string][]byte{"a/1": []byte("x"), "A/2": []byte("y"), "a_3": []byte("z")}}
+	db := sql.OpenDB(d)
+	defer db.Close()
+	s := &SQLKVStore{DB: db}
+	ctx := context.Background()
+
+	// The key is inserted between the check and the insert
+	d.stale = 1
+	if ok, err := s.CompareAndSwap(ctx, "a/1", nil, []byte("w")); err != nil || ok {
+		t.Errorf("CompareAndSwap of a concurrently inserted key returned %v, %v, want false", ok, err)
+	}
+	if keys, err := s.Keys(ctx, "a/"); err != nil || !reflect.DeepEqual(keys, []string{"a/1"}) {
+		t.Errorf("Keys returned %v, %v, want [a/1]", keys, err)
+	}
+}
+
+func TestSQLKVStore_query(t *testing.T) {
+	s := &SQLKVStore{Table: "kv", Dollar: true}
+	got := s.query("UPDATE TABLE SET v = ? WHERE k = ? AND v = ?")
+	if want := "UPDATE kv SET v = $1 WHERE k = $2 AND v = $3"; got != want {
+		t.Errorf("query returned %q, want %q", got, want)
+	}
+}
+
+func TestGetJSON(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryKVStore()
+	if err := PutJSON(ctx, s, "doc", map[string]int64{"n": 3}); err != nil {
+		t.Fatalf("PutJSON returned error: %v", err)
+	}
+	var got map[string]int64
+	if ok, err := GetJSON(ctx, s, "doc", &got); err != nil || !ok {
+		t.Fatalf("GetJSON returned %v, %v", ok, err)
+	}
+	if want := map[string]int64{"n": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetJSON decoded %v, want %v", got, want)
+	}
+	if ok, err := GetJSON(ctx, s, "missing", &got); err != nil || ok {
+		t.Errorf("GetJSON of missing key returned %v, %v", ok, err)
+	}
+}
+
+func TestKVInvoiceCounter(t *testing.T) {
+	ctx := context.Background()
+	c := &KVInvoiceCounter{Store: NewMemoryKVStore()}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Next(ctx, "invoice"); err != nil {
+				t.Errorf("Next returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n, _ := c.Next(ctx, "invoice"); n != 11 {
+		t.Errorf("Next returned %d, want 11", n)
+	}
+	if ok, _ := c.Release(ctx, "invoice", 10); ok {
+		t.Errorf("Release of an earlier number returned true")
+	}
+	if ok, _ := c.Release(ctx, "invoice", 11); !ok {
+		t.Errorf("Release of the last number returned false")
+	}
+	if n, _ := c.Next(ctx, "invoice"); n != 11 {
+		t.Errorf("Next after Release returned %d, want 11", n)
+	}
+}
+
+// fakeKVDriver is a database/sql connector understanding only the statements of
+// SQLKVStore
+type fakeKVDriver struct {
+	mu    sync.Mutex
+	rows  map[string][]byte
+	stale int // Reads missing every key, as before a concurrent insert.
+}
+
+func (d *fakeKVDriver) Connect(context.Context) (driver.Conn, error) { return fakeKVConn{d}, nil }
+func (d *fakeKVDriver) Driver() driver.Driver                        { return d }
+func (d *fakeKVDriver) Open(string) (driver.Conn, error)             { return fakeKVConn{d}, nil }
+
+type fakeKVConn struct{ d *fakeKVDriver }
+
+func (c fakeKVConn) Prepare(query string) (driver.Stmt, error) { return fakeKVStmt{c.d, query}, nil }
+func (c fakeKVConn) Close() error                              { return nil }
+func (c fakeKVConn) Begin() (driver.Tx, error)                 { return fakeKVTx{}, nil }
+
+type fakeKVTx struct{}
+
+func (fakeKVTx) Commit() error   { return nil }
+func (fakeKVTx) Rollback() error { return nil }
+
+type fakeKVStmt struct {
+	d     *fakeKVDriver
+	query string
+}
+
+func (s fakeKVStmt) Close() error  { return nil }
+func (s fakeKVStmt) NumInput() int { return strings.Count(s.query, "?") }
+
+func (s fakeKVStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	switch s.query {
+	case "DELETE FROM bigcommerce_kv WHERE k = ?":
+		delete(s.d.rows, args[0].(string))
+		return driver.RowsAffected(1), nil
+	case "INSERT INTO bigcommerce_kv (k, v) VALUES (?, ?)":
+		if _, ok := s.d.rows[args[0].(string)]; ok {
+			return nil, fmt.Errorf("duplicate key %s", args[0])
+		}
+		s.d.rows[args[0].(string)] = args[1].([]byte)
+		return driver.RowsAffected(1), nil
+	case "UPDATE bigcommerce_kv SET v = ? WHERE k = ?":
+		k := args[1].(string)
+		if _, ok := s.d.rows[k]; !ok {
+			return driver.RowsAffected(0), nil
+		}
+		s.d.rows[k] = args[0].([]byte)
+		return driver.RowsAffected(1), nil
+	case "UPDATE bigcommerce_kv SET v = ? WHERE k = ? AND v = ?":
+		k := args[1].(string)
+		if v, ok := s.d.rows[k]; !ok || string(v) != string(args[2].([]byte)) {
+			return driver.RowsAffected(0), nil
+		}
+		s.d.rows[k] = args[0].([]byte)
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unexpected statement %q", s.query)
+}
+
+func (s fakeKVStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	switch s.query {
+	case "SELECT v FROM bigcommerce_kv WHERE k = ?":
+		if s.d.stale > 0 {
+			s.d.stale--
+			return &fakeKVRows{}, nil
+		}
+		if v, ok := s.d.rows[args[0].(string)]; ok {
+			return &fakeKVRows{[]driver.Value{v}}, nil
+		}
+		return &fakeKVRows{}, nil
+	case "SELECT k FROM bigcommerce_kv WHERE k LIKE ? ESCAPE '!'":
+		// Matches case-insensitively, as some collations do, in map order
+		prefix := strings.TrimSuffix(strings.NewReplacer("!!", "!", "!%", "%", "!_", "_").Replace(args[0].(string)), "%")
+		var keys []string
+		for k := range s.d.rows {
+			if strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
+				keys = append(keys, k)
+			}
+		}
+		rows := &fakeKVRows{}
+		for _, k := range keys {
+			rows.values = append(rows.values, k)
+		}
+		return rows, nil
+	}
+	return nil, fmt.Errorf("unexpected query %q", s.query)
+}
+
+type fakeKVRows struct{ values []driver.Value }
+
+func (r *fakeKVRows) Columns() []string { return []string{"c"} }
+func (r *fakeKVRows) Close() error      { return nil }
+
+func (r *fakeKVRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
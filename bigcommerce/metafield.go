@@ -0,0 +1,203 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// MetafieldService handles communication with the V3 metafield endpoints of
+// every resource that supports them
+type MetafieldService service
+
+// Metafield describes a BigCommerce V3 Metafield Object, a namespaced key/value
+// pair attached to a resource for use by apps
+type Metafield struct {
+	ID            int64                  `json:"id,omitempty"`             // The unique numerical ID of the metafield.
+	Key           string                 `json:"key,omitempty"`            // The key, unique within the namespace and resource.
+	Value         string                 `json:"value,omitempty"`          // The value, at most 65,535 characters.
+	Namespace     string                 `json:"namespace,omitempty"`      // Groups related metafields, e.g. an app's name.
+	PermissionSet MetafieldPermissionSet `json:"permission_set,omitempty"` // Who can read and write the metafield.
+	Description   string                 `json:"description,omitempty"`    // Description of the metafield.
+	ResourceType  string                 `json:"resource_type,omitempty"`  // The type of resource the metafield is attached to, e.g. product.
+	ResourceID    int64                  `json:"resource_id,omitempty"`    // The ID of the resource. Required by the batch endpoints.
+	OwnerClientID string                 `json:"owner_client_id,omitempty"`
+	DateCreated   string                 `json:"date_created,omitempty"`
+	DateModified  string                 `json:"date_modified,omitempty"`
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// MetafieldPermissionSet - Who can read and write a metafield
+type MetafieldPermissionSet string
+
+// MetafieldResource - The collection path of a resource type supporting metafields
+type MetafieldResource string
+
+const (
+	// AppOnlyMetafield - readable and writable only by the app that created it
+	AppOnlyMetafield MetafieldPermissionSet = "app_only"
+	// ReadMetafield - readable by other apps
+	ReadMetafield MetafieldPermissionSet = "read"
+	// WriteMetafield - readable and writable by other apps
+	WriteMetafield MetafieldPermissionSet = "write"
+	// ReadStorefrontMetafield - readable by other apps and the storefront
+	ReadStorefrontMetafield MetafieldPermissionSet = "read_and_sf_access"
+	// WriteStorefrontMetafield - writable by other apps and readable by the storefront
+	WriteStorefrontMetafield MetafieldPermissionSet = "write_and_sf_access"
+
+	// ProductMetafields - metafields of products
+	ProductMetafields MetafieldResource = "v3/catalog/products"
+	// VariantMetafields - metafields of product variants
+	VariantMetafields MetafieldResource = "v3/catalog/variants"
+	// CategoryMetafields - metafields of categories
+	CategoryMetafields MetafieldResource = "v3/catalog/categories"
+	// BrandMetafields - metafields of brands
+	BrandMetafields MetafieldResource = "v3/catalog/brands"
+	// OrderMetafields - metafields of orders
+	OrderMetafields MetafieldResource = "v3/orders"
+	// CartMetafields - metafields of carts
+	CartMetafields MetafieldResource = "v3/carts"
+	// ChannelMetafields - metafields of channels
+	ChannelMetafields MetafieldResource = "v3/channels"
+	// CustomerMetafields - metafields of customers
+	CustomerMetafields MetafieldResource = "v3/customers"
+	// LocationMetafields - metafields of inventory locations
+	LocationMetafields MetafieldResource = "v3/inventory/locations"
+)
+
+// MetafieldOwner identifies the resource whose metafields are managed
+type MetafieldOwner struct {
+	Resource  MetafieldResource
+	ID        string // The resource's ID. Carts are identified by UUID.
+	ProductID int64  // The product of a variant, whose metafields are nested under it.
+}
+
+// MetafieldOwnerOf returns the owner of a resource with a numeric ID
+func MetafieldOwnerOf(resource MetafieldResource, id int64) MetafieldOwner {
+	return MetafieldOwner{Resource: resource, ID: strconv.FormatInt(id, 10)}
+}
+
+// VariantMetafieldOwner returns the owner of a variant's metafields
+func VariantMetafieldOwner(productID, variantID int64) MetafieldOwner {
+	return MetafieldOwner{Resource: VariantMetafields, ID: strconv.FormatInt(variantID, 10), ProductID: productID}
+}
+
+// CartMetafieldOwner returns the owner of a cart's metafields
+func CartMetafieldOwner(cartID string) MetafieldOwner {
+	return MetafieldOwner{Resource: CartMetafields, ID: cartID}
+}
+
+func (o MetafieldOwner) path() string {
+	if o.Resource == VariantMetafields {
+		return fmt.Sprintf("v3/catalog/products/%d/variants/%s/metafields", o.ProductID, o.ID)
+	}
+	return fmt.Sprintf("%s/%s/metafields", o.Resource, o.ID)
+}
+
+// MetafieldListOptions specifies the optional parameters for listing metafields
+type MetafieldListOptions struct {
+	ListOptions
+	Key         string   `url:"key,omitempty"`
+	Namespace   string   `url:"namespace,omitempty"`
+	ResourceIDs []int64  `url:"resource_id:in,omitempty"` // Only used when listing a whole resource type.
+	Namespaces  []string `url:"namespace:in,omitempty"`
+}
+
+// List returns the metafields of a resource
+func (s *MetafieldService) List(ctx context.Context, owner MetafieldOwner, opts *MetafieldListOptions) ([]*Metafield, *Response, error) {
+	path, err := addOptions(owner.path(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metafields []*Metafield
+	resp, err := s.client.call(ctx, "GET", path, nil, &metafields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metafields, resp, nil
+}
+
+// Get returns a single metafield of a resource
+func (s *MetafieldService) Get(ctx context.Context, owner MetafieldOwner, metafieldID int64) (*Metafield, *Response, error) {
+	path := fmt.Sprintf("%s/%d", owner.path(), metafieldID)
+	metafield := new(Metafield)
+	resp, err := s.client.call(ctx, "GET", path, nil, metafield)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metafield, resp, nil
+}
+
+// Create adds a metafield to a resource
+func (s *MetafieldService) Create(ctx context.Context, owner MetafieldOwner, metafield *Metafield) (*Metafield, *Response, error) {
+	created := new(Metafield)
+	resp, err := s.client.call(ctx, "POST", owner.path(), metafield, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a metafield of a resource
+func (s *MetafieldService) Update(ctx context.Context, owner MetafieldOwner, metafieldID int64, metafield *Metafield) (*Metafield, *Response, error) {
+	path := fmt.Sprintf("%s/%d", owner.path(), metafieldID)
+	updated := new(Metafield)
+	resp, err := s.client.call(ctx, "PUT", path, metafield, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a metafield from a resource
+func (s *MetafieldService) Delete(ctx context.Context, owner MetafieldOwner, metafieldID int64) (*Response, error) {
+	path := fmt.Sprintf("%s/%d", owner.path(), metafieldID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListAll returns the metafields of every resource of a type, optionally
+// filtered by ResourceIDs
+func (s *MetafieldService) ListAll(ctx context.Context, resource MetafieldResource, opts *MetafieldListOptions) ([]*Metafield, *Response, error) {
+	path, err := addOptions(string(resource)+"/metafields", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metafields []*Metafield
+	resp, err := s.client.call(ctx, "GET", path, nil, &metafields)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metafields, resp, nil
+}
+
+// CreateBatch adds metafields to many resources of a type in one request. Every
+// metafield must set ResourceID.
+func (s *MetafieldService) CreateBatch(ctx context.Context, resource MetafieldResource, metafields []*Metafield) ([]*Metafield, *Response, error) {
+	return s.batch(ctx, "POST", resource, metafields)
+}
+
+// UpdateBatch modifies metafields of many resources of a type in one request.
+// Every metafield must set ID and ResourceID.
+func (s *MetafieldService) UpdateBatch(ctx context.Context, resource MetafieldResource, metafields []*Metafield) ([]*Metafield, *Response, error) {
+	return s.batch(ctx, "PUT", resource, metafields)
+}
+
+// DeleteBatch removes metafields from resources of a type in one request
+func (s *MetafieldService) DeleteBatch(ctx context.Context, resource MetafieldResource, metafieldIDs []int64) (*Response, error) {
+	if len(metafieldIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	return s.client.call(ctx, "DELETE", string(resource)+"/metafields", metafieldIDs, nil)
+}
+
+func (s *MetafieldService) batch(ctx context.Context, method string, resource MetafieldResource, metafields []*Metafield) ([]*Metafield, *Response, error) {
+	var results []*Metafield
+	resp, err := s.client.call(ctx, method, string(resource)+"/metafields", metafields, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+	return results, resp, nil
+}
@@ -0,0 +1,105 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestMetafieldOwner_path(t *testing.T) {
+	tests := []struct {
+		owner MetafieldOwner
+		want  string
+	}{
+		{MetafieldOwnerOf(ProductMetafields, 32), "v3/catalog/products/32/metafields"},
+		{VariantMetafieldOwner(32, 5), "v3/catalog/products/32/variants/5/metafields"},
+		{MetafieldOwnerOf(OrderMetafields, 100), "v3/orders/100/metafields"},
+		{MetafieldOwnerOf(LocationMetafields, 2), "v3/inventory/locations/2/metafields"},
+		{CartMetafieldOwner("d4e9"), "v3/carts/d4e9/metafields"},
+	}
+	for _, tt := range tests {
+		if got := tt.owner.path(); got != tt.want {
+			t.Errorf("path() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMetafieldService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Metafield{Key: "color", Value: "red", Namespace: "app", PermissionSet: AppOnlyMetafield}
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories/4/metafields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Metafield), input)
+		fmt.Fprint(w, `{"data":{"id":9,"key":"color","value":"red","namespace":"app","permission_set":"app_only","resource_type":"category","resource_id":4},"meta":{}}`)
+	})
+
+	metafield, _, err := client.Metafields.Create(context.Background(), MetafieldOwnerOf(CategoryMetafields, 4), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metafield.ID != 9 || metafield.ResourceType != "category" || metafield.ResourceID != 4 {
+		t.Errorf("Unexpected metafield %+v", metafield)
+	}
+}
+
+func TestMetafieldService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/variants/5/metafields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"namespace": "app", "key": "color"})
+		fmt.Fprint(w, `{"data":[{"id":9,"key":"color"}],"meta":{}}`)
+	})
+
+	opts := &MetafieldListOptions{Namespace: "app", Key: "color"}
+	metafields, _, err := client.Metafields.List(context.Background(), VariantMetafieldOwner(32, 5), opts)
+	if err != nil || len(metafields) != 1 || metafields[0].ID != 9 {
+		t.Errorf("Unexpected metafields %+v, %v", metafields, err)
+	}
+}
+
+func TestMetafieldService_batch(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/metafields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQuery(t, r, map[string]string{"resource_id:in": "1,2"})
+			fmt.Fprint(w, `{"data":[{"id":1,"resource_id":1},{"id":2,"resource_id":2}],"meta":{}}`)
+		case "PUT":
+			testBody(t, r, &[]*Metafield{}, &[]*Metafield{{ID: 1, ResourceID: 1, Value: "x"}})
+			fmt.Fprint(w, `{"data":[{"id":1,"resource_id":1,"value":"x"}],"meta":{}}`)
+		case "DELETE":
+			testBody(t, r, &[]int64{}, &[]int64{1, 2})
+			fmt.Fprint(w, `{"data":[],"meta":{}}`)
+		}
+	})
+
+	ctx := context.Background()
+	all, _, err := client.Metafields.ListAll(ctx, OrderMetafields, &MetafieldListOptions{ResourceIDs: []int64{1, 2}})
+	if err != nil || len(all) != 2 {
+		t.Errorf("ListAll returned %+v, %v", all, err)
+	}
+	updated, _, err := client.Metafields.UpdateBatch(ctx, OrderMetafields, []*Metafield{{ID: 1, ResourceID: 1, Value: "x"}})
+	if err != nil || len(updated) != 1 || updated[0].Value != "x" {
+		t.Errorf("UpdateBatch returned %+v, %v", updated, err)
+	}
+	if _, err := client.Metafields.DeleteBatch(ctx, OrderMetafields, []int64{1, 2}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMetafieldService_DeleteBatch_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Metafields.DeleteBatch(ctx, ProductMetafields, nil); err != ErrNoIDs {
+		t.Errorf("DeleteBatch returned %v, want ErrNoIDs", err)
+	}
+}
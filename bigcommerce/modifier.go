@@ -0,0 +1,229 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ModifierService handles communication with the V3 product modifier endpoints
+type ModifierService service
+
+// Modifier describes a BigCommerce V3 Product Modifier Object. Unlike options,
+// modifiers capture shopper input (text, files, dates, checkboxes, ...) and
+// adjust the price or weight of the product without creating variants.
+type Modifier struct {
+	ID           int64           `json:"id,omitempty"`            // The unique numerical ID of the modifier.
+	ProductID    int64           `json:"product_id,omitempty"`    // The ID of the product the modifier belongs to.
+	Name         string          `json:"name,omitempty"`          // The unique modifier name, auto-generated from the display name if not set.
+	DisplayName  string          `json:"display_name,omitempty"`  // The name shown on the storefront.
+	Type         ModifierType    `json:"type,omitempty"`          // The type of storefront input used for the modifier.
+	Required     bool            `json:"required"`                // Whether the shopper must provide a value.
+	SortOrder    int64           `json:"sort_order,omitempty"`    // Order in which the modifier is displayed on the product page.
+	Config       *ModifierConfig `json:"config,omitempty"`        // Type-specific configuration.
+	OptionValues []ModifierValue `json:"option_values,omitempty"` // Values of list-style modifiers. Values included on create are created with the modifier.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ModifierValue describes a value of a list-style modifier, with the adjustments it applies
+type ModifierValue struct {
+	OptionValue
+	Adjusters *ModifierAdjusters `json:"adjusters,omitempty"` // Adjustments applied when the value is selected.
+}
+
+// ModifierAdjusters describes the adjustments a modifier value applies to the product
+type ModifierAdjusters struct {
+	Price              *Adjuster           `json:"price,omitempty"`               // Price adjustment.
+	Weight             *Adjuster           `json:"weight,omitempty"`              // Weight adjustment.
+	ImageURL           string              `json:"image_url,omitempty"`           // Image shown when the value is selected.
+	PurchasingDisabled *PurchasingDisabled `json:"purchasing_disabled,omitempty"` // Disables purchasing when the value is selected.
+}
+
+// Adjuster describes a relative or percentage adjustment to a price or weight
+type Adjuster struct {
+	Adjuster      AdjusterType `json:"adjuster,omitempty"` // The type of adjustment.
+	AdjusterValue float64      `json:"adjuster_value"`     // The amount or percentage to adjust by. May be negative.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// PurchasingDisabled describes whether purchasing is disabled, and the message shown when it is
+type PurchasingDisabled struct {
+	Status  bool   `json:"status"`            // If true, the product cannot be purchased.
+	Message string `json:"message,omitempty"` // Message shown to the shopper.
+}
+
+// ModifierConfig describes the type-specific configuration of a Modifier. Only
+// the fields relevant to the modifier's Type are used.
+type ModifierConfig struct {
+	DefaultValue                string   `json:"default_value,omitempty"`                  // Default value of text, number and date modifiers.
+	CheckedByDefault            bool     `json:"checked_by_default,omitempty"`             // Whether a checkbox modifier starts checked.
+	CheckboxLabel               string   `json:"checkbox_label,omitempty"`                 // Label shown next to a checkbox.
+	DateLimited                 bool     `json:"date_limited,omitempty"`                   // Whether the selectable dates are limited.
+	DateLimitMode               string   `json:"date_limit_mode,omitempty"`                // One of earliest, range, latest.
+	DateEarliestValue           string   `json:"date_earliest_value,omitempty"`            // Earliest selectable date.
+	DateLatestValue             string   `json:"date_latest_value,omitempty"`              // Latest selectable date.
+	FileTypesMode               string   `json:"file_types_mode,omitempty"`                // One of specific, all.
+	FileTypesSupported          []string `json:"file_types_supported,omitempty"`           // File groups accepted: images, documents, other.
+	FileTypesOther              []string `json:"file_types_other,omitempty"`               // Extra file extensions accepted when "other" is supported.
+	FileMaxSize                 int64    `json:"file_max_size,omitempty"`                  // Maximum upload size, in kilobytes.
+	TextCharactersLimited       bool     `json:"text_characters_limited,omitempty"`        // Whether text length is limited.
+	TextMinLength               int64    `json:"text_min_length,omitempty"`                // Minimum text length.
+	TextMaxLength               int64    `json:"text_max_length,omitempty"`                // Maximum text length.
+	TextLinesLimited            bool     `json:"text_lines_limited,omitempty"`             // Whether multi-line text is limited in lines.
+	TextMaxLines                int64    `json:"text_max_lines,omitempty"`                 // Maximum number of lines.
+	NumberLimited               bool     `json:"number_limited,omitempty"`                 // Whether numbers are limited.
+	NumberLimitMode             string   `json:"number_limit_mode,omitempty"`              // One of lowest, highest, range.
+	NumberLowestValue           float64  `json:"number_lowest_value,omitempty"`            // Lowest allowed number.
+	NumberHighestValue          float64  `json:"number_highest_value,omitempty"`           // Highest allowed number.
+	NumberIntegersOnly          bool     `json:"number_integers_only,omitempty"`           // Whether only integers are accepted.
+	ProductListAdjustsInventory bool     `json:"product_list_adjusts_inventory,omitempty"` // Whether picking a listed product adjusts its inventory.
+	ProductListAdjustsPricing   bool     `json:"product_list_adjusts_pricing,omitempty"`   // Whether the listed product's price is added.
+	ProductListShippingCalc     string   `json:"product_list_shipping_calc,omitempty"`     // One of none, weight, package.
+}
+
+// ModifierType - The type of storefront input used for a modifier
+type ModifierType string
+
+// AdjusterType - How an Adjuster changes a price or weight
+type AdjusterType string
+
+const (
+	// DateModifier - a date picker
+	DateModifier ModifierType = "date"
+	// CheckboxModifier - a single checkbox
+	CheckboxModifier ModifierType = "checkbox"
+	// FileModifier - a file upload
+	FileModifier ModifierType = "file"
+	// TextModifier - a single-line text field
+	TextModifier ModifierType = "text"
+	// MultiLineTextModifier - a multi-line text area
+	MultiLineTextModifier ModifierType = "multi_line_text"
+	// NumbersOnlyTextModifier - a numeric text field
+	NumbersOnlyTextModifier ModifierType = "numbers_only_text"
+	// RadioButtonsModifier - a set of radio buttons
+	RadioButtonsModifier ModifierType = "radio_buttons"
+	// RectanglesModifier - a set of rectangle buttons
+	RectanglesModifier ModifierType = "rectangles"
+	// DropdownModifier - a drop-down list
+	DropdownModifier ModifierType = "dropdown"
+	// ProductListModifier - a list of other products
+	ProductListModifier ModifierType = "product_list"
+	// ProductListWithImagesModifier - a list of other products, with their images
+	ProductListWithImagesModifier ModifierType = "product_list_with_images"
+	// SwatchModifier - a set of color or pattern swatches
+	SwatchModifier ModifierType = "swatch"
+
+	// RelativeAdjuster - adds AdjusterValue to the price or weight
+	RelativeAdjuster AdjusterType = "relative"
+	// PercentageAdjuster - adjusts the price or weight by AdjusterValue percent
+	PercentageAdjuster AdjusterType = "percentage"
+)
+
+// List returns the modifiers of a product
+func (s *ModifierService) List(ctx context.Context, productID int64, opts *ListOptions) ([]*Modifier, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/modifiers", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var modifiers []*Modifier
+	resp, err := s.client.call(ctx, "GET", path, nil, &modifiers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return modifiers, resp, nil
+}
+
+// Get returns a single modifier of a product
+func (s *ModifierService) Get(ctx context.Context, productID, modifierID int64) (*Modifier, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d", productID, modifierID)
+	modifier := new(Modifier)
+	resp, err := s.client.call(ctx, "GET", path, nil, modifier)
+	if err != nil {
+		return nil, resp, err
+	}
+	return modifier, resp, nil
+}
+
+// Create adds a modifier to a product
+func (s *ModifierService) Create(ctx context.Context, productID int64, modifier *Modifier) (*Modifier, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers", productID)
+	created := new(Modifier)
+	resp, err := s.client.call(ctx, "POST", path, modifier, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a modifier of a product
+func (s *ModifierService) Update(ctx context.Context, productID, modifierID int64, modifier *Modifier) (*Modifier, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d", productID, modifierID)
+	updated := new(Modifier)
+	resp, err := s.client.call(ctx, "PUT", path, modifier, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a modifier from a product
+func (s *ModifierService) Delete(ctx context.Context, productID, modifierID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d", productID, modifierID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListValues returns the values of a modifier
+func (s *ModifierService) ListValues(ctx context.Context, productID, modifierID int64, opts *ListOptions) ([]*ModifierValue, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/modifiers/%d/values", productID, modifierID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []*ModifierValue
+	resp, err := s.client.call(ctx, "GET", path, nil, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// GetValue returns a single value of a modifier
+func (s *ModifierService) GetValue(ctx context.Context, productID, modifierID, valueID int64) (*ModifierValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, valueID)
+	value := new(ModifierValue)
+	resp, err := s.client.call(ctx, "GET", path, nil, value)
+	if err != nil {
+		return nil, resp, err
+	}
+	return value, resp, nil
+}
+
+// CreateValue adds a value to a modifier
+func (s *ModifierService) CreateValue(ctx context.Context, productID, modifierID int64, value *ModifierValue) (*ModifierValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d/values", productID, modifierID)
+	created := new(ModifierValue)
+	resp, err := s.client.call(ctx, "POST", path, value, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateValue modifies a value of a modifier
+func (s *ModifierService) UpdateValue(ctx context.Context, productID, modifierID, valueID int64, value *ModifierValue) (*ModifierValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, valueID)
+	updated := new(ModifierValue)
+	resp, err := s.client.call(ctx, "PUT", path, value, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteValue removes a value from a modifier
+func (s *ModifierService) DeleteValue(ctx context.Context, productID, modifierID, valueID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, valueID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,57 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestModifierService_CreateFileModifier(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Modifier{
+		DisplayName: "Upload your artwork",
+		Type:        FileModifier,
+		Required:    true,
+		Config: &ModifierConfig{
+			FileTypesMode:      "specific",
+			FileTypesSupported: []string{"images"},
+			FileMaxSize:        5120,
+		},
+	}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Modifier), input)
+		fmt.Fprint(w, `{"data":{"id":11,"product_id":32,"display_name":"Upload your artwork","type":"file","required":true,
+			"config":{"file_types_mode":"specific","file_types_supported":["images"],"file_max_size":5120}},"meta":{}}`)
+	})
+
+	modifier, _, err := client.Modifiers.Create(context.Background(), 32, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modifier.ID != 11 || modifier.Config.FileMaxSize != 5120 {
+		t.Errorf("Unexpected modifier %+v", modifier)
+	}
+}
+
+func TestModifierService_ListValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/modifiers/12/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":20,"label":"Gift wrap","sort_order":0,
+			"adjusters":{"price":{"adjuster":"relative","adjuster_value":4.5},"purchasing_disabled":{"status":false}}}],"meta":{}}`)
+	})
+
+	values, _, err := client.Modifiers.ListValues(context.Background(), 32, 12, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 1 || values[0].Label != "Gift wrap" || values[0].Adjusters.Price.Adjuster != RelativeAdjuster || values[0].Adjusters.Price.AdjusterValue != 4.5 {
+		t.Errorf("Unexpected values %+v", values)
+	}
+}
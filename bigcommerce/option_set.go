@@ -0,0 +1,125 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OptionSetService handles communication with the V2 option set endpoints. A
+// product's OptionSetID refers to an option set, which groups store options.
+type OptionSetService service
+
+// OptionSet describes a BigCommerce V2 Option Set Object
+type OptionSet struct {
+	ID      int64       `json:"id,omitempty"`      // The unique numerical ID of the option set.
+	Name    string      `json:"name,omitempty"`    // The unique name of the option set.
+	Options *BCResource `json:"options,omitempty"` // The option set's options resource. Read-only.
+}
+
+// OptionSetOption describes a store option included in an option set
+type OptionSetOption struct {
+	ID          int64  `json:"id,omitempty"`            // The unique numerical ID of the option set option.
+	OptionID    int64  `json:"option_id,omitempty"`     // The ID of the store option.
+	OptionSetID int64  `json:"option_set_id,omitempty"` // The ID of the option set.
+	DisplayName string `json:"display_name,omitempty"`  // Overrides the option's display name within the set.
+	SortOrder   int64  `json:"sort_order"`              // Order in which the option is displayed.
+	IsRequired  bool   `json:"is_required"`             // Whether shoppers must choose a value.
+}
+
+// List returns a page of option sets
+func (s *OptionSetService) List(ctx context.Context, opts *ListOptions) ([]*OptionSet, *Response, error) {
+	path, err := addOptions("v2/option_sets", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sets []*OptionSet
+	resp, err := s.client.call(ctx, "GET", path, nil, &sets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sets, resp, nil
+}
+
+// Get returns a single option set
+func (s *OptionSetService) Get(ctx context.Context, setID int64) (*OptionSet, *Response, error) {
+	set := new(OptionSet)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/option_sets/%d", setID), nil, set)
+	if err != nil {
+		return nil, resp, err
+	}
+	return set, resp, nil
+}
+
+// Create adds an option set. Name is required.
+func (s *OptionSetService) Create(ctx context.Context, set *OptionSet) (*OptionSet, *Response, error) {
+	created := new(OptionSet)
+	resp, err := s.client.call(ctx, "POST", "v2/option_sets", set, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies an option set
+func (s *OptionSetService) Update(ctx context.Context, setID int64, set *OptionSet) (*OptionSet, *Response, error) {
+	updated := new(OptionSet)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/option_sets/%d", setID), set, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes an option set
+func (s *OptionSetService) Delete(ctx context.Context, setID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/option_sets/%d", setID), nil, nil)
+}
+
+// ListOptions returns the options of an option set
+func (s *OptionSetService) ListOptions(ctx context.Context, setID int64, opts *ListOptions) ([]*OptionSetOption, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/option_sets/%d/options", setID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var options []*OptionSetOption
+	resp, err := s.client.call(ctx, "GET", path, nil, &options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
+
+// AddOption adds a store option to an option set. OptionID is required.
+func (s *OptionSetService) AddOption(ctx context.Context, setID int64, option *OptionSetOption) (*OptionSetOption, *Response, error) {
+	created := new(OptionSetOption)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/option_sets/%d/options", setID), option, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateOption modifies an option of an option set
+func (s *OptionSetService) UpdateOption(ctx context.Context, setID, id int64, option *OptionSetOption) (*OptionSetOption, *Response, error) {
+	updated := new(OptionSetOption)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/option_sets/%d/options/%d", setID, id), option, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// RemoveOption removes an option from an option set
+func (s *OptionSetService) RemoveOption(ctx context.Context, setID, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/option_sets/%d/options/%d", setID, id), nil, nil)
+}
+
+// ForProduct returns the option set applied to a V2 product, or nil if none is
+func (s *OptionSetService) ForProduct(ctx context.Context, product *Product) (*OptionSet, *Response, error) {
+	if product.OptionSetID == 0 {
+		return nil, nil, nil
+	}
+	return s.Get(ctx, product.OptionSetID)
+}
@@ -0,0 +1,46 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestOptionSetService_AddOption(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &OptionSetOption{OptionID: 3, DisplayName: "Size", SortOrder: 1, IsRequired: true}
+	mux.HandleFunc("/stores/abc123/v2/option_sets/8/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(OptionSetOption), input)
+		fmt.Fprint(w, `{"id":21,"option_id":3,"option_set_id":8,"display_name":"Size","sort_order":1,"is_required":true}`)
+	})
+
+	option, _, err := client.OptionSets.AddOption(context.Background(), 8, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.ID != 21 || option.OptionSetID != 8 || !option.IsRequired {
+		t.Errorf("Unexpected option %+v", option)
+	}
+}
+
+func TestOptionSetService_ForProduct(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/option_sets/8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":8,"name":"Shirts","options":{"url":"https://api.bigcommerce.com/stores/abc123/v2/option_sets/8/options.json","resource":"/option_sets/8/options"}}`)
+	})
+
+	set, _, err := client.OptionSets.ForProduct(context.Background(), &Product{OptionSetID: 8})
+	if err != nil || set.Name != "Shirts" || set.Options.Resource != "/option_sets/8/options" {
+		t.Errorf("Unexpected option set %+v, %v", set, err)
+	}
+	if set, _, err := client.OptionSets.ForProduct(context.Background(), &Product{}); set != nil || err != nil {
+		t.Errorf("Expected no option set, got %+v, %v", set, err)
+	}
+}
@@ -0,0 +1,306 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OrderService handles communication with the V2 order endpoints
+type OrderService service
+
+// Order describes a BigCommerce V2 Order Object. Amounts are decimal strings,
+// as returned by the API.
+type Order struct {
+	ID                    int64                  `json:"id,omitempty"`              // The unique numerical ID of the order.
+	CustomerID            int64                  `json:"customer_id,omitempty"`     // The ID of the customer, 0 for guest orders.
+	DateCreated           string                 `json:"date_created,omitempty"`    // Date the order was created, in RFC 2822 format.
+	DateModified          string                 `json:"date_modified,omitempty"`   // Date the order was last modified, in RFC 2822 format.
+	DateShipped           string                 `json:"date_shipped,omitempty"`    // Date the order was shipped. Read-only.
+	StatusID              OrderStatus            `json:"status_id,omitempty"`       // The ID of the order's status.
+	Status                string                 `json:"status,omitempty"`          // The name of the order's status. Read-only.
+	SubtotalExTax         string                 `json:"subtotal_ex_tax,omitempty"` // Subtotal, excluding tax.
+	SubtotalIncTax        string                 `json:"subtotal_inc_tax,omitempty"`
+	SubtotalTax           string                 `json:"subtotal_tax,omitempty"`
+	BaseShippingCost      string                 `json:"base_shipping_cost,omitempty"`
+	ShippingCostExTax     string                 `json:"shipping_cost_ex_tax,omitempty"`
+	ShippingCostIncTax    string                 `json:"shipping_cost_inc_tax,omitempty"`
+	BaseHandlingCost      string                 `json:"base_handling_cost,omitempty"`
+	HandlingCostExTax     string                 `json:"handling_cost_ex_tax,omitempty"`
+	HandlingCostIncTax    string                 `json:"handling_cost_inc_tax,omitempty"`
+	BaseWrappingCost      string                 `json:"base_wrapping_cost,omitempty"`
+	WrappingCostExTax     string                 `json:"wrapping_cost_ex_tax,omitempty"`
+	WrappingCostIncTax    string                 `json:"wrapping_cost_inc_tax,omitempty"`
+	TotalExTax            string                 `json:"total_ex_tax,omitempty"` // Order total, excluding tax.
+	TotalIncTax           string                 `json:"total_inc_tax,omitempty"`
+	TotalTax              string                 `json:"total_tax,omitempty"`
+	ItemsTotal            int64                  `json:"items_total,omitempty"`             // Number of items in the order. Read-only.
+	ItemsShipped          int64                  `json:"items_shipped,omitempty"`           // Number of items shipped. Read-only.
+	DiscountAmount        string                 `json:"discount_amount,omitempty"`         // Manual discount applied to the order.
+	CouponDiscount        string                 `json:"coupon_discount,omitempty"`         // Discount from coupons. Read-only.
+	RefundedAmount        string                 `json:"refunded_amount,omitempty"`         // Total amount refunded. Read-only.
+	StoreCreditAmount     string                 `json:"store_credit_amount,omitempty"`     // Store credit redeemed against the order.
+	GiftCertificateAmount string                 `json:"gift_certificate_amount,omitempty"` // Gift certificates redeemed against the order.
+	CurrencyCode          string                 `json:"currency_code,omitempty"`           // The currency the order was placed in.
+	CurrencyExchangeRate  string                 `json:"currency_exchange_rate,omitempty"`
+	DefaultCurrencyCode   string                 `json:"default_currency_code,omitempty"` // The store's default currency. Read-only.
+	PaymentMethod         string                 `json:"payment_method,omitempty"`        // The payment method used.
+	PaymentProviderID     string                 `json:"payment_provider_id,omitempty"`   // The payment provider's transaction ID.
+	PaymentStatus         string                 `json:"payment_status,omitempty"`        // The status of the payment. Read-only.
+	IPAddress             string                 `json:"ip_address,omitempty"`            // The shopper's IP address.
+	GeoIPCountry          string                 `json:"geoip_country,omitempty"`
+	CustomerMessage       string                 `json:"customer_message,omitempty"` // Message left by the shopper.
+	StaffNotes            string                 `json:"staff_notes,omitempty"`      // Notes visible to staff only.
+	OrderSource           string                 `json:"order_source,omitempty"`     // Where the order was placed, e.g. www or external. Read-only.
+	ExternalSource        string                 `json:"external_source,omitempty"`  // The system that placed an externally created order.
+	ExternalID            string                 `json:"external_id,omitempty"`      // The order's ID in an external system.
+	ChannelID             int64                  `json:"channel_id,omitempty"`       // The channel the order was placed on.
+	IsDeleted             bool                   `json:"is_deleted,omitempty"`       // Whether the order is archived. Read-only.
+	ShippingAddressCount  int64                  `json:"shipping_address_count,omitempty"`
+	BillingAddress        *Address               `json:"billing_address,omitempty"`    // The billing address. Required on create.
+	ShippingAddresses     OrderShippingAddresses `json:"shipping_addresses,omitempty"` // Shipping addresses. Only set on create; fetch them with their own endpoint.
+	Products              OrderProducts          `json:"products,omitempty"`           // Products. Required on create; fetch them with their own endpoint.
+}
+
+// MarshalJSON encodes the order with the billing address in V2 naming
+func (o Order) MarshalJSON() ([]byte, error) {
+	type order Order
+	return json.Marshal(&struct {
+		*order
+		BillingAddress *v2Address `json:"billing_address,omitempty"`
+	}{(*order)(&o), (*v2Address)(o.BillingAddress)})
+}
+
+// OrderProducts holds the products sent when creating an order. Orders list
+// their products as a resource link instead, which is ignored when decoding.
+type OrderProducts []*OrderProduct
+
+// UnmarshalJSON decodes a list of products, ignoring resource links
+func (p *OrderProducts) UnmarshalJSON(data []byte) error {
+	var products []*OrderProduct
+	if err := unmarshalResourceList(data, &products); err != nil {
+		return err
+	}
+	*p = products
+	return nil
+}
+
+// OrderShippingAddresses holds the shipping addresses sent when creating an
+// order. Orders list their addresses as a resource link instead, which is
+// ignored when decoding.
+type OrderShippingAddresses []*Address
+
+// UnmarshalJSON decodes a list of addresses, ignoring resource links
+func (a *OrderShippingAddresses) UnmarshalJSON(data []byte) error {
+	var addresses []*Address
+	if err := unmarshalResourceList(data, &addresses); err != nil {
+		return err
+	}
+	*a = addresses
+	return nil
+}
+
+// MarshalJSON encodes the addresses in V2 naming
+func (a OrderShippingAddresses) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	addresses := make([]*v2Address, len(a))
+	for i, addr := range a {
+		addresses[i] = (*v2Address)(addr)
+	}
+	return json.Marshal(addresses)
+}
+
+// unmarshalResourceList decodes a JSON array into v, leaving it untouched when
+// data is a V2 resource link such as {"url": ..., "resource": ...}
+func unmarshalResourceList(data []byte, v interface{}) error {
+	if data = bytes.TrimSpace(data); len(data) == 0 || data[0] != '[' {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// OrderStatus - The ID of an order status
+type OrderStatus int64
+
+const (
+	// IncompleteOrder - an order abandoned before payment
+	IncompleteOrder OrderStatus = 0
+	// PendingOrder - payment has not been confirmed
+	PendingOrder OrderStatus = 1
+	// ShippedOrder - every item has shipped
+	ShippedOrder OrderStatus = 2
+	// PartiallyShippedOrder - some items have shipped
+	PartiallyShippedOrder OrderStatus = 3
+	// RefundedOrder - the order has been refunded in full
+	RefundedOrder OrderStatus = 4
+	// CancelledOrder - the order has been cancelled
+	CancelledOrder OrderStatus = 5
+	// DeclinedOrder - payment was declined
+	DeclinedOrder OrderStatus = 6
+	// AwaitingPaymentOrder - waiting for an offline payment
+	AwaitingPaymentOrder OrderStatus = 7
+	// AwaitingPickupOrder - ready for the shopper to collect
+	AwaitingPickupOrder OrderStatus = 8
+	// AwaitingShipmentOrder - packed and ready to ship
+	AwaitingShipmentOrder OrderStatus = 9
+	// CompletedOrder - the order needs no further action
+	CompletedOrder OrderStatus = 10
+	// AwaitingFulfillmentOrder - paid, and waiting to be packed
+	AwaitingFulfillmentOrder OrderStatus = 11
+	// ManualVerificationRequiredOrder - payment needs to be verified by staff
+	ManualVerificationRequiredOrder OrderStatus = 12
+	// DisputedOrder - the payment is disputed
+	DisputedOrder OrderStatus = 13
+	// PartiallyRefundedOrder - part of the order has been refunded
+	PartiallyRefundedOrder OrderStatus = 14
+)
+
+// OrderListOptions specifies the optional parameters to OrderService.List and OrderService.Count
+type OrderListOptions struct {
+	ListOptions
+	MinID           int64        `url:"min_id,omitempty"`
+	MaxID           int64        `url:"max_id,omitempty"`
+	StatusID        *OrderStatus `url:"status_id,omitempty"` // A pointer, since incomplete orders have status 0.
+	CustomerID      int64        `url:"customer_id,omitempty"`
+	Email           string       `url:"email,omitempty"`
+	MinTotal        string       `url:"min_total,omitempty"`
+	MaxTotal        string       `url:"max_total,omitempty"`
+	MinDateCreated  time.Time    `url:"min_date_created,omitempty"`
+	MaxDateCreated  time.Time    `url:"max_date_created,omitempty"`
+	MinDateModified time.Time    `url:"min_date_modified,omitempty"`
+	MaxDateModified time.Time    `url:"max_date_modified,omitempty"`
+	PaymentMethod   string       `url:"payment_method,omitempty"`
+	ChannelID       int64        `url:"channel_id,omitempty"`
+	IsDeleted       *bool        `url:"is_deleted,omitempty"` // List archived orders.
+	Sort            string       `url:"sort,omitempty"`       // Field and direction, e.g. "date_created:desc".
+}
+
+// List returns a page of orders. The V2 API responds to pages past the end
+// with no content, returned as an empty list.
+func (s *OrderService) List(ctx context.Context, opts *OrderListOptions) ([]*Order, *Response, error) {
+	path, err := addOptions("v2/orders", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var orders []*Order
+	resp, err := s.client.call(ctx, "GET", path, nil, &orders)
+	if err != nil {
+		return nil, resp, err
+	}
+	return orders, resp, nil
+}
+
+// Count returns the number of orders matching opts
+func (s *OrderService) Count(ctx context.Context, opts *OrderListOptions) (int64, *Response, error) {
+	path, err := addOptions("v2/orders/count", opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := s.client.call(ctx, "GET", path, nil, &count)
+	if err != nil {
+		return 0, resp, err
+	}
+	return count.Count, resp, nil
+}
+
+// Get returns a single order
+func (s *OrderService) Get(ctx context.Context, id int64) (*Order, *Response, error) {
+	order := new(Order)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/orders/%d", id), nil, order)
+	if err != nil {
+		return nil, resp, err
+	}
+	return order, resp, nil
+}
+
+// Create places an order. BillingAddress and Products are required.
+func (s *OrderService) Create(ctx context.Context, order *Order) (*Order, *Response, error) {
+	if err := s.client.validateAddresses(ctx, orderAddresses(order)...); err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Order)
+	resp, err := s.client.call(ctx, "POST", "v2/orders", order, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies an order
+func (s *OrderService) Update(ctx context.Context, id int64, order *Order) (*Order, *Response, error) {
+	if err := s.client.validateAddressUpdates(ctx, orderAddresses(order)...); err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Order)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/orders/%d", id), order, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Archive moves an order to the archive. Archived orders are hidden from
+// lists unless OrderListOptions.IsDeleted is set, and can be restored from
+// the control panel.
+func (s *OrderService) Archive(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/orders/%d", id), nil, nil)
+}
+
+// Delete removes an order. The V2 API never deletes orders outright, so this
+// is the same as Archive.
+func (s *OrderService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.Archive(ctx, id)
+}
+
+// OrderShippingAddress describes a shipping address of an order, to which
+// its products are shipped by one or more shipments
+type OrderShippingAddress struct {
+	Address
+	ShippingMethod string `json:"shipping_method,omitempty"` // The method chosen at checkout, e.g. "Free Shipping".
+	ItemsTotal     int64  `json:"items_total,omitempty"`     // The number of items shipped to the address.
+	ItemsShipped   int64  `json:"items_shipped,omitempty"`   // The number of those items already shipped.
+	CostExTax      string `json:"cost_ex_tax,omitempty"`
+	CostIncTax     string `json:"cost_inc_tax,omitempty"`
+}
+
+// UnmarshalJSON decodes the address and its shipping details, which the
+// decoder of the embedded Address alone would drop
+func (a *OrderShippingAddress) UnmarshalJSON(data []byte) error {
+	if err := a.Address.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	details := struct {
+		ShippingMethod *string `json:"shipping_method"`
+		ItemsTotal     *int64  `json:"items_total"`
+		ItemsShipped   *int64  `json:"items_shipped"`
+		CostExTax      *string `json:"cost_ex_tax"`
+		CostIncTax     *string `json:"cost_inc_tax"`
+	}{&a.ShippingMethod, &a.ItemsTotal, &a.ItemsShipped, &a.CostExTax, &a.CostIncTax}
+	return json.Unmarshal(data, &details)
+}
+
+// ListShippingAddresses returns the shipping addresses of an order
+func (s *OrderService) ListShippingAddresses(ctx context.Context, orderID int64, opts *ListOptions) ([]*OrderShippingAddress, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/shipping_addresses", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addresses []*OrderShippingAddress
+	resp, err := s.client.call(ctx, "GET", path, nil, &addresses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return addresses, resp, nil
+}
@@ -0,0 +1,171 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// StaffNote is a structured entry appended to the staff notes of an order,
+// so notes added by scripts can be told apart and searched
+type StaffNote struct {
+	Time   time.Time // When the note was written, now if zero.
+	Tag    string    // A reference grouping related notes, e.g. an incident ID.
+	Author string    // Who or what wrote the note.
+	Text   string
+}
+
+// String formats the note as a single line, e.g.
+// "[2021-03-01 10:00 UTC] [INC-42] ops-bot: Refunded shipping"
+func (n StaffNote) String() string {
+	t := n.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s]", t.UTC().Format("2006-01-02 15:04 MST"))
+	if n.Tag != "" {
+		fmt.Fprintf(&b, " [%s]", n.Tag)
+	}
+	if n.Author != "" {
+		fmt.Fprintf(&b, " %s:", n.Author)
+	}
+	b.WriteString(" " + strings.ReplaceAll(n.Text, "\n", " "))
+	return b.String()
+}
+
+// MessageTemplate is a customer message whose subject and text are
+// text/template templates executed with the *Order, e.g.
+// "Hi {{.BillingAddress.FirstName}}, your order #{{.ID}} ships today."
+type MessageTemplate struct {
+	subject *template.Template
+	text    *template.Template
+}
+
+// NewMessageTemplate parses a message template. Referencing a field the order
+// does not have is an error when the template is executed.
+func NewMessageTemplate(subject, text string) (*MessageTemplate, error) {
+	s, err := template.New("subject").Option("missingkey=error").Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	t, err := template.New("text").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTemplate{subject: s, text: t}, nil
+}
+
+// Render returns the message for an order
+func (m *MessageTemplate) Render(order *Order) (*OrderMessage, error) {
+	var subject, text bytes.Buffer
+	if err := m.subject.Execute(&subject, order); err != nil {
+		return nil, err
+	}
+	if err := m.text.Execute(&text, order); err != nil {
+		return nil, err
+	}
+	return &OrderMessage{
+		OrderID:    order.ID,
+		CustomerID: order.CustomerID,
+		Type:       OwnerMessage,
+		Subject:    subject.String(),
+		Message:    text.String(),
+	}, nil
+}
+
+// OrderAnnotator appends staff notes and sends templated customer messages
+// to orders, e.g. to record what was done to each order during an incident
+// cleanup
+type OrderAnnotator struct {
+	Client *Client
+
+	// Limiter paces the orders annotated by AnnotateAll, each costing up to
+	// three requests, so bulk runs leave quota for other jobs. It is
+	// independent of the client's rate limiter; nil means no pacing.
+	Limiter *RateLimiter
+}
+
+// AnnotationResult describes the outcome of annotating a single order
+type AnnotationResult struct {
+	OrderID int64
+	Message *OrderMessage // The message sent, if any.
+	Err     error
+}
+
+// AppendNote adds a line to the staff notes of an order, keeping the
+// existing notes
+func (a *OrderAnnotator) AppendNote(ctx context.Context, orderID int64, note StaffNote) (*Order, error) {
+	order, _, err := a.Client.Orders.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return a.appendNote(ctx, order, note)
+}
+
+func (a *OrderAnnotator) appendNote(ctx context.Context, order *Order, note StaffNote) (*Order, error) {
+	notes := note.String()
+	if existing := strings.TrimRight(order.StaffNotes, "\n"); existing != "" {
+		notes = existing + "\n" + notes
+	}
+	updated, _, err := a.Client.Orders.Update(ctx, order.ID, &Order{StaffNotes: notes})
+	return updated, err
+}
+
+// SendMessage renders msg with the order's data and adds it to the order's
+// messages
+func (a *OrderAnnotator) SendMessage(ctx context.Context, orderID int64, msg *MessageTemplate) (*OrderMessage, error) {
+	order, _, err := a.Client.Orders.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return a.sendMessage(ctx, order, msg)
+}
+
+func (a *OrderAnnotator) sendMessage(ctx context.Context, order *Order, msg *MessageTemplate) (*OrderMessage, error) {
+	message, err := msg.Render(order)
+	if err != nil {
+		return nil, fmt.Errorf("bigcommerce: rendering message for order %d: %v", order.ID, err)
+	}
+	created, _, err := a.Client.Orders.CreateMessage(ctx, order.ID, message)
+	return created, err
+}
+
+// AnnotateAll appends note to each order and, if msg is not nil, sends it the
+// rendered message. Orders are processed one at a time, paced by Limiter;
+// failures are reported per order. The error is only set when ctx is done.
+func (a *OrderAnnotator) AnnotateAll(ctx context.Context, orderIDs []int64, note StaffNote, msg *MessageTemplate) ([]AnnotationResult, error) {
+	if a.Limiter != nil {
+		a.Limiter.init(a.Client.StoreHash)
+	}
+	if note.Time.IsZero() {
+		note.Time = time.Now()
+	}
+
+	results := make([]AnnotationResult, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		if a.Limiter != nil {
+			if err := a.Limiter.Wait(ctx); err != nil {
+				return results, err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		result := AnnotationResult{OrderID: id}
+		order, _, err := a.Client.Orders.Get(ctx, id)
+		if err == nil && msg != nil {
+			// Send first, so a note is only recorded for orders messaged
+			result.Message, err = a.sendMessage(ctx, order, msg)
+		}
+		if err == nil {
+			_, err = a.appendNote(ctx, order, note)
+		}
+		result.Err = err
+		results = append(results, result)
+	}
+	return results, nil
+}
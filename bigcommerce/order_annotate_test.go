@@ -0,0 +1,115 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStaffNote_String(t *testing.T) {
+	at := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		note StaffNote
+		want string
+	}{
+		{StaffNote{Time: at, Tag: "INC-42", Author: "ops-bot", Text: "Refunded shipping"}, "[2021-03-01 10:00 UTC] [INC-42] ops-bot: Refunded shipping"},
+		{StaffNote{Time: at, Text: "two\nlines"}, "[2021-03-01 10:00 UTC] two lines"},
+	}
+	for _, tt := range tests {
+		if got := tt.note.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMessageTemplate_Render(t *testing.T) {
+	tmpl, err := NewMessageTemplate("Order #{{.ID}}", "Hi {{.BillingAddress.FirstName}}, we refunded {{.CurrencyCode}} {{.ShippingCostIncTax}}.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := &Order{ID: 101, CustomerID: 7, CurrencyCode: "USD", ShippingCostIncTax: "5.00", BillingAddress: &Address{FirstName: "Jane"}}
+	msg, err := tmpl.Render(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Subject != "Order #101" || msg.Message != "Hi Jane, we refunded USD 5.00." || msg.CustomerID != 7 || msg.Type != OwnerMessage {
+		t.Errorf("Render = %+v", msg)
+	}
+
+	bad, _ := NewMessageTemplate("x", "{{.Nope}}")
+	if _, err := bad.Render(order); err == nil {
+		t.Error("Render succeeded with an unknown field")
+	}
+}
+
+func TestOrderAnnotator_AppendNote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/101", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"id":101,"staff_notes":"Called customer\n"}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Order), &Order{StaffNotes: "Called customer\n[2021-03-01 10:00 UTC] [INC-42] Reshipped"})
+		fmt.Fprint(w, `{"id":101}`)
+	})
+
+	annotator := &OrderAnnotator{Client: client}
+	note := StaffNote{Time: time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC), Tag: "INC-42", Text: "Reshipped"}
+	if _, err := annotator.AppendNote(context.Background(), 101, note); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOrderAnnotator_AnnotateAll(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	notes := map[string]string{}
+	var messages []*OrderMessage
+	for _, id := range []string{"101", "102"} {
+		id := id
+		mux.HandleFunc("/stores/abc123/v2/orders/"+id, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "GET" {
+				fmt.Fprintf(w, `{"id":%s,"customer_id":7,"billing_address":{"first_name":"Jane"}}`, id)
+				return
+			}
+			var order Order
+			json.NewDecoder(r.Body).Decode(&order)
+			notes[id] = order.StaffNotes
+			fmt.Fprintf(w, `{"id":%s}`, id)
+		})
+		mux.HandleFunc("/stores/abc123/v2/orders/"+id+"/messages", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			var msg OrderMessage
+			json.NewDecoder(r.Body).Decode(&msg)
+			messages = append(messages, &msg)
+			fmt.Fprint(w, `{"id":1}`)
+		})
+	}
+	mux.HandleFunc("/stores/abc123/v2/orders/103", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `[{"status":404,"message":"not found"}]`, http.StatusNotFound)
+	})
+
+	tmpl, _ := NewMessageTemplate("Your order", "Hi {{.BillingAddress.FirstName}}, order {{.ID}} was delayed.")
+	annotator := &OrderAnnotator{Client: client, Limiter: &RateLimiter{Rate: 1000, Burst: 10}}
+	results, err := annotator.AnnotateAll(context.Background(), []int64{101, 102, 103}, StaffNote{Tag: "INC-42", Text: "Delay notice sent"}, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || results[0].Err != nil || results[1].Err != nil || results[2].Err == nil {
+		t.Fatalf("results = %+v", results)
+	}
+	if len(messages) != 2 || messages[1].Message != "Hi Jane, order 102 was delayed." {
+		t.Errorf("messages = %+v", messages)
+	}
+	if !strings.HasSuffix(notes["101"], "[INC-42] Delay notice sent") || notes["101"] != notes["102"] {
+		t.Errorf("notes = %q", notes)
+	}
+}
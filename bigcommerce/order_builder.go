@@ -0,0 +1,191 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OrderBuilder assembles the payload of a new V2 order: its customer,
+// addresses, catalog or custom products and manual discount. Build checks the
+// fields the API requires, so incomplete orders fail before being sent.
+//
+//	order, _, err := client.Orders.NewBuilder().
+//		Customer(42).
+//		BillingAddress(billing).
+//		ShippingAddress(shipping).
+//		AddProduct(77, 2, OrderProductOption{ID: 113, Value: "1082"}).
+//		AddCustomProduct("Engraving", "ENGRAVE", 1, "10.00", "12.00").
+//		Discount("5.00").
+//		Create(ctx)
+type OrderBuilder struct {
+	service *OrderService
+	order   Order
+}
+
+// OrderValidationError describes why an OrderBuilder could not build an order
+type OrderValidationError struct {
+	Problems []OrderProblem
+}
+
+// OrderProblem describes a problem with a single field of an order
+type OrderProblem struct {
+	Field   string // The JSON path of the field, e.g. "products[1].quantity".
+	Message string
+}
+
+func (e *OrderValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.Field + ": " + p.Message
+	}
+	return "bigcommerce: invalid order: " + strings.Join(msgs, "; ")
+}
+
+// NewBuilder returns an OrderBuilder creating orders with this service
+func (s *OrderService) NewBuilder() *OrderBuilder {
+	return &OrderBuilder{service: s}
+}
+
+// Customer places the order for a registered customer. Orders without a
+// customer are guest orders.
+func (b *OrderBuilder) Customer(id int64) *OrderBuilder {
+	b.order.CustomerID = id
+	return b
+}
+
+// Status sets the status of the new order, pending by default
+func (b *OrderBuilder) Status(status OrderStatus) *OrderBuilder {
+	b.order.StatusID = status
+	return b
+}
+
+// BillingAddress sets the billing address. The email of the billing address
+// is the email the order confirmation is sent to.
+func (b *OrderBuilder) BillingAddress(addr *Address) *OrderBuilder {
+	b.order.BillingAddress = addr
+	return b
+}
+
+// ShippingAddress adds a shipping address. Orders without one are shipped to
+// the billing address.
+func (b *OrderBuilder) ShippingAddress(addr *Address) *OrderBuilder {
+	b.order.ShippingAddresses = append(b.order.ShippingAddresses, addr)
+	return b
+}
+
+// AddProduct adds a catalog product, priced by the store, with the values of
+// its options, e.g. OrderProductOption{ID: productOptionID, Value: valueID}
+func (b *OrderBuilder) AddProduct(productID, quantity int64, options ...OrderProductOption) *OrderBuilder {
+	b.order.Products = append(b.order.Products, &OrderProduct{ProductID: productID, Quantity: quantity, ProductOptions: options})
+	return b
+}
+
+// AddCustomProduct adds a product that is not in the catalog, at the given
+// prices excluding and including tax
+func (b *OrderBuilder) AddCustomProduct(name, sku string, quantity int64, priceExTax, priceIncTax string) *OrderBuilder {
+	b.order.Products = append(b.order.Products, &OrderProduct{
+		Name: name, SKU: sku, Quantity: quantity, PriceExTax: priceExTax, PriceIncTax: priceIncTax,
+	})
+	return b
+}
+
+// Discount sets a manual discount taken off the order total
+func (b *OrderBuilder) Discount(amount string) *OrderBuilder {
+	b.order.DiscountAmount = amount
+	return b
+}
+
+// With sets other fields of the order, e.g. its channel or staff notes
+func (b *OrderBuilder) With(fn func(*Order)) *OrderBuilder {
+	fn(&b.order)
+	return b
+}
+
+// Build returns the order payload, or an *OrderValidationError listing the
+// missing or invalid fields
+func (b *OrderBuilder) Build() (*Order, error) {
+	verr := &OrderValidationError{}
+	problem := func(field, format string, args ...interface{}) {
+		verr.Problems = append(verr.Problems, OrderProblem{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if b.order.BillingAddress == nil {
+		problem("billing_address", "is required")
+	} else {
+		checkOrderAddress(b.order.BillingAddress, "billing_address", true, problem)
+	}
+	for i, addr := range b.order.ShippingAddresses {
+		checkOrderAddress(addr, fmt.Sprintf("shipping_addresses[%d]", i), false, problem)
+	}
+
+	if len(b.order.Products) == 0 {
+		problem("products", "at least one product is required")
+	}
+	for i, p := range b.order.Products {
+		field := fmt.Sprintf("products[%d]", i)
+		if p.Quantity <= 0 {
+			problem(field+".quantity", "must be positive, got %d", p.Quantity)
+		}
+		if p.ProductID != 0 {
+			continue
+		}
+		if p.Name == "" {
+			problem(field+".name", "is required for custom products")
+		}
+		if !isAmount(p.PriceExTax) {
+			problem(field+".price_ex_tax", "must be a non-negative amount for custom products, got %q", p.PriceExTax)
+		}
+		if !isAmount(p.PriceIncTax) {
+			problem(field+".price_inc_tax", "must be a non-negative amount for custom products, got %q", p.PriceIncTax)
+		}
+	}
+
+	if b.order.DiscountAmount != "" && !isAmount(b.order.DiscountAmount) {
+		problem("discount_amount", "must be a non-negative amount, got %q", b.order.DiscountAmount)
+	}
+
+	if len(verr.Problems) > 0 {
+		return nil, verr
+	}
+	order := b.order
+	return &order, nil
+}
+
+// Create builds the order and places it with OrderService.Create
+func (b *OrderBuilder) Create(ctx context.Context) (*Order, *Response, error) {
+	order, err := b.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.service.Create(ctx, order)
+}
+
+// checkOrderAddress reports the missing required fields of an address
+func checkOrderAddress(addr *Address, field string, billing bool, problem func(field, format string, args ...interface{})) {
+	required := []struct{ name, value string }{
+		{"first_name", addr.FirstName},
+		{"last_name", addr.LastName},
+		{"street_1", addr.Address1},
+		{"city", addr.City},
+		{"zip", addr.PostalCode},
+	}
+	if billing {
+		required = append(required, struct{ name, value string }{"email", addr.Email})
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			problem(field+"."+r.name, "is required")
+		}
+	}
+	if addr.Country == "" && addr.CountryCode == "" {
+		problem(field+".country", "is required")
+	}
+}
+
+// isAmount reports whether s is a non-negative decimal amount
+func isAmount(s string) bool {
+	f, err := strconv.ParseFloat(s, 64)
+	return err == nil && f >= 0
+}
@@ -0,0 +1,89 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func testBillingAddress() *Address {
+	return &Address{
+		FirstName: "Jane", LastName: "Doe", Address1: "1 Main St", City: "Austin", StateOrProvince: "Texas", PostalCode: "78701",
+		CountryCode: "US", Email: "jane@example.com",
+	}
+}
+
+func TestOrderBuilder_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	shipping := &Address{FirstName: "John", LastName: "Doe", Address1: "2 Side St", City: "Boston", PostalCode: "02101", Country: "United States"}
+	want := &Order{
+		CustomerID:        42,
+		StatusID:          AwaitingFulfillmentOrder,
+		BillingAddress:    testBillingAddress(),
+		ShippingAddresses: OrderShippingAddresses{shipping},
+		Products: OrderProducts{
+			{ProductID: 77, Quantity: 2, ProductOptions: []OrderProductOption{{ID: 113, Value: "1082"}}},
+			{Name: "Engraving", SKU: "ENGRAVE", Quantity: 1, PriceExTax: "10.00", PriceIncTax: "12.00"},
+		},
+		DiscountAmount: "5.00",
+		StaffNotes:     "Imported from POS",
+	}
+	mux.HandleFunc("/stores/abc123/v2/orders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Order), want)
+		fmt.Fprint(w, `{"id":100,"customer_id":42,"status_id":11,"discount_amount":"5.0000"}`)
+	})
+
+	order, _, err := client.Orders.NewBuilder().
+		Customer(42).
+		Status(AwaitingFulfillmentOrder).
+		BillingAddress(testBillingAddress()).
+		ShippingAddress(shipping).
+		AddProduct(77, 2, OrderProductOption{ID: 113, Value: "1082"}).
+		AddCustomProduct("Engraving", "ENGRAVE", 1, "10.00", "12.00").
+		Discount("5.00").
+		With(func(o *Order) { o.StaffNotes = "Imported from POS" }).
+		Create(context.Background())
+	if err != nil || order.ID != 100 {
+		t.Errorf("Create = %+v, %v", order, err)
+	}
+}
+
+func TestOrderBuilder_Build(t *testing.T) {
+	client := NewClient("abc123", "token")
+
+	billing := testBillingAddress()
+	billing.Email, billing.CountryCode = "", ""
+	_, err := client.Orders.NewBuilder().
+		BillingAddress(billing).
+		ShippingAddress(&Address{FirstName: "John", LastName: "Doe", City: "Boston", PostalCode: "02101", CountryCode: "US"}).
+		AddProduct(77, 0).
+		AddCustomProduct("", "", 1, "ten", "").
+		Discount("-1").
+		Build()
+
+	var verr *OrderValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Build returned %v, want an OrderValidationError", err)
+	}
+	var fields []string
+	for _, p := range verr.Problems {
+		fields = append(fields, p.Field)
+	}
+	want := []string{
+		"billing_address.email", "billing_address.country", "shipping_addresses[0].street_1", "products[0].quantity",
+		"products[1].name", "products[1].price_ex_tax", "products[1].price_inc_tax", "discount_amount",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Problems with %v, want %v", fields, want)
+	}
+
+	if _, err := client.Orders.NewBuilder().Build(); err == nil || err.Error() != "bigcommerce: invalid order: billing_address: is required; products: at least one product is required" {
+		t.Errorf("Build of an empty order returned %v", err)
+	}
+}
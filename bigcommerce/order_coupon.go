@@ -0,0 +1,50 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrderCoupon describes a coupon redeemed against a V2 order. Read-only.
+type OrderCoupon struct {
+	ID       int64           `json:"id"`        // The unique numerical ID of the order coupon.
+	CouponID int64           `json:"coupon_id"` // The ID of the coupon.
+	OrderID  int64           `json:"order_id"`  // The ID of the order.
+	Code     string          `json:"code"`      // The code entered by the shopper.
+	Amount   string          `json:"amount"`    // The coupon's configured amount or percentage.
+	Type     OrderCouponType `json:"type"`      // How the coupon discounts the order.
+	Discount float64         `json:"discount"`  // The amount taken off the order.
+}
+
+// OrderCouponType - How an OrderCoupon discounts an order
+type OrderCouponType int64
+
+const (
+	// PerItemDiscountCoupon - an amount off each item
+	PerItemDiscountCoupon OrderCouponType = 0
+	// PercentageDiscountCoupon - a percentage off each item
+	PercentageDiscountCoupon OrderCouponType = 1
+	// PerTotalDiscountCoupon - an amount off the order total
+	PerTotalDiscountCoupon OrderCouponType = 2
+	// ShippingDiscountCoupon - an amount off shipping
+	ShippingDiscountCoupon OrderCouponType = 3
+	// FreeShippingCoupon - free shipping
+	FreeShippingCoupon OrderCouponType = 4
+	// PromotionCoupon - a promotion applied by coupon code
+	PromotionCoupon OrderCouponType = 5
+)
+
+// ListCoupons returns the coupons redeemed against an order
+func (s *OrderService) ListCoupons(ctx context.Context, orderID int64, opts *ListOptions) ([]*OrderCoupon, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/coupons", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var coupons []*OrderCoupon
+	resp, err := s.client.call(ctx, "GET", path, nil, &coupons)
+	if err != nil {
+		return nil, resp, err
+	}
+	return coupons, resp, nil
+}
@@ -0,0 +1,28 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrderService_ListCoupons(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/coupons", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"coupon_id":5,"order_id":100,"code":"TEN","amount":"10.0000","type":1,"discount":4.5}]`)
+	})
+
+	coupons, _, err := client.Orders.ListCoupons(context.Background(), 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*OrderCoupon{{ID: 1, CouponID: 5, OrderID: 100, Code: "TEN", Amount: "10.0000", Type: PercentageDiscountCoupon, Discount: 4.5}}
+	if !reflect.DeepEqual(coupons, want) {
+		t.Errorf("ListCoupons = %+v, want %+v", coupons, want)
+	}
+}
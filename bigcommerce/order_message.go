@@ -0,0 +1,81 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OrderMessage describes a message between the store's staff and a shopper
+// about a V2 order
+type OrderMessage struct {
+	ID          int64              `json:"id,omitempty"`           // The unique numerical ID of the message.
+	OrderID     int64              `json:"order_id,omitempty"`     // The ID of the order.
+	StaffID     int64              `json:"staff_id,omitempty"`     // The staff user who wrote or replied to the message.
+	CustomerID  int64              `json:"customer_id,omitempty"`  // The customer who wrote or received the message.
+	Type        OrderMessageType   `json:"type,omitempty"`         // Who wrote the message.
+	Subject     string             `json:"subject,omitempty"`      // The message's subject.
+	Message     string             `json:"message"`                // The message's text.
+	Status      OrderMessageStatus `json:"status,omitempty"`       // Whether staff have read the message.
+	IsFlagged   bool               `json:"is_flagged"`             // Whether staff have flagged the message for follow-up.
+	DateCreated string             `json:"date_created,omitempty"` // RFC 2822 date. Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// OrderMessageType - Who wrote an OrderMessage
+type OrderMessageType string
+
+// OrderMessageStatus - Whether an OrderMessage has been read
+type OrderMessageStatus string
+
+const (
+	// CustomerMessage - written by the shopper
+	CustomerMessage OrderMessageType = "customer"
+	// OwnerMessage - written by the store's staff
+	OwnerMessage OrderMessageType = "owner"
+
+	// ReadMessage - read by staff
+	ReadMessage OrderMessageStatus = "read"
+	// UnreadMessage - not yet read by staff
+	UnreadMessage OrderMessageStatus = "unread"
+)
+
+// OrderMessageListOptions specifies the optional parameters to OrderService.ListMessages
+type OrderMessageListOptions struct {
+	ListOptions
+	MinID          int64              `url:"min_id,omitempty"`
+	MaxID          int64              `url:"max_id,omitempty"`
+	CustomerID     int64              `url:"customer_id,omitempty"`
+	MinDateCreated time.Time          `url:"min_date_created,omitempty"`
+	MaxDateCreated time.Time          `url:"max_date_created,omitempty"`
+	IsFlagged      *bool              `url:"is_flagged,omitempty"`
+	Status         OrderMessageStatus `url:"status,omitempty"`
+}
+
+// ListMessages returns the messages of an order
+func (s *OrderService) ListMessages(ctx context.Context, orderID int64, opts *OrderMessageListOptions) ([]*OrderMessage, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/messages", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var messages []*OrderMessage
+	resp, err := s.client.call(ctx, "GET", path, nil, &messages)
+	if err != nil {
+		return nil, resp, err
+	}
+	return messages, resp, nil
+}
+
+// CreateMessage adds a message to an order. Staff replies set Type to
+// OwnerMessage and StaffID; messages logged on behalf of the shopper set Type
+// to CustomerMessage.
+func (s *OrderService) CreateMessage(ctx context.Context, orderID int64, message *OrderMessage) (*OrderMessage, *Response, error) {
+	created := new(OrderMessage)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/orders/%d/messages", orderID), message, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
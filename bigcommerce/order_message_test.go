@@ -0,0 +1,50 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrderService_ListMessages(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"status": "unread", "is_flagged": "true"})
+		fmt.Fprint(w, `[{"id":3,"order_id":100,"staff_id":0,"customer_id":7,"type":"customer","subject":"Gift wrap","message":"Can you gift wrap this?",
+			"status":"unread","is_flagged":true,"date_created":"Tue, 05 Jan 2021 10:00:00 +0000","customer":{"id":7}}]`)
+	})
+
+	messages, _, err := client.Orders.ListMessages(context.Background(), 100, &OrderMessageListOptions{Status: UnreadMessage, IsFlagged: Bool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*OrderMessage{{
+		ID: 3, OrderID: 100, CustomerID: 7, Type: CustomerMessage, Subject: "Gift wrap", Message: "Can you gift wrap this?",
+		Status: UnreadMessage, IsFlagged: true, DateCreated: "Tue, 05 Jan 2021 10:00:00 +0000",
+	}}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("ListMessages = %+v, want %+v", messages, want)
+	}
+}
+
+func TestOrderService_CreateMessage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &OrderMessage{StaffID: 2, Type: OwnerMessage, Subject: "Re: Gift wrap", Message: "Done!"}
+	mux.HandleFunc("/stores/abc123/v2/orders/100/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(OrderMessage), input)
+		fmt.Fprint(w, `{"id":4,"order_id":100,"staff_id":2,"type":"owner","subject":"Re: Gift wrap","message":"Done!","status":"read"}`)
+	})
+
+	message, _, err := client.Orders.CreateMessage(context.Background(), 100, input)
+	if err != nil || message.ID != 4 || message.Status != ReadMessage {
+		t.Errorf("CreateMessage = %+v, %v", message, err)
+	}
+}
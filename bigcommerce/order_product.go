@@ -0,0 +1,130 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrderProduct describes a product line of a V2 order. Catalog products are
+// added by ProductID; custom products by Name and price. Read-only fields are
+// ignored on create.
+type OrderProduct struct {
+	ID                   int64                  `json:"id,omitempty"`       // The unique numerical ID of the order product. Read-only.
+	OrderID              int64                  `json:"order_id,omitempty"` // Read-only.
+	ProductID            int64                  `json:"product_id,omitempty"`
+	VariantID            int64                  `json:"variant_id,omitempty"`
+	OrderAddressID       int64                  `json:"order_address_id,omitempty"` // The shipping address the product ships to.
+	Name                 string                 `json:"name,omitempty"`
+	NameCustomer         string                 `json:"name_customer,omitempty"` // The name shown to the shopper. Read-only.
+	NameMerchant         string                 `json:"name_merchant,omitempty"` // The name shown in the control panel. Read-only.
+	SKU                  string                 `json:"sku,omitempty"`
+	UPC                  string                 `json:"upc,omitempty"`
+	Type                 string                 `json:"type,omitempty"` // Either physical or digital. Read-only.
+	Brand                string                 `json:"brand,omitempty"`
+	Quantity             int64                  `json:"quantity"`
+	QuantityShipped      int64                  `json:"quantity_shipped,omitempty"`  // Read-only.
+	QuantityRefunded     int64                  `json:"quantity_refunded,omitempty"` // Read-only.
+	BasePrice            string                 `json:"base_price,omitempty"`        // The product's price before options and discounts. Read-only.
+	PriceExTax           string                 `json:"price_ex_tax,omitempty"`
+	PriceIncTax          string                 `json:"price_inc_tax,omitempty"`
+	PriceTax             string                 `json:"price_tax,omitempty"` // Read-only.
+	BaseTotal            string                 `json:"base_total,omitempty"`
+	TotalExTax           string                 `json:"total_ex_tax,omitempty"`
+	TotalIncTax          string                 `json:"total_inc_tax,omitempty"`
+	TotalTax             string                 `json:"total_tax,omitempty"`
+	BaseCostPrice        string                 `json:"base_cost_price,omitempty"`
+	Weight               float64                `json:"weight,omitempty"`
+	Width                string                 `json:"width,omitempty"`
+	Height               string                 `json:"height,omitempty"`
+	Depth                string                 `json:"depth,omitempty"`
+	FixedShippingCost    string                 `json:"fixed_shipping_cost,omitempty"`
+	IsRefunded           bool                   `json:"is_refunded,omitempty"` // Read-only.
+	RefundAmount         string                 `json:"refund_amount,omitempty"`
+	ReturnID             int64                  `json:"return_id,omitempty"`
+	WrappingName         string                 `json:"wrapping_name,omitempty"` // The gift wrapping chosen, if any.
+	WrappingMessage      string                 `json:"wrapping_message,omitempty"`
+	BaseWrappingCost     string                 `json:"base_wrapping_cost,omitempty"`
+	EventName            string                 `json:"event_name,omitempty"`              // The label of the product's event date field, e.g. "Delivery date".
+	EventDate            string                 `json:"event_date,omitempty"`              // The date chosen by the shopper, if the product has an event date.
+	IsBundledProduct     bool                   `json:"is_bundled_product,omitempty"`      // Whether the product was added by a product list option. Read-only.
+	ParentOrderProductID int64                  `json:"parent_order_product_id,omitempty"` // The product whose option added this one. Read-only.
+	GiftCertificateID    int64                  `json:"gift_certificate_id,omitempty"`
+	FulfillmentSource    string                 `json:"fulfillment_source,omitempty"`
+	AppliedDiscounts     []OrderProductDiscount `json:"applied_discounts,omitempty"` // Discounts applied to the product. Read-only.
+	ProductOptions       []OrderProductOption   `json:"product_options,omitempty"`   // The options chosen for the product.
+	ConfigurableFields   []OrderProductField    `json:"configurable_fields,omitempty"`
+}
+
+// OrderProductOption describes an option chosen for an order product. On
+// create only ID, the ID of the product option, and Value are sent; when read
+// ID is the ID of the order product option.
+type OrderProductOption struct {
+	ID              int64  `json:"id"`                          // The ID of the product option on create, of the order product option when read.
+	Value           string `json:"value"`                       // The ID of the chosen option value, or the text entered.
+	OptionID        int64  `json:"option_id,omitempty"`         // The ID of the store option. Read-only.
+	OrderProductID  int64  `json:"order_product_id,omitempty"`  // Read-only.
+	ProductOptionID int64  `json:"product_option_id,omitempty"` // Read-only.
+	DisplayName     string `json:"display_name,omitempty"`      // The option's name, e.g. "Size". Read-only.
+	DisplayValue    string `json:"display_value,omitempty"`     // The chosen value, e.g. "Large". Read-only.
+	Type            string `json:"type,omitempty"`              // The option's type, e.g. "Multiple choice". Read-only.
+	Name            string `json:"name,omitempty"`              // The option's unique name. Read-only.
+	DisplayStyle    string `json:"display_style,omitempty"`     // e.g. "Pick list" or "Rectangle". Read-only.
+}
+
+// OrderProductDiscount describes a discount applied to an order product
+type OrderProductDiscount struct {
+	ID     string `json:"id"`             // The ID of the promotion, or "coupon" and "manual-discount" for those discounts.
+	Amount string `json:"amount"`         // The amount taken off the product.
+	Name   string `json:"name,omitempty"` // The name of the promotion or coupon.
+	Code   string `json:"code,omitempty"` // The coupon code, for coupon discounts.
+	Target string `json:"target"`         // Either "order" or "product".
+}
+
+// OrderProductField describes a configurable field filled in for an order product
+type OrderProductField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ListProducts returns a page of the products of an order
+func (s *OrderService) ListProducts(ctx context.Context, orderID int64, opts *ListOptions) ([]*OrderProduct, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/products", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []*OrderProduct
+	resp, err := s.client.call(ctx, "GET", path, nil, &products)
+	if err != nil {
+		return nil, resp, err
+	}
+	return products, resp, nil
+}
+
+// ListAllProducts returns every product of an order
+func (s *OrderService) ListAllProducts(ctx context.Context, orderID int64) ([]*OrderProduct, error) {
+	var products []*OrderProduct
+	opts := &ListOptions{Page: 1, Limit: 250}
+	for {
+		page, _, err := s.ListProducts(ctx, orderID, opts)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, page...)
+		if len(page) < opts.Limit {
+			return products, nil
+		}
+		opts.Page++
+	}
+}
+
+// GetProduct returns a single product of an order
+func (s *OrderService) GetProduct(ctx context.Context, orderID, productID int64) (*OrderProduct, *Response, error) {
+	path := fmt.Sprintf("v2/orders/%d/products/%d", orderID, productID)
+	product := new(OrderProduct)
+	resp, err := s.client.call(ctx, "GET", path, nil, product)
+	if err != nil {
+		return nil, resp, err
+	}
+	return product, resp, nil
+}
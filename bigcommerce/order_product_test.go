@@ -0,0 +1,58 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrderService_ListProducts(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"page": "1", "limit": "250"})
+		fmt.Fprint(w, `[{"id":5,"order_id":100,"product_id":32,"variant_id":70,"name":"Shirt","sku":"SHIRT-RED-L","quantity":2,
+			"price_inc_tax":"20.0000","event_name":"Delivery date","event_date":"2021-12-24T00:00:00+00:00",
+			"applied_discounts":[{"id":"coupon","amount":"4.0000","name":"10% off","code":"TEN","target":"order"}],
+			"product_options":[{"id":9,"option_id":3,"order_product_id":5,"product_option_id":11,"display_name":"Size","display_value":"Large","value":"70","type":"Multiple choice","name":"Size","display_style":"Rectangle"}]}]`)
+	})
+
+	products, err := client.Orders.ListAllProducts(context.Background(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*OrderProduct{{
+		ID: 5, OrderID: 100, ProductID: 32, VariantID: 70, Name: "Shirt", SKU: "SHIRT-RED-L", Quantity: 2,
+		PriceIncTax: "20.0000", EventName: "Delivery date", EventDate: "2021-12-24T00:00:00+00:00",
+		AppliedDiscounts: []OrderProductDiscount{{ID: "coupon", Amount: "4.0000", Name: "10% off", Code: "TEN", Target: "order"}},
+		ProductOptions: []OrderProductOption{{
+			ID: 9, Value: "70", OptionID: 3, OrderProductID: 5, ProductOptionID: 11, DisplayName: "Size", DisplayValue: "Large",
+			Type: "Multiple choice", Name: "Size", DisplayStyle: "Rectangle",
+		}},
+	}}
+	if !reflect.DeepEqual(products, want) {
+		t.Errorf("ListAllProducts = %+v, want %+v", products, want)
+	}
+}
+
+func TestOrderService_GetProduct(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/products/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":5,"order_id":100,"quantity":2,"quantity_shipped":1}`)
+	})
+
+	product, _, err := client.Orders.GetProduct(context.Background(), 100, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.ID != 5 || product.QuantityShipped != 1 {
+		t.Errorf("GetProduct = %+v", product)
+	}
+}
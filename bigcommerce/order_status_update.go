@@ -0,0 +1,211 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// OrderStatusUpdater moves orders to a status in bulk, e.g. from Awaiting
+// Shipment to Shipped once a 3PL has dispatched a batch. Each order is updated
+// on its own, retrying on the rate limit, and its outcome is reported. With a
+// Store, orders updated are recorded under the job's name, so running the same
+// job again after a failure or crash only updates the orders left.
+type OrderStatusUpdater struct {
+	Client      *Client
+	Store       KVStore // Optional progress store, for resuming jobs.
+	Job         string  // The job's name, keying its progress. Required with Store.
+	Concurrency int     // Number of updates made at once, 4 if zero.
+}
+
+// OrderStatusResult is the outcome of moving one order
+type OrderStatusResult struct {
+	OrderID int64
+	From    OrderStatus // The order's status before the update, -1 if unknown.
+	Skipped bool        // Whether the order was already at the status, or updated by an earlier run of the job.
+	Err     error       // Nil if the order is at the status.
+}
+
+// OrderStatusReport is the outcome of a bulk status update
+type OrderStatusReport struct {
+	Job      string
+	Status   OrderStatus
+	Started  time.Time
+	Finished time.Time
+	Results  []OrderStatusResult // Ordered by order ID.
+}
+
+// Counts returns the number of orders updated, skipped and failed
+func (r *OrderStatusReport) Counts() (updated, skipped, failed int) {
+	for _, result := range r.Results {
+		switch {
+		case result.Err != nil:
+			failed++
+		case result.Skipped:
+			skipped++
+		default:
+			updated++
+		}
+	}
+	return updated, skipped, failed
+}
+
+// Failed returns the IDs of the orders that could not be updated, to retry
+// or investigate
+func (r *OrderStatusReport) Failed() []int64 {
+	var ids []int64
+	for _, result := range r.Results {
+		if result.Err != nil {
+			ids = append(ids, result.OrderID)
+		}
+	}
+	return ids
+}
+
+// WriteCSV writes the report as CSV, one row per order with the columns
+// order_id, from_status, to_status, outcome and error
+func (r *OrderStatusReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"order_id", "from_status", "to_status", "outcome", "error"})
+	for _, result := range r.Results {
+		outcome, msg := "updated", ""
+		switch {
+		case result.Err != nil:
+			outcome, msg = "failed", result.Err.Error()
+		case result.Skipped:
+			outcome = "skipped"
+		}
+		from := ""
+		if result.From >= 0 {
+			from = strconv.FormatInt(int64(result.From), 10)
+		}
+		cw.Write([]string{strconv.FormatInt(result.OrderID, 10), from, strconv.FormatInt(int64(r.Status), 10), outcome, msg})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Run moves every order matching filter to status. Matching orders are listed
+// before any is updated, so updates do not shift the pages of the filter.
+func (u *OrderStatusUpdater) Run(ctx context.Context, filter *OrderListOptions, status OrderStatus) (*OrderStatusReport, error) {
+	list := &OrderListOptions{}
+	if filter != nil {
+		*list = *filter
+	}
+	list.Page, list.Limit = 1, 250
+
+	var orders []OrderStatusResult
+	for {
+		page, _, err := u.Client.Orders.List(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range page {
+			orders = append(orders, OrderStatusResult{OrderID: o.ID, From: o.StatusID})
+		}
+		if len(page) < list.Limit {
+			break
+		}
+		list.Page++
+	}
+	return u.update(ctx, orders, status)
+}
+
+// RunIDs moves the orders with the given IDs to status. Each order is read
+// first, to skip orders already at the status.
+func (u *OrderStatusUpdater) RunIDs(ctx context.Context, orderIDs []int64, status OrderStatus) (*OrderStatusReport, error) {
+	orders := make([]OrderStatusResult, len(orderIDs))
+	for i, id := range orderIDs {
+		orders[i] = OrderStatusResult{OrderID: id, From: -1}
+	}
+	return u.update(ctx, orders, status)
+}
+
+func (u *OrderStatusUpdater) update(ctx context.Context, orders []OrderStatusResult, status OrderStatus) (*OrderStatusReport, error) {
+	if status == IncompleteOrder {
+		return nil, errors.New("bigcommerce: orders cannot be moved to the incomplete status")
+	}
+	if u.Store != nil && u.Job == "" {
+		return nil, errors.New("bigcommerce: a bulk status update with a progress store needs a job name")
+	}
+	report := &OrderStatusReport{Job: u.Job, Status: status, Started: time.Now()}
+
+	done := map[int64]bool{}
+	if u.Store != nil {
+		prefix := u.prefix(status)
+		keys, err := u.Store.Keys(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if id, err := strconv.ParseInt(key[len(prefix):], 10, 64); err == nil {
+				done[id] = true
+			}
+		}
+	}
+
+	workers := 4
+	if u.Concurrency > 0 {
+		workers = u.Concurrency
+	}
+	report.Results = make([]OrderStatusResult, len(orders))
+	forEach(ctx, len(orders), workers, func(i int) {
+		result := orders[i]
+		if done[result.OrderID] {
+			result.Skipped = true
+		} else {
+			result = u.move(ctx, result, status)
+		}
+		report.Results[i] = result
+	}, func(i int) {
+		// Orders never handed to a worker are reported too, as failed
+		report.Results[i] = orders[i]
+		report.Results[i].Err = ctx.Err()
+	})
+
+	sort.Slice(report.Results, func(i, j int) bool { return report.Results[i].OrderID < report.Results[j].OrderID })
+	report.Finished = time.Now()
+	return report, ctx.Err()
+}
+
+// move updates one order, recording it as done in the progress store
+func (u *OrderStatusUpdater) move(ctx context.Context, result OrderStatusResult, status OrderStatus) OrderStatusResult {
+	if result.From < 0 {
+		result.Err = retryRateLimited(ctx, func() error {
+			order, _, err := u.Client.Orders.Get(ctx, result.OrderID)
+			if err == nil {
+				result.From = order.StatusID
+			}
+			return err
+		})
+		if result.Err != nil {
+			return result
+		}
+	}
+	if result.From == status {
+		result.Skipped = true
+	} else {
+		result.Err = retryRateLimited(ctx, func() error {
+			_, _, err := u.Client.Orders.Update(ctx, result.OrderID, &Order{StatusID: status})
+			return err
+		})
+	}
+	if result.Err == nil && u.Store != nil {
+		key := u.prefix(status) + strconv.FormatInt(result.OrderID, 10)
+		if err := u.Store.Put(ctx, key, []byte(strconv.FormatInt(int64(status), 10))); err != nil {
+			result.Err = fmt.Errorf("bigcommerce: order %d updated, but recording progress failed: %v", result.OrderID, err)
+		}
+	}
+	return result
+}
+
+// prefix returns the progress keys of the orders the job moved to status, so
+// that reusing a job name for another status does not skip its orders
+func (u *OrderStatusUpdater) prefix(status OrderStatus) string {
+	return fmt.Sprintf("order_status/%s/%d/", u.Job, status)
+}
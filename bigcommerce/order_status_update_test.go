@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestOrderStatusUpdater_Run(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"status_id": "9", "page": "1", "limit": "250"})
+		fmt.Fprint(w, `[{"id":1,"status_id":9},{"id":2,"status_id":9},{"id":3,"status_id":2},{"id":4,"status_id":9}]`)
+	})
+	var mu sync.Mutex
+	updates := map[string]int{}
+	for _, id := range []string{"1", "2", "4"} {
+		id := id
+		mux.HandleFunc("/stores/abc123/v2/orders/"+id, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, new(Order), &Order{StatusID: ShippedOrder})
+			mu.Lock()
+			updates[id]++
+			n := updates[id]
+			mu.Unlock()
+			switch {
+			case id == "2" && n == 1:
+				w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+			case id == "4":
+				w.WriteHeader(http.StatusInternalServerError)
+			default:
+				fmt.Fprintf(w, `{"id":%s,"status_id":2}`, id)
+			}
+		})
+	}
+
+	store := NewMemoryKVStore()
+	u := &OrderStatusUpdater{Client: client, Store: store, Job: "3pl-batch-7"}
+	status := AwaitingShipmentOrder
+	report, err := u.Run(context.Background(), &OrderListOptions{StatusID: &status}, ShippedOrder)
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if updated, skipped, failed := report.Counts(); updated != 2 || skipped != 1 || failed != 1 {
+		t.Errorf("Counts = %d, %d, %d, want 2, 1, 1", updated, skipped, failed)
+	}
+	if failed := report.Failed(); len(failed) != 1 || failed[0] != 4 {
+		t.Errorf("Failed = %v, want [4]", failed)
+	}
+	if keys, _ := store.Keys(context.Background(), "order_status/3pl-batch-7/2/"); len(keys) != 3 {
+		t.Errorf("Progress keys = %v, want orders 1, 2 and 3", keys)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV returned error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 || lines[1] != "1,9,2,updated," || lines[3] != "3,2,2,skipped," || !strings.HasPrefix(lines[4], "4,9,2,failed,") {
+		t.Errorf("WriteCSV wrote:\n%s", buf.String())
+	}
+
+	// A second run of the job only retries the failed order.
+	report, err = u.Run(context.Background(), &OrderListOptions{StatusID: &status}, ShippedOrder)
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if updated, skipped, failed := report.Counts(); updated != 0 || skipped != 3 || failed != 1 {
+		t.Errorf("Counts on resume = %d, %d, %d, want 0, 3, 1", updated, skipped, failed)
+	}
+	if updates["1"] != 1 || updates["2"] != 2 || updates["4"] != 2 {
+		t.Errorf("Updates = %v", updates)
+	}
+
+	// Progress towards another status does not count.
+	report, err = u.RunIDs(context.Background(), []int64{3}, CompletedOrder)
+	if err != nil || len(report.Results) != 1 || report.Results[0].Skipped || report.Results[0].Err == nil {
+		t.Errorf("RunIDs to another status = %+v, %v, want order 3 read again", report, err)
+	}
+}
+
+func TestOrderStatusUpdater_RunIDs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":5,"status_id":11}`)
+		case "PUT":
+			fmt.Fprint(w, `{"id":5,"status_id":10}`)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v2/orders/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":6,"status_id":10}`)
+	})
+
+	report, err := (&OrderStatusUpdater{Client: client}).RunIDs(context.Background(), []int64{6, 5}, CompletedOrder)
+	if err != nil {
+		t.Fatalf("RunIDs returned error: %v", err)
+	}
+	want := []OrderStatusResult{{OrderID: 5, From: AwaitingFulfillmentOrder}, {OrderID: 6, From: CompletedOrder, Skipped: true}}
+	if len(report.Results) != 2 || report.Results[0] != want[0] || report.Results[1] != want[1] {
+		t.Errorf("Results = %+v, want %+v", report.Results, want)
+	}
+
+	if _, err := (&OrderStatusUpdater{Client: client, Store: NewMemoryKVStore()}).RunIDs(context.Background(), []int64{5}, CompletedOrder); err == nil {
+		t.Error("RunIDs with a store and no job name returned no error")
+	}
+}
+
+func TestOrderStatusUpdater_canceled(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err := (&OrderStatusUpdater{Client: client, Concurrency: 1}).RunIDs(ctx, []int64{3, 1, 2}, ShippedOrder)
+	if err != context.Canceled {
+		t.Errorf("RunIDs returned %v, want context.Canceled", err)
+	}
+	if failed := report.Failed(); len(failed) != 3 || failed[0] != 1 || failed[2] != 3 {
+		t.Errorf("Failed = %v, want every order", failed)
+	}
+}
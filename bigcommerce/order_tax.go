@@ -0,0 +1,44 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrderTax describes a tax charged on a V2 order. Read-only.
+type OrderTax struct {
+	ID             int64  `json:"id"`               // The unique numerical ID of the order tax.
+	OrderID        int64  `json:"order_id"`         // The ID of the order.
+	OrderAddressID int64  `json:"order_address_id"` // The shipping address the tax applies to.
+	TaxRateID      int64  `json:"tax_rate_id"`      // The ID of the tax rate, 0 for taxes from a tax provider.
+	TaxClassID     int64  `json:"tax_class_id"`     // The ID of the tax class.
+	Name           string `json:"name"`             // The tax's name, e.g. "Sales Tax".
+	Class          string `json:"class"`            // The name of the tax class.
+	Rate           string `json:"rate"`             // The tax rate, as a percentage.
+	Priority       int64  `json:"priority"`         // Taxes with a higher priority are compounded on lower ones.
+	PriorityAmount string `json:"priority_amount"`  // The tax charged at the priority level.
+	LineAmount     string `json:"line_amount"`      // The tax charged on the line.
+	OrderProductID string `json:"order_product_id"` // The order product taxed, when listed with details.
+	LineItemType   string `json:"line_item_type"`   // What was taxed: item, shipping, handling or gift-wrapping.
+}
+
+// OrderTaxListOptions specifies the optional parameters to OrderService.ListTaxes
+type OrderTaxListOptions struct {
+	ListOptions
+	Details bool `url:"details,omitempty"` // List taxes per line item instead of per tax rate.
+}
+
+// ListTaxes returns the taxes charged on an order
+func (s *OrderService) ListTaxes(ctx context.Context, orderID int64, opts *OrderTaxListOptions) ([]*OrderTax, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/taxes", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var taxes []*OrderTax
+	resp, err := s.client.call(ctx, "GET", path, nil, &taxes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return taxes, resp, nil
+}
@@ -0,0 +1,33 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrderService_ListTaxes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/taxes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"details": "true"})
+		fmt.Fprint(w, `[{"id":1,"order_id":100,"order_address_id":9,"tax_rate_id":2,"tax_class_id":0,"name":"Sales Tax","class":"Default Tax Class",
+			"rate":"8.2500","priority":0,"priority_amount":"1.6500","line_amount":"1.6500","order_product_id":"5","line_item_type":"item"}]`)
+	})
+
+	taxes, _, err := client.Orders.ListTaxes(context.Background(), 100, &OrderTaxListOptions{Details: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*OrderTax{{
+		ID: 1, OrderID: 100, OrderAddressID: 9, TaxRateID: 2, Name: "Sales Tax", Class: "Default Tax Class",
+		Rate: "8.2500", PriorityAmount: "1.6500", LineAmount: "1.6500", OrderProductID: "5", LineItemType: "item",
+	}}
+	if !reflect.DeepEqual(taxes, want) {
+		t.Errorf("ListTaxes = %+v, want %+v", taxes, want)
+	}
+}
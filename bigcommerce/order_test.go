@@ -0,0 +1,147 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrderService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{
+			"status_id":         "0",
+			"customer_id":       "7",
+			"min_date_created":  "2021-03-01T00:00:00Z",
+			"max_date_modified": "2021-03-31T00:00:00Z",
+			"max_date_created":  "",
+		})
+		fmt.Fprint(w, `[{"id":100,"status_id":0,"products":{"url":"https://api.bigcommerce.com/stores/abc123/v2/orders/100/products","resource":"/orders/100/products"}}]`)
+	})
+
+	status := IncompleteOrder
+	orders, _, err := client.Orders.List(context.Background(), &OrderListOptions{
+		StatusID:        &status,
+		CustomerID:      7,
+		MinDateCreated:  time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		MaxDateModified: time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*Order{{ID: 100}}; !reflect.DeepEqual(orders, want) {
+		t.Errorf("List = %+v, want %+v", orders, want)
+	}
+}
+
+func TestOrderService_Count(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"status_id": "11"})
+		fmt.Fprint(w, `{"count":27}`)
+	})
+
+	status := AwaitingFulfillmentOrder
+	count, _, err := client.Orders.Count(context.Background(), &OrderListOptions{StatusID: &status})
+	if err != nil || count != 27 {
+		t.Errorf("Count = %d, %v, want 27", count, err)
+	}
+}
+
+func TestOrderService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Order{
+		CustomerID:     7,
+		StatusID:       AwaitingFulfillmentOrder,
+		BillingAddress: &Address{FirstName: "Jane", LastName: "Doe", Address1: "1 Main St", City: "Austin", StateOrProvince: "Texas", PostalCode: "78701", Country: "United States", Email: "jane@example.com"},
+		Products:       OrderProducts{{ProductID: 32, Quantity: 2, ProductOptions: []OrderProductOption{{ID: 3, Value: "70"}}}, {Name: "Engraving", Quantity: 1, PriceExTax: "5.00", PriceIncTax: "5.00"}},
+	}
+	mux.HandleFunc("/stores/abc123/v2/orders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Order), input)
+		fmt.Fprint(w, `{"id":101,"customer_id":7,"status_id":11,"status":"Awaiting Fulfillment","total_inc_tax":"45.0000",
+			"shipping_addresses":{"url":"https://api.bigcommerce.com/stores/abc123/v2/orders/101/shipping_addresses","resource":"/orders/101/shipping_addresses"}}`)
+	})
+
+	order, _, err := client.Orders.Create(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != 101 || order.Status != "Awaiting Fulfillment" || order.ShippingAddresses != nil {
+		t.Errorf("Create = %+v", order)
+	}
+}
+
+func TestOrderService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/101", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Order), &Order{StatusID: ShippedOrder})
+		fmt.Fprint(w, `{"id":101,"status_id":2,"status":"Shipped"}`)
+	})
+
+	order, _, err := client.Orders.Update(context.Background(), 101, &Order{StatusID: ShippedOrder})
+	if err != nil || order.StatusID != ShippedOrder {
+		t.Errorf("Update = %+v, %v", order, err)
+	}
+}
+
+func TestOrderService_Archive(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/stores/abc123/v2/orders/101", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Orders.Archive(context.Background(), 101); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Orders.Delete(context.Background(), 101); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("Made %d requests, want 2", calls)
+	}
+}
+
+func TestOrderService_ListShippingAddresses(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/101/shipping_addresses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"limit": "50"})
+		fmt.Fprint(w, `[{"id":7,"order_id":101,"first_name":"Jane","street_1":"1 Main St","country_iso2":"US","shipping_method":"Free Shipping","items_total":3,"items_shipped":1}]`)
+	})
+
+	addresses, _, err := client.Orders.ListShippingAddresses(context.Background(), 101, &ListOptions{Limit: 50})
+	if err != nil {
+		t.Fatalf("ListShippingAddresses returned error: %v", err)
+	}
+	want := []*OrderShippingAddress{{
+		Address:        Address{ID: 7, OrderID: 101, FirstName: "Jane", Address1: "1 Main St", CountryCode: "US"},
+		ShippingMethod: "Free Shipping",
+		ItemsTotal:     3,
+		ItemsShipped:   1,
+	}}
+	if !reflect.DeepEqual(addresses, want) {
+		t.Errorf("ListShippingAddresses returned %+v, want %+v", addresses, want)
+	}
+}
@@ -0,0 +1,148 @@
+package bigcommerce
+
+import (
+	"context"
+	"sort"
+)
+
+// PackingSlips assembles the packing slips of an order, one per shipping
+// address, joining its products, shipping addresses and the bin picking
+// numbers of the catalog variants
+type PackingSlips struct {
+	Client *Client
+
+	IncludeShipped bool // Whether to list items that were already shipped. Only items left to ship are listed by default.
+}
+
+// PackingSlip lists the items shipped to one address of an order
+type PackingSlip struct {
+	OrderID int64
+	Address *OrderShippingAddress
+	Items   []*PackingSlipItem // Sorted by bin picking number, then SKU, for the pick path.
+}
+
+// PackingSlipItem is a product line of a packing slip
+type PackingSlipItem struct {
+	OrderProductID       int64
+	ProductID            int64
+	VariantID            int64
+	Name                 string
+	SKU                  string
+	BinPickingNumber     string           // The variant's bin, empty for custom products.
+	Options              []*PackingOption // The options chosen, e.g. Size: Large.
+	Quantity             int64            // The quantity ordered.
+	QuantityShipped      int64            // The quantity already shipped.
+	QuantityToShip       int64            // Quantity minus QuantityShipped.
+	GiftWrapping         string           // The gift wrapping chosen, if any.
+	GiftMessage          string           // The gift wrapping message, if any.
+	ParentOrderProductID int64            // For products added by a product list option, the product that added them.
+}
+
+// PackingOption is an option chosen for a packing slip item
+type PackingOption struct {
+	Name  string
+	Value string
+}
+
+// Build returns the packing slips of an order, in the order of its shipping
+// addresses. Digital products and addresses with nothing to pack are left out.
+func (p *PackingSlips) Build(ctx context.Context, orderID int64) ([]*PackingSlip, error) {
+	var addresses []*OrderShippingAddress
+	opts := &ListOptions{Page: 1, Limit: 250}
+	for {
+		page, _, err := p.Client.Orders.ListShippingAddresses(ctx, orderID, opts)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, page...)
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+	products, err := p.Client.Orders.ListAllProducts(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var variantIDs []int64
+	for _, product := range products {
+		if product.VariantID != 0 && !hasInt64(variantIDs, product.VariantID) {
+			variantIDs = append(variantIDs, product.VariantID)
+		}
+	}
+	bins, err := p.binPickingNumbers(ctx, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	slips := make(map[int64]*PackingSlip, len(addresses))
+	for _, addr := range addresses {
+		slips[addr.ID] = &PackingSlip{OrderID: orderID, Address: addr}
+	}
+	for _, product := range products {
+		slip, ok := slips[product.OrderAddressID]
+		if !ok || product.Type == "digital" {
+			continue
+		}
+		item := &PackingSlipItem{
+			OrderProductID:       product.ID,
+			ProductID:            product.ProductID,
+			VariantID:            product.VariantID,
+			Name:                 product.Name,
+			SKU:                  product.SKU,
+			BinPickingNumber:     bins[product.VariantID],
+			Quantity:             product.Quantity,
+			QuantityShipped:      product.QuantityShipped,
+			QuantityToShip:       product.Quantity - product.QuantityShipped,
+			GiftWrapping:         product.WrappingName,
+			GiftMessage:          product.WrappingMessage,
+			ParentOrderProductID: product.ParentOrderProductID,
+		}
+		if item.QuantityToShip <= 0 && !p.IncludeShipped {
+			continue
+		}
+		for _, o := range product.ProductOptions {
+			item.Options = append(item.Options, &PackingOption{Name: o.DisplayName, Value: o.DisplayValue})
+		}
+		slip.Items = append(slip.Items, item)
+	}
+
+	var result []*PackingSlip
+	for _, addr := range addresses {
+		slip := slips[addr.ID]
+		if len(slip.Items) == 0 {
+			continue
+		}
+		sort.SliceStable(slip.Items, func(i, j int) bool {
+			a, b := slip.Items[i], slip.Items[j]
+			if a.BinPickingNumber != b.BinPickingNumber {
+				return a.BinPickingNumber < b.BinPickingNumber
+			}
+			return a.SKU < b.SKU
+		})
+		result = append(result, slip)
+	}
+	return result, nil
+}
+
+// binPickingNumbers returns the bin picking numbers of variants by ID
+func (p *PackingSlips) binPickingNumbers(ctx context.Context, ids []int64) (map[int64]string, error) {
+	bins := make(map[int64]string, len(ids))
+	for len(ids) > 0 {
+		n := minInt(len(ids), 250)
+		variants, _, err := p.Client.Variants.ListCatalog(ctx, &VariantListOptions{
+			ListOptions:   ListOptions{Limit: n},
+			IDs:           ids[:n],
+			IncludeFields: []string{"bin_picking_number"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range variants {
+			bins[v.ID] = v.BinPickingNumber
+		}
+		ids = ids[n:]
+	}
+	return bins, nil
+}
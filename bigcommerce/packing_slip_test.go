@@ -0,0 +1,69 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPackingSlips_Build(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/101/shipping_addresses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":7,"first_name":"Jane","shipping_method":"Ground"},{"id":8,"first_name":"John"},{"id":9,"first_name":"Done"}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/orders/101/products", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id":1,"product_id":10,"variant_id":100,"order_address_id":7,"name":"Shirt","sku":"SHIRT-L","type":"physical","quantity":2,"quantity_shipped":1,
+			 "wrapping_name":"Gift box","wrapping_message":"Happy birthday",
+			 "product_options":[{"id":5,"value":"3","display_name":"Size","display_value":"Large"}]},
+			{"id":2,"product_id":11,"variant_id":110,"order_address_id":7,"name":"Mug","sku":"MUG","type":"physical","quantity":1},
+			{"id":3,"product_id":12,"variant_id":120,"order_address_id":7,"name":"E-book","sku":"EBOOK","type":"digital","quantity":1},
+			{"id":4,"product_id":11,"variant_id":110,"order_address_id":8,"name":"Mug","sku":"MUG","type":"physical","quantity":3},
+			{"id":5,"product_id":10,"variant_id":100,"order_address_id":9,"name":"Shirt","sku":"SHIRT-L","type":"physical","quantity":1,"quantity_shipped":1}
+		]`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"id:in": "100,110,120", "include_fields": "bin_picking_number"})
+		fmt.Fprint(w, `{"data":[{"id":100,"bin_picking_number":"B-2"},{"id":110,"bin_picking_number":"A-1"},{"id":120}],"meta":{}}`)
+	})
+
+	p := &PackingSlips{Client: client}
+	slips, err := p.Build(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+	if len(slips) != 2 {
+		t.Fatalf("Build returned %d slips, want 2", len(slips))
+	}
+	if slips[0].Address.ID != 7 || slips[0].Address.ShippingMethod != "Ground" || slips[1].Address.ID != 8 {
+		t.Errorf("Build returned slips for addresses %+v and %+v", slips[0].Address, slips[1].Address)
+	}
+	want := []*PackingSlipItem{
+		{OrderProductID: 2, ProductID: 11, VariantID: 110, Name: "Mug", SKU: "MUG", BinPickingNumber: "A-1", Quantity: 1, QuantityToShip: 1},
+		{
+			OrderProductID: 1, ProductID: 10, VariantID: 100, Name: "Shirt", SKU: "SHIRT-L", BinPickingNumber: "B-2",
+			Options:  []*PackingOption{{Name: "Size", Value: "Large"}},
+			Quantity: 2, QuantityShipped: 1, QuantityToShip: 1,
+			GiftWrapping: "Gift box", GiftMessage: "Happy birthday",
+		},
+	}
+	if !reflect.DeepEqual(slips[0].Items, want) {
+		t.Errorf("Build returned items %+v, want %+v", slips[0].Items, want)
+	}
+	if got := slips[1].Items; len(got) != 1 || got[0].QuantityToShip != 3 {
+		t.Errorf("Build returned items %+v for the second address", got)
+	}
+
+	p.IncludeShipped = true
+	slips, err = p.Build(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+	if len(slips) != 3 || slips[2].Items[0].QuantityToShip != 0 {
+		t.Errorf("Build with IncludeShipped returned %d slips", len(slips))
+	}
+}
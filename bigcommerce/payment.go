@@ -0,0 +1,163 @@
+package bigcommerce
+
+import "context"
+
+// PaymentService handles communication with the V3 payment endpoints and the
+// payment processing API, to pay for orders created by headless checkouts
+type PaymentService service
+
+// PaymentInstrument types
+const (
+	CardInstrument              = "card"                  // A new card.
+	StoredCardInstrument        = "stored_card"           // A card vaulted for the customer.
+	StoredPayPalInstrument      = "stored_paypal_account" // A PayPal account vaulted for the customer.
+	StoredBankAccountInstrument = "stored_bank_account"   // A bank account vaulted for the customer.
+)
+
+// PaymentInstrument describes how a payment is made: either a card's details,
+// or the token of a stored instrument
+type PaymentInstrument struct {
+	Type              string `json:"type"`                         // CardInstrument, StoredCardInstrument, ...
+	Number            string `json:"number,omitempty"`             // The card number, for cards.
+	CardholderName    string `json:"cardholder_name,omitempty"`    // For cards.
+	ExpiryMonth       int    `json:"expiry_month,omitempty"`       // For cards.
+	ExpiryYear        int    `json:"expiry_year,omitempty"`        // Four digits, for cards.
+	VerificationValue string `json:"verification_value,omitempty"` // The CVV, for cards and, if the gateway requires it, stored cards.
+	Token             string `json:"token,omitempty"`              // The stored instrument's token, from the payment methods endpoint.
+}
+
+// NewCardInstrument returns the instrument of a payment by card
+func NewCardInstrument(number, cardholderName, cvv string, expiryMonth, expiryYear int) *PaymentInstrument {
+	return &PaymentInstrument{
+		Type:              CardInstrument,
+		Number:            number,
+		CardholderName:    cardholderName,
+		ExpiryMonth:       expiryMonth,
+		ExpiryYear:        expiryYear,
+		VerificationValue: cvv,
+	}
+}
+
+// NewStoredCardInstrument returns the instrument of a payment with a stored
+// card. The CVV may be empty if the gateway does not require it.
+func NewStoredCardInstrument(token, cvv string) *PaymentInstrument {
+	return &PaymentInstrument{Type: StoredCardInstrument, Token: token, VerificationValue: cvv}
+}
+
+// Payment describes a payment to process
+type Payment struct {
+	Instrument      *PaymentInstrument `json:"instrument"`
+	PaymentMethodID string             `json:"payment_method_id"`         // The gateway and method, e.g. "braintree.card".
+	SaveInstrument  bool               `json:"save_instrument,omitempty"` // Whether to vault a card for the order's customer.
+}
+
+// PaymentResult is the outcome of a processed payment
+type PaymentResult struct {
+	ID              string `json:"id"`
+	Status          string `json:"status"`           // "success" or "pending".
+	TransactionType string `json:"transaction_type"` // "purchase" or "authorization", depending on the store's settings.
+}
+
+// CreateAccessToken returns a payment access token for an order awaiting
+// payment, e.g. one created by CheckoutService.CreateOrder. It is passed to
+// Process.
+func (s *PaymentService) CreateAccessToken(ctx context.Context, orderID int64) (string, *Response, error) {
+	body := map[string]interface{}{"order": map[string]int64{"id": orderID}}
+	var token struct {
+		ID string `json:"id"`
+	}
+	resp, err := s.client.call(ctx, "POST", "v3/payments/access_tokens", body, &token)
+	if err != nil {
+		return "", resp, err
+	}
+	return token.ID, resp, nil
+}
+
+// Process pays for the order of an access token. The request is sent to
+// PaymentsURL with the access token only, so the API token never reaches the
+// payments host.
+func (s *PaymentService) Process(ctx context.Context, accessToken string, payment *Payment) (*PaymentResult, *Response, error) {
+	u, err := s.client.PaymentsURL.Parse("payments")
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, "POST", u.String(), map[string]*Payment{"payment": payment})
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Del("X-Auth-Token")
+	req.Header.Del("X-Auth-Client")
+	req.Header.Set("Authorization", "PAT "+accessToken)
+	req.Header.Set("Accept", "application/vnd.bc.v1+json")
+	req.Header.Set("Content-Type", "application/vnd.bc.v1+json")
+
+	result := new(PaymentResult)
+	resp, err := s.client.Do(req, &envelope{Data: result})
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// PaymentMethod describes a payment method accepted for an order
+type PaymentMethod struct {
+	ID                   string                 `json:"id"`   // Passed as Payment.PaymentMethodID, e.g. "braintree.card".
+	Name                 string                 `json:"name"` // The method's display name.
+	Type                 string                 `json:"type"` // The kind of method, e.g. "card" or "paypal".
+	TestMode             bool                   `json:"test_mode"`
+	SupportedInstruments []*SupportedInstrument `json:"supported_instruments,omitempty"`
+	StoredInstruments    []*StoredInstrument    `json:"stored_instruments,omitempty"` // The instruments vaulted for the order's customer.
+}
+
+// SupportedInstrument describes an instrument accepted by a payment method
+type SupportedInstrument struct {
+	InstrumentType            string `json:"instrument_type"` // e.g. "VISA" or "MASTERCARD".
+	VerificationValueRequired bool   `json:"verification_value_required"`
+}
+
+// StoredInstrument describes a card, PayPal account or bank account vaulted
+// for a customer. The fields set depend on its Type.
+type StoredInstrument struct {
+	Type      string `json:"type"`  // StoredCardInstrument, StoredPayPalInstrument or StoredBankAccountInstrument.
+	Token     string `json:"token"` // Passed as PaymentInstrument.Token.
+	IsDefault bool   `json:"is_default"`
+
+	Brand                      string   `json:"brand,omitempty"`                        // The card brand, e.g. "VISA".
+	ExpiryMonth                int      `json:"expiry_month,omitempty"`                 // For cards.
+	ExpiryYear                 int      `json:"expiry_year,omitempty"`                  // For cards.
+	IssuerIdentificationNumber string   `json:"issuer_identification_number,omitempty"` // The card's first 6 digits.
+	Last4Digits                string   `json:"last_4_digits,omitempty"`                // For cards.
+	BillingAddress             *Address `json:"billing_address,omitempty"`              // For cards.
+
+	Email string `json:"email,omitempty"` // For PayPal accounts.
+
+	MaskedAccountNumber string `json:"masked_account_number,omitempty"` // For bank accounts.
+	Issuer              string `json:"issuer,omitempty"`                // For bank accounts.
+}
+
+// PaymentInstrument returns the instrument paying with a stored instrument.
+// The CVV is only sent for stored cards, if not empty.
+func (i *StoredInstrument) PaymentInstrument(cvv string) *PaymentInstrument {
+	if i.Type != StoredCardInstrument {
+		cvv = ""
+	}
+	return &PaymentInstrument{Type: i.Type, Token: i.Token, VerificationValue: cvv}
+}
+
+// ListMethods returns the payment methods accepted for an order awaiting
+// payment, with the instruments stored for its customer. A checkout must be
+// converted to an order with CheckoutService.CreateOrder first.
+func (s *PaymentService) ListMethods(ctx context.Context, orderID int64) ([]*PaymentMethod, *Response, error) {
+	path, err := addOptions("v3/payments/methods", &struct {
+		OrderID int64 `url:"order_id"`
+	}{orderID})
+	if err != nil {
+		return nil, nil, err
+	}
+	var methods []*PaymentMethod
+	resp, err := s.client.call(ctx, "GET", path, nil, &methods)
+	if err != nil {
+		return nil, resp, err
+	}
+	return methods, resp, nil
+}
@@ -0,0 +1,142 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPaymentService_CreateAccessToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/payments/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(map[string]interface{}), &map[string]interface{}{"order": map[string]interface{}{"id": float64(101)}})
+		fmt.Fprint(w, `{"data":{"id":"pat-token"},"meta":{}}`)
+	})
+
+	token, _, err := client.Payments.CreateAccessToken(context.Background(), 101)
+	if err != nil || token != "pat-token" {
+		t.Errorf("CreateAccessToken returned %q, %v", token, err)
+	}
+}
+
+func TestPaymentService_Process(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	paymentsURL, _ := url.Parse(client.BaseURL.String() + "payments-host")
+	WithPaymentsURL(paymentsURL)(client)
+
+	mux.HandleFunc("/stores/abc123/payments-host/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Authorization"); got != "PAT pat-token" {
+			t.Errorf("Authorization header is %q", got)
+		}
+		if got := r.Header.Get("X-Auth-Token"); got != "" {
+			t.Errorf("X-Auth-Token header sent to the payments host: %q", got)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.bc.v1+json" {
+			t.Errorf("Accept header is %q", got)
+		}
+		want := map[string]*Payment{"payment": {
+			Instrument:      &PaymentInstrument{Type: "card", Number: "4111111111111111", CardholderName: "Jane Doe", ExpiryMonth: 12, ExpiryYear: 2030, VerificationValue: "123"},
+			PaymentMethodID: "braintree.card",
+			SaveInstrument:  true,
+		}}
+		testBody(t, r, new(map[string]*Payment), &want)
+		fmt.Fprint(w, `{"data":{"id":"b1","status":"success","transaction_type":"purchase"}}`)
+	})
+
+	payment := &Payment{
+		Instrument:      NewCardInstrument("4111111111111111", "Jane Doe", "123", 12, 2030),
+		PaymentMethodID: "braintree.card",
+		SaveInstrument:  true,
+	}
+	result, _, err := client.Payments.Process(context.Background(), "pat-token", payment)
+	if err != nil {
+		t.Fatalf("Process returned error: %v", err)
+	}
+	want := &PaymentResult{ID: "b1", Status: "success", TransactionType: "purchase"}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Process returned %+v, want %+v", result, want)
+	}
+}
+
+func TestPaymentService_Process_storedCard(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	paymentsURL, _ := url.Parse(client.BaseURL.String() + "payments-host/")
+	WithPaymentsURL(paymentsURL)(client)
+
+	mux.HandleFunc("/stores/abc123/payments-host/payments", func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]*Payment{"payment": {
+			Instrument:      &PaymentInstrument{Type: "stored_card", Token: "vault-token"},
+			PaymentMethodID: "braintree.card",
+		}}
+		testBody(t, r, new(map[string]*Payment), &want)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status":422,"title":"Card declined"}`)
+	})
+
+	payment := &Payment{Instrument: NewStoredCardInstrument("vault-token", ""), PaymentMethodID: "braintree.card"}
+	if _, _, err := client.Payments.Process(context.Background(), "pat-token", payment); err == nil {
+		t.Errorf("Process of a declined payment returned no error")
+	}
+}
+
+func TestNewClient_paymentsURL(t *testing.T) {
+	client := NewClient("abc123", "token")
+	if got, want := client.PaymentsURL.String(), "https://payments.bigcommerce.com/stores/abc123/"; got != want {
+		t.Errorf("PaymentsURL is %q, want %q", got, want)
+	}
+}
+
+func TestPaymentService_ListMethods(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/payments/methods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"order_id": "101"})
+		fmt.Fprint(w, `{"data":[{
+			"id":"braintree.card","name":"Braintree","type":"card","test_mode":false,
+			"supported_instruments":[{"instrument_type":"VISA","verification_value_required":true}],
+			"stored_instruments":[
+				{"type":"stored_card","token":"card-token","is_default":true,"brand":"VISA","expiry_month":12,"expiry_year":2030,"issuer_identification_number":"411111","last_4_digits":"1111","billing_address":{"first_name":"Jane","country_code":"US"}},
+				{"type":"stored_paypal_account","token":"paypal-token","is_default":false,"email":"jane@example.com"}
+			]}],"meta":{}}`)
+	})
+
+	methods, _, err := client.Payments.ListMethods(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("ListMethods returned error: %v", err)
+	}
+	want := []*PaymentMethod{{
+		ID:                   "braintree.card",
+		Name:                 "Braintree",
+		Type:                 "card",
+		SupportedInstruments: []*SupportedInstrument{{InstrumentType: "VISA", VerificationValueRequired: true}},
+		StoredInstruments: []*StoredInstrument{
+			{Type: StoredCardInstrument, Token: "card-token", IsDefault: true, Brand: "VISA", ExpiryMonth: 12, ExpiryYear: 2030, IssuerIdentificationNumber: "411111", Last4Digits: "1111", BillingAddress: &Address{FirstName: "Jane", CountryCode: "US"}},
+			{Type: StoredPayPalInstrument, Token: "paypal-token", Email: "jane@example.com"},
+		},
+	}}
+	if !reflect.DeepEqual(methods, want) {
+		t.Errorf("ListMethods returned %+v, want %+v", methods, want)
+	}
+}
+
+func TestStoredInstrument_PaymentInstrument(t *testing.T) {
+	card := &StoredInstrument{Type: StoredCardInstrument, Token: "card-token"}
+	if got, want := card.PaymentInstrument("123"), (&PaymentInstrument{Type: "stored_card", Token: "card-token", VerificationValue: "123"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("PaymentInstrument returned %+v, want %+v", got, want)
+	}
+	paypal := &StoredInstrument{Type: StoredPayPalInstrument, Token: "paypal-token"}
+	if got, want := paypal.PaymentInstrument("123"), (&PaymentInstrument{Type: "stored_paypal_account", Token: "paypal-token"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("PaymentInstrument returned %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OAuthScope - An OAuth scope granted to an API account or app
+type OAuthScope string
+
+const (
+	// ProductsReadOnlyScope - read the catalog
+	ProductsReadOnlyScope OAuthScope = "store_v2_products_read_only"
+	// ProductsScope - read and modify the catalog
+	ProductsScope OAuthScope = "store_v2_products"
+	// OrdersReadOnlyScope - read orders
+	OrdersReadOnlyScope OAuthScope = "store_v2_orders_read_only"
+	// OrdersScope - read and modify orders and their shipments
+	OrdersScope OAuthScope = "store_v2_orders"
+	// CustomersReadOnlyScope - read customers and customer groups
+	CustomersReadOnlyScope OAuthScope = "store_v2_customers_read_only"
+	// CustomersScope - read and modify customers and customer groups
+	CustomersScope OAuthScope = "store_v2_customers"
+	// MarketingScope - read and modify coupons, banners, gift certificates and subscribers
+	MarketingScope OAuthScope = "store_v2_marketing"
+	// InformationReadOnlyScope - read store information, countries and shipping settings
+	InformationReadOnlyScope OAuthScope = "store_v2_information_read_only"
+	// InventoryReadOnlyScope - read inventory levels and locations
+	InventoryReadOnlyScope OAuthScope = "store_inventory_read_only"
+)
+
+// Preset restricts a client to the API paths a job needs, so code holding a
+// least-privilege token cannot drift into calls the token was never meant to
+// make. Requests outside the preset fail with a *PresetError before being
+// sent. Presets other than the predefined ones list their own Rules.
+type Preset struct {
+	Name   string
+	Scopes []OAuthScope // The scopes a token for the preset must be granted.
+	Rules  []PresetRule // The requests allowed; a request matching no rule is denied.
+}
+
+// PresetRule allows the requests to paths starting with Prefix, relative to
+// the store, e.g. "v3/catalog/"
+type PresetRule struct {
+	Prefix string
+	Write  bool // Whether requests other than GET and HEAD are allowed.
+}
+
+var (
+	// ReadOnlyCatalog allows reading products, variants, categories, brands
+	// and options, e.g. for feeds and storefront sync
+	ReadOnlyCatalog = &Preset{
+		Name:   "ReadOnlyCatalog",
+		Scopes: []OAuthScope{ProductsReadOnlyScope},
+		Rules: []PresetRule{
+			{Prefix: "v3/catalog/"},
+			{Prefix: "v2/products"},
+			{Prefix: "v2/brands"},
+			{Prefix: "v2/categories"},
+			{Prefix: "v2/options"},
+			{Prefix: "v2/option_sets"},
+		},
+	}
+
+	// OrdersFulfillment allows managing orders and their shipments, refunds
+	// and payments, and reading countries, shipping zones and inventory
+	OrdersFulfillment = &Preset{
+		Name:   "OrdersFulfillment",
+		Scopes: []OAuthScope{OrdersScope, InformationReadOnlyScope, InventoryReadOnlyScope},
+		Rules: []PresetRule{
+			{Prefix: "v2/orders", Write: true},
+			{Prefix: "v3/orders", Write: true},
+			{Prefix: "v2/countries"},
+			{Prefix: "v2/shipping"},
+			{Prefix: "v3/inventory"},
+		},
+	}
+
+	// MarketingOnly allows managing newsletter subscribers, coupons, banners,
+	// gift certificates and promotions, and reading customers and groups for
+	// segmentation
+	MarketingOnly = &Preset{
+		Name:   "MarketingOnly",
+		Scopes: []OAuthScope{MarketingScope, CustomersReadOnlyScope},
+		Rules: []PresetRule{
+			{Prefix: "v3/customers/subscribers", Write: true},
+			{Prefix: "v2/coupons", Write: true},
+			{Prefix: "v2/banners", Write: true},
+			{Prefix: "v2/gift_certificates", Write: true},
+			{Prefix: "v3/promotions", Write: true},
+			{Prefix: "v2/customers"},
+			{Prefix: "v2/customer_groups"},
+			{Prefix: "v3/customers"},
+		},
+	}
+)
+
+// WithPreset restricts the client to the requests allowed by p
+func WithPreset(p *Preset) ClientOption {
+	return func(c *Client) {
+		c.preset = p
+	}
+}
+
+// Allows reports whether the preset permits a request. The path is relative
+// to the store, e.g. "v3/catalog/products".
+func (p *Preset) Allows(method, path string) bool {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimPrefix(path, "/")
+	read := method == "GET" || method == "HEAD"
+	for _, r := range p.Rules {
+		if strings.HasPrefix(path, r.Prefix) && (read || r.Write) {
+			return true
+		}
+	}
+	return false
+}
+
+// PresetError is returned for a request not allowed by the client's preset.
+// Classify reports it as a ScopeError.
+type PresetError struct {
+	Preset string
+	Method string
+	Path   string
+}
+
+func (e *PresetError) Error() string {
+	return fmt.Sprintf("bigcommerce: %s %s is not allowed by the %s preset", e.Method, e.Path, e.Preset)
+}
+
+// checkPreset returns a *PresetError if the client's preset does not allow
+// the request
+func (c *Client) checkPreset(method, path string) error {
+	if c.preset == nil || c.preset.Allows(method, path) {
+		return nil
+	}
+	return &PresetError{Preset: c.preset.Name, Method: method, Path: path}
+}
@@ -0,0 +1,66 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPreset_Allows(t *testing.T) {
+	webhooks := &Preset{Name: "Webhooks", Rules: []PresetRule{{Prefix: "v3/hooks", Write: true}, {Prefix: "v2/store"}}}
+	tests := []struct {
+		preset *Preset
+		method string
+		path   string
+		want   bool
+	}{
+		{ReadOnlyCatalog, "GET", "v3/catalog/products?include=variants", true},
+		{ReadOnlyCatalog, "GET", "/v2/products/7", true},
+		{ReadOnlyCatalog, "PUT", "v3/catalog/products", false},
+		{ReadOnlyCatalog, "GET", "v2/orders", false},
+		{OrdersFulfillment, "POST", "v2/orders/7/shipments", true},
+		{OrdersFulfillment, "POST", "v3/orders/7/payment_actions/refunds", true},
+		{OrdersFulfillment, "GET", "v3/inventory/locations", true},
+		{OrdersFulfillment, "PUT", "v3/inventory/adjustments/absolute", false},
+		{MarketingOnly, "POST", "v3/customers/subscribers", true},
+		{MarketingOnly, "GET", "v2/customers/7", true},
+		{MarketingOnly, "PUT", "v2/customers/7", false},
+		{MarketingOnly, "DELETE", "v3/customers/addresses", false},
+		{webhooks, "POST", "v3/hooks", true},
+		{webhooks, "GET", "v2/store", true},
+		{webhooks, "GET", "v3/catalog/products", false},
+	}
+	for _, tt := range tests {
+		if got := tt.preset.Allows(tt.method, tt.path); got != tt.want {
+			t.Errorf("%s.Allows(%s %s) = %v, want %v", tt.preset.Name, tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestWithPreset(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	WithPreset(ReadOnlyCatalog)(client)
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/summary", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"variant_count":3},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/products/7", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request outside the preset was sent")
+	})
+
+	if _, _, err := client.Catalog.Summary(context.Background()); err != nil {
+		t.Errorf("Summary returned error: %v", err)
+	}
+
+	_, err := client.Products.Delete(context.Background(), 7)
+	var presetErr *PresetError
+	if !errors.As(err, &presetErr) || presetErr.Method != "DELETE" || presetErr.Path != "v2/products/7" {
+		t.Fatalf("Delete error = %v, want a *PresetError", err)
+	}
+	if c := Classify(err); c.Category != ScopeError || c.Retry {
+		t.Errorf("Classify = %+v, want a ScopeError", c)
+	}
+}
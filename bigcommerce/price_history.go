@@ -0,0 +1,293 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// OmnibusWindow is the period before a price reduction whose lowest price must
+// be shown alongside it under the EU Omnibus Directive
+const OmnibusWindow = 30 * 24 * time.Hour
+
+// DefaultPriceSyncInterval is how often PriceTracker.Run looks for changed
+// products
+const DefaultPriceSyncInterval = 15 * time.Minute
+
+// PriceChange is a price of a SKU from a time until the next change
+type PriceChange struct {
+	At        time.Time `json:"at"`
+	Price     float64   `json:"price"`
+	SalePrice float64   `json:"sale_price,omitempty"` // Zero if the SKU was not on sale.
+}
+
+// Effective returns the price shoppers paid: the sale price if the SKU was on
+// sale, the price otherwise
+func (c *PriceChange) Effective() float64 {
+	if c.SalePrice > 0 && c.SalePrice < c.Price {
+		return c.SalePrice
+	}
+	return c.Price
+}
+
+// PriceTracker records the price history of every SKU in a KVStore, under
+// "price_history/", and answers the questions price compliance rules ask,
+// such as the lowest price of the last 30 days. It follows the products
+// modified since its last sync, and can also be fed product webhook events.
+// A store should be tracked by a single PriceTracker at a time.
+type PriceTracker struct {
+	Client   *Client
+	Store    KVStore
+	Interval time.Duration // How often Run syncs, DefaultPriceSyncInterval if zero.
+
+	now func() time.Time
+}
+
+// NewPriceTracker returns a tracker reading prices through client and
+// recording them in store
+func NewPriceTracker(client *Client, store KVStore) *PriceTracker {
+	return &PriceTracker{Client: client, Store: store, now: time.Now}
+}
+
+const (
+	priceHistoryPrefix = "price_history/sku/"
+	priceCursorKey     = "price_history/cursor"
+)
+
+// Record adds a price of a SKU at a time, unless it is the price the SKU
+// already had. It reports whether a change was recorded. Prices recorded out
+// of order are inserted in place. Concurrent records, e.g. from Sync and
+// Handle, are applied with CompareAndSwap so none is lost.
+func (t *PriceTracker) Record(ctx context.Context, sku string, price, salePrice float64, at time.Time) (bool, error) {
+	key := priceHistoryPrefix + sku
+	for {
+		old, ok, err := t.Store.Get(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		var history []*PriceChange
+		if ok {
+			if err := json.Unmarshal(old, &history); err != nil {
+				return false, fmt.Errorf("bigcommerce: invalid price history %s: %v", key, err)
+			}
+		} else {
+			old = nil
+		}
+
+		i := sort.Search(len(history), func(i int) bool { return history[i].At.After(at) })
+		if i > 0 && history[i-1].Price == price && history[i-1].SalePrice == salePrice {
+			return false, nil
+		}
+		change := &PriceChange{At: at, Price: price, SalePrice: salePrice}
+		history = append(history, nil)
+		copy(history[i+1:], history[i:])
+		history[i] = change
+
+		data, err := json.Marshal(history)
+		if err != nil {
+			return false, err
+		}
+		if swapped, err := t.Store.CompareAndSwap(ctx, key, old, data); err != nil || swapped {
+			return swapped, err
+		}
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+}
+
+// History returns the recorded prices of a SKU, oldest first
+func (t *PriceTracker) History(ctx context.Context, sku string) ([]*PriceChange, error) {
+	var history []*PriceChange
+	if _, err := GetJSON(ctx, t.Store, priceHistoryPrefix+sku, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// PriceAt returns the price of a SKU at a time, or nil if none was recorded
+// by then
+func (t *PriceTracker) PriceAt(ctx context.Context, sku string, at time.Time) (*PriceChange, error) {
+	history, err := t.History(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	i := sort.Search(len(history), func(i int) bool { return history[i].At.After(at) })
+	if i == 0 {
+		return nil, nil
+	}
+	return history[i-1], nil
+}
+
+// LowestPrice returns the lowest effective price of a SKU over the window
+// before at, e.g. OmnibusWindow before a price reduction. Prices set at at
+// itself are excluded, so the reduced price is not compared with itself. It
+// reports false if no price was recorded before at.
+func (t *PriceTracker) LowestPrice(ctx context.Context, sku string, at time.Time, window time.Duration) (float64, bool, error) {
+	history, err := t.History(ctx, sku)
+	if err != nil {
+		return 0, false, err
+	}
+	from := at.Add(-window)
+	lowest, found := 0.0, false
+	for i, c := range history {
+		if !c.At.Before(at) {
+			break
+		}
+		// Skip prices replaced before the window opened
+		if i+1 < len(history) && !history[i+1].At.After(from) {
+			continue
+		}
+		if p := c.Effective(); !found || p < lowest {
+			lowest, found = p, true
+		}
+	}
+	return lowest, found, nil
+}
+
+// Sync records the prices of the products modified since the last sync, or
+// of every product on the first one. It returns the number of price changes
+// recorded.
+func (t *PriceTracker) Sync(ctx context.Context) (int, error) {
+	var cursor time.Time
+	if _, err := GetJSON(ctx, t.Store, priceCursorKey, &cursor); err != nil {
+		return 0, err
+	}
+	started := t.clock()
+
+	opts := &ProductListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, MinDateModified: cursor}
+	var products []*Product
+	for {
+		page, _, err := t.Client.Products.List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		products = append(products, page...)
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+
+	recorded, err := t.recordProducts(ctx, products, time.Time{})
+	if err != nil {
+		return recorded, err
+	}
+	return recorded, PutJSON(ctx, t.Store, priceCursorKey, started)
+}
+
+// Handle records the prices of the product of a "store/product/..." event,
+// so a PriceTracker can be the Handle of an EventWorker. Other events are
+// ignored. Deleted products keep their history.
+func (t *PriceTracker) Handle(ctx context.Context, e *Event) error {
+	resource, action := digestScope(e.Scope)
+	if resource != "product" || action == "deleted" {
+		return nil
+	}
+	id, err := strconv.ParseInt(e.Data.ID, 10, 64)
+	if err != nil {
+		return nil
+	}
+	product, _, err := t.Client.Products.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	at := time.Unix(e.CreatedAt, 0)
+	if e.CreatedAt == 0 {
+		at = t.clock()
+	}
+	_, err = t.recordProducts(ctx, []*Product{product}, at)
+	return err
+}
+
+// Run syncs every Interval until ctx is canceled. It implements Component.
+func (t *PriceTracker) Run(ctx context.Context) error {
+	interval := t.Interval
+	if interval == 0 {
+		interval = DefaultPriceSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := t.Sync(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// recordProducts records the price of every variant of products at at, or
+// when the product was last modified if at is zero. Variants without a price
+// of their own have the product's; variants without a SKU are skipped.
+func (t *PriceTracker) recordProducts(ctx context.Context, products []*Product, at time.Time) (int, error) {
+	byID := make(map[int64]*Product, len(products))
+	ids := make([]int64, len(products))
+	for i, p := range products {
+		byID[p.ID] = p
+		ids[i] = p.ID
+	}
+
+	recorded := 0
+	for start := 0; start < len(ids); start += listingBatch {
+		opts := &VariantListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, ProductIDs: ids[start:minInt(start+listingBatch, len(ids))]}
+		for {
+			variants, resp, err := t.Client.Variants.ListCatalog(ctx, opts)
+			if err != nil {
+				return recorded, err
+			}
+			for _, v := range variants {
+				p := byID[v.ProductID]
+				if v.SKU == "" || p == nil {
+					continue
+				}
+				price := parseAmount(p.Price)
+				salePrice := parseAmount(p.SalePrice)
+				if v.Price != nil {
+					price = *v.Price
+				}
+				if v.SalePrice != nil {
+					salePrice = *v.SalePrice
+				}
+				when := at
+				if when.IsZero() {
+					when = t.modified(p)
+				}
+				ok, err := t.Record(ctx, v.SKU, price, salePrice, when)
+				if err != nil {
+					return recorded, err
+				}
+				if ok {
+					recorded++
+				}
+			}
+			if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+				break
+			}
+			opts.Page++
+		}
+	}
+	return recorded, nil
+}
+
+// modified returns when a product was last modified, or now if unknown
+func (t *PriceTracker) modified(p *Product) time.Time {
+	modified, err := time.Parse(time.RFC1123Z, p.DateModified)
+	if err != nil {
+		return t.clock()
+	}
+	return modified
+}
+
+func (t *PriceTracker) clock() time.Time {
+	if t.now == nil {
+		return time.Now()
+	}
+	return t.now()
+}
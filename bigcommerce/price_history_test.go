@@ -0,0 +1,159 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPriceTracker_Queries(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewPriceTracker(nil, NewMemoryKVStore())
+	day := func(d int) time.Time { return time.Date(2022, 5, d, 0, 0, 0, 0, time.UTC) }
+
+	for _, c := range []PriceChange{
+		{At: day(1), Price: 100},
+		{At: day(10), Price: 100, SalePrice: 80},
+		{At: day(3), Price: 90},
+		{At: day(12), Price: 100},
+		{At: day(31), Price: 100, SalePrice: 70},
+	} {
+		if _, err := tracker.Record(ctx, "BOOT-42", c.Price, c.SalePrice, c.At); err != nil {
+			t.Fatalf("Record returned error: %v", err)
+		}
+	}
+	if ok, err := tracker.Record(ctx, "BOOT-42", 100, 0, day(20)); err != nil || ok {
+		t.Errorf("Record of an unchanged price returned %v, %v", ok, err)
+	}
+
+	history, _ := tracker.History(ctx, "BOOT-42")
+	if len(history) != 5 || !history[1].At.Equal(day(3)) {
+		t.Errorf("History returned %+v, want 5 changes in order", history)
+	}
+
+	price, err := tracker.PriceAt(ctx, "BOOT-42", day(11))
+	if err != nil {
+		t.Fatalf("PriceAt returned error: %v", err)
+	}
+	if want := (&PriceChange{At: day(10), Price: 100, SalePrice: 80}); !reflect.DeepEqual(price, want) {
+		t.Errorf("PriceAt returned %+v, want %+v", price, want)
+	}
+	if price, _ := tracker.PriceAt(ctx, "BOOT-42", day(1).Add(-time.Second)); price != nil {
+		t.Errorf("PriceAt before the first change returned %+v", price)
+	}
+
+	// The 30 days before the sale on the 31st start on the 1st, so the 90 of
+	// the 3rd and the sale of the 10th count, but not the sale price itself
+	lowest, ok, err := tracker.LowestPrice(ctx, "BOOT-42", day(31), OmnibusWindow)
+	if err != nil || !ok || lowest != 80 {
+		t.Errorf("LowestPrice returned %v, %v, %v, want 80", lowest, ok, err)
+	}
+	lowest, ok, _ = tracker.LowestPrice(ctx, "BOOT-42", day(31), 10*24*time.Hour)
+	if !ok || lowest != 100 {
+		t.Errorf("LowestPrice over 10 days returned %v, %v, want 100", lowest, ok)
+	}
+	if _, ok, _ := tracker.LowestPrice(ctx, "BOOT-42", day(1), OmnibusWindow); ok {
+		t.Error("LowestPrice before any price reported one")
+	}
+}
+
+func TestPriceTracker_Record_concurrent(t *testing.T) {
+	tracker := NewPriceTracker(nil, NewMemoryKVStore())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := tracker.Record(context.Background(), "SKU-1", float64(10+i), 0, start.Add(time.Duration(i)*time.Hour)); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	history, err := tracker.History(context.Background(), "SKU-1")
+	if err != nil || len(history) != 20 {
+		t.Fatalf("History has %d changes, %v, want 20", len(history), err)
+	}
+	for i, c := range history {
+		if c.Price != float64(10+i) {
+			t.Errorf("change %d has price %v, want %v", i, c.Price, 10+i)
+		}
+	}
+}
+
+func TestPriceTracker_Sync(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	price := "100.0000"
+	mux.HandleFunc("/stores/abc123/v2/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("min_date_modified") == "" {
+			fmt.Fprintf(w, `[{"id":7,"price":"%s","sale_price":"0.0000","date_modified":"Mon, 02 May 2022 10:00:00 +0000"}]`, price)
+		} else {
+			fmt.Fprintf(w, `[{"id":7,"price":"%s","sale_price":"0.0000","date_modified":"Tue, 03 May 2022 10:00:00 +0000"}]`, price)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"product_id:in": "7"})
+		fmt.Fprint(w, `{"data":[{"id":70,"product_id":7,"sku":"BOOT-41"},{"id":71,"product_id":7,"sku":"BOOT-42","price":110},{"id":72,"product_id":7}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+
+	ctx := context.Background()
+	tracker := NewPriceTracker(client, NewMemoryKVStore())
+	tracker.now = func() time.Time { return time.Date(2022, 5, 2, 12, 0, 0, 0, time.UTC) }
+	if n, err := tracker.Sync(ctx); err != nil || n != 2 {
+		t.Fatalf("Sync returned %v, %v, want 2 changes", n, err)
+	}
+
+	price = "90.0000"
+	if n, err := tracker.Sync(ctx); err != nil || n != 1 {
+		t.Fatalf("second Sync returned %v, %v, want 1 change", n, err)
+	}
+	history, _ := tracker.History(ctx, "BOOT-41")
+	want := []*PriceChange{
+		{At: time.Date(2022, 5, 2, 10, 0, 0, 0, time.UTC), Price: 100},
+		{At: time.Date(2022, 5, 3, 10, 0, 0, 0, time.UTC), Price: 90},
+	}
+	if len(history) != 2 || !history[0].At.Equal(want[0].At) || !history[1].At.Equal(want[1].At) || history[1].Price != 90 {
+		t.Errorf("History returned %+v, want %+v", history, want)
+	}
+	if history, _ := tracker.History(ctx, "BOOT-42"); len(history) != 1 || history[0].Price != 110 {
+		t.Errorf("History of a variant with its own price returned %+v", history)
+	}
+}
+
+func TestPriceTracker_Handle(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/products/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":7,"price":"100.0000","sale_price":"75.0000"}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":70,"product_id":7,"sku":"BOOT-41"}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+
+	ctx := context.Background()
+	tracker := NewPriceTracker(client, NewMemoryKVStore())
+	for _, e := range []*Event{
+		{Scope: "store/product/updated", Data: EventData{Type: "product", ID: "7"}, CreatedAt: 1651500000},
+		{Scope: "store/product/deleted", Data: EventData{Type: "product", ID: "8"}},
+		{Scope: "store/order/created", Data: EventData{Type: "order", ID: "9"}},
+	} {
+		if err := tracker.Handle(ctx, e); err != nil {
+			t.Fatalf("Handle(%s) returned error: %v", e.Scope, err)
+		}
+	}
+	price, _ := tracker.PriceAt(ctx, "BOOT-41", time.Unix(1651500000, 0))
+	if price == nil || price.Effective() != 75 {
+		t.Errorf("PriceAt after Handle returned %+v, want a sale at 75", price)
+	}
+}
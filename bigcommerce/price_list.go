@@ -0,0 +1,211 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PriceListService handles communication with the V3 price list endpoints
+type PriceListService service
+
+// PriceList describes a BigCommerce V3 Price List Object, a set of variant
+// prices assigned to customer groups or channels
+type PriceList struct {
+	ID           int64  `json:"id,omitempty"`            // The unique numerical ID of the price list. Read-only.
+	Name         string `json:"name,omitempty"`          // Unique across the store. Required on create.
+	Active       bool   `json:"active"`                  // Whether the price list's prices are used.
+	DateCreated  string `json:"date_created,omitempty"`  // Read-only.
+	DateModified string `json:"date_modified,omitempty"` // Read-only.
+}
+
+// PriceListListOptions specifies the optional parameters to PriceListService.List
+type PriceListListOptions struct {
+	ListOptions
+	IDs  []int64 `url:"id:in,omitempty"`
+	Name string  `url:"name,omitempty"`
+}
+
+// List returns a page of price lists
+func (s *PriceListService) List(ctx context.Context, opts *PriceListListOptions) ([]*PriceList, *Response, error) {
+	path, err := addOptions("v3/pricelists", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lists []*PriceList
+	resp, err := s.client.call(ctx, "GET", path, nil, &lists)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lists, resp, nil
+}
+
+// Get returns a single price list
+func (s *PriceListService) Get(ctx context.Context, id int64) (*PriceList, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("v3/pricelists/%d", id), nil)
+}
+
+// Create adds a price list
+func (s *PriceListService) Create(ctx context.Context, list *PriceList) (*PriceList, *Response, error) {
+	return s.do(ctx, "POST", "v3/pricelists", list)
+}
+
+// Update modifies a price list's name and whether it is active
+func (s *PriceListService) Update(ctx context.Context, id int64, list *PriceList) (*PriceList, *Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("v3/pricelists/%d", id), list)
+}
+
+// Delete removes a price list along with its records and assignments
+func (s *PriceListService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/pricelists/%d", id), nil, nil)
+}
+
+func (s *PriceListService) do(ctx context.Context, method, path string, body interface{}) (*PriceList, *Response, error) {
+	list := new(PriceList)
+	resp, err := s.client.call(ctx, method, path, body, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// PriceRecord describes a BigCommerce V3 Price Record Object, the price of a
+// variant in one currency of a price list
+type PriceRecord struct {
+	PriceListID     int64    `json:"price_list_id,omitempty"`    // The price list the record belongs to. Read-only.
+	VariantID       int64    `json:"variant_id,omitempty"`       // The variant priced. Required unless SKU is set.
+	ProductID       int64    `json:"product_id,omitempty"`       // The product of the variant. Read-only.
+	SKU             string   `json:"sku,omitempty"`              // The variant's SKU, an alternative to VariantID on upsert.
+	Currency        string   `json:"currency,omitempty"`         // The ISO 4217 code of the currency, e.g. usd. Required.
+	Price           float64  `json:"price"`                      // The list price. Required, and may be 0.
+	SalePrice       *float64 `json:"sale_price"`                 // Used instead of Price when set. Nil clears it on upsert.
+	RetailPrice     *float64 `json:"retail_price"`               // Shown struck through next to the price. Nil clears it on upsert.
+	MapPrice        *float64 `json:"map_price"`                  // Minimum advertised price. Nil clears it on upsert.
+	CalculatedPrice float64  `json:"calculated_price,omitempty"` // The price the customer pays. Read-only.
+	DateCreated     string   `json:"date_created,omitempty"`
+	DateModified    string   `json:"date_modified,omitempty"`
+}
+
+// PriceRecordListOptions specifies the optional parameters to PriceListService.ListRecords
+type PriceRecordListOptions struct {
+	ListOptions
+	VariantIDs []int64  `url:"variant_id:in,omitempty"` // Filter by variant IDs.
+	ProductIDs []int64  `url:"product_id:in,omitempty"` // Filter by product IDs.
+	Currency   string   `url:"currency,omitempty"`      // Filter by currency code.
+	SKUs       []string `url:"sku:in,omitempty"`        // Filter by SKUs.
+}
+
+// ListRecords returns a page of the records of a price list
+func (s *PriceListService) ListRecords(ctx context.Context, priceListID int64, opts *PriceRecordListOptions) ([]*PriceRecord, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/pricelists/%d/records", priceListID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []*PriceRecord
+	resp, err := s.client.call(ctx, "GET", path, nil, &records)
+	if err != nil {
+		return nil, resp, err
+	}
+	return records, resp, nil
+}
+
+// UpsertRecords creates or replaces up to 1000 records of a price list in one
+// request. Records are matched by variant and currency.
+func (s *PriceListService) UpsertRecords(ctx context.Context, priceListID int64, records []*PriceRecord) (*Response, error) {
+	return s.client.call(ctx, "PUT", fmt.Sprintf("v3/pricelists/%d/records", priceListID), records, nil)
+}
+
+// UpsertAllRecords creates or replaces any number of records of a price list,
+// 1000 per request. Batches are sent in order; on error, the batches before
+// the failing one have been applied.
+func (s *PriceListService) UpsertAllRecords(ctx context.Context, priceListID int64, records []*PriceRecord) error {
+	for start := 0; start < len(records); start += maxRecordBatch {
+		if _, err := s.UpsertRecords(ctx, priceListID, records[start:minInt(start+maxRecordBatch, len(records))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteRecords removes the records of the variants in a currency from a
+// price list
+func (s *PriceListService) DeleteRecords(ctx context.Context, priceListID int64, currency string, variantIDs []int64) (*Response, error) {
+	if len(variantIDs) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions(fmt.Sprintf("v3/pricelists/%d/records", priceListID), &struct {
+		VariantIDs []int64 `url:"variant_id:in"`
+		Currency   string  `url:"currency"`
+	}{variantIDs, currency})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// PriceListAssignment describes a BigCommerce V3 Price List Assignment
+// Object, attaching a price list to a customer group, a channel or both
+type PriceListAssignment struct {
+	ID              int64 `json:"id,omitempty"` // Read-only.
+	PriceListID     int64 `json:"price_list_id"`
+	CustomerGroupID int64 `json:"customer_group_id,omitempty"` // All customers of the channel if zero.
+	ChannelID       int64 `json:"channel_id,omitempty"`        // All channels if zero.
+}
+
+// PriceListAssignmentFilter selects price list assignments. Fields left empty
+// do not filter.
+type PriceListAssignmentFilter struct {
+	IDs              []int64 `url:"id:in,omitempty"`
+	PriceListIDs     []int64 `url:"price_list_id:in,omitempty"`
+	CustomerGroupIDs []int64 `url:"customer_group_id:in,omitempty"`
+	ChannelIDs       []int64 `url:"channel_id:in,omitempty"`
+}
+
+func (f *PriceListAssignmentFilter) empty() bool {
+	return f == nil || len(f.IDs) == 0 && len(f.PriceListIDs) == 0 && len(f.CustomerGroupIDs) == 0 && len(f.ChannelIDs) == 0
+}
+
+// PriceListAssignmentListOptions specifies the optional parameters to
+// PriceListService.ListAssignments
+type PriceListAssignmentListOptions struct {
+	ListOptions
+	PriceListAssignmentFilter
+}
+
+// ListAssignments returns a page of price list assignments
+func (s *PriceListService) ListAssignments(ctx context.Context, opts *PriceListAssignmentListOptions) ([]*PriceListAssignment, *Response, error) {
+	path, err := addOptions("v3/pricelists/assignments", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assignments []*PriceListAssignment
+	resp, err := s.client.call(ctx, "GET", path, nil, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignments, resp, nil
+}
+
+// CreateAssignments attaches price lists to customer groups and channels, up
+// to 25 assignments per request. A customer group or channel has at most one
+// price list; creating a second assignment for it fails.
+func (s *PriceListService) CreateAssignments(ctx context.Context, assignments []*PriceListAssignment) (*Response, error) {
+	return s.client.call(ctx, "POST", "v3/pricelists/assignments", assignments, nil)
+}
+
+// DeleteAssignments removes the price list assignments matching a filter. The
+// filter must set at least one field, so that every assignment of the store
+// cannot be removed by mistake.
+func (s *PriceListService) DeleteAssignments(ctx context.Context, filter *PriceListAssignmentFilter) (*Response, error) {
+	if filter.empty() {
+		return nil, errors.New("bigcommerce: deleting price list assignments requires a filter")
+	}
+	path, err := addOptions("v3/pricelists/assignments", filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,304 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Batch sizes of price record upserts and deletes. Deletes pass variant IDs in
+// the query string, so take fewer.
+const (
+	maxRecordBatch       = 1000
+	maxRecordDeleteBatch = 100
+)
+
+// priceRecordColumns are the columns of a price list CSV, in order
+var priceRecordColumns = []string{"price_list_id", "variant_id", "product_id", "sku", "currency", "price", "sale_price", "retail_price", "map_price"}
+
+// PriceListImportOptions configures PriceListService.ImportCSV
+type PriceListImportOptions struct {
+	DeleteMissing bool // Delete the records of the imported price lists missing from the CSV.
+	DryRun        bool // Compute the changes without applying them.
+}
+
+// PriceListDiff lists the changes an import makes to a price list
+type PriceListDiff struct {
+	PriceListID int64
+	Upserted    []*PriceRecord // Records that are new or have different prices.
+	Deleted     []*PriceRecord // Records missing from the CSV, with DeleteMissing.
+	Unchanged   int            // Records already priced as in the CSV.
+}
+
+// WritePriceRecordsCSV writes records as CSV, one row per variant and
+// currency. Unset sale, retail and MAP prices are written as empty cells.
+func WritePriceRecordsCSV(w io.Writer, records []*PriceRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(priceRecordColumns); err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := []string{
+			strconv.FormatInt(r.PriceListID, 10),
+			strconv.FormatInt(r.VariantID, 10),
+			formatID(r.ProductID),
+			r.SKU,
+			r.Currency,
+			formatPrice(r.Price),
+			formatOptionalPrice(r.SalePrice),
+			formatOptionalPrice(r.RetailPrice),
+			formatOptionalPrice(r.MapPrice),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadPriceRecordsCSV reads records written by WritePriceRecordsCSV, possibly
+// edited in a spreadsheet. Columns are matched by name; price_list_id,
+// variant_id, currency and price are required, and currencies are lower-cased.
+func ReadPriceRecordsCSV(r io.Reader) ([]*PriceRecord, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	columns, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	header := make(map[string]int, len(columns))
+	for i, c := range columns {
+		header[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(c, "\ufeff")))] = i
+	}
+	for _, c := range []string{"price_list_id", "variant_id", "currency", "price"} {
+		if _, ok := header[c]; !ok {
+			return nil, fmt.Errorf("bigcommerce: price list CSV is missing the %s column", c)
+		}
+	}
+
+	var records []*PriceRecord
+	for line := 2; ; line++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		get := func(column string) string {
+			if i, ok := header[column]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		if strings.Join(row, "") == "" {
+			continue
+		}
+
+		record := &PriceRecord{SKU: get("sku"), Currency: strings.ToLower(get("currency"))}
+		var perr error
+		parseInt := func(column string, v *int64, required bool) {
+			s := get(column)
+			if perr != nil || s == "" && !required {
+				return
+			}
+			if *v, perr = strconv.ParseInt(s, 10, 64); perr != nil {
+				perr = fmt.Errorf("%s: invalid ID %q", column, s)
+			}
+		}
+		parsePrice := func(column string, v *float64) {
+			s := get(column)
+			if perr != nil {
+				return
+			}
+			if *v, perr = strconv.ParseFloat(s, 64); perr != nil || *v < 0 {
+				perr = fmt.Errorf("%s: invalid price %q", column, s)
+			}
+		}
+		parseOptionalPrice := func(column string, v **float64) {
+			if perr != nil || get(column) == "" {
+				return
+			}
+			*v = new(float64)
+			parsePrice(column, *v)
+		}
+		parseInt("price_list_id", &record.PriceListID, true)
+		parseInt("variant_id", &record.VariantID, true)
+		parseInt("product_id", &record.ProductID, false)
+		parsePrice("price", &record.Price)
+		parseOptionalPrice("sale_price", &record.SalePrice)
+		parseOptionalPrice("retail_price", &record.RetailPrice)
+		parseOptionalPrice("map_price", &record.MapPrice)
+		if perr == nil && record.Currency == "" {
+			perr = fmt.Errorf("currency: is required")
+		}
+		if perr != nil {
+			return nil, fmt.Errorf("bigcommerce: price list CSV line %d: %v", line, perr)
+		}
+		records = append(records, record)
+	}
+}
+
+// ExportCSV writes every record of the price lists, across all currencies,
+// with WritePriceRecordsCSV
+func (s *PriceListService) ExportCSV(ctx context.Context, w io.Writer, priceListIDs ...int64) error {
+	var all []*PriceRecord
+	for _, id := range priceListIDs {
+		records, err := s.allRecords(ctx, id)
+		if err != nil {
+			return err
+		}
+		all = append(all, records...)
+	}
+	return WritePriceRecordsCSV(w, all)
+}
+
+// ImportCSV reads a price list CSV and applies the differences with the
+// store's records: new or repriced records are upserted in batches, and with
+// DeleteMissing, records of the imported price lists absent from the CSV are
+// deleted. Only price lists appearing in the CSV are touched.
+func (s *PriceListService) ImportCSV(ctx context.Context, r io.Reader, opts *PriceListImportOptions) ([]*PriceListDiff, error) {
+	if opts == nil {
+		opts = &PriceListImportOptions{}
+	}
+	records, err := ReadPriceRecordsCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	byList := map[int64][]*PriceRecord{}
+	for _, record := range records {
+		byList[record.PriceListID] = append(byList[record.PriceListID], record)
+	}
+	var diffs []*PriceListDiff
+	for id, wanted := range byList {
+		current, err := s.allRecords(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diffPriceRecords(id, current, wanted, opts.DeleteMissing))
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].PriceListID < diffs[j].PriceListID })
+
+	if opts.DryRun {
+		return diffs, nil
+	}
+	for _, d := range diffs {
+		if err := s.apply(ctx, d); err != nil {
+			return diffs, err
+		}
+	}
+	return diffs, nil
+}
+
+// apply upserts and deletes the records of a diff
+func (s *PriceListService) apply(ctx context.Context, d *PriceListDiff) error {
+	upserts := make([]*PriceRecord, len(d.Upserted))
+	for i, r := range d.Upserted {
+		upserts[i] = &PriceRecord{VariantID: r.VariantID, Currency: r.Currency, Price: r.Price,
+			SalePrice: r.SalePrice, RetailPrice: r.RetailPrice, MapPrice: r.MapPrice}
+	}
+	if err := s.UpsertAllRecords(ctx, d.PriceListID, upserts); err != nil {
+		return err
+	}
+
+	byCurrency := map[string][]int64{}
+	for _, r := range d.Deleted {
+		byCurrency[r.Currency] = append(byCurrency[r.Currency], r.VariantID)
+	}
+	for currency, ids := range byCurrency {
+		for start := 0; start < len(ids); start += maxRecordDeleteBatch {
+			if _, err := s.DeleteRecords(ctx, d.PriceListID, currency, ids[start:minInt(start+maxRecordDeleteBatch, len(ids))]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// allRecords returns every record of a price list
+func (s *PriceListService) allRecords(ctx context.Context, priceListID int64) ([]*PriceRecord, error) {
+	var all []*PriceRecord
+	opts := &PriceRecordListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}}
+	for {
+		records, resp, err := s.ListRecords(ctx, priceListID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			r.PriceListID = priceListID
+		}
+		all = append(all, records...)
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return all, nil
+		}
+		opts.Page++
+	}
+}
+
+// diffPriceRecords compares the current records of a price list with the
+// wanted ones, keyed by variant and currency
+func diffPriceRecords(priceListID int64, current, wanted []*PriceRecord, deleteMissing bool) *PriceListDiff {
+	type key struct {
+		variant  int64
+		currency string
+	}
+	existing := make(map[key]*PriceRecord, len(current))
+	for _, r := range current {
+		existing[key{r.VariantID, strings.ToLower(r.Currency)}] = r
+	}
+
+	d := &PriceListDiff{PriceListID: priceListID}
+	seen := map[key]bool{}
+	for _, r := range wanted {
+		k := key{r.VariantID, r.Currency}
+		seen[k] = true
+		old, ok := existing[k]
+		if ok && old.Price == r.Price && samePrice(old.SalePrice, r.SalePrice) && samePrice(old.RetailPrice, r.RetailPrice) &&
+			samePrice(old.MapPrice, r.MapPrice) {
+			d.Unchanged++
+			continue
+		}
+		d.Upserted = append(d.Upserted, r)
+	}
+	if deleteMissing {
+		for _, r := range current {
+			if !seen[key{r.VariantID, strings.ToLower(r.Currency)}] {
+				d.Deleted = append(d.Deleted, r)
+			}
+		}
+	}
+	return d
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', -1, 64)
+}
+
+func formatOptionalPrice(price *float64) string {
+	if price == nil {
+		return ""
+	}
+	return formatPrice(*price)
+}
+
+// samePrice reports whether two optional prices are both unset or equal
+func samePrice(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
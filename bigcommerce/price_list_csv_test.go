@@ -0,0 +1,150 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPriceRecordsCSV_roundTrip(t *testing.T) {
+	records := []*PriceRecord{
+		{PriceListID: 2, VariantID: 7, ProductID: 1, SKU: "SKU-7", Currency: "usd", Price: 19.99, SalePrice: Float64(14.5)},
+		{PriceListID: 2, VariantID: 7, ProductID: 1, SKU: "SKU-7", Currency: "eur", Price: 18, MapPrice: Float64(15)},
+		{PriceListID: 2, VariantID: 7, ProductID: 1, SKU: "SKU-7", Currency: "gbp", Price: 0, SalePrice: Float64(0)},
+	}
+	var buf bytes.Buffer
+	if err := WritePriceRecordsCSV(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+	want := "price_list_id,variant_id,product_id,sku,currency,price,sale_price,retail_price,map_price\n" +
+		"2,7,1,SKU-7,usd,19.99,14.5,,\n" +
+		"2,7,1,SKU-7,eur,18,,,15\n" +
+		"2,7,1,SKU-7,gbp,0,0,,\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	got, err := ReadPriceRecordsCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("ReadPriceRecordsCSV = %+v, want %+v", got, records)
+	}
+}
+
+func TestReadPriceRecordsCSV_spreadsheet(t *testing.T) {
+	// Reordered columns, a BOM, upper-case currencies and blank rows, as saved by spreadsheets
+	in := "\ufeffCurrency,Price,Variant_ID,Price_List_ID\nUSD, 12.50 ,7,2\n,,,\n"
+	got, err := ReadPriceRecordsCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*PriceRecord{{PriceListID: 2, VariantID: 7, Currency: "usd", Price: 12.5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadPriceRecordsCSV = %+v, want %+v", got[0], want[0])
+	}
+}
+
+func TestReadPriceRecordsCSV_errors(t *testing.T) {
+	tests := []struct {
+		in, err string
+	}{
+		{"variant_id,currency,price\n7,usd,1\n", "missing the price_list_id column"},
+		{"price_list_id,variant_id,currency,price\n2,7,usd,free\n", "line 2: price: invalid price \"free\""},
+		{"price_list_id,variant_id,currency,price\n2,7,usd,-1\n", "line 2: price: invalid price \"-1\""},
+		{"price_list_id,variant_id,currency,price\n2,7,usd,1\n2,x,usd,1\n", "line 3: variant_id: invalid ID \"x\""},
+		{"price_list_id,variant_id,currency,price\n2,7,,1\n", "line 2: currency: is required"},
+	}
+	for _, tt := range tests {
+		_, err := ReadPriceRecordsCSV(strings.NewReader(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("ReadPriceRecordsCSV(%q) error = %v, want %q", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestPriceListService_ExportCSV(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"data":[{"variant_id":7,"currency":"usd","price":10}],"meta":{"pagination":{"total_pages":2}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"variant_id":8,"currency":"usd","price":11}],"meta":{"pagination":{"total_pages":2}}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/pricelists/3/records", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"variant_id":7,"currency":"eur","price":9}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+
+	var buf bytes.Buffer
+	if err := client.PriceLists.ExportCSV(context.Background(), &buf, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	want := "price_list_id,variant_id,product_id,sku,currency,price,sale_price,retail_price,map_price\n" +
+		"2,7,,,usd,10,,,\n2,8,,,usd,11,,,\n3,7,,,eur,9,,,\n"
+	if buf.String() != want {
+		t.Errorf("ExportCSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestPriceListService_ImportCSV(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var upserted []*PriceRecord
+	var upsertBody string
+	var deleted []string
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":[{"variant_id":7,"currency":"usd","price":10,"sale_price":8},{"variant_id":8,"currency":"usd","price":11},
+				{"variant_id":9,"currency":"usd","price":12}],"meta":{"pagination":{"total_pages":1}}}`)
+		case "PUT":
+			body, _ := io.ReadAll(r.Body)
+			upsertBody = string(body)
+			json.Unmarshal(body, &upserted)
+			fmt.Fprint(w, `{"data":{},"meta":{}}`)
+		case "DELETE":
+			deleted = append(deleted, r.URL.Query().Get("currency")+":"+r.URL.Query().Get("variant_id:in"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	in := "price_list_id,variant_id,currency,price,sale_price\n2,7,usd,10,\n2,8,usd,11,9.5\n2,10,usd,0,\n"
+
+	diffs, err := client.PriceLists.ImportCSV(context.Background(), strings.NewReader(in), &PriceListImportOptions{DeleteMissing: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || len(diffs[0].Upserted) != 3 || len(diffs[0].Deleted) != 1 || diffs[0].Unchanged != 0 {
+		t.Fatalf("ImportCSV dry run = %+v", diffs[0])
+	}
+	if upserted != nil || deleted != nil {
+		t.Fatal("dry run changed the price list")
+	}
+
+	if _, err := client.PriceLists.ImportCSV(context.Background(), strings.NewReader(in), &PriceListImportOptions{DeleteMissing: true}); err != nil {
+		t.Fatal(err)
+	}
+	// Variant 7's sale price is cleared by its empty cell
+	want := []*PriceRecord{{VariantID: 7, Currency: "usd", Price: 10}, {VariantID: 8, Currency: "usd", Price: 11, SalePrice: Float64(9.5)},
+		{VariantID: 10, Currency: "usd", Price: 0}}
+	if !reflect.DeepEqual(upserted, want) {
+		t.Errorf("upserted %+v, want %+v", upserted, want)
+	}
+	if !strings.Contains(upsertBody, `{"variant_id":10,"currency":"usd","price":0,`) {
+		t.Errorf("upsert body %s does not price variant 10 at 0", upsertBody)
+	}
+	if !reflect.DeepEqual(deleted, []string{"usd:9"}) {
+		t.Errorf("deleted %v, want [usd:9]", deleted)
+	}
+}
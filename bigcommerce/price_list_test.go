@@ -0,0 +1,211 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPriceListService_ListRecords(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"variant_id:in": "7,8", "currency": "usd"})
+		fmt.Fprint(w, `{"data":[{"price_list_id":2,"variant_id":7,"product_id":1,"sku":"SKU-7","currency":"usd","price":19.99,"sale_price":14.5,"calculated_price":14.5}],"meta":{}}`)
+	})
+
+	records, _, err := client.PriceLists.ListRecords(context.Background(), 2, &PriceRecordListOptions{VariantIDs: []int64{7, 8}, Currency: "usd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*PriceRecord{{PriceListID: 2, VariantID: 7, ProductID: 1, SKU: "SKU-7", Currency: "usd", Price: 19.99, SalePrice: Float64(14.5), CalculatedPrice: 14.5}}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("ListRecords = %+v, want %+v", records[0], want[0])
+	}
+}
+
+func TestPriceListService_UpsertRecords(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*PriceRecord{{VariantID: 7, Currency: "usd", Price: 21}, {SKU: "SKU-8", Currency: "eur", Price: 18.5}}
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*PriceRecord{}, &input)
+		fmt.Fprint(w, `{"data":{},"meta":{}}`)
+	})
+
+	if _, err := client.PriceLists.UpsertRecords(context.Background(), 2, input); err != nil {
+		t.Errorf("UpsertRecords returned error: %v", err)
+	}
+}
+
+func TestPriceListService_DeleteRecords(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"variant_id:in": "7,8", "currency": "usd"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PriceLists.DeleteRecords(context.Background(), 2, "usd", []int64{7, 8}); err != nil {
+		t.Errorf("DeleteRecords returned error: %v", err)
+	}
+}
+
+func TestPriceListService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"name": "Wholesale"})
+		fmt.Fprint(w, `{"data":[{"id":2,"name":"Wholesale","active":true,"date_created":"2021-01-01T00:00:00Z"}],"meta":{}}`)
+	})
+
+	lists, _, err := client.PriceLists.List(context.Background(), &PriceListListOptions{Name: "Wholesale"})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	want := []*PriceList{{ID: 2, Name: "Wholesale", Active: true, DateCreated: "2021-01-01T00:00:00Z"}}
+	if !reflect.DeepEqual(lists, want) {
+		t.Errorf("List returned %+v, want %+v", lists, want)
+	}
+}
+
+func TestPriceListService_CreateUpdateDelete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(PriceList), &PriceList{Name: "VIP", Active: true})
+		fmt.Fprint(w, `{"data":{"id":3,"name":"VIP","active":true},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/pricelists/3", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testBody(t, r, new(PriceList), &PriceList{Name: "VIP"})
+			fmt.Fprint(w, `{"data":{"id":3,"name":"VIP","active":false},"meta":{}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s", r.Method)
+		}
+	})
+
+	created, _, err := client.PriceLists.Create(context.Background(), &PriceList{Name: "VIP", Active: true})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if want := (&PriceList{ID: 3, Name: "VIP", Active: true}); !reflect.DeepEqual(created, want) {
+		t.Errorf("Create returned %+v, want %+v", created, want)
+	}
+	updated, _, err := client.PriceLists.Update(context.Background(), 3, &PriceList{Name: "VIP"})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if updated.Active {
+		t.Errorf("Update returned %+v, want inactive", updated)
+	}
+	if _, err := client.PriceLists.Delete(context.Background(), 3); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestPriceListService_UpsertAllRecords(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var sizes []int
+	mux.HandleFunc("/stores/abc123/v3/pricelists/2/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var records []*PriceRecord
+		json.NewDecoder(r.Body).Decode(&records)
+		sizes = append(sizes, len(records))
+		fmt.Fprint(w, `{"data":{},"meta":{}}`)
+	})
+
+	records := make([]*PriceRecord, 2500)
+	for i := range records {
+		records[i] = &PriceRecord{VariantID: int64(i + 1), Currency: "usd", Price: 10}
+	}
+	if err := client.PriceLists.UpsertAllRecords(context.Background(), 2, records); err != nil {
+		t.Fatalf("UpsertAllRecords returned error: %v", err)
+	}
+	if want := []int{1000, 1000, 500}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Batch sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestPriceListService_ListAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists/assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"price_list_id:in": "2", "channel_id:in": "1,3"})
+		fmt.Fprint(w, `{"data":[{"id":5,"price_list_id":2,"customer_group_id":4,"channel_id":1}],"meta":{}}`)
+	})
+
+	opts := &PriceListAssignmentListOptions{PriceListAssignmentFilter: PriceListAssignmentFilter{PriceListIDs: []int64{2}, ChannelIDs: []int64{1, 3}}}
+	assignments, _, err := client.PriceLists.ListAssignments(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("ListAssignments returned error: %v", err)
+	}
+	want := []*PriceListAssignment{{ID: 5, PriceListID: 2, CustomerGroupID: 4, ChannelID: 1}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("ListAssignments returned %+v, want %+v", assignments, want)
+	}
+}
+
+func TestPriceListService_CreateAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*PriceListAssignment{{PriceListID: 2, CustomerGroupID: 4}, {PriceListID: 2, ChannelID: 1}}
+	mux.HandleFunc("/stores/abc123/v3/pricelists/assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, &[]*PriceListAssignment{}, &input)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PriceLists.CreateAssignments(context.Background(), input); err != nil {
+		t.Errorf("CreateAssignments returned error: %v", err)
+	}
+}
+
+func TestPriceListService_DeleteAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/pricelists/assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"customer_group_id:in": "4,6"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PriceLists.DeleteAssignments(context.Background(), &PriceListAssignmentFilter{CustomerGroupIDs: []int64{4, 6}}); err != nil {
+		t.Errorf("DeleteAssignments returned error: %v", err)
+	}
+	if _, err := client.PriceLists.DeleteAssignments(context.Background(), &PriceListAssignmentFilter{}); err == nil {
+		t.Error("DeleteAssignments without a filter returned no error")
+	}
+}
+
+func TestPriceListService_DeleteRecords_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.PriceLists.DeleteRecords(ctx, 2, "usd", nil); err != ErrNoIDs {
+		t.Errorf("DeleteRecords returned %v, want ErrNoIDs", err)
+	}
+}
@@ -1,10 +1,14 @@
 package bigcommerce
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
 
+// ProductService handles communication with the V2 product endpoints
+type ProductService service
+
 // Product describes a BigCommerce Product Object
 type Product struct {
 	ID                      int64               `json:"id,omitempty"`                        // The unique numerical ID of the product. Increments sequentially.
@@ -83,6 +87,8 @@ type Product struct {
 	Rules                   *BCResource         `json:"rules,omitempty"`                     // Rules that apply only to this product, based on the product’s option set. See Product Rules resource for information.
 	OptionSet               *BCResource         `json:"option_set,omitempty"`                // See the Product Option Sets resource for information.
 	Options                 *BCResource         `json:"options,omitempty"`                   // Options from the option set applied to the product. See the Product Options resource for information.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
 }
 
 // ParsedProduct is a complete product containing parsed brands, discount_rules, custom_fields, etc...
@@ -159,6 +165,86 @@ const (
 	PreorderProduct ProductAvailability = "preorder"
 )
 
+// ProductListOptions specifies the optional parameters to ProductService.List
+type ProductListOptions struct {
+	ListOptions
+	MinID           int64     `url:"min_id,omitempty"`            // Only return products with an ID of at least this value.
+	MaxID           int64     `url:"max_id,omitempty"`            // Only return products with an ID of at most this value.
+	Name            string    `url:"name,omitempty"`              // Filter by exact product name.
+	SKU             string    `url:"sku,omitempty"`               // Filter by SKU.
+	KeywordFilter   string    `url:"keyword_filter,omitempty"`    // Filter by keyword.
+	Category        int64     `url:"category,omitempty"`          // Filter by category ID.
+	BrandID         int64     `url:"brand_id,omitempty"`          // Filter by brand ID.
+	IsVisible       *bool     `url:"is_visible,omitempty"`        // Filter by storefront visibility.
+	Availability    string    `url:"availability,omitempty"`      // Filter by availability.
+	MinDateModified time.Time `url:"min_date_modified,omitempty"` // Only return products modified on or after this date.
+	MaxDateModified time.Time `url:"max_date_modified,omitempty"` // Only return products modified on or before this date.
+	ExcludeArchived bool      `url:"-"`                           // Drop archived products from the page, which may then hold fewer than Limit.
+}
+
+// List returns a page of products
+func (s *ProductService) List(ctx context.Context, opts *ProductListOptions) ([]*Product, *Response, error) {
+	path, err := addOptions("v2/products", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []*Product
+	resp, err := s.client.call(ctx, "GET", path, nil, &products)
+	if err != nil {
+		return nil, resp, err
+	}
+	if opts != nil && opts.ExcludeArchived {
+		archived, err := s.archivedFilter(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		kept := products[:0]
+		for _, p := range products {
+			if !archived(p.Categories) {
+				kept = append(kept, p)
+			}
+		}
+		products = kept
+	}
+	return products, resp, nil
+}
+
+// Get returns a single product
+func (s *ProductService) Get(ctx context.Context, id int64) (*Product, *Response, error) {
+	product := new(Product)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/products/%d", id), nil, product)
+	if err != nil {
+		return nil, resp, err
+	}
+	return product, resp, nil
+}
+
+// Create adds a product. Name, Type, Price, Categories, Availability and Weight are required.
+func (s *ProductService) Create(ctx context.Context, product *Product) (*Product, *Response, error) {
+	created := new(Product)
+	resp, err := s.client.call(ctx, "POST", "v2/products", product, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a product
+func (s *ProductService) Update(ctx context.Context, id int64, product *Product) (*Product, *Response, error) {
+	updated := new(Product)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/products/%d", id), product, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a product
+func (s *ProductService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/products/%d", id), nil, nil)
+}
+
 // DateRFC2822 describes RFC2822 type of Date, used by BigCommerce
 // ***Experimenting with GO's JSON Marshalling for DateRFC2822 (Not Implemented)***
 type DateRFC2822 time.Time
@@ -0,0 +1,134 @@
+package bigcommerce
+
+import (
+	"context"
+)
+
+// ArchiveCategoryName is the name of the hidden top-level category holding
+// archived products
+const ArchiveCategoryName = "Archived"
+
+// archiveUpdate is the V3 batch payload archiving or restoring a product.
+// IsVisible is always sent, unlike CatalogProduct's.
+type archiveUpdate struct {
+	ID         int64   `json:"id"`
+	IsVisible  bool    `json:"is_visible"`
+	Categories []int64 `json:"categories"`
+}
+
+// Archive hides products from the storefront and moves them into the
+// archive category, created hidden on first use. BigCommerce has no archive
+// state; archived products are kept with their data, reviews and order
+// history, and can be excluded from lists with ExcludeArchived.
+func (s *ProductService) Archive(ctx context.Context, productIDs ...int64) error {
+	archiveID, err := s.archiveCategory(ctx, true)
+	if err != nil {
+		return err
+	}
+	return s.updateArchived(ctx, productIDs, func(p *CatalogProduct) *archiveUpdate {
+		if hasInt64(p.Categories, archiveID) && !p.IsVisible {
+			return nil
+		}
+		categories := p.Categories
+		if !hasInt64(categories, archiveID) {
+			categories = append(categories, archiveID)
+		}
+		return &archiveUpdate{ID: p.ID, IsVisible: false, Categories: categories}
+	})
+}
+
+// Unarchive removes products from the archive category and shows them on
+// the storefront again. Products are shown even if they were hidden before
+// being archived.
+func (s *ProductService) Unarchive(ctx context.Context, productIDs ...int64) error {
+	archiveID, err := s.archiveCategory(ctx, false)
+	if err != nil || archiveID == 0 {
+		return err
+	}
+	return s.updateArchived(ctx, productIDs, func(p *CatalogProduct) *archiveUpdate {
+		if !hasInt64(p.Categories, archiveID) {
+			return nil
+		}
+		categories := make([]int64, 0, len(p.Categories))
+		for _, id := range p.Categories {
+			if id != archiveID {
+				categories = append(categories, id)
+			}
+		}
+		return &archiveUpdate{ID: p.ID, IsVisible: true, Categories: categories}
+	})
+}
+
+// updateArchived reads the products in batches and sends the updates
+// returned by fn, skipping products for which it returns nil
+func (s *ProductService) updateArchived(ctx context.Context, productIDs []int64, fn func(*CatalogProduct) *archiveUpdate) error {
+	for start := 0; start < len(productIDs); start += maxProductBatch {
+		ids := productIDs[start:minInt(start+maxProductBatch, len(productIDs))]
+		products, _, err := s.client.Catalog.ListProducts(ctx, &CatalogProductListOptions{
+			ListOptions:   ListOptions{Limit: maxProductBatch},
+			IDs:           ids,
+			IncludeFields: []string{"categories", "is_visible"},
+		})
+		if err != nil {
+			return err
+		}
+		var updates []*archiveUpdate
+		for _, p := range products {
+			if u := fn(p); u != nil {
+				updates = append(updates, u)
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if _, err := s.client.call(ctx, "PUT", "v3/catalog/products", updates, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveCategory returns the ID of the archive category, creating it if
+// create is set. It returns 0 if the category does not exist.
+func (s *ProductService) archiveCategory(ctx context.Context, create bool) (int64, error) {
+	categories, _, err := s.client.Categories.List(ctx, &CategoryListOptions{Name: ArchiveCategoryName, ParentID: Int64(0)})
+	if err != nil {
+		return 0, err
+	}
+	if len(categories) > 0 {
+		return categories[0].ID, nil
+	}
+	if !create {
+		return 0, nil
+	}
+	category, _, err := s.client.Categories.Create(ctx, &Category{
+		Name:        ArchiveCategoryName,
+		Description: "Archived products, hidden from the storefront.",
+		IsVisible:   false,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return category.ID, nil
+}
+
+// archivedFilter returns a function reporting whether a product with the
+// given categories is archived
+func (s *ProductService) archivedFilter(ctx context.Context) (func(categories []int64) bool, error) {
+	archiveID, err := s.archiveCategory(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return func(categories []int64) bool {
+		return archiveID != 0 && hasInt64(categories, archiveID)
+	}, nil
+}
+
+func hasInt64(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestProductService_Archive(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			testQuery(t, r, map[string]string{"name": "Archived", "parent_id": "0"})
+			fmt.Fprint(w, `{"data":[],"meta":{}}`)
+			return
+		}
+		testMethod(t, r, "POST")
+		var category Category
+		json.NewDecoder(r.Body).Decode(&category)
+		if category.Name != ArchiveCategoryName || category.IsVisible || category.ParentID != 0 {
+			t.Errorf("created category %+v", category)
+		}
+		fmt.Fprint(w, `{"data":{"id":99,"name":"Archived"},"meta":{}}`)
+	})
+	var updates []map[string]interface{}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			testQuery(t, r, map[string]string{"id:in": "1,2", "include_fields": "categories,is_visible"})
+			fmt.Fprint(w, `{"data":[{"id":1,"categories":[18],"is_visible":true},{"id":2,"categories":[18,99],"is_visible":false}],"meta":{}}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		json.NewDecoder(r.Body).Decode(&updates)
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	if err := client.Products.Archive(context.Background(), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{{"id": 1.0, "is_visible": false, "categories": []interface{}{18.0, 99.0}}}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %v, want %v", updates, want)
+	}
+}
+
+func TestProductService_Unarchive(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":99,"name":"Archived"}],"meta":{}}`)
+	})
+	var updates []map[string]interface{}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"data":[{"id":1,"categories":[18],"is_visible":true},{"id":2,"categories":[18,99],"is_visible":false}],"meta":{}}`)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&updates)
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	if err := client.Products.Unarchive(context.Background(), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{{"id": 2.0, "is_visible": true, "categories": []interface{}{18.0}}}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %v, want %v", updates, want)
+	}
+}
+
+func TestProductService_Unarchive_noCategory(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	if err := client.Products.Unarchive(context.Background(), 1); err != nil {
+		t.Errorf("Unarchive returned error: %v", err)
+	}
+}
+
+func TestExcludeArchived(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":99,"name":"Archived"}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/products", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"categories":[18]},{"id":2,"categories":[18,99]}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"include_fields": "name,categories"})
+		fmt.Fprint(w, `{"data":[{"id":1,"categories":[99]},{"id":2,"categories":[18]}],"meta":{}}`)
+	})
+
+	products, _, err := client.Products.List(context.Background(), &ProductListOptions{ExcludeArchived: true})
+	if err != nil || len(products) != 1 || products[0].ID != 1 {
+		t.Errorf("Products.List = %+v, %v", products, err)
+	}
+
+	opts := &CatalogProductListOptions{IncludeFields: []string{"name"}, ExcludeArchived: true}
+	catalog, _, err := client.Catalog.ListProducts(context.Background(), opts)
+	if err != nil || len(catalog) != 1 || catalog[0].ID != 2 {
+		t.Errorf("Catalog.ListProducts = %+v, %v", catalog, err)
+	}
+	if !reflect.DeepEqual(opts.IncludeFields, []string{"name"}) {
+		t.Errorf("ListProducts modified opts.IncludeFields: %v", opts.IncludeFields)
+	}
+}
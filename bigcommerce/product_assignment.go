@@ -0,0 +1,125 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+)
+
+// ProductChannelAssignment assigns a product to a channel, making it
+// available to be listed there. Multi-storefront stores only show a product
+// on the storefronts it is assigned to.
+type ProductChannelAssignment struct {
+	ProductID int64 `json:"product_id"`
+	ChannelID int64 `json:"channel_id"`
+}
+
+// ProductCategoryAssignment assigns a product to a category. With several
+// storefronts, the category's tree decides which storefront shows it there.
+type ProductCategoryAssignment struct {
+	ProductID  int64 `json:"product_id"`
+	CategoryID int64 `json:"category_id"`
+}
+
+// ProductChannelFilter selects product channel assignments. Fields left empty
+// do not filter.
+type ProductChannelFilter struct {
+	ProductIDs []int64 `url:"product_id:in,omitempty"`
+	ChannelIDs []int64 `url:"channel_id:in,omitempty"`
+}
+
+func (f *ProductChannelFilter) empty() bool {
+	return f == nil || len(f.ProductIDs) == 0 && len(f.ChannelIDs) == 0
+}
+
+// ProductCategoryFilter selects product category assignments. Fields left
+// empty do not filter.
+type ProductCategoryFilter struct {
+	ProductIDs  []int64 `url:"product_id:in,omitempty"`
+	CategoryIDs []int64 `url:"category_id:in,omitempty"`
+}
+
+func (f *ProductCategoryFilter) empty() bool {
+	return f == nil || len(f.ProductIDs) == 0 && len(f.CategoryIDs) == 0
+}
+
+// ProductChannelListOptions specifies the optional parameters to
+// CatalogService.ListChannelAssignments
+type ProductChannelListOptions struct {
+	ListOptions
+	ProductChannelFilter
+}
+
+// ProductCategoryListOptions specifies the optional parameters to
+// CatalogService.ListCategoryAssignments
+type ProductCategoryListOptions struct {
+	ListOptions
+	ProductCategoryFilter
+}
+
+// ListChannelAssignments returns a page of product channel assignments
+func (s *CatalogService) ListChannelAssignments(ctx context.Context, opts *ProductChannelListOptions) ([]*ProductChannelAssignment, *Response, error) {
+	path, err := addOptions("v3/catalog/products/channel-assignments", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assignments []*ProductChannelAssignment
+	resp, err := s.client.call(ctx, "GET", path, nil, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignments, resp, nil
+}
+
+// AssignChannels assigns products to channels. Existing assignments are kept.
+func (s *CatalogService) AssignChannels(ctx context.Context, assignments []*ProductChannelAssignment) (*Response, error) {
+	return s.client.call(ctx, "PUT", "v3/catalog/products/channel-assignments", assignments, nil)
+}
+
+// DeleteChannelAssignments removes the product channel assignments matching a
+// filter. The filter must set at least one field, so that every product cannot
+// be unassigned from every channel by mistake.
+func (s *CatalogService) DeleteChannelAssignments(ctx context.Context, filter *ProductChannelFilter) (*Response, error) {
+	if filter.empty() {
+		return nil, errors.New("bigcommerce: deleting product channel assignments requires a filter")
+	}
+	path, err := addOptions("v3/catalog/products/channel-assignments", filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListCategoryAssignments returns a page of product category assignments
+func (s *CatalogService) ListCategoryAssignments(ctx context.Context, opts *ProductCategoryListOptions) ([]*ProductCategoryAssignment, *Response, error) {
+	path, err := addOptions("v3/catalog/products/category-assignments", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assignments []*ProductCategoryAssignment
+	resp, err := s.client.call(ctx, "GET", path, nil, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignments, resp, nil
+}
+
+// AssignCategories assigns products to categories. Existing assignments are
+// kept.
+func (s *CatalogService) AssignCategories(ctx context.Context, assignments []*ProductCategoryAssignment) (*Response, error) {
+	return s.client.call(ctx, "PUT", "v3/catalog/products/category-assignments", assignments, nil)
+}
+
+// DeleteCategoryAssignments removes the product category assignments matching
+// a filter. The filter must set at least one field.
+func (s *CatalogService) DeleteCategoryAssignments(ctx context.Context, filter *ProductCategoryFilter) (*Response, error) {
+	if filter.empty() {
+		return nil, errors.New("bigcommerce: deleting product category assignments requires a filter")
+	}
+	path, err := addOptions("v3/catalog/products/category-assignments", filter)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
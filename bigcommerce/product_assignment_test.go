@@ -0,0 +1,123 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCatalogService_ListChannelAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/channel-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"product_id:in": "7,8", "limit": "50"})
+		fmt.Fprint(w, `{"data":[{"product_id":7,"channel_id":1},{"product_id":8,"channel_id":2}],"meta":{}}`)
+	})
+
+	assignments, _, err := client.Catalog.ListChannelAssignments(context.Background(), &ProductChannelListOptions{
+		ListOptions:          ListOptions{Limit: 50},
+		ProductChannelFilter: ProductChannelFilter{ProductIDs: []int64{7, 8}},
+	})
+	if err != nil {
+		t.Fatalf("ListChannelAssignments returned error: %v", err)
+	}
+	want := []*ProductChannelAssignment{{ProductID: 7, ChannelID: 1}, {ProductID: 8, ChannelID: 2}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("ListChannelAssignments returned %+v, want %+v", assignments, want)
+	}
+}
+
+func TestCatalogService_AssignChannels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*ProductChannelAssignment{{ProductID: 7, ChannelID: 2}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/channel-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*ProductChannelAssignment{}, &input)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Catalog.AssignChannels(context.Background(), input); err != nil {
+		t.Errorf("AssignChannels returned error: %v", err)
+	}
+}
+
+func TestCatalogService_DeleteChannelAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/channel-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"product_id:in": "7", "channel_id:in": "2"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	filter := &ProductChannelFilter{ProductIDs: []int64{7}, ChannelIDs: []int64{2}}
+	if _, err := client.Catalog.DeleteChannelAssignments(context.Background(), filter); err != nil {
+		t.Errorf("DeleteChannelAssignments returned error: %v", err)
+	}
+	if _, err := client.Catalog.DeleteChannelAssignments(context.Background(), &ProductChannelFilter{}); err == nil {
+		t.Error("DeleteChannelAssignments without a filter returned no error")
+	}
+}
+
+func TestCatalogService_ListCategoryAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/category-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"category_id:in": "23"})
+		fmt.Fprint(w, `{"data":[{"product_id":7,"category_id":23}],"meta":{}}`)
+	})
+
+	assignments, _, err := client.Catalog.ListCategoryAssignments(context.Background(), &ProductCategoryListOptions{
+		ProductCategoryFilter: ProductCategoryFilter{CategoryIDs: []int64{23}},
+	})
+	if err != nil {
+		t.Fatalf("ListCategoryAssignments returned error: %v", err)
+	}
+	want := []*ProductCategoryAssignment{{ProductID: 7, CategoryID: 23}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("ListCategoryAssignments returned %+v, want %+v", assignments, want)
+	}
+}
+
+func TestCatalogService_AssignCategories(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := []*ProductCategoryAssignment{{ProductID: 7, CategoryID: 23}, {ProductID: 8, CategoryID: 23}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/category-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &[]*ProductCategoryAssignment{}, &input)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Catalog.AssignCategories(context.Background(), input); err != nil {
+		t.Errorf("AssignCategories returned error: %v", err)
+	}
+}
+
+func TestCatalogService_DeleteCategoryAssignments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/category-assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"product_id:in": "7,8"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Catalog.DeleteCategoryAssignments(context.Background(), &ProductCategoryFilter{ProductIDs: []int64{7, 8}}); err != nil {
+		t.Errorf("DeleteCategoryAssignments returned error: %v", err)
+	}
+	if _, err := client.Catalog.DeleteCategoryAssignments(context.Background(), nil); err == nil {
+		t.Error("DeleteCategoryAssignments without a filter returned no error")
+	}
+}
@@ -0,0 +1,134 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ProductImageService handles communication with the V3 product image endpoints
+type ProductImageService service
+
+// CatalogImage describes a BigCommerce V3 Product Image Object
+type CatalogImage struct {
+	ID           int64  `json:"id,omitempty"`            // The unique numerical ID of the image.
+	ProductID    int64  `json:"product_id,omitempty"`    // The ID of the product the image belongs to.
+	IsThumbnail  bool   `json:"is_thumbnail,omitempty"`  // Whether the image is the product's thumbnail.
+	SortOrder    int64  `json:"sort_order,omitempty"`    // Order in which the image is displayed on the product page.
+	Description  string `json:"description,omitempty"`   // The image's alt text.
+	ImageFile    string `json:"image_file,omitempty"`    // The path of the uploaded file on the store's CDN. Read-only.
+	ImageURL     string `json:"image_url,omitempty"`     // A remote URL to fetch the image from when creating it. Write-only.
+	URLZoom      string `json:"url_zoom,omitempty"`      // CDN URL of the zoom-size image.
+	URLStandard  string `json:"url_standard,omitempty"`  // CDN URL of the standard-size image.
+	URLThumbnail string `json:"url_thumbnail,omitempty"` // CDN URL of the thumbnail-size image.
+	URLTiny      string `json:"url_tiny,omitempty"`      // CDN URL of the tiny-size image.
+	DateModified string `json:"date_modified,omitempty"` // The date the image was last modified.
+}
+
+// URLs returns the image's CDN URLs, from largest to smallest
+func (i *CatalogImage) URLs() []string {
+	var urls []string
+	for _, u := range []string{i.URLZoom, i.URLStandard, i.URLThumbnail, i.URLTiny} {
+		if u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// List returns the images of a product
+func (s *ProductImageService) List(ctx context.Context, productID int64, opts *ListOptions) ([]*CatalogImage, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/images", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var images []*CatalogImage
+	resp, err := s.client.call(ctx, "GET", path, nil, &images)
+	if err != nil {
+		return nil, resp, err
+	}
+	return images, resp, nil
+}
+
+// Get returns a single image of a product
+func (s *ProductImageService) Get(ctx context.Context, productID, imageID int64) (*CatalogImage, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/images/%d", productID, imageID)
+	image := new(CatalogImage)
+	resp, err := s.client.call(ctx, "GET", path, nil, image)
+	if err != nil {
+		return nil, resp, err
+	}
+	return image, resp, nil
+}
+
+// Create adds an image to a product, fetched by BigCommerce from image.ImageURL
+func (s *ProductImageService) Create(ctx context.Context, productID int64, image *CatalogImage) (*CatalogImage, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/images", productID)
+	created := new(CatalogImage)
+	resp, err := s.client.call(ctx, "POST", path, image, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Upload adds an image to a product by streaming its content from r as
+// multipart/form-data. The other fields of image, if given, are sent with it.
+func (s *ProductImageService) Upload(ctx context.Context, productID int64, filename string, r io.Reader, image *CatalogImage) (*CatalogImage, *Response, error) {
+	fields := map[string]string{}
+	if image != nil {
+		if image.IsThumbnail {
+			fields["is_thumbnail"] = "true"
+		}
+		if image.SortOrder != 0 {
+			fields["sort_order"] = strconv.FormatInt(image.SortOrder, 10)
+		}
+		if image.Description != "" {
+			fields["description"] = image.Description
+		}
+	}
+
+	path := fmt.Sprintf("v3/catalog/products/%d/images", productID)
+	req, err := s.client.NewUploadRequest(ctx, "POST", path, "image_file", filename, r, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(CatalogImage)
+	resp, err := s.client.Do(req, &envelope{Data: created})
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UploadFile adds an image to a product from a local file
+func (s *ProductImageService) UploadFile(ctx context.Context, productID int64, name string, image *CatalogImage) (*CatalogImage, *Response, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return s.Upload(ctx, productID, filepath.Base(name), f, image)
+}
+
+// Update modifies an image of a product
+func (s *ProductImageService) Update(ctx context.Context, productID, imageID int64, image *CatalogImage) (*CatalogImage, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/images/%d", productID, imageID)
+	updated := new(CatalogImage)
+	resp, err := s.client.call(ctx, "PUT", path, image, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes an image from a product
+func (s *ProductImageService) Delete(ctx context.Context, productID, imageID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/images/%d", productID, imageID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,65 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProductImageService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(CatalogImage), &CatalogImage{ImageURL: "https://example.com/scarf.jpg", IsThumbnail: true})
+		fmt.Fprint(w, `{"data":{"id":247,"product_id":32,"is_thumbnail":true,"url_zoom":"https://cdn/zoom.jpg","url_tiny":"https://cdn/tiny.jpg"},"meta":{}}`)
+	})
+
+	image, _, err := client.ProductImages.Create(context.Background(), 32, &CatalogImage{ImageURL: "https://example.com/scarf.jpg", IsThumbnail: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if urls := image.URLs(); len(urls) != 2 || urls[0] != "https://cdn/zoom.jpg" {
+		t.Errorf("Unexpected URLs %v", urls)
+	}
+}
+
+func TestProductImageService_UploadFile(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			t.Errorf("Unexpected content type %v", r.Header.Get("Content-Type"))
+		}
+		file, header, err := r.FormFile("image_file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "scarf.jpg" || string(content) != "image-bytes" || r.FormValue("description") != "Red scarf" {
+			t.Errorf("Unexpected upload %v %q %q", header.Filename, content, r.FormValue("description"))
+		}
+		fmt.Fprint(w, `{"data":{"id":248,"product_id":32,"description":"Red scarf","url_standard":"https://cdn/standard.jpg"},"meta":{}}`)
+	})
+
+	name := filepath.Join(t.TempDir(), "scarf.jpg")
+	if err := os.WriteFile(name, []byte("image-bytes"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	image, _, err := client.ProductImages.UploadFile(context.Background(), 32, name, &CatalogImage{Description: "Red scarf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.ID != 248 || image.URLStandard != "https://cdn/standard.jpg" {
+		t.Errorf("Unexpected image %+v", image)
+	}
+}
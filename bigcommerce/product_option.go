@@ -0,0 +1,187 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProductOptionService handles communication with the V3 product option and option value endpoints
+type ProductOptionService service
+
+// ProductOption describes a BigCommerce V3 Product Option Object. Options are the
+// choices (e.g. Color, Size) whose values combine into a product's variants.
+type ProductOption struct {
+	ID           int64         `json:"id,omitempty"`            // The unique numerical ID of the option.
+	ProductID    int64         `json:"product_id,omitempty"`    // The ID of the product the option belongs to.
+	Name         string        `json:"name,omitempty"`          // The unique option name, auto-generated from the display name if not set.
+	DisplayName  string        `json:"display_name,omitempty"`  // The name shown on the storefront.
+	Type         OptionType    `json:"type,omitempty"`          // The type of storefront control used for the option.
+	SortOrder    int64         `json:"sort_order,omitempty"`    // Order in which the option is displayed on the product page.
+	OptionValues []OptionValue `json:"option_values,omitempty"` // The option's values. Values included on create are created with the option.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// OptionValue describes a value of a V3 product option or modifier
+type OptionValue struct {
+	ID        int64            `json:"id,omitempty"`         // The unique numerical ID of the value.
+	Label     string           `json:"label,omitempty"`      // The text shown for the value, e.g. "Red".
+	SortOrder int64            `json:"sort_order"`           // Order in which the value is displayed.
+	IsDefault bool             `json:"is_default,omitempty"` // Whether the value is selected by default.
+	ValueData *OptionValueData `json:"value_data,omitempty"` // Extra data for swatch and product list options.
+}
+
+// OptionValueData describes the type-specific data of an OptionValue
+type OptionValueData struct {
+	Colors    []string `json:"colors,omitempty"`     // Up to three hex colors for swatch values.
+	ImageURL  string   `json:"image_url,omitempty"`  // Pattern image for swatch values.
+	ProductID int64    `json:"product_id,omitempty"` // Referenced product for product list values.
+}
+
+// OptionType - The type of storefront control used for an option
+type OptionType string
+
+const (
+	// SwatchOption - a set of color or pattern swatches
+	SwatchOption OptionType = "swatch"
+	// DropdownOption - a drop-down list
+	DropdownOption OptionType = "dropdown"
+	// RadioButtonsOption - a set of radio buttons
+	RadioButtonsOption OptionType = "radio_buttons"
+	// RectanglesOption - a set of rectangle buttons
+	RectanglesOption OptionType = "rectangles"
+	// ProductListOption - a list of other products
+	ProductListOption OptionType = "product_list"
+	// ProductListWithImagesOption - a list of other products, with their images
+	ProductListWithImagesOption OptionType = "product_list_with_images"
+)
+
+// Value returns the option value with the given label, or nil if there is none
+func (o *ProductOption) Value(label string) *OptionValue {
+	for i := range o.OptionValues {
+		if o.OptionValues[i].Label == label {
+			return &o.OptionValues[i]
+		}
+	}
+	return nil
+}
+
+// VariantOptionValue returns the reference to the labelled value used when
+// creating variants, so that options created together with their values can be
+// combined into variants without another lookup.
+func (o *ProductOption) VariantOptionValue(label string) (VariantOptionValue, bool) {
+	v := o.Value(label)
+	if v == nil {
+		return VariantOptionValue{}, false
+	}
+	return VariantOptionValue{ID: v.ID, OptionID: o.ID, Label: v.Label, OptionDisplayName: o.DisplayName}, true
+}
+
+// List returns the options of a product
+func (s *ProductOptionService) List(ctx context.Context, productID int64, opts *ListOptions) ([]*ProductOption, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/options", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var options []*ProductOption
+	resp, err := s.client.call(ctx, "GET", path, nil, &options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
+
+// Get returns a single option of a product
+func (s *ProductOptionService) Get(ctx context.Context, productID, optionID int64) (*ProductOption, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d", productID, optionID)
+	option := new(ProductOption)
+	resp, err := s.client.call(ctx, "GET", path, nil, option)
+	if err != nil {
+		return nil, resp, err
+	}
+	return option, resp, nil
+}
+
+// Create adds an option to a product. Any OptionValues are created along with
+// the option and returned with their new IDs.
+func (s *ProductOptionService) Create(ctx context.Context, productID int64, option *ProductOption) (*ProductOption, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options", productID)
+	created := new(ProductOption)
+	resp, err := s.client.call(ctx, "POST", path, option, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies an option of a product
+func (s *ProductOptionService) Update(ctx context.Context, productID, optionID int64, option *ProductOption) (*ProductOption, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d", productID, optionID)
+	updated := new(ProductOption)
+	resp, err := s.client.call(ctx, "PUT", path, option, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes an option, and any variants built from it, from a product
+func (s *ProductOptionService) Delete(ctx context.Context, productID, optionID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d", productID, optionID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// ListValues returns the values of a product option
+func (s *ProductOptionService) ListValues(ctx context.Context, productID, optionID int64, opts *ListOptions) ([]*OptionValue, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/catalog/products/%d/options/%d/values", productID, optionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []*OptionValue
+	resp, err := s.client.call(ctx, "GET", path, nil, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// GetValue returns a single value of a product option
+func (s *ProductOptionService) GetValue(ctx context.Context, productID, optionID, valueID int64) (*OptionValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d/values/%d", productID, optionID, valueID)
+	value := new(OptionValue)
+	resp, err := s.client.call(ctx, "GET", path, nil, value)
+	if err != nil {
+		return nil, resp, err
+	}
+	return value, resp, nil
+}
+
+// CreateValue adds a value to a product option
+func (s *ProductOptionService) CreateValue(ctx context.Context, productID, optionID int64, value *OptionValue) (*OptionValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d/values", productID, optionID)
+	created := new(OptionValue)
+	resp, err := s.client.call(ctx, "POST", path, value, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateValue modifies a value of a product option
+func (s *ProductOptionService) UpdateValue(ctx context.Context, productID, optionID, valueID int64, value *OptionValue) (*OptionValue, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d/values/%d", productID, optionID, valueID)
+	updated := new(OptionValue)
+	resp, err := s.client.call(ctx, "PUT", path, value, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteValue removes a value from a product option
+func (s *ProductOptionService) DeleteValue(ctx context.Context, productID, optionID, valueID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/options/%d/values/%d", productID, optionID, valueID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,75 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProductOptionService_CreateWithValues(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &ProductOption{
+		DisplayName: "Color",
+		Type:        SwatchOption,
+		OptionValues: []OptionValue{
+			{Label: "Red", ValueData: &OptionValueData{Colors: []string{"#FF0000"}}},
+			{Label: "Blue", SortOrder: 1, ValueData: &OptionValueData{Colors: []string{"#0000FF"}}},
+		},
+	}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(ProductOption), input)
+		fmt.Fprint(w, `{"data":{"id":3,"product_id":32,"display_name":"Color","type":"swatch","option_values":[
+			{"id":7,"label":"Red","sort_order":0,"value_data":{"colors":["#FF0000"]}},
+			{"id":8,"label":"Blue","sort_order":1,"value_data":{"colors":["#0000FF"]}}]},"meta":{}}`)
+	})
+
+	option, _, err := client.ProductOptions.Create(context.Background(), 32, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref, ok := option.VariantOptionValue("Blue")
+	if !ok || ref.ID != 8 || ref.OptionID != 3 {
+		t.Errorf("Unexpected variant option value %+v", ref)
+	}
+	if _, ok := option.VariantOptionValue("Green"); ok {
+		t.Error("Expected no value for Green")
+	}
+}
+
+func TestProductOptionService_Values(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/options/3/values", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":[{"id":7,"label":"Red","sort_order":0,"is_default":true}],"meta":{}}`)
+		case "POST":
+			testBody(t, r, new(OptionValue), &OptionValue{Label: "Green", SortOrder: 2})
+			fmt.Fprint(w, `{"data":{"id":9,"label":"Green","sort_order":2},"meta":{}}`)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/options/3/values/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	values, _, err := client.ProductOptions.ListValues(context.Background(), 32, 3, nil)
+	if err != nil || len(values) != 1 || !values[0].IsDefault {
+		t.Errorf("Unexpected values %+v, %v", values, err)
+	}
+
+	value, _, err := client.ProductOptions.CreateValue(context.Background(), 32, 3, &OptionValue{Label: "Green", SortOrder: 2})
+	if err != nil || value.ID != 9 {
+		t.Errorf("Unexpected value %+v, %v", value, err)
+	}
+
+	if _, err := client.ProductOptions.DeleteValue(context.Background(), 32, 3, 9); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,134 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// PromotionService handles communication with the V3 promotion endpoints
+type PromotionService service
+
+// Promotion describes a BigCommerce V3 Promotion Object, a set of rules
+// discounting carts that is applied automatically or with a coupon code
+type Promotion struct {
+	ID                           int64                   `json:"id,omitempty"`
+	RedemptionType               PromotionRedemptionType `json:"redemption_type,omitempty"` // Required on create.
+	Name                         string                  `json:"name,omitempty"`            // The name shown in the control panel. Required on create.
+	DisplayName                  string                  `json:"display_name,omitempty"`    // The name shown to shoppers.
+	Channels                     []*PromotionChannel     `json:"channels,omitempty"`        // The channels the promotion applies to, all if empty.
+	Customer                     *PromotionCustomer      `json:"customer,omitempty"`        // The customers eligible, all if nil.
+	Rules                        []*PromotionRule        `json:"rules,omitempty"`           // Required on create; build them with the rule helpers, e.g. DiscountCart.
+	CurrentUses                  int64                   `json:"current_uses,omitempty"`    // Read-only.
+	MaxUses                      int64                   `json:"max_uses,omitempty"`        // Unlimited if zero.
+	Status                       PromotionStatus         `json:"status,omitempty"`
+	StartDate                    string                  `json:"start_date,omitempty"` // RFC 3339.
+	EndDate                      string                  `json:"end_date,omitempty"`   // RFC 3339, none if empty.
+	Stop                         bool                    `json:"stop,omitempty"`       // Whether promotions of lower priority are skipped once this one applies.
+	CanBeUsedWithOtherPromotions bool                    `json:"can_be_used_with_other_promotions,omitempty"`
+	CurrencyCode                 string                  `json:"currency_code,omitempty"` // The currency of the amounts in the rules, the default currency if empty.
+	CreatedFrom                  string                  `json:"created_from,omitempty"`  // "api" or "react_ui". Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// PromotionChannel identifies a channel of a promotion
+type PromotionChannel struct {
+	ID int64 `json:"id"`
+}
+
+// PromotionCustomer restricts the customers eligible for a promotion
+type PromotionCustomer struct {
+	GroupIDs          []int64 `json:"group_ids,omitempty"`
+	ExcludedGroupIDs  []int64 `json:"excluded_group_ids,omitempty"`
+	MinimumOrderCount int64   `json:"minimum_order_count,omitempty"` // Only customers with at least this many orders.
+}
+
+// PromotionRedemptionType - How a promotion is redeemed
+type PromotionRedemptionType string
+
+const (
+	// AutomaticPromotion - applied to every eligible cart
+	AutomaticPromotion PromotionRedemptionType = "AUTOMATIC"
+	// CouponPromotion - applied to carts with one of its coupon codes
+	CouponPromotion PromotionRedemptionType = "COUPON"
+)
+
+// PromotionStatus - Whether a promotion is in use
+type PromotionStatus string
+
+const (
+	// EnabledPromotion - the promotion applies between its start and end dates
+	EnabledPromotion PromotionStatus = "ENABLED"
+	// DisabledPromotion - the promotion never applies
+	DisabledPromotion PromotionStatus = "DISABLED"
+	// InvalidPromotion - the promotion refers to deleted products or
+	// categories, and must be fixed
+	InvalidPromotion PromotionStatus = "INVALID"
+)
+
+// PromotionListOptions specifies the optional parameters to PromotionService.List
+type PromotionListOptions struct {
+	ListOptions
+	ID             int64                   `url:"id,omitempty"`
+	Name           string                  `url:"name,omitempty"`
+	Code           string                  `url:"code,omitempty"` // Filter by coupon code.
+	CurrencyCode   string                  `url:"currency_code,omitempty"`
+	RedemptionType PromotionRedemptionType `url:"redemption_type,omitempty"`
+	Status         PromotionStatus         `url:"status,omitempty"`
+	Channels       []int64                 `url:"channels,omitempty"`
+}
+
+// List returns a page of promotions
+func (s *PromotionService) List(ctx context.Context, opts *PromotionListOptions) ([]*Promotion, *Response, error) {
+	path, err := addOptions("v3/promotions", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var promotions []*Promotion
+	resp, err := s.client.call(ctx, "GET", path, nil, &promotions)
+	if err != nil {
+		return nil, resp, err
+	}
+	return promotions, resp, nil
+}
+
+// Get returns a single promotion
+func (s *PromotionService) Get(ctx context.Context, id int64) (*Promotion, *Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("v3/promotions/%d", id), nil)
+}
+
+// Create adds a promotion
+func (s *PromotionService) Create(ctx context.Context, promotion *Promotion) (*Promotion, *Response, error) {
+	return s.do(ctx, "POST", "v3/promotions", promotion)
+}
+
+// Update modifies a promotion. Fields left empty are unchanged; Rules, if
+// set, replace every rule.
+func (s *PromotionService) Update(ctx context.Context, id int64, promotion *Promotion) (*Promotion, *Response, error) {
+	return s.do(ctx, "PATCH", fmt.Sprintf("v3/promotions/%d", id), promotion)
+}
+
+// Delete removes the promotions with the given IDs, along with their coupon
+// codes
+func (s *PromotionService) Delete(ctx context.Context, ids []int64) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/promotions", &struct {
+		IDs []int64 `url:"id:in"`
+	}{ids})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *PromotionService) do(ctx context.Context, method, path string, body interface{}) (*Promotion, *Response, error) {
+	promotion := new(Promotion)
+	resp, err := s.client.call(ctx, method, path, body, promotion)
+	if err != nil {
+		return nil, resp, err
+	}
+	return promotion, resp, nil
+}
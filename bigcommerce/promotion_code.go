@@ -0,0 +1,127 @@
+package bigcommerce
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+// PromotionCode describes a coupon code of a coupon promotion
+type PromotionCode struct {
+	ID                 int64  `json:"id,omitempty"`
+	Code               string `json:"code"`                            // Up to 50 characters, unique across the store.
+	CurrentUses        int64  `json:"current_uses,omitempty"`          // Read-only.
+	MaxUses            int64  `json:"max_uses,omitempty"`              // Unlimited if zero.
+	MaxUsesPerCustomer int64  `json:"max_uses_per_customer,omitempty"` // Unlimited if zero.
+	Created            string `json:"created,omitempty"`               // RFC 3339. Read-only.
+}
+
+// PromotionCodeListOptions specifies the optional parameters to
+// PromotionService.ListCodes
+type PromotionCodeListOptions struct {
+	ListOptions
+}
+
+// ListCodes returns a page of the coupon codes of a promotion
+func (s *PromotionService) ListCodes(ctx context.Context, promotionID int64, opts *PromotionCodeListOptions) ([]*PromotionCode, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v3/promotions/%d/codes", promotionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var codes []*PromotionCode
+	resp, err := s.client.call(ctx, "GET", path, nil, &codes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return codes, resp, nil
+}
+
+// CreateCode adds a coupon code to a coupon promotion
+func (s *PromotionService) CreateCode(ctx context.Context, promotionID int64, code *PromotionCode) (*PromotionCode, *Response, error) {
+	created := new(PromotionCode)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/promotions/%d/codes", promotionID), code, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// DeleteCodes removes the coupon codes with the given IDs from a promotion
+func (s *PromotionService) DeleteCodes(ctx context.Context, promotionID int64, ids []int64) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions(fmt.Sprintf("v3/promotions/%d/codes", promotionID), &struct {
+		IDs []int64 `url:"id:in"`
+	}{ids})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// promotionCodeAlphabet leaves out characters easily misread, such as 0 and O
+const promotionCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// Attempts per code before GenerateCodes gives up on finding an unused one
+const promotionCodeAttempts = 5
+
+// GenerateCodesOptions specifies the codes made by PromotionService.GenerateCodes
+type GenerateCodesOptions struct {
+	Prefix             string // Prepended to every code, e.g. "SPRING-".
+	Length             int    // Random characters after the prefix, 8 if zero.
+	MaxUses            int64  // Uses of each code, unlimited if zero.
+	MaxUsesPerCustomer int64  // Uses of each code per customer, unlimited if zero.
+}
+
+// GenerateCodes adds n random coupon codes to a coupon promotion, one request
+// per code, and returns those created. A code already used elsewhere in the
+// store is replaced by a new random one. On error, the codes created so far are
+// returned with it.
+func (s *PromotionService) GenerateCodes(ctx context.Context, promotionID int64, n int, opts *GenerateCodesOptions) ([]*PromotionCode, error) {
+	if opts == nil {
+		opts = &GenerateCodesOptions{}
+	}
+	length := opts.Length
+	if length == 0 {
+		length = 8
+	}
+	if len(opts.Prefix)+length > 50 {
+		return nil, fmt.Errorf("bigcommerce: coupon codes of %d characters exceed the 50 character limit", len(opts.Prefix)+length)
+	}
+
+	var codes []*PromotionCode
+	for len(codes) < n {
+		for attempt := 1; ; attempt++ {
+			code, err := randomPromotionCode(opts.Prefix, length)
+			if err != nil {
+				return codes, err
+			}
+			created, _, err := s.CreateCode(ctx, promotionID, &PromotionCode{
+				Code:               code,
+				MaxUses:            opts.MaxUses,
+				MaxUsesPerCustomer: opts.MaxUsesPerCustomer,
+			})
+			if err == nil {
+				codes = append(codes, created)
+				break
+			}
+			if Classify(err).Category != ConflictError || attempt == promotionCodeAttempts {
+				return codes, err
+			}
+		}
+	}
+	return codes, nil
+}
+
+func randomPromotionCode(prefix string, length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = promotionCodeAlphabet[int(b[i])%len(promotionCodeAlphabet)]
+	}
+	return prefix + string(b), nil
+}
@@ -0,0 +1,118 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPromotionService_ListCodes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions/9/codes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"page": "2"})
+		fmt.Fprint(w, `{"data":[{"id":1,"code":"SAVE10","current_uses":3,"max_uses":100}],"meta":{}}`)
+	})
+
+	codes, _, err := client.Promotions.ListCodes(context.Background(), 9, &PromotionCodeListOptions{ListOptions{Page: 2}})
+	if err != nil {
+		t.Fatalf("ListCodes returned error: %v", err)
+	}
+	want := []*PromotionCode{{ID: 1, Code: "SAVE10", CurrentUses: 3, MaxUses: 100}}
+	if !reflect.DeepEqual(codes, want) {
+		t.Errorf("ListCodes returned %+v, want %+v", codes, want)
+	}
+}
+
+func TestPromotionService_CreateCode(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions/9/codes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(PromotionCode), &PromotionCode{Code: "VIP", MaxUsesPerCustomer: 1})
+		fmt.Fprint(w, `{"data":{"id":2,"code":"VIP","max_uses_per_customer":1},"meta":{}}`)
+	})
+
+	code, _, err := client.Promotions.CreateCode(context.Background(), 9, &PromotionCode{Code: "VIP", MaxUsesPerCustomer: 1})
+	if err != nil {
+		t.Fatalf("CreateCode returned error: %v", err)
+	}
+	if want := (&PromotionCode{ID: 2, Code: "VIP", MaxUsesPerCustomer: 1}); !reflect.DeepEqual(code, want) {
+		t.Errorf("CreateCode returned %+v, want %+v", code, want)
+	}
+}
+
+func TestPromotionService_DeleteCodes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions/9/codes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "1,2"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Promotions.DeleteCodes(context.Background(), 9, []int64{1, 2}); err != nil {
+		t.Fatalf("DeleteCodes returned error: %v", err)
+	}
+}
+
+func TestPromotionService_GenerateCodes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requests int
+	seen := map[string]bool{}
+	mux.HandleFunc("/stores/abc123/v3/promotions/9/codes", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		code := new(PromotionCode)
+		json.NewDecoder(r.Body).Decode(code)
+		if !strings.HasPrefix(code.Code, "SPRING-") || len(code.Code) != len("SPRING-")+6 {
+			t.Errorf("Generated code %q", code.Code)
+		}
+		if code.MaxUses != 1 {
+			t.Errorf("MaxUses = %d, want 1", code.MaxUses)
+		}
+		if requests == 2 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"status":409,"title":"Coupon code already exists"}`)
+			return
+		}
+		seen[code.Code] = true
+		fmt.Fprintf(w, `{"data":{"id":%d,"code":%q,"max_uses":1},"meta":{}}`, requests, code.Code)
+	})
+
+	codes, err := client.Promotions.GenerateCodes(context.Background(), 9, 3, &GenerateCodesOptions{Prefix: "SPRING-", Length: 6, MaxUses: 1})
+	if err != nil {
+		t.Fatalf("GenerateCodes returned error: %v", err)
+	}
+	if len(codes) != 3 || requests != 4 {
+		t.Errorf("GenerateCodes returned %d codes in %d requests, want 3 in 4", len(codes), requests)
+	}
+	for _, c := range codes {
+		if !seen[c.Code] {
+			t.Errorf("GenerateCodes returned unexpected code %q", c.Code)
+		}
+	}
+
+	if _, err := client.Promotions.GenerateCodes(context.Background(), 9, 1, &GenerateCodesOptions{Prefix: strings.Repeat("X", 45)}); err == nil {
+		t.Error("GenerateCodes with codes over 50 characters returned no error")
+	}
+}
+
+func TestPromotionService_DeleteCodes_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Promotions.DeleteCodes(ctx, 5, nil); err != ErrNoIDs {
+		t.Errorf("DeleteCodes returned %v, want ErrNoIDs", err)
+	}
+}
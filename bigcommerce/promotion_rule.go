@@ -0,0 +1,207 @@
+package bigcommerce
+
+import "strconv"
+
+// PromotionRule is a rule of a promotion: an action taken when a condition
+// holds. Build rules with NewPromotionRule and the action, condition and item
+// matcher helpers, e.g.
+//
+//	NewPromotionRule(
+//		DiscountItems(MatchCategories(21), PercentOff(20)),
+//		CartSpendAtLeast(100),
+//	)
+type PromotionRule struct {
+	Action    *PromotionAction    `json:"action"`
+	Condition *PromotionCondition `json:"condition,omitempty"` // Always applies if nil.
+	ApplyOnce bool                `json:"apply_once"`          // Whether the action applies once per cart, rather than once per match of the condition.
+	Stop      bool                `json:"stop"`                // Whether the promotion's later rules are skipped once this one applies.
+}
+
+// PromotionAction is the discount of a rule. Exactly one field is set.
+type PromotionAction struct {
+	CartValue *CartValueAction `json:"cart_value,omitempty"`
+	CartItems *CartItemsAction `json:"cart_items,omitempty"`
+	GiftItem  *GiftItemAction  `json:"gift_item,omitempty"`
+	Shipping  *ShippingAction  `json:"shipping,omitempty"`
+}
+
+// PromotionDiscount is a percentage or a fixed amount off. Exactly one field
+// is set.
+type PromotionDiscount struct {
+	PercentageAmount string `json:"percentage_amount,omitempty"`
+	FixedAmount      string `json:"fixed_amount,omitempty"` // In the promotion's currency.
+}
+
+// CartValueAction discounts the cart's subtotal
+type CartValueAction struct {
+	Discount *PromotionDiscount `json:"discount"`
+}
+
+// CartItemsAction discounts the cart items matched
+type CartItemsAction struct {
+	Discount                          *PromotionDiscount `json:"discount"`
+	Items                             *ItemMatcher       `json:"items,omitempty"`    // The items discounted, all if nil.
+	Quantity                          int64              `json:"quantity,omitempty"` // The number of items discounted, all if zero.
+	Strategy                          ItemStrategy       `json:"strategy,omitempty"` // Which items are discounted first when Quantity is set.
+	AsTotal                           bool               `json:"as_total,omitempty"` // Whether a fixed amount is split across the items, rather than taken off each.
+	AddFreeItem                       bool               `json:"add_free_item,omitempty"`
+	IncludeItemsConsideredByCondition bool               `json:"include_items_considered_by_condition,omitempty"`
+	ExcludeItemsOnSale                bool               `json:"exclude_items_on_sale,omitempty"`
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// GiftItemAction adds a free product or variant to the cart
+type GiftItemAction struct {
+	ProductID int64 `json:"product_id,omitempty"`
+	VariantID int64 `json:"variant_id,omitempty"`
+	Quantity  int64 `json:"quantity"`
+}
+
+// ShippingAction makes shipping free
+type ShippingAction struct {
+	FreeShipping bool        `json:"free_shipping"`
+	ZoneIDs      interface{} `json:"zone_ids"` // The shipping zones, a list of IDs or "*" for all.
+}
+
+// ItemStrategy - Which items a cart items action discounts first
+type ItemStrategy string
+
+const (
+	// LeastExpensiveItems - the cheapest items first
+	LeastExpensiveItems ItemStrategy = "LEAST_EXPENSIVE"
+	// MostExpensiveItems - the most expensive items first
+	MostExpensiveItems ItemStrategy = "MOST_EXPENSIVE"
+)
+
+// PromotionCondition is the condition of a rule. Exactly one field is set.
+type PromotionCondition struct {
+	Cart *CartCondition        `json:"cart,omitempty"`
+	And  []*PromotionCondition `json:"and,omitempty"`
+	Or   []*PromotionCondition `json:"or,omitempty"`
+	Not  *PromotionCondition   `json:"not,omitempty"`
+}
+
+// CartCondition holds when the cart's matched items reach a spend or quantity
+type CartCondition struct {
+	Items           *ItemMatcher `json:"items,omitempty"`            // The items counted, all if nil.
+	MinimumSpend    string       `json:"minimum_spend,omitempty"`    // In the promotion's currency.
+	MinimumQuantity int64        `json:"minimum_quantity,omitempty"` // Items counted.
+}
+
+// ItemMatcher selects cart items. Exactly one field is set.
+type ItemMatcher struct {
+	Products   []int64        `json:"products,omitempty"`
+	Variants   []int64        `json:"variants,omitempty"`
+	Categories []int64        `json:"categories,omitempty"`
+	Brands     []int64        `json:"brands,omitempty"`
+	And        []*ItemMatcher `json:"and,omitempty"`
+	Or         []*ItemMatcher `json:"or,omitempty"`
+	Not        *ItemMatcher   `json:"not,omitempty"`
+}
+
+// NewPromotionRule returns a rule taking an action when a condition holds,
+// always if condition is nil
+func NewPromotionRule(action *PromotionAction, condition *PromotionCondition) *PromotionRule {
+	return &PromotionRule{Action: action, Condition: condition}
+}
+
+func promotionAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// PercentOff returns a discount of a percentage, e.g. 15 for 15%
+func PercentOff(percent float64) *PromotionDiscount {
+	return &PromotionDiscount{PercentageAmount: promotionAmount(percent)}
+}
+
+// AmountOff returns a discount of a fixed amount
+func AmountOff(amount float64) *PromotionDiscount {
+	return &PromotionDiscount{FixedAmount: promotionAmount(amount)}
+}
+
+// DiscountCart returns an action discounting the cart's subtotal
+func DiscountCart(discount *PromotionDiscount) *PromotionAction {
+	return &PromotionAction{CartValue: &CartValueAction{Discount: discount}}
+}
+
+// DiscountItems returns an action discounting the items matched, all items if
+// items is nil
+func DiscountItems(items *ItemMatcher, discount *PromotionDiscount) *PromotionAction {
+	return &PromotionAction{CartItems: &CartItemsAction{Discount: discount, Items: items}}
+}
+
+// GiftProduct returns an action adding a free product to the cart
+func GiftProduct(productID, quantity int64) *PromotionAction {
+	return &PromotionAction{GiftItem: &GiftItemAction{ProductID: productID, Quantity: quantity}}
+}
+
+// GiftVariant returns an action adding a free variant to the cart
+func GiftVariant(variantID, quantity int64) *PromotionAction {
+	return &PromotionAction{GiftItem: &GiftItemAction{VariantID: variantID, Quantity: quantity}}
+}
+
+// FreeShipping returns an action making shipping free in the given zones, or
+// in every zone if none are given
+func FreeShipping(zoneIDs ...int64) *PromotionAction {
+	var zones interface{} = "*"
+	if len(zoneIDs) > 0 {
+		zones = zoneIDs
+	}
+	return &PromotionAction{Shipping: &ShippingAction{FreeShipping: true, ZoneIDs: zones}}
+}
+
+// CartSpendAtLeast returns a condition holding when the cart's subtotal
+// reaches an amount
+func CartSpendAtLeast(amount float64) *PromotionCondition {
+	return &PromotionCondition{Cart: &CartCondition{MinimumSpend: promotionAmount(amount)}}
+}
+
+// ItemsSpendAtLeast returns a condition holding when the items matched cost
+// at least an amount in total
+func ItemsSpendAtLeast(items *ItemMatcher, amount float64) *PromotionCondition {
+	return &PromotionCondition{Cart: &CartCondition{Items: items, MinimumSpend: promotionAmount(amount)}}
+}
+
+// ItemsQuantityAtLeast returns a condition holding when the cart holds at
+// least quantity of the items matched
+func ItemsQuantityAtLeast(items *ItemMatcher, quantity int64) *PromotionCondition {
+	return &PromotionCondition{Cart: &CartCondition{Items: items, MinimumQuantity: quantity}}
+}
+
+// AllConditions returns a condition holding when every condition holds
+func AllConditions(conditions ...*PromotionCondition) *PromotionCondition {
+	return &PromotionCondition{And: conditions}
+}
+
+// AnyCondition returns a condition holding when at least one condition holds
+func AnyCondition(conditions ...*PromotionCondition) *PromotionCondition {
+	return &PromotionCondition{Or: conditions}
+}
+
+// NotCondition returns a condition holding when a condition does not
+func NotCondition(condition *PromotionCondition) *PromotionCondition {
+	return &PromotionCondition{Not: condition}
+}
+
+// MatchProducts returns a matcher selecting the items of products
+func MatchProducts(ids ...int64) *ItemMatcher { return &ItemMatcher{Products: ids} }
+
+// MatchVariants returns a matcher selecting the items of variants
+func MatchVariants(ids ...int64) *ItemMatcher { return &ItemMatcher{Variants: ids} }
+
+// MatchCategories returns a matcher selecting the items of products in
+// categories
+func MatchCategories(ids ...int64) *ItemMatcher { return &ItemMatcher{Categories: ids} }
+
+// MatchBrands returns a matcher selecting the items of products of brands
+func MatchBrands(ids ...int64) *ItemMatcher { return &ItemMatcher{Brands: ids} }
+
+// MatchAll returns a matcher selecting the items selected by every matcher
+func MatchAll(matchers ...*ItemMatcher) *ItemMatcher { return &ItemMatcher{And: matchers} }
+
+// MatchAny returns a matcher selecting the items selected by any matcher
+func MatchAny(matchers ...*ItemMatcher) *ItemMatcher { return &ItemMatcher{Or: matchers} }
+
+// MatchNot returns a matcher selecting the items a matcher does not
+func MatchNot(matcher *ItemMatcher) *ItemMatcher { return &ItemMatcher{Not: matcher} }
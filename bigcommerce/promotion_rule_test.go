@@ -0,0 +1,43 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPromotionRule_builders(t *testing.T) {
+	tests := []struct {
+		rule *PromotionRule
+		want string
+	}{
+		{
+			NewPromotionRule(DiscountItems(MatchCategories(21, 22), PercentOff(12.5)), nil),
+			`{"action":{"cart_items":{"discount":{"percentage_amount":"12.5"},"items":{"categories":[21,22]}}},"apply_once":false,"stop":false}`,
+		},
+		{
+			NewPromotionRule(DiscountCart(AmountOff(5)), AllConditions(CartSpendAtLeast(50), NotCondition(ItemsQuantityAtLeast(MatchBrands(3), 1)))),
+			`{"action":{"cart_value":{"discount":{"fixed_amount":"5"}}},"condition":{"and":[{"cart":{"minimum_spend":"50"}},{"not":{"cart":{"items":{"brands":[3]},"minimum_quantity":1}}}]},"apply_once":false,"stop":false}`,
+		},
+		{
+			NewPromotionRule(FreeShipping(), ItemsSpendAtLeast(MatchAny(MatchProducts(1), MatchAll(MatchVariants(2), MatchNot(MatchCategories(4)))), 20)),
+			`{"action":{"shipping":{"free_shipping":true,"zone_ids":"*"}},"condition":{"cart":{"items":{"or":[{"products":[1]},{"and":[{"variants":[2]},{"not":{"categories":[4]}}]}]},"minimum_spend":"20"}},"apply_once":false,"stop":false}`,
+		},
+		{
+			NewPromotionRule(FreeShipping(1, 2), AnyCondition(CartSpendAtLeast(100))),
+			`{"action":{"shipping":{"free_shipping":true,"zone_ids":[1,2]}},"condition":{"or":[{"cart":{"minimum_spend":"100"}}]},"apply_once":false,"stop":false}`,
+		},
+		{
+			NewPromotionRule(GiftVariant(8, 2), nil),
+			`{"action":{"gift_item":{"variant_id":8,"quantity":2}},"apply_once":false,"stop":false}`,
+		},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Rule encoded as\n%s\nwant\n%s", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPromotionService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"redemption_type": "COUPON", "status": "ENABLED", "code": "SAVE10"})
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Save 10","redemption_type":"COUPON","status":"ENABLED","current_uses":4,
+			"rules":[{"action":{"cart_value":{"discount":{"percentage_amount":"10"}}},"apply_once":true,"stop":false}]}],"meta":{}}`)
+	})
+
+	promotions, _, err := client.Promotions.List(context.Background(), &PromotionListOptions{
+		RedemptionType: CouponPromotion,
+		Status:         EnabledPromotion,
+		Code:           "SAVE10",
+	})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	want := []*Promotion{{
+		ID:             1,
+		Name:           "Save 10",
+		RedemptionType: CouponPromotion,
+		Status:         EnabledPromotion,
+		CurrentUses:    4,
+		Rules:          []*PromotionRule{{Action: DiscountCart(PercentOff(10)), ApplyOnce: true}},
+	}}
+	if !reflect.DeepEqual(promotions, want) {
+		t.Errorf("List returned %+v, want %+v", promotions, want)
+	}
+}
+
+func TestPromotionService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		rules, _ := json.Marshal(body["rules"])
+		want := `[{"action":{"gift_item":{"product_id":77,"quantity":1}},"apply_once":true,"condition":{"cart":{"minimum_spend":"150"}},"stop":false}]`
+		if string(rules) != want {
+			t.Errorf("Request rules = %s, want %s", rules, want)
+		}
+		fmt.Fprint(w, `{"data":{"id":9,"name":"Free gift","redemption_type":"AUTOMATIC"},"meta":{}}`)
+	})
+
+	rule := NewPromotionRule(GiftProduct(77, 1), CartSpendAtLeast(150))
+	rule.ApplyOnce = true
+	promotion, _, err := client.Promotions.Create(context.Background(), &Promotion{
+		Name:           "Free gift",
+		RedemptionType: AutomaticPromotion,
+		Rules:          []*PromotionRule{rule},
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if promotion.ID != 9 {
+		t.Errorf("Create returned %+v", promotion)
+	}
+}
+
+func TestPromotionService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, new(Promotion), &Promotion{Status: DisabledPromotion})
+		fmt.Fprint(w, `{"data":{"id":9,"status":"DISABLED"},"meta":{}}`)
+	})
+
+	promotion, _, err := client.Promotions.Update(context.Background(), 9, &Promotion{Status: DisabledPromotion})
+	if err != nil || promotion.Status != DisabledPromotion {
+		t.Errorf("Update returned %+v, %v", promotion, err)
+	}
+}
+
+func TestPromotionService_Get(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":{"id":9,"customer":{"group_ids":[1,2],"minimum_order_count":3}},"meta":{}}`)
+	})
+
+	promotion, _, err := client.Promotions.Get(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	want := &Promotion{ID: 9, Customer: &PromotionCustomer{GroupIDs: []int64{1, 2}, MinimumOrderCount: 3}}
+	if !reflect.DeepEqual(promotion, want) {
+		t.Errorf("Get returned %+v, want %+v", promotion, want)
+	}
+}
+
+func TestPromotionService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/promotions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "1,2"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Promotions.Delete(context.Background(), []int64{1, 2}); err != nil {
+		t.Errorf("Delete returned error: %v", err)
+	}
+}
+
+func TestPromotionService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Promotions.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
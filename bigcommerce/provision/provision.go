@@ -0,0 +1,416 @@
+// Package provision applies a declarative store blueprint to a BigCommerce
+// store. Resources are matched to existing ones by name, created when missing
+// and updated when they differ, so applying a blueprint again only changes what
+// has drifted since.
+package provision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/internal/jsondiff"
+)
+
+// Blueprint describes the configuration of a store
+type Blueprint struct {
+	Settings      map[bigcommerce.SettingsGroup]map[string]interface{} `json:"settings,omitempty"`       // Settings groups, applied field by field.
+	TaxClasses    []string                                             `json:"tax_classes,omitempty"`    // Tax class names.
+	Categories    []*Category                                          `json:"categories,omitempty"`     // The category tree, matched by name among siblings.
+	Brands        []*bigcommerce.Brand                                 `json:"brands,omitempty"`         // Brands, matched by name.
+	ShippingZones []*bigcommerce.ShippingZone                          `json:"shipping_zones,omitempty"` // Shipping zones, matched by name.
+	Scripts       []*bigcommerce.Script                                `json:"scripts,omitempty"`        // Storefront scripts, matched by name.
+	Webhooks      []*bigcommerce.Webhook                               `json:"webhooks,omitempty"`       // Webhooks, matched by scope and destination.
+}
+
+// Category is a category of a Blueprint, with its subcategories
+type Category struct {
+	bigcommerce.Category
+	Children []*Category `json:"children,omitempty"`
+}
+
+// ReadBlueprint decodes a JSON blueprint
+func ReadBlueprint(r io.Reader) (*Blueprint, error) {
+	bp := new(Blueprint)
+	if err := json.NewDecoder(r).Decode(bp); err != nil {
+		return nil, err
+	}
+	return bp, nil
+}
+
+// Op - What was done to a resource
+type Op string
+
+const (
+	// Created - the resource was missing and has been created
+	Created Op = "created"
+	// Updated - the resource differed from the blueprint and has been updated
+	Updated Op = "updated"
+	// Unchanged - the resource already matched the blueprint
+	Unchanged Op = "unchanged"
+)
+
+// Action records what was done to a single resource
+type Action struct {
+	Resource string // The kind of resource, e.g. "category".
+	Name     string // The name the resource was matched by.
+	ID       string // The resource's ID.
+	Op       Op
+}
+
+// Report lists the actions taken by Provisioner.Apply
+type Report struct {
+	Actions []Action
+}
+
+// Count returns the number of actions of the given op
+func (r *Report) Count(op Op) int {
+	n := 0
+	for _, a := range r.Actions {
+		if a.Op == op {
+			n++
+		}
+	}
+	return n
+}
+
+// Provisioner applies blueprints to a store
+type Provisioner struct {
+	Client *bigcommerce.Client
+	DryRun bool // Report what would change without changing anything.
+}
+
+// Apply brings the store in line with bp. Resources are applied in dependency
+// order: settings, tax classes, categories (parents first), brands, shipping
+// zones, scripts, then webhooks, so that events are only delivered once the
+// store is set up. Apply stops at the first error, returning the actions taken
+// so far; fixing the cause and applying again resumes where it stopped.
+func (p *Provisioner) Apply(ctx context.Context, bp *Blueprint) (*Report, error) {
+	report := new(Report)
+	steps := []func(context.Context, *Blueprint, *Report) error{
+		p.applySettings,
+		p.applyTaxClasses,
+		p.applyCategories,
+		p.applyBrands,
+		p.applyShippingZones,
+		p.applyScripts,
+		p.applyWebhooks,
+	}
+	for _, step := range steps {
+		if err := step(ctx, bp, report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// record adds an action to the report, returning whether the change should be made
+func (p *Provisioner) record(report *Report, resource, name, id string, op Op) bool {
+	report.Actions = append(report.Actions, Action{Resource: resource, Name: name, ID: id, Op: op})
+	return op != Unchanged && !p.DryRun
+}
+
+func (p *Provisioner) applySettings(ctx context.Context, bp *Blueprint, report *Report) error {
+	groups := make([]string, 0, len(bp.Settings))
+	for group := range bp.Settings {
+		groups = append(groups, string(group))
+	}
+	sort.Strings(groups)
+
+	for _, g := range groups {
+		group := bigcommerce.SettingsGroup(g)
+		current := map[string]interface{}{}
+		if _, err := p.Client.Settings.Get(ctx, group, &current); err != nil {
+			return fmt.Errorf("provision: reading %s settings: %v", group, err)
+		}
+		op := Unchanged
+		if jsondiff.Differs(bp.Settings[group], current) {
+			op = Updated
+		}
+		if p.record(report, "settings", g, "", op) {
+			if _, err := p.Client.Settings.Update(ctx, group, bp.Settings[group]); err != nil {
+				return fmt.Errorf("provision: updating %s settings: %v", group, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Provisioner) applyTaxClasses(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.TaxClasses) == 0 {
+		return nil
+	}
+	existing := map[string]*bigcommerce.TaxClass{}
+	opts := &bigcommerce.ListOptions{Page: 1, Limit: 250}
+	for {
+		page, _, err := p.Client.TaxClasses.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provision: listing tax classes: %v", err)
+		}
+		for _, c := range page {
+			existing[c.Name] = c
+		}
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, name := range bp.TaxClasses {
+		if c, ok := existing[name]; ok {
+			p.record(report, "tax class", name, strconv.FormatInt(c.ID, 10), Unchanged)
+			continue
+		}
+		if !p.record(report, "tax class", name, "", Created) {
+			continue
+		}
+		created, _, err := p.Client.TaxClasses.Create(ctx, &bigcommerce.TaxClass{Name: name})
+		if err != nil {
+			return fmt.Errorf("provision: creating tax class %q: %v", name, err)
+		}
+		setID(report, strconv.FormatInt(created.ID, 10))
+	}
+	return nil
+}
+
+func (p *Provisioner) applyCategories(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.Categories) == 0 {
+		return nil
+	}
+	type key struct {
+		parentID int64
+		name     string
+	}
+	existing := map[key]*bigcommerce.Category{}
+	opts := &bigcommerce.CategoryListOptions{ListOptions: bigcommerce.ListOptions{Page: 1, Limit: 250}}
+	for {
+		page, resp, err := p.Client.Categories.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provision: listing categories: %v", err)
+		}
+		for _, c := range page {
+			existing[key{c.ParentID, c.Name}] = c
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+
+	var apply func(categories []*Category, parentID int64, path string) error
+	apply = func(categories []*Category, parentID int64, path string) error {
+		for _, c := range categories {
+			desired := c.Category
+			desired.ParentID = parentID
+			name := path + c.Name
+
+			var id int64
+			if current, ok := existing[key{parentID, c.Name}]; ok {
+				id = current.ID
+				op := Unchanged
+				if jsondiff.Differs(desired, current) {
+					op = Updated
+				}
+				if p.record(report, "category", name, strconv.FormatInt(id, 10), op) {
+					if _, _, err := p.Client.Categories.Update(ctx, id, &desired); err != nil {
+						return fmt.Errorf("provision: updating category %q: %v", name, err)
+					}
+				}
+			} else if p.record(report, "category", name, "", Created) {
+				created, _, err := p.Client.Categories.Create(ctx, &desired)
+				if err != nil {
+					return fmt.Errorf("provision: creating category %q: %v", name, err)
+				}
+				id = created.ID
+				setID(report, strconv.FormatInt(id, 10))
+			} else {
+				continue // A dry run cannot plan the children of a missing category by ID.
+			}
+
+			if err := apply(c.Children, id, name+"/"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return apply(bp.Categories, 0, "")
+}
+
+func (p *Provisioner) applyBrands(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.Brands) == 0 {
+		return nil
+	}
+	existing := map[string]*bigcommerce.Brand{}
+	opts := &bigcommerce.BrandListOptions{ListOptions: bigcommerce.ListOptions{Page: 1, Limit: 250}}
+	for {
+		page, resp, err := p.Client.Brands.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provision: listing brands: %v", err)
+		}
+		for _, b := range page {
+			existing[b.Name] = b
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, b := range bp.Brands {
+		if current, ok := existing[b.Name]; ok {
+			op := Unchanged
+			if jsondiff.Differs(b, current) {
+				op = Updated
+			}
+			if p.record(report, "brand", b.Name, strconv.FormatInt(current.ID, 10), op) {
+				if _, _, err := p.Client.Brands.Update(ctx, current.ID, b); err != nil {
+					return fmt.Errorf("provision: updating brand %q: %v", b.Name, err)
+				}
+			}
+			continue
+		}
+		if p.record(report, "brand", b.Name, "", Created) {
+			created, _, err := p.Client.Brands.Create(ctx, b)
+			if err != nil {
+				return fmt.Errorf("provision: creating brand %q: %v", b.Name, err)
+			}
+			setID(report, strconv.FormatInt(created.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (p *Provisioner) applyShippingZones(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.ShippingZones) == 0 {
+		return nil
+	}
+	zones, _, err := p.Client.ShippingZones.List(ctx)
+	if err != nil {
+		return fmt.Errorf("provision: listing shipping zones: %v", err)
+	}
+	existing := map[string]*bigcommerce.ShippingZone{}
+	for _, z := range zones {
+		existing[z.Name] = z
+	}
+
+	for _, z := range bp.ShippingZones {
+		if current, ok := existing[z.Name]; ok {
+			op := Unchanged
+			if jsondiff.Differs(z, current) {
+				op = Updated
+			}
+			if p.record(report, "shipping zone", z.Name, strconv.FormatInt(current.ID, 10), op) {
+				if _, _, err := p.Client.ShippingZones.Update(ctx, current.ID, z); err != nil {
+					return fmt.Errorf("provision: updating shipping zone %q: %v", z.Name, err)
+				}
+			}
+			continue
+		}
+		if p.record(report, "shipping zone", z.Name, "", Created) {
+			created, _, err := p.Client.ShippingZones.Create(ctx, z)
+			if err != nil {
+				return fmt.Errorf("provision: creating shipping zone %q: %v", z.Name, err)
+			}
+			setID(report, strconv.FormatInt(created.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (p *Provisioner) applyScripts(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.Scripts) == 0 {
+		return nil
+	}
+	existing := map[string]*bigcommerce.Script{}
+	opts := &bigcommerce.ListOptions{Page: 1, Limit: 250}
+	for {
+		page, resp, err := p.Client.Scripts.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provision: listing scripts: %v", err)
+		}
+		for _, s := range page {
+			existing[s.Name] = s
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, s := range bp.Scripts {
+		if current, ok := existing[s.Name]; ok {
+			op := Unchanged
+			if jsondiff.Differs(s, current) {
+				op = Updated
+			}
+			if p.record(report, "script", s.Name, current.UUID, op) {
+				if _, _, err := p.Client.Scripts.Update(ctx, current.UUID, s); err != nil {
+					return fmt.Errorf("provision: updating script %q: %v", s.Name, err)
+				}
+			}
+			continue
+		}
+		if p.record(report, "script", s.Name, "", Created) {
+			created, _, err := p.Client.Scripts.Create(ctx, s)
+			if err != nil {
+				return fmt.Errorf("provision: creating script %q: %v", s.Name, err)
+			}
+			setID(report, created.UUID)
+		}
+	}
+	return nil
+}
+
+func (p *Provisioner) applyWebhooks(ctx context.Context, bp *Blueprint, report *Report) error {
+	if len(bp.Webhooks) == 0 {
+		return nil
+	}
+	existing := map[string]*bigcommerce.Webhook{}
+	opts := &bigcommerce.WebhookListOptions{ListOptions: bigcommerce.ListOptions{Page: 1, Limit: 250}}
+	for {
+		page, resp, err := p.Client.Webhooks.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provision: listing webhooks: %v", err)
+		}
+		for _, h := range page {
+			existing[h.Scope+" "+h.Destination] = h
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, h := range bp.Webhooks {
+		name := h.Scope + " " + h.Destination
+		if current, ok := existing[name]; ok {
+			op := Unchanged
+			if jsondiff.Differs(h, current) {
+				op = Updated
+			}
+			if p.record(report, "webhook", name, strconv.FormatInt(current.ID, 10), op) {
+				if _, _, err := p.Client.Webhooks.Update(ctx, current.ID, h); err != nil {
+					return fmt.Errorf("provision: updating webhook %q: %v", name, err)
+				}
+			}
+			continue
+		}
+		if p.record(report, "webhook", name, "", Created) {
+			created, _, err := p.Client.Webhooks.Create(ctx, h)
+			if err != nil {
+				return fmt.Errorf("provision: creating webhook %q: %v", name, err)
+			}
+			setID(report, strconv.FormatInt(created.ID, 10))
+		}
+	}
+	return nil
+}
+
+// setID sets the ID of the last action, once a created resource has one
+func setID(report *Report, id string) {
+	report.Actions[len(report.Actions)-1].ID = id
+}
@@ -0,0 +1,123 @@
+package provision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+const testBlueprint = `{
+	"settings": {"store/profile": {"store_name": "Acme", "store_email": "hello@acme.test"}},
+	"tax_classes": ["Default Tax Class", "Clothing"],
+	"categories": [
+		{"name": "Apparel", "is_visible": true, "children": [
+			{"name": "Shirts", "is_visible": true},
+			{"name": "Hats", "is_visible": false}
+		]}
+	],
+	"brands": [{"name": "Acme", "page_title": "Acme Co"}],
+	"shipping_zones": [{"name": "US", "type": "country", "locations": [{"country_iso2": "US"}], "enabled": true}],
+	"scripts": [{"name": "Analytics", "src": "https://cdn.acme.test/a.js", "kind": "src", "location": "footer", "visibility": "all_pages", "auto_uninstall": true, "enabled": true}],
+	"webhooks": [{"scope": "store/order/created", "destination": "https://hooks.acme.test/orders", "is_active": true}]
+}`
+
+// newTestStore serves a sandbox, with the settings endpoints backed by a map
+func newTestStore(t *testing.T) (*bigcommerce.Client, *bctest.Server, map[string]interface{}) {
+	sandbox := bctest.NewServer()
+	if err := sandbox.Seed("v2/tax_classes", &bigcommerce.TaxClass{ID: 1, Name: "Default Tax Class"}); err != nil {
+		t.Fatal(err)
+	}
+	profile := map[string]interface{}{"store_name": "My Store"}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", sandbox)
+	mux.HandleFunc("/stores/abc123/v3/settings/store/profile", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			var update map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range update {
+				profile[k] = v
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": profile, "meta": map[string]interface{}{}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/stores/abc123/")
+	return bigcommerce.NewClient("abc123", "token", bigcommerce.WithBaseURL(baseURL)), sandbox, profile
+}
+
+func TestProvisioner_Apply(t *testing.T) {
+	client, sandbox, profile := newTestStore(t)
+	bp, err := ReadBlueprint(strings.NewReader(testBlueprint))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Provisioner{Client: client}
+
+	report, err := p.Apply(context.Background(), bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := report.Count(Created); got != 8 {
+		t.Errorf("Created %d resources, want 8: %+v", got, report.Actions)
+	}
+	if profile["store_name"] != "Acme" {
+		t.Errorf("Store profile = %v", profile)
+	}
+
+	categories := sandbox.Objects("v3/catalog/categories")
+	if len(categories) != 3 || fmt.Sprint(categories[1]["parent_id"]) != fmt.Sprint(categories[0]["id"]) || categories[2]["is_visible"] != false {
+		t.Errorf("Categories = %v", categories)
+	}
+
+	// Applying again changes nothing.
+	report, err = p.Apply(context.Background(), bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := report.Count(Unchanged); got != len(report.Actions) || got != 10 {
+		t.Errorf("Re-run actions = %+v, want 10 unchanged", report.Actions)
+	}
+
+	// Drift is corrected.
+	bp.Brands[0].PageTitle = "Acme Inc"
+	report, err = p.Apply(context.Background(), bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Count(Updated) != 1 || sandbox.Objects("v3/catalog/brands")[0]["page_title"] != "Acme Inc" {
+		t.Errorf("Drift actions = %+v", report.Actions)
+	}
+}
+
+func TestProvisioner_DryRun(t *testing.T) {
+	client, sandbox, profile := newTestStore(t)
+	bp, err := ReadBlueprint(strings.NewReader(testBlueprint))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := (&Provisioner{Client: client, DryRun: true}).Apply(context.Background(), bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Subcategories of missing categories cannot be planned.
+	if got := report.Count(Created); got != 6 {
+		t.Errorf("Planned %d creations, want 6: %+v", got, report.Actions)
+	}
+	if len(sandbox.Objects("v3/catalog/brands")) != 0 || profile["store_name"] != "My Store" {
+		t.Error("Dry run changed the store")
+	}
+}
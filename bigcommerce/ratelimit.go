@@ -0,0 +1,334 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitStore holds token bucket state. Workers sharing a store, and a
+// bucket key, collectively respect a single quota instead of each assuming a
+// full window for themselves.
+type RateLimitStore interface {
+	// Take removes a token from the bucket named key, which refills at rate
+	// tokens per second up to burst. It returns zero if a token was taken, or
+	// how long to wait before trying again.
+	Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+
+	// Pause empties the bucket named key until the given time, used when the
+	// API reports that the quota is exhausted.
+	Pause(ctx context.Context, key string, until time.Time) error
+}
+
+// DefaultRateLimit is the rate of a RateLimiter without one, in requests per
+// second: the quota of a Standard plan store, 150 requests per 30 seconds
+const DefaultRateLimit = 5.0
+
+// errInvalidRate is returned by the stores for buckets that would never refill
+var errInvalidRate = errors.New("bigcommerce: rate limit must be positive")
+
+// RateLimiter throttles a Client's requests with a token bucket. Requests
+// wait in lanes by the Priority of their context: a request only takes a
+// token when no request of a higher priority is waiting in the same process,
+// so a batch job cannot starve interactive requests.
+type RateLimiter struct {
+	Store RateLimitStore // Bucket state, NewMemoryRateLimitStore() if nil.
+	Key   string         // Bucket name, the client's store hash if empty.
+	Rate  float64        // Requests per second, DefaultRateLimit if not positive.
+	Burst int            // Maximum requests made at once after an idle period.
+
+	mu      sync.Mutex
+	waiting [priorityLanes]int
+	changed chan struct{} // Closed when a request leaves a lane.
+}
+
+// Priority - The lane a request waits in for the rate limiter
+type Priority int
+
+const (
+	// InteractivePriority - requests a user is waiting for; the default
+	InteractivePriority Priority = iota
+	// WebhookPriority - requests made while handling webhook events
+	WebhookPriority
+	// BatchPriority - background jobs such as exports and syncs
+	BatchPriority
+
+	priorityLanes = iota
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose requests wait in the lane of p
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns the priority of ctx, InteractivePriority if it has none
+func PriorityFrom(ctx context.Context) Priority {
+	p, _ := ctx.Value(priorityKey{}).(Priority)
+	if p < 0 || p >= priorityLanes {
+		return BatchPriority
+	}
+	return p
+}
+
+// WithRateLimiter throttles the client's requests with limiter. The limiter is
+// also paused whenever the API reports the quota as exhausted.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+func (l *RateLimiter) init(storeHash string) {
+	if l.Store == nil {
+		l.Store = NewMemoryRateLimitStore()
+	}
+	if l.Key == "" {
+		l.Key = storeHash
+	}
+	if l.Rate <= 0 {
+		l.Rate = DefaultRateLimit
+	}
+	if l.Burst < 1 {
+		l.Burst = 1
+	}
+}
+
+// Wait blocks until a request may be made or ctx is done. Requests of a lower
+// priority than ctx's wait for it first.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	lane := PriorityFrom(ctx)
+	l.enter(lane)
+	defer l.leave(lane)
+
+	for {
+		if ahead := l.ahead(lane); ahead != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ahead:
+			}
+			continue
+		}
+
+		wait, err := l.Store.Take(ctx, l.Key, l.Rate, l.Burst)
+		if err != nil || wait <= 0 {
+			return err
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (l *RateLimiter) enter(lane Priority) {
+	l.mu.Lock()
+	l.waiting[lane]++
+	l.mu.Unlock()
+}
+
+func (l *RateLimiter) leave(lane Priority) {
+	l.mu.Lock()
+	l.waiting[lane]--
+	if l.changed != nil {
+		close(l.changed)
+		l.changed = nil
+	}
+	l.mu.Unlock()
+}
+
+// ahead returns a channel closed when a request leaves its lane if requests of
+// a higher priority than lane are waiting, or nil
+func (l *RateLimiter) ahead(lane Priority) <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for p := InteractivePriority; p < lane; p++ {
+		if l.waiting[p] > 0 {
+			if l.changed == nil {
+				l.changed = make(chan struct{})
+			}
+			return l.changed
+		}
+	}
+	return nil
+}
+
+// observe pauses the limiter when a response reports the quota as exhausted,
+// until the window reset given by X-Rate-Limit-Time-Reset-Ms. The response is
+// used whether or not the store could be paused: failing a request that
+// succeeded would have callers retry it, and without the pause the next
+// request is at worst answered with a 429.
+func (l *RateLimiter) observe(ctx context.Context, resp *http.Response) {
+	left := resp.Header.Get("X-Rate-Limit-Requests-Left")
+	if resp.StatusCode != http.StatusTooManyRequests && left != "0" {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Time-Reset-Ms"), 10, 64)
+	if err != nil || reset <= 0 {
+		return
+	}
+	l.Store.Pause(ctx, l.Key, time.Now().Add(time.Duration(reset)*time.Millisecond))
+}
+
+// MemoryRateLimitStore is a RateLimitStore shared by the clients of a single process
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	now     func() time.Time
+}
+
+type memoryBucket struct {
+	tokens float64
+	last   time.Time
+	paused time.Time
+}
+
+// NewMemoryRateLimitStore returns an empty in-memory RateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: map[string]*memoryBucket{}, now: time.Now}
+}
+
+// Take implements RateLimitStore
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	if rate <= 0 {
+		return 0, errInvalidRate
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: float64(burst), last: now}
+		s.buckets[key] = b
+	}
+	if now.Before(b.paused) {
+		return b.paused.Sub(now), nil
+	}
+
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, nil
+	}
+	return time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+}
+
+// Pause implements RateLimitStore
+func (s *MemoryRateLimitStore) Pause(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{}
+		s.buckets[key] = b
+	}
+	b.tokens, b.last, b.paused = 0, until, until
+	return nil
+}
+
+// RedisScripter is the subset of a Redis client used by RedisRateLimitStore.
+// Clients such as go-redis or redigo can be adapted in a few lines.
+type RedisScripter interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// RedisRateLimitStore is a RateLimitStore kept in Redis, shared by every
+// process using the same Redis and key prefix. Buckets are updated atomically
+// by Lua scripts and expire once they would have refilled.
+type RedisRateLimitStore struct {
+	Redis  RedisScripter
+	Prefix string // Prepended to bucket keys, "bigcommerce:ratelimit:" if empty.
+	now    func() time.Time
+}
+
+// NewRedisRateLimitStore returns a RateLimitStore backed by redis
+func NewRedisRateLimitStore(redis RedisScripter) *RedisRateLimitStore {
+	return &RedisRateLimitStore{Redis: redis, now: time.Now}
+}
+
+const redisTakeScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+if not rate or rate <= 0 then
+  return redis.error_reply('rate limit must be positive')
+end
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts', 'paused')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+local paused = tonumber(state[3]) or 0
+if paused > now then
+  return paused - now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return wait
+`
+
+const redisPauseScript = `
+local ttl = tonumber(ARGV[1]) - tonumber(ARGV[2])
+if ttl <= 0 then
+  return 0
+end
+redis.call('HSET', KEYS[1], 'tokens', '0', 'ts', ARGV[1], 'paused', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ttl + 1000)
+return ttl
+`
+
+func (s *RedisRateLimitStore) key(key string) string {
+	if s.Prefix == "" {
+		return "bigcommerce:ratelimit:" + key
+	}
+	return s.Prefix + key
+}
+
+func (s *RedisRateLimitStore) nowMs() int64 {
+	if s.now == nil {
+		return time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	return s.now().UnixNano() / int64(time.Millisecond)
+}
+
+// Take implements RateLimitStore
+func (s *RedisRateLimitStore) Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	if rate <= 0 {
+		return 0, errInvalidRate
+	}
+	result, err := s.Redis.Eval(ctx, redisTakeScript, []string{s.key(key)}, rate, burst, s.nowMs())
+	if err != nil {
+		return 0, err
+	}
+	wait, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("bigcommerce: unexpected redis result %T", result)
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+// Pause implements RateLimitStore
+func (s *RedisRateLimitStore) Pause(ctx context.Context, key string, until time.Time) error {
+	untilMs := until.UnixNano() / int64(time.Millisecond)
+	_, err := s.Redis.Eval(ctx, redisPauseScript, []string{s.key(key)}, untilMs, s.nowMs())
+	return err
+}
@@ -0,0 +1,178 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := NewMemoryRateLimitStore()
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if wait, _ := s.Take(ctx, "store", 4, 2); wait != 0 {
+			t.Fatalf("Expected burst token %d, got wait %v", i, wait)
+		}
+	}
+	if wait, _ := s.Take(ctx, "store", 4, 2); wait != 250*time.Millisecond {
+		t.Errorf("Expected 250ms wait, got %v", wait)
+	}
+	if wait, _ := s.Take(ctx, "other", 4, 2); wait != 0 {
+		t.Errorf("Expected separate bucket for another key, got wait %v", wait)
+	}
+
+	now = now.Add(250 * time.Millisecond)
+	if wait, _ := s.Take(ctx, "store", 4, 2); wait != 0 {
+		t.Errorf("Expected refilled token, got wait %v", wait)
+	}
+
+	if _, err := s.Take(ctx, "store", 0, 2); err != errInvalidRate {
+		t.Errorf("Take with a zero rate returned %v, want errInvalidRate", err)
+	}
+
+	s.Pause(ctx, "store", now.Add(time.Second))
+	if wait, _ := s.Take(ctx, "store", 4, 2); wait != time.Second {
+		t.Errorf("Expected paused bucket to wait 1s, got %v", wait)
+	}
+}
+
+// fakeRedis records the scripts run against it and returns canned results
+type fakeRedis struct {
+	keys   []string
+	args   [][]interface{}
+	result interface{}
+}
+
+func (r *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	r.keys = append(r.keys, keys...)
+	r.args = append(r.args, args)
+	return r.result, nil
+}
+
+func TestRedisRateLimitStore(t *testing.T) {
+	redis := &fakeRedis{result: int64(120)}
+	s := NewRedisRateLimitStore(redis)
+	s.now = func() time.Time { return time.Unix(10, 0) }
+
+	wait, err := s.Take(context.Background(), "abc123", 5, 10)
+	if err != nil || wait != 120*time.Millisecond {
+		t.Errorf("Unexpected Take result %v, %v", wait, err)
+	}
+	if redis.keys[0] != "bigcommerce:ratelimit:abc123" || redis.args[0][2] != int64(10000) {
+		t.Errorf("Unexpected script call %v %v", redis.keys, redis.args)
+	}
+
+	if _, err := s.Take(context.Background(), "abc123", -1, 10); err != errInvalidRate {
+		t.Errorf("Take with a negative rate returned %v, want errInvalidRate", err)
+	}
+
+	redis.result = "bad"
+	if _, err := s.Take(context.Background(), "abc123", 5, 10); err == nil {
+		t.Error("Expected error for unexpected result type")
+	}
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	store := NewMemoryRateLimitStore()
+	client, mux, teardown := setup()
+	defer teardown()
+	WithRateLimiter(&RateLimiter{Store: store, Rate: 1000, Burst: 5})(client)
+	client.limiter.init(client.StoreHash)
+
+	mux.HandleFunc("/stores/abc123/v2/products", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "60000")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, _, err := client.Products.List(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The exhausted quota pauses the shared bucket, so the next call must wait.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Products.List(ctx, nil); err != context.DeadlineExceeded {
+		t.Errorf("Expected paused limiter to block until the deadline, got %v", err)
+	}
+}
+
+// failingPauseStore is a RateLimitStore that cannot be paused, like a Redis
+// store during an outage
+type failingPauseStore struct {
+	*MemoryRateLimitStore
+}
+
+func (failingPauseStore) Pause(ctx context.Context, key string, until time.Time) error {
+	return errors.New("store unavailable")
+}
+
+func TestClientRateLimiter_pauseFailure(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	WithRateLimiter(&RateLimiter{Store: failingPauseStore{NewMemoryRateLimitStore()}})(client)
+	client.limiter.init(client.StoreHash)
+
+	mux.HandleFunc("/stores/abc123/v2/customers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "60000")
+		fmt.Fprint(w, `{"id":7}`)
+	})
+
+	customer, _, err := client.Customers.Create(context.Background(), &Customer{FirstName: "Jane"})
+	if err != nil || customer.ID != 7 {
+		t.Errorf("Create = %+v, %v, want the created customer", customer, err)
+	}
+}
+
+func TestRateLimiter_Wait_priority(t *testing.T) {
+	limiter := &RateLimiter{Rate: 50, Burst: 1}
+	limiter.init("abc123")
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The batch request waits for a token first, yet the interactive request
+	// arriving while it waits goes ahead.
+	done := make(chan Priority, 2)
+	wait := func(p Priority) {
+		if err := limiter.Wait(WithPriority(context.Background(), p)); err != nil {
+			t.Error(err)
+		}
+		done <- p
+	}
+	go wait(BatchPriority)
+	time.Sleep(5 * time.Millisecond)
+	go wait(InteractivePriority)
+
+	if first, second := <-done, <-done; first != InteractivePriority || second != BatchPriority {
+		t.Errorf("requests were let through in order %v, %v, want interactive first", first, second)
+	}
+}
+
+func TestRateLimiter_init(t *testing.T) {
+	limiter := &RateLimiter{}
+	limiter.init("abc123")
+	if limiter.Rate != DefaultRateLimit || limiter.Burst != 1 || limiter.Key != "abc123" {
+		t.Errorf("init left %+v", limiter)
+	}
+}
+
+func TestPriorityFrom(t *testing.T) {
+	ctx := context.Background()
+	if p := PriorityFrom(ctx); p != InteractivePriority {
+		t.Errorf("PriorityFrom(background) = %v, want InteractivePriority", p)
+	}
+	if p := PriorityFrom(WithPriority(ctx, WebhookPriority)); p != WebhookPriority {
+		t.Errorf("PriorityFrom = %v, want WebhookPriority", p)
+	}
+	if p := PriorityFrom(WithPriority(ctx, Priority(9))); p != BatchPriority {
+		t.Errorf("PriorityFrom of an unknown priority = %v, want BatchPriority", p)
+	}
+}
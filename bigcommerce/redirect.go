@@ -0,0 +1,159 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/url"
+)
+
+// RedirectService handles communication with the V3 storefront redirect endpoints
+type RedirectService service
+
+// Redirect describes a BigCommerce V3 Redirect Object, a 301 redirect from a storefront path
+type Redirect struct {
+	ID       int64          `json:"id,omitempty"`     // The unique numerical ID of the redirect.
+	SiteID   int64          `json:"site_id"`          // The site the redirect belongs to.
+	FromPath string         `json:"from_path"`        // The path being redirected, e.g. "/old-product".
+	To       RedirectTarget `json:"to"`               // Where the path redirects to.
+	ToURL    string         `json:"to_url,omitempty"` // The resolved destination URL. Read-only.
+}
+
+// RedirectTarget describes the destination of a Redirect
+type RedirectTarget struct {
+	Type     RedirectType `json:"type"`                // The type of destination.
+	EntityID int64        `json:"entity_id,omitempty"` // The destination entity, for entity redirects.
+	URL      string       `json:"url,omitempty"`       // The destination URL, for URL redirects.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// RedirectType - The type of a redirect's destination
+type RedirectType string
+
+const (
+	// ProductRedirect - redirects to a product
+	ProductRedirect RedirectType = "product"
+	// BrandRedirect - redirects to a brand
+	BrandRedirect RedirectType = "brand"
+	// CategoryRedirect - redirects to a category
+	CategoryRedirect RedirectType = "category"
+	// PageRedirect - redirects to a web page
+	PageRedirect RedirectType = "page"
+	// URLRedirect - redirects to an arbitrary URL
+	URLRedirect RedirectType = "url"
+)
+
+// RedirectListOptions specifies the optional parameters to RedirectService.List
+type RedirectListOptions struct {
+	ListOptions
+	SiteID int64   `url:"site_id,omitempty"` // Filter by site.
+	IDs    []int64 `url:"id:in,omitempty"`   // Filter by redirect IDs.
+}
+
+// List returns a page of redirects
+func (s *RedirectService) List(ctx context.Context, opts *RedirectListOptions) ([]*Redirect, *Response, error) {
+	path, err := addOptions("v3/storefront/redirects", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var redirects []*Redirect
+	resp, err := s.client.call(ctx, "GET", path, nil, &redirects)
+	if err != nil {
+		return nil, resp, err
+	}
+	return redirects, resp, nil
+}
+
+// Upsert creates or updates redirects, matched on site and from path
+func (s *RedirectService) Upsert(ctx context.Context, redirects []*Redirect) ([]*Redirect, *Response, error) {
+	var upserted []*Redirect
+	resp, err := s.client.call(ctx, "PUT", "v3/storefront/redirects", redirects, &upserted)
+	if err != nil {
+		return nil, resp, err
+	}
+	return upserted, resp, nil
+}
+
+// Delete removes the redirects with the given IDs
+func (s *RedirectService) Delete(ctx context.Context, ids []int64) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoIDs
+	}
+	path, err := addOptions("v3/storefront/redirects", &RedirectListOptions{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// DefaultRedirectChunkSize is the number of redirects upserted per request by RedirectGenerator
+const DefaultRedirectChunkSize = 50
+
+// RedirectGenerator creates 301 redirects from the old URLs of migrated products
+// to the new BigCommerce products
+type RedirectGenerator struct {
+	Client    *Client
+	SiteID    int64 // The site to create redirects for.
+	ChunkSize int   // Redirects per request, DefaultRedirectChunkSize if zero.
+
+	// Checkpoint, if set, is called after every chunk with the number of
+	// products processed so far. Persisting it lets an interrupted run resume.
+	Checkpoint func(done int) error
+}
+
+// Generate upserts redirects for products[resumeFrom:] in chunks and returns the
+// number of products processed, which can be passed back as resumeFrom after a
+// failure. Upserts are keyed on the from path, so repeating a chunk is harmless.
+// Products without a source URL are skipped.
+func (g *RedirectGenerator) Generate(ctx context.Context, products []*MigratedProduct, resumeFrom int) (int, error) {
+	size := g.ChunkSize
+	if size <= 0 {
+		size = DefaultRedirectChunkSize
+	}
+
+	done := resumeFrom
+	for done < len(products) {
+		end := done + size
+		if end > len(products) {
+			end = len(products)
+		}
+
+		var chunk []*Redirect
+		for _, p := range products[done:end] {
+			from := redirectPath(p.SourceURL)
+			if from == "" || from == p.CustomURL {
+				continue
+			}
+			chunk = append(chunk, &Redirect{
+				SiteID:   g.SiteID,
+				FromPath: from,
+				To:       RedirectTarget{Type: ProductRedirect, EntityID: p.ProductID},
+			})
+		}
+		if len(chunk) > 0 {
+			if _, _, err := g.Client.Redirects.Upsert(ctx, chunk); err != nil {
+				return done, err
+			}
+		}
+
+		done = end
+		if g.Checkpoint != nil {
+			if err := g.Checkpoint(done); err != nil {
+				return done, err
+			}
+		}
+	}
+	return done, nil
+}
+
+// redirectPath returns the path and query of a source URL
+func redirectPath(source string) string {
+	u, err := url.Parse(source)
+	if err != nil || u.Path == "" {
+		return ""
+	}
+	if u.RawQuery != "" {
+		return u.EscapedPath() + "?" + u.RawQuery
+	}
+	return u.EscapedPath()
+}
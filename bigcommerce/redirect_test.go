@@ -0,0 +1,84 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRedirectService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/redirects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuery(t, r, map[string]string{"id:in": "1,2"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Redirects.Delete(context.Background(), []int64{1, 2}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRedirectGenerator_Generate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/stores/abc123/v3/storefront/redirects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		calls++
+		var redirects []*Redirect
+		json.NewDecoder(r.Body).Decode(&redirects)
+		if calls == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if calls == 1 && (len(redirects) != 2 || redirects[1].FromPath != "/product/b/?ref=1" || redirects[0].To.EntityID != 1) {
+			t.Errorf("Unexpected redirects %+v", redirects)
+		}
+		if calls == 3 && (len(redirects) != 1 || redirects[0].FromPath != "/product/c/") {
+			t.Errorf("Unexpected redirects %+v", redirects)
+		}
+		fmt.Fprint(w, `{"data":[],"meta":{}}`)
+	})
+
+	products := []*MigratedProduct{
+		{SourceURL: "https://shop.example.com/product/a/", ProductID: 1, CustomURL: "/a/"},
+		{SourceURL: "https://shop.example.com/product/b/?ref=1", ProductID: 2, CustomURL: "/b/"},
+		{SourceURL: "https://shop.example.com/product/c/", ProductID: 3, CustomURL: "/c/"},
+		{SourceURL: "", ProductID: 4},
+	}
+
+	var checkpoints []int
+	g := &RedirectGenerator{Client: client, SiteID: 1000, ChunkSize: 2, Checkpoint: func(done int) error {
+		checkpoints = append(checkpoints, done)
+		return nil
+	}}
+
+	done, err := g.Generate(context.Background(), products, 0)
+	if err == nil || done != 2 {
+		t.Fatalf("Expected failure after first chunk, got %d, %v", done, err)
+	}
+
+	done, err = g.Generate(context.Background(), products, done)
+	if err != nil || done != 4 {
+		t.Errorf("Expected resumed run to finish, got %d, %v", done, err)
+	}
+	if len(checkpoints) != 2 || checkpoints[1] != 4 {
+		t.Errorf("Unexpected checkpoints %v", checkpoints)
+	}
+}
+
+func TestRedirectService_Delete_noIDs(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Redirects.Delete(ctx, nil); err != ErrNoIDs {
+		t.Errorf("Delete returned %v, want ErrNoIDs", err)
+	}
+}
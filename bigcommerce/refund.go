@@ -0,0 +1,144 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RefundService handles communication with the V3 order refund endpoints
+type RefundService service
+
+// Refund describes a BigCommerce V3 Refund Object
+type Refund struct {
+	ID                         int64           `json:"id,omitempty"`                            // The unique numerical ID of the refund.
+	OrderID                    int64           `json:"order_id,omitempty"`                      // The ID of the refunded order.
+	UserID                     int64           `json:"user_id,omitempty"`                       // The ID of the staff user who issued the refund.
+	Created                    string          `json:"created,omitempty"`                       // Date the refund was issued.
+	Reason                     string          `json:"reason,omitempty"`                        // Reason for the refund.
+	TotalAmount                float64         `json:"total_amount,omitempty"`                  // Total amount refunded.
+	TotalTax                   float64         `json:"total_tax,omitempty"`                     // Tax included in the refund.
+	UsesMerchantOverrideValues bool            `json:"uses_merchant_override_values,omitempty"` // Whether the merchant overrode the calculated amounts.
+	Items                      []RefundItem    `json:"items,omitempty"`                         // The refunded items.
+	Payments                   []RefundPayment `json:"payments,omitempty"`                      // How the refund was paid out.
+}
+
+// RefundItem describes an item of a refund
+type RefundItem struct {
+	ItemType        string  `json:"item_type"`                  // One of PRODUCT, GIFT_WRAPPING, SHIPPING, HANDLING, ORDER.
+	ItemID          int64   `json:"item_id"`                    // The ID of the order product, address or order.
+	Quantity        int64   `json:"quantity,omitempty"`         // Quantity refunded, for products.
+	RequestedAmount float64 `json:"requested_amount,omitempty"` // Amount refunded, for amount-based items.
+	Reason          string  `json:"reason,omitempty"`           // Reason for refunding the item.
+}
+
+// RefundPayment describes a payment making up a refund
+type RefundPayment struct {
+	ID              int64   `json:"id,omitempty"`
+	ProviderID      string  `json:"provider_id"`                // The payment provider, e.g. braintree or storecredit.
+	Amount          float64 `json:"amount"`                     // Amount refunded through the provider.
+	Offline         bool    `json:"offline"`                    // Whether the refund was paid outside BigCommerce.
+	IsDeclined      bool    `json:"is_declined,omitempty"`      // Whether the provider declined the refund.
+	DeclinedMessage string  `json:"declined_message,omitempty"` // The provider's reason for declining.
+}
+
+// RefundRequest describes a refund to issue for some items of an order
+type RefundRequest struct {
+	Items    []RefundItem    `json:"items"`    // The items to refund.
+	Payments []RefundPayment `json:"payments"` // How to pay out the refund, usually one of the methods of a RefundQuote.
+}
+
+// RefundQuote describes the amounts refundable for some items of an order
+// and the ways they can be paid out
+type RefundQuote struct {
+	OrderID              int64            `json:"order_id"`
+	TotalRefundAmount    float64          `json:"total_refund_amount"`     // Amount refundable for the items.
+	TotalRefundTaxAmount float64          `json:"total_refund_tax_amount"` // Tax included in the refundable amount.
+	Rounding             float64          `json:"rounding"`                // Rounding applied to the amount.
+	Adjustment           float64          `json:"adjustment"`              // Adjustment applied to the amount.
+	TaxInclusive         bool             `json:"tax_inclusive"`           // Whether the item prices include tax.
+	RefundMethods        [][]RefundMethod `json:"refund_methods"`          // The ways to pay out the refund, each a combination of providers.
+}
+
+// RefundMethod describes the part of a quoted refund paid out by a provider
+type RefundMethod struct {
+	ProviderID          string  `json:"provider_id"`          // The payment provider, e.g. braintree or storecredit.
+	ProviderDescription string  `json:"provider_description"` // The name of the provider.
+	Amount              float64 `json:"amount"`               // Amount refunded through the provider.
+	Offline             bool    `json:"offline"`              // Whether the refund is paid outside BigCommerce.
+	OfflineProvider     bool    `json:"offline_provider"`     // Whether the provider only supports offline refunds.
+	OfflineReason       string  `json:"offline_reason"`       // Why the refund has to be paid offline.
+}
+
+// Payments returns the payments paying out a refund with the i-th refund
+// method of the quote, or nil if there is no such method
+func (q *RefundQuote) Payments(i int) []RefundPayment {
+	if i < 0 || i >= len(q.RefundMethods) {
+		return nil
+	}
+	var payments []RefundPayment
+	for _, m := range q.RefundMethods[i] {
+		payments = append(payments, RefundPayment{ProviderID: m.ProviderID, Amount: m.Amount, Offline: m.Offline})
+	}
+	return payments
+}
+
+// RefundListOptions specifies the optional parameters to RefundService.List
+type RefundListOptions struct {
+	ListOptions
+	OrderIDs   []int64   `url:"order_id:in,omitempty"`
+	IDs        []int64   `url:"id:in,omitempty"`
+	CreatedMin time.Time `url:"created:min,omitempty"` // Only return refunds issued on or after this time.
+	CreatedMax time.Time `url:"created:max,omitempty"` // Only return refunds issued on or before this time.
+}
+
+// List returns a page of refunds across orders
+func (s *RefundService) List(ctx context.Context, opts *RefundListOptions) ([]*Refund, *Response, error) {
+	path, err := addOptions("v3/orders/payment_actions/refunds", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var refunds []*Refund
+	resp, err := s.client.call(ctx, "GET", path, nil, &refunds)
+	if err != nil {
+		return nil, resp, err
+	}
+	return refunds, resp, nil
+}
+
+// ListForOrder returns the refunds of an order
+func (s *RefundService) ListForOrder(ctx context.Context, orderID int64) ([]*Refund, *Response, error) {
+	var refunds []*Refund
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/orders/%d/payment_actions/refunds", orderID), nil, &refunds)
+	if err != nil {
+		return nil, resp, err
+	}
+	return refunds, resp, nil
+}
+
+// CreateQuote calculates the amount refundable for items of an order and
+// the methods available to pay it out
+func (s *RefundService) CreateQuote(ctx context.Context, orderID int64, items []RefundItem) (*RefundQuote, *Response, error) {
+	body := struct {
+		Items []RefundItem `json:"items"`
+	}{items}
+
+	quote := new(RefundQuote)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/orders/%d/payment_actions/refund_quotes", orderID), body, quote)
+	if err != nil {
+		return nil, resp, err
+	}
+	return quote, resp, nil
+}
+
+// Create issues a refund for items of an order. Partial refunds refund some
+// quantity of a product, or an amount of the shipping, handling or order
+func (s *RefundService) Create(ctx context.Context, orderID int64, refund *RefundRequest) (*Refund, *Response, error) {
+	created := new(Refund)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v3/orders/%d/payment_actions/refunds", orderID), refund, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
@@ -0,0 +1,153 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// RefundMismatchKind - The kind of discrepancy found by RefundReconciler
+type RefundMismatchKind string
+
+const (
+	// RefundExceedsCaptured - the order's refunds total more than was captured
+	RefundExceedsCaptured RefundMismatchKind = "refund_exceeds_captured"
+	// RefundExceedsOrderTotal - the order's refunds total more than the order
+	RefundExceedsOrderTotal RefundMismatchKind = "refund_exceeds_order_total"
+	// RefundedAmountMismatch - the order's refunded amount differs from its refunds
+	RefundedAmountMismatch RefundMismatchKind = "refunded_amount_mismatch"
+	// RefundTransactionMismatch - the order's refund transactions differ from its refunds
+	RefundTransactionMismatch RefundMismatchKind = "refund_transaction_mismatch"
+	// OrphanedRefund - the refund's order does not exist
+	OrphanedRefund RefundMismatchKind = "orphaned_refund"
+)
+
+// RefundMismatch describes a discrepancy between an order's refunds, its
+// transactions and its totals
+type RefundMismatch struct {
+	Kind      RefundMismatchKind
+	OrderID   int64
+	RefundIDs []int64 // The order's refunds.
+	Refunded  float64 // Total of the order's refunds.
+	Expected  float64 // The amount the refunds were compared against.
+	Message   string
+}
+
+// RefundReconciler joins refunds, transactions and order totals to find
+// refunds that do not add up, for finance teams
+type RefundReconciler struct {
+	Client   *Client
+	PageSize int // Refunds per page, 250 if zero.
+}
+
+// refundTolerance absorbs rounding differences between the APIs
+const refundTolerance = 0.005
+
+// Reconcile checks every order refunded between from and to, calling fn for
+// each mismatch as it is found. Each order is checked once, against all its
+// refunds, including those outside the range.
+func (r *RefundReconciler) Reconcile(ctx context.Context, from, to time.Time, fn func(*RefundMismatch) error) error {
+	opts := &RefundListOptions{ListOptions: ListOptions{Page: 1, Limit: r.PageSize}, CreatedMin: from, CreatedMax: to}
+	if opts.Limit == 0 {
+		opts.Limit = 250
+	}
+	seen := map[int64]bool{}
+	for {
+		refunds, resp, err := r.Client.Refunds.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, refund := range refunds {
+			if seen[refund.OrderID] {
+				continue
+			}
+			seen[refund.OrderID] = true
+			mismatches, err := r.reconcileOrder(ctx, refund)
+			if err != nil {
+				return fmt.Errorf("bigcommerce: reconciling order %d: %w", refund.OrderID, err)
+			}
+			for _, m := range mismatches {
+				if err := fn(m); err != nil {
+					return err
+				}
+			}
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+func (r *RefundReconciler) reconcileOrder(ctx context.Context, first *Refund) ([]*RefundMismatch, error) {
+	orderID := first.OrderID
+	orphaned := func() []*RefundMismatch {
+		return []*RefundMismatch{{Kind: OrphanedRefund, OrderID: orderID, RefundIDs: []int64{first.ID},
+			Refunded: first.TotalAmount, Message: fmt.Sprintf("order %d does not exist", orderID)}}
+	}
+
+	// The order is read first: the refunds of a deleted order cannot be listed
+	order, _, err := r.Client.Orders.Get(ctx, orderID)
+	if Classify(err).Category == NotFoundError {
+		return orphaned(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	refunds, _, err := r.Client.Refunds.ListForOrder(ctx, orderID)
+	if Classify(err).Category == NotFoundError {
+		return orphaned(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	base := RefundMismatch{OrderID: orderID}
+	for _, refund := range refunds {
+		base.RefundIDs = append(base.RefundIDs, refund.ID)
+		base.Refunded += refund.TotalAmount
+	}
+	mismatch := func(kind RefundMismatchKind, expected float64, format string, args ...interface{}) *RefundMismatch {
+		m := base
+		m.Kind, m.Expected, m.Message = kind, expected, fmt.Sprintf(format, args...)
+		return &m
+	}
+
+	transactions, _, err := r.Client.Transactions.List(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var captured, refundTransactions float64
+	for _, t := range transactions {
+		if t.Status != "" && t.Status != "ok" {
+			continue
+		}
+		switch t.Event {
+		case "purchase", "capture":
+			captured += t.Amount
+		case "refund":
+			refundTransactions += t.Amount
+		}
+	}
+
+	var mismatches []*RefundMismatch
+	if base.Refunded > captured+refundTolerance {
+		mismatches = append(mismatches, mismatch(RefundExceedsCaptured, captured,
+			"refunds of %.2f exceed the %.2f captured", base.Refunded, captured))
+	}
+	if total, err := strconv.ParseFloat(order.TotalIncTax, 64); err == nil && base.Refunded > total+refundTolerance {
+		mismatches = append(mismatches, mismatch(RefundExceedsOrderTotal, total,
+			"refunds of %.2f exceed the order total of %.2f", base.Refunded, total))
+	}
+	if refunded, err := strconv.ParseFloat(order.RefundedAmount, 64); err == nil && math.Abs(refunded-base.Refunded) > refundTolerance {
+		mismatches = append(mismatches, mismatch(RefundedAmountMismatch, refunded,
+			"refunds total %.2f but the order reports %.2f refunded", base.Refunded, refunded))
+	}
+	if math.Abs(refundTransactions-base.Refunded) > refundTolerance {
+		mismatches = append(mismatches, mismatch(RefundTransactionMismatch, refundTransactions,
+			"refunds total %.2f but refund transactions total %.2f", base.Refunded, refundTransactions))
+	}
+	return mismatches, nil
+}
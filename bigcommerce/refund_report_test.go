@@ -0,0 +1,76 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestRefundReconciler_Reconcile(t *testing.T) {
+	server := bctest.NewServer()
+	refunds := []*Refund{
+		{ID: 1, OrderID: 100, TotalAmount: 10},
+		{ID: 2, OrderID: 100, TotalAmount: 5},
+		{ID: 3, OrderID: 200, TotalAmount: 80},
+		{ID: 4, OrderID: 300, TotalAmount: 1},
+	}
+	for _, refund := range refunds {
+		server.Seed("v3/orders/payment_actions/refunds", refund)
+		if refund.OrderID != 300 {
+			server.Seed(fmt.Sprintf("v3/orders/%d/payment_actions/refunds", refund.OrderID), refund)
+		}
+	}
+	// Order 100 reconciles; order 200 was refunded more than captured and its
+	// refunded amount disagrees; order 300 does not exist.
+	server.Seed("v2/orders",
+		&Order{ID: 100, TotalIncTax: "50.0000", RefundedAmount: "15.0000"},
+		&Order{ID: 200, TotalIncTax: "100.0000", RefundedAmount: "0.0000"},
+	)
+	server.Seed("v3/orders/100/transactions",
+		&Transaction{Event: "capture", Amount: 50, Status: "ok"},
+		&Transaction{Event: "refund", Amount: 15, Status: "ok"},
+	)
+	server.Seed("v3/orders/200/transactions",
+		&Transaction{Event: "authorization", Amount: 100, Status: "ok"},
+		&Transaction{Event: "capture", Amount: 60, Status: "ok"},
+		&Transaction{Event: "capture", Amount: 40, Status: "error"},
+		&Transaction{Event: "refund", Amount: 80, Status: "ok"},
+	)
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+
+	var mismatches []*RefundMismatch
+	reconciler := &RefundReconciler{Client: client, PageSize: 2}
+	err := reconciler.Reconcile(context.Background(), time.Now().Add(-time.Hour), time.Now(), func(m *RefundMismatch) error {
+		mismatches = append(mismatches, m)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		kind    RefundMismatchKind
+		orderID int64
+	}{
+		{RefundExceedsCaptured, 200},
+		{RefundedAmountMismatch, 200},
+		{OrphanedRefund, 300},
+	}
+	if len(mismatches) != len(want) {
+		t.Fatalf("Got %d mismatches, want %d: %+v", len(mismatches), len(want), mismatches)
+	}
+	for i, w := range want {
+		if m := mismatches[i]; m.Kind != w.kind || m.OrderID != w.orderID || m.Message == "" {
+			t.Errorf("Mismatch %d = %+v, want %v for order %d", i, m, w.kind, w.orderID)
+		}
+	}
+	if m := mismatches[0]; m.Refunded != 80 || m.Expected != 60 {
+		t.Errorf("Unexpected amounts %+v", m)
+	}
+	if m := mismatches[2]; len(m.RefundIDs) != 1 || m.RefundIDs[0] != 4 || m.Refunded != 1 {
+		t.Errorf("Unexpected orphaned refund %+v", m)
+	}
+}
@@ -0,0 +1,104 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRefundService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/payment_actions/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"order_id:in": "1,2", "created:min": "2021-03-01T00:00:00Z"})
+		fmt.Fprint(w, `{"data":[{"id":9,"order_id":1,"total_amount":12.5,"items":[{"item_type":"PRODUCT","item_id":4,"quantity":1}],
+			"payments":[{"provider_id":"braintree","amount":12.5,"offline":false}]}],"meta":{}}`)
+	})
+
+	opts := &RefundListOptions{OrderIDs: []int64{1, 2}, CreatedMin: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)}
+	refunds, _, err := client.Refunds.List(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refunds) != 1 || refunds[0].Items[0].ItemType != "PRODUCT" || refunds[0].Payments[0].Amount != 12.5 {
+		t.Errorf("Unexpected refunds %+v", refunds)
+	}
+}
+
+func TestRefundService_ListForOrder(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/100/payment_actions/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":9,"order_id":100,"total_amount":12.5}],"meta":{}}`)
+	})
+
+	refunds, _, err := client.Refunds.ListForOrder(context.Background(), 100)
+	if err != nil || len(refunds) != 1 || refunds[0].ID != 9 {
+		t.Errorf("ListForOrder = %+v, %v", refunds, err)
+	}
+}
+
+func TestRefundService_CreateQuote(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	items := []RefundItem{{ItemType: "PRODUCT", ItemID: 4, Quantity: 1}, {ItemType: "SHIPPING", ItemID: 1, RequestedAmount: 5}}
+	mux.HandleFunc("/stores/abc123/v3/orders/100/payment_actions/refund_quotes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, &struct {
+			Items []RefundItem `json:"items"`
+		}{}, &struct {
+			Items []RefundItem `json:"items"`
+		}{items})
+		fmt.Fprint(w, `{"data":{"order_id":100,"total_refund_amount":17.5,"total_refund_tax_amount":1.5,"rounding":0,"adjustment":0,"tax_inclusive":true,
+			"refund_methods":[
+				[{"provider_id":"braintree","provider_description":"Braintree","amount":17.5,"offline":false,"offline_provider":false,"offline_reason":""}],
+				[{"provider_id":"storecredit","provider_description":"Store Credit","amount":10,"offline":false},
+				 {"provider_id":"custom","provider_description":"Cash","amount":7.5,"offline":true,"offline_provider":true,"offline_reason":"Paid in store"}]
+			]},"meta":{}}`)
+	})
+
+	quote, _, err := client.Refunds.CreateQuote(context.Background(), 100, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.TotalRefundAmount != 17.5 || len(quote.RefundMethods) != 2 {
+		t.Errorf("Unexpected quote %+v", quote)
+	}
+
+	want := []RefundPayment{{ProviderID: "storecredit", Amount: 10}, {ProviderID: "custom", Amount: 7.5, Offline: true}}
+	if got := quote.Payments(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("Payments(1) = %+v, want %+v", got, want)
+	}
+	if got := quote.Payments(2); got != nil {
+		t.Errorf("Payments(2) = %+v, want nil", got)
+	}
+}
+
+func TestRefundService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &RefundRequest{
+		Items:    []RefundItem{{ItemType: "PRODUCT", ItemID: 4, Quantity: 1}},
+		Payments: []RefundPayment{{ProviderID: "braintree", Amount: 12.5}},
+	}
+	mux.HandleFunc("/stores/abc123/v3/orders/100/payment_actions/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(RefundRequest), input)
+		fmt.Fprint(w, `{"data":{"id":10,"order_id":100,"total_amount":12.5,"items":[{"item_type":"PRODUCT","item_id":4,"quantity":1}],
+			"payments":[{"id":3,"provider_id":"braintree","amount":12.5,"offline":false,"is_declined":false}]},"meta":{}}`)
+	})
+
+	refund, _, err := client.Refunds.Create(context.Background(), 100, input)
+	if err != nil || refund.ID != 10 || refund.Payments[0].ID != 3 {
+		t.Errorf("Create = %+v, %v", refund, err)
+	}
+}
@@ -0,0 +1,137 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReportPeriod names a reporting window relative to the current date in the
+// store's time zone
+type ReportPeriod string
+
+const (
+	// TodayPeriod - the current day so far
+	TodayPeriod ReportPeriod = "today"
+	// YesterdayPeriod - the previous day
+	YesterdayPeriod ReportPeriod = "yesterday"
+	// ThisWeekPeriod - the current week so far, starting on Monday
+	ThisWeekPeriod ReportPeriod = "this_week"
+	// LastWeekPeriod - the previous week, from Monday to Sunday
+	LastWeekPeriod ReportPeriod = "last_week"
+	// ThisMonthPeriod - the current month so far
+	ThisMonthPeriod ReportPeriod = "this_month"
+	// LastMonthPeriod - the previous month
+	LastMonthPeriod ReportPeriod = "last_month"
+	// ThisYearPeriod - the current year so far
+	ThisYearPeriod ReportPeriod = "this_year"
+	// LastYearPeriod - the previous year
+	LastYearPeriod ReportPeriod = "last_year"
+	// Last7DaysPeriod - the 7 days before today
+	Last7DaysPeriod ReportPeriod = "last_7_days"
+	// Last30DaysPeriod - the 30 days before today
+	Last30DaysPeriod ReportPeriod = "last_30_days"
+)
+
+// ReportWindow is a range of time covering whole days in the store's time
+// zone, from Start up to but excluding End
+type ReportWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// StoreLocation returns the time zone of a store, UTC if it is unknown
+func StoreLocation(store *Store) *time.Location {
+	if loc, err := time.LoadLocation(store.Timezone.Name); err == nil && store.Timezone.Name != "" {
+		return loc
+	}
+	if store.Timezone.RawOffset != 0 {
+		return time.FixedZone(store.Timezone.Name, store.Timezone.RawOffset)
+	}
+	return time.UTC
+}
+
+// Window returns the window of the period containing now, with days starting
+// at midnight in loc. Days are computed on the calendar, so windows spanning
+// daylight saving changes are 23 or 25 hours longer or shorter as needed.
+func (p ReportPeriod) Window(now time.Time, loc *time.Location) (ReportWindow, error) {
+	now = now.In(loc)
+	y, m, d := now.Date()
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, loc) }
+	today := day(y, m, d)
+	monday := d - (int(now.Weekday())+6)%7
+
+	switch p {
+	case TodayPeriod:
+		return ReportWindow{today, day(y, m, d+1)}, nil
+	case YesterdayPeriod:
+		return ReportWindow{day(y, m, d-1), today}, nil
+	case ThisWeekPeriod:
+		return ReportWindow{day(y, m, monday), day(y, m, monday+7)}, nil
+	case LastWeekPeriod:
+		return ReportWindow{day(y, m, monday-7), day(y, m, monday)}, nil
+	case ThisMonthPeriod:
+		return ReportWindow{day(y, m, 1), day(y, m+1, 1)}, nil
+	case LastMonthPeriod:
+		return ReportWindow{day(y, m-1, 1), day(y, m, 1)}, nil
+	case ThisYearPeriod:
+		return ReportWindow{day(y, 1, 1), day(y+1, 1, 1)}, nil
+	case LastYearPeriod:
+		return ReportWindow{day(y-1, 1, 1), day(y, 1, 1)}, nil
+	case Last7DaysPeriod:
+		return ReportWindow{day(y, m, d-7), today}, nil
+	case Last30DaysPeriod:
+		return ReportWindow{day(y, m, d-30), today}, nil
+	}
+	return ReportWindow{}, fmt.Errorf("bigcommerce: unknown report period %q", p)
+}
+
+// Contains reports whether t falls within the window
+func (w ReportWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// Bounds returns the window as inclusive UTC bounds, as expected by the
+// min_ and max_ date filters, which have a precision of one second
+func (w ReportWindow) Bounds() (min, max time.Time) {
+	return w.Start.UTC(), w.End.Add(-time.Second).UTC()
+}
+
+// OrdersCreated sets the date_created filters of opts to the window and
+// returns opts, allocating it if nil
+func (w ReportWindow) OrdersCreated(opts *OrderListOptions) *OrderListOptions {
+	if opts == nil {
+		opts = new(OrderListOptions)
+	}
+	opts.MinDateCreated, opts.MaxDateCreated = w.Bounds()
+	return opts
+}
+
+// OrdersModified sets the date_modified filters of opts to the window and
+// returns opts, allocating it if nil
+func (w ReportWindow) OrdersModified(opts *OrderListOptions) *OrderListOptions {
+	if opts == nil {
+		opts = new(OrderListOptions)
+	}
+	opts.MinDateModified, opts.MaxDateModified = w.Bounds()
+	return opts
+}
+
+// CustomersCreated sets the date_created filters of opts to the window and
+// returns opts, allocating it if nil
+func (w ReportWindow) CustomersCreated(opts *CustomerListOptions) *CustomerListOptions {
+	if opts == nil {
+		opts = new(CustomerListOptions)
+	}
+	opts.MinDateCreated, opts.MaxDateCreated = w.Bounds()
+	return opts
+}
+
+// CustomersModified sets the date_modified filters of opts to the window and
+// returns opts, allocating it if nil
+func (w ReportWindow) CustomersModified(opts *CustomerListOptions) *CustomerListOptions {
+	if opts == nil {
+		opts = new(CustomerListOptions)
+	}
+	opts.MinDateModified, opts.MaxDateModified = w.Bounds()
+	return opts
+}
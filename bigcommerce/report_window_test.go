@@ -0,0 +1,85 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReportPeriod_Window(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	now := time.Date(2023, 3, 15, 5, 30, 0, 0, time.UTC) // March 15th, 1:30 AM in New York, after the switch to DST.
+	tests := []struct {
+		period     ReportPeriod
+		start, end string
+	}{
+		{TodayPeriod, "2023-03-15T00:00:00-04:00", "2023-03-16T00:00:00-04:00"},
+		{YesterdayPeriod, "2023-03-14T00:00:00-04:00", "2023-03-15T00:00:00-04:00"},
+		{ThisWeekPeriod, "2023-03-13T00:00:00-04:00", "2023-03-20T00:00:00-04:00"},
+		{LastWeekPeriod, "2023-03-06T00:00:00-05:00", "2023-03-13T00:00:00-04:00"},
+		{ThisMonthPeriod, "2023-03-01T00:00:00-05:00", "2023-04-01T00:00:00-04:00"},
+		{LastMonthPeriod, "2023-02-01T00:00:00-05:00", "2023-03-01T00:00:00-05:00"},
+		{LastYearPeriod, "2022-01-01T00:00:00-05:00", "2023-01-01T00:00:00-05:00"},
+		{Last7DaysPeriod, "2023-03-08T00:00:00-05:00", "2023-03-15T00:00:00-04:00"},
+	}
+	for _, tt := range tests {
+		w, err := tt.period.Window(now, ny)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := w.Start.Format(time.RFC3339); got != tt.start {
+			t.Errorf("%s start = %s, want %s", tt.period, got, tt.start)
+		}
+		if got := w.End.Format(time.RFC3339); got != tt.end {
+			t.Errorf("%s end = %s, want %s", tt.period, got, tt.end)
+		}
+	}
+
+	if _, err := ReportPeriod("fortnight").Window(now, ny); err == nil {
+		t.Error("Window succeeded for an unknown period")
+	}
+}
+
+func TestReportWindow_edges(t *testing.T) {
+	loc := StoreLocation(&Store{Timezone: StoreTimezone{Name: "Fixed/Test", RawOffset: -5 * 3600}})
+	now := time.Date(2021, 3, 2, 3, 0, 0, 0, time.UTC) // March 1st, 10 PM in the store's time zone.
+	w, _ := YesterdayPeriod.Window(now, loc)
+
+	// An order placed late on February 28th locally, already March 1st in UTC
+	if !w.Contains(time.Date(2021, 3, 1, 4, 59, 0, 0, time.UTC)) || w.Contains(time.Date(2021, 3, 1, 5, 0, 0, 0, time.UTC)) {
+		t.Errorf("Contains misattributes orders at the edge of %v", w)
+	}
+	min, max := w.Bounds()
+	if min != time.Date(2021, 2, 28, 5, 0, 0, 0, time.UTC) || max != time.Date(2021, 3, 1, 4, 59, 59, 0, time.UTC) {
+		t.Errorf("Bounds = %v, %v", min, max)
+	}
+}
+
+func TestReportWindow_OrdersCreated(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{
+			"min_date_created": "2021-02-28T05:00:00Z",
+			"max_date_created": "2021-03-01T04:59:59Z",
+			"status_id":        "10",
+		})
+		fmt.Fprint(w, `{"count":3}`)
+	})
+
+	w := ReportWindow{
+		Start: time.Date(2021, 2, 28, 0, 0, 0, 0, time.FixedZone("EST", -5*3600)),
+		End:   time.Date(2021, 3, 1, 0, 0, 0, 0, time.FixedZone("EST", -5*3600)),
+	}
+	status := CompletedOrder
+	count, _, err := client.Orders.Count(context.Background(), w.OrdersCreated(&OrderListOptions{StatusID: &status}))
+	if err != nil || count != 3 {
+		t.Errorf("Orders.Count = %d, %v", count, err)
+	}
+}
@@ -0,0 +1,216 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultResolverTTL is how long ProductResolver.Product serves a mirrored
+// product before reading it again
+const DefaultResolverTTL = time.Minute
+
+// DefaultResolverInclude are the sub-resources ProductResolver hydrates
+// products with
+var DefaultResolverInclude = []string{"variants", "images", "custom_fields", "bulk_pricing_rules"}
+
+// ErrNotResolved is returned by ProductResolver for products and variants
+// that do not exist
+var ErrNotResolved = errors.New("bigcommerce: resolved resource does not exist")
+
+// ProductResolver gives webhook handlers, which only receive IDs, hydrated
+// products and variants. Objects are mirrored in a KVStore, under "mirror/",
+// and read from the API only when the mirror is too old; concurrent reads of
+// the same object share one request, which is made again for the others if
+// the caller making it gives up. A storm of webhooks, e.g. from a bulk
+// import in the control panel, then costs about one request per object. If
+// the API is rate limited, the mirrored object is returned however old. It is
+// safe for concurrent use.
+type ProductResolver struct {
+	Client  *Client
+	Store   KVStore
+	TTL     time.Duration // How old Product and Variant accept the mirror, DefaultResolverTTL if zero.
+	Include []string      // Sub-resources of products, DefaultResolverInclude if nil.
+
+	now   func() time.Time
+	mu    sync.Mutex
+	calls map[string]*resolveCall
+}
+
+type resolveCall struct {
+	done chan struct{}
+	err  error
+}
+
+// mirrored is a mirrored object and when it was read from the API
+type mirrored struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// NewProductResolver returns a resolver reading through client and
+// mirroring in store
+func NewProductResolver(client *Client, store KVStore) *ProductResolver {
+	return &ProductResolver{Client: client, Store: store, now: time.Now}
+}
+
+const mirrorPrefix = "mirror/"
+
+// Product returns a product, from the mirror if it was read within TTL
+func (r *ProductResolver) Product(ctx context.Context, id int64) (*CatalogProduct, error) {
+	return r.ProductSince(ctx, id, r.clock().Add(-r.ttl()))
+}
+
+// ProductSince returns a product, from the mirror if it was read at or after
+// since. Pass the time of a webhook event to see the product as it was after
+// the event, yet share one request between the events of a storm.
+func (r *ProductResolver) ProductSince(ctx context.Context, id int64, since time.Time) (*CatalogProduct, error) {
+	product := new(CatalogProduct)
+	err := r.resolve(ctx, fmt.Sprintf("%sproduct/%d", mirrorPrefix, id), since, product, func() (interface{}, error) {
+		include := r.Include
+		if include == nil {
+			include = DefaultResolverInclude
+		}
+		products, _, err := r.Client.Catalog.ListProducts(ctx, &CatalogProductListOptions{IDs: []int64{id}, Include: include})
+		if err != nil || len(products) == 0 {
+			return nil, err
+		}
+		return products[0], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// Variant returns a variant, from the mirror if it was read within TTL
+func (r *ProductResolver) Variant(ctx context.Context, id int64) (*Variant, error) {
+	return r.VariantSince(ctx, id, r.clock().Add(-r.ttl()))
+}
+
+// VariantSince returns a variant, from the mirror if it was read at or after
+// since
+func (r *ProductResolver) VariantSince(ctx context.Context, id int64, since time.Time) (*Variant, error) {
+	variant := new(Variant)
+	err := r.resolve(ctx, fmt.Sprintf("%svariant/%d", mirrorPrefix, id), since, variant, func() (interface{}, error) {
+		variants, _, err := r.Client.Variants.ListCatalog(ctx, &VariantListOptions{IDs: []int64{id}})
+		if err != nil || len(variants) == 0 {
+			return nil, err
+		}
+		return variants[0], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// ForgetProduct removes a product from the mirror, e.g. when it is deleted
+func (r *ProductResolver) ForgetProduct(ctx context.Context, id int64) error {
+	return r.Store.Delete(ctx, fmt.Sprintf("%sproduct/%d", mirrorPrefix, id))
+}
+
+// ForgetVariant removes a variant from the mirror
+func (r *ProductResolver) ForgetVariant(ctx context.Context, id int64) error {
+	return r.Store.Delete(ctx, fmt.Sprintf("%svariant/%d", mirrorPrefix, id))
+}
+
+// resolve decodes the object mirrored under key into v, reading it with fetch
+// first if the mirror is older than since. fetch returns nil for objects that
+// do not exist.
+func (r *ProductResolver) resolve(ctx context.Context, key string, since time.Time, v interface{}, fetch func() (interface{}, error)) error {
+	for {
+		var m mirrored
+		ok, err := GetJSON(ctx, r.Store, key, &m)
+		if err != nil {
+			return err
+		}
+		if ok && !m.FetchedAt.Before(since) {
+			return json.Unmarshal(m.Value, v)
+		}
+
+		r.mu.Lock()
+		if call, inflight := r.calls[key]; inflight {
+			r.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			switch {
+			case call.err == nil:
+				// The mirror now holds what the other call read
+				since = time.Time{}
+			case ctx.Err() == nil && isContextErr(call.err):
+				// The other caller gave up; fetch for this one
+			case ok && Classify(call.err).Category == RateLimitError:
+				return json.Unmarshal(m.Value, v)
+			default:
+				return call.err
+			}
+			continue
+		}
+		call := &resolveCall{done: make(chan struct{})}
+		if r.calls == nil {
+			r.calls = map[string]*resolveCall{}
+		}
+		r.calls[key] = call
+		r.mu.Unlock()
+
+		call.err = r.fetch(ctx, key, fetch)
+		r.mu.Lock()
+		delete(r.calls, key)
+		r.mu.Unlock()
+		close(call.done)
+
+		if call.err != nil && ok && Classify(call.err).Category == RateLimitError {
+			return json.Unmarshal(m.Value, v)
+		}
+		if call.err != nil {
+			return call.err
+		}
+		since = time.Time{}
+	}
+}
+
+// fetch reads an object and mirrors it under key
+func (r *ProductResolver) fetch(ctx context.Context, key string, fetch func() (interface{}, error)) error {
+	fetchedAt := r.clock()
+	value, err := fetch()
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		if err := r.Store.Delete(ctx, key); err != nil {
+			return err
+		}
+		return ErrNotResolved
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return PutJSON(ctx, r.Store, key, &mirrored{FetchedAt: fetchedAt, Value: data})
+}
+
+// isContextErr reports whether err comes from a canceled or expired context
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (r *ProductResolver) ttl() time.Duration {
+	if r.TTL == 0 {
+		return DefaultResolverTTL
+	}
+	return r.TTL
+}
+
+func (r *ProductResolver) clock() time.Time {
+	if r.now == nil {
+		return time.Now()
+	}
+	return r.now()
+}
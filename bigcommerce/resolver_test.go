@@ -0,0 +1,159 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProductResolver_Product(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var calls int32
+	release := make(chan struct{})
+	limited := false
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"id:in": "7", "include": "variants,images,custom_fields,bulk_pricing_rules"})
+		atomic.AddInt32(&calls, 1)
+		<-release
+		if limited {
+			w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":7,"name":"Shirt","variants":[{"id":70,"sku":"SHIRT-S"}]}]}`)
+	})
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver := NewProductResolver(client, NewMemoryKVStore())
+	resolver.now = func() time.Time { return now }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			product, err := resolver.Product(context.Background(), 7)
+			if err != nil || product.Name != "Shirt" || len(product.Variants) != 1 {
+				t.Errorf("Product = %+v, %v", product, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("concurrent calls made %d requests, want 1", calls)
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, err := resolver.Product(context.Background(), 7); err != nil || calls != 1 {
+		t.Errorf("call within TTL made %d requests, err %v", calls, err)
+	}
+	if _, err := resolver.ProductSince(context.Background(), 7, now); err != nil || calls != 2 {
+		t.Errorf("call since a later event made %d requests, err %v", calls, err)
+	}
+
+	limited = true
+	now = now.Add(time.Hour)
+	if product, err := resolver.Product(context.Background(), 7); err != nil || product.Name != "Shirt" {
+		t.Errorf("rate limited Product = %+v, %v, want the mirrored product", product, err)
+	}
+}
+
+func TestProductResolver_notFound(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"id:in": "70"})
+		fmt.Fprint(w, `{"data":[]}`)
+	})
+
+	store := NewMemoryKVStore()
+	resolver := NewProductResolver(client, store)
+	ctx := context.Background()
+	PutJSON(ctx, store, "mirror/variant/70", &mirrored{Value: []byte(`{"id":70}`)})
+
+	if _, err := resolver.Variant(ctx, 70); err != ErrNotResolved {
+		t.Errorf("Variant returned %v, want ErrNotResolved", err)
+	}
+	if ok, _ := GetJSON(ctx, store, "mirror/variant/70", new(mirrored)); ok {
+		t.Error("Variant left a deleted variant in the mirror")
+	}
+}
+
+func TestProductResolver_sharedFailures(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var limited int32
+	release := make(chan struct{})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		if atomic.LoadInt32(&limited) == 1 {
+			w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":7,"name":"Shirt"}]}`)
+	})
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver := NewProductResolver(client, NewMemoryKVStore())
+	resolver.now = func() time.Time { return now }
+
+	// The caller making the request gives up; the one waiting makes it again
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, err := resolver.Product(leaderCtx, 7)
+		leaderDone <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	waiterDone := make(chan *CatalogProduct)
+	go func() {
+		product, err := resolver.Product(context.Background(), 7)
+		if err != nil {
+			t.Errorf("waiting Product returned %v", err)
+		}
+		waiterDone <- product
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-leaderDone; err == nil {
+		t.Error("canceled Product returned no error")
+	}
+	close(release)
+	if product := <-waiterDone; product == nil || product.Name != "Shirt" {
+		t.Errorf("waiting Product = %+v", product)
+	}
+
+	// Callers waiting on a rate limited request get the mirrored product
+	release = make(chan struct{})
+	now = now.Add(time.Hour)
+	atomic.StoreInt32(&limited, 1)
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if product, err := resolver.Product(context.Background(), 7); err != nil || product.Name != "Shirt" {
+				t.Errorf("rate limited Product = %+v, %v, want the mirrored product", product, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+}
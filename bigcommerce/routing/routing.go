@@ -0,0 +1,226 @@
+// Package routing plans how an order is fulfilled from a store's inventory
+// locations. A Strategy splits the order's lines into consignments, one per
+// shipping location, using the stock reported by the Inventory API; a Router
+// loads that stock and creates the planned shipments.
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+// ErrUnfulfillable is returned by Router.Execute for plans with lines that no
+// location can ship
+var ErrUnfulfillable = errors.New("routing: order cannot be fulfilled from stock")
+
+// Order is the part of an order relevant to routing
+type Order struct {
+	ID             int64                       // The order's ID.
+	OrderAddressID int64                       // The ID of the order's shipping address.
+	Destination    *bigcommerce.GeoCoordinates // Where the order is shipped, used by Nearest.
+	Lines          []Line                      // The order products to ship.
+}
+
+// Line is an order product to be shipped
+type Line struct {
+	OrderProductID int64  // The ID of the order product.
+	SKU            string // The SKU whose stock is used.
+	Quantity       int64  // The quantity to ship.
+}
+
+// Location is a place stock can be shipped from
+type Location struct {
+	ID          int64                       // The inventory location's ID.
+	Name        string                      // The location's label.
+	Coordinates *bigcommerce.GeoCoordinates // Where the location is, used by Nearest.
+	Cost        float64                     // The cost of shipping a consignment from the location, used by Cheapest.
+}
+
+// Stock is the quantity available to sell, by location ID then SKU
+type Stock map[int64]map[string]int64
+
+// Available returns the quantity of sku available at a location
+func (s Stock) Available(locationID int64, sku string) int64 {
+	return s[locationID][sku]
+}
+
+// take removes up to qty units of sku from a location, returning how many were taken
+func (s Stock) take(locationID int64, sku string, qty int64) int64 {
+	n := s[locationID][sku]
+	if n > qty {
+		n = qty
+	}
+	if n > 0 {
+		s[locationID][sku] -= n
+	}
+	return n
+}
+
+func (s Stock) clone() Stock {
+	c := make(Stock, len(s))
+	for id, skus := range s {
+		c[id] = make(map[string]int64, len(skus))
+		for sku, n := range skus {
+			c[id][sku] = n
+		}
+	}
+	return c
+}
+
+// Plan describes how an order is to be fulfilled
+type Plan struct {
+	OrderID      int64          // The order's ID.
+	Consignments []*Consignment // One consignment per location shipping part of the order.
+	Unfulfilled  []Line         // Quantities no location has in stock.
+}
+
+// Consignment is the part of an order shipped from a single location
+type Consignment struct {
+	Location *Location
+	Lines    []Line
+}
+
+// Fulfilled reports whether every line of the order is covered by a consignment
+func (p *Plan) Fulfilled() bool {
+	return len(p.Unfulfilled) == 0
+}
+
+// add records qty units of line as shipped from loc
+func (p *Plan) add(loc *Location, line Line, qty int64) {
+	var c *Consignment
+	for _, existing := range p.Consignments {
+		if existing.Location.ID == loc.ID {
+			c = existing
+			break
+		}
+	}
+	if c == nil {
+		c = &Consignment{Location: loc}
+		p.Consignments = append(p.Consignments, c)
+	}
+	line.Quantity = qty
+	c.Lines = append(c.Lines, line)
+}
+
+// Strategy splits an order into consignments. Strategies must not modify stock.
+type Strategy interface {
+	Plan(order *Order, locations []*Location, stock Stock) (*Plan, error)
+}
+
+// Router plans orders using stock read from the Inventory API, and creates the
+// shipments of a plan through the order shipments endpoints
+type Router struct {
+	Client   *bigcommerce.Client
+	Strategy Strategy // How orders are split, Nearest() if nil.
+
+	// Locations describes the locations orders may ship from. If nil, every
+	// enabled inventory location is used, with its coordinates and no cost.
+	Locations []*Location
+}
+
+// Route returns a plan for fulfilling order from current stock
+func (r *Router) Route(ctx context.Context, order *Order) (*Plan, error) {
+	locations := r.Locations
+	if locations == nil {
+		var err error
+		if locations, err = r.loadLocations(ctx); err != nil {
+			return nil, err
+		}
+	}
+	stock, err := r.loadStock(ctx, order, locations)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := r.Strategy
+	if strategy == nil {
+		strategy = Nearest()
+	}
+	return strategy.Plan(order, locations, stock)
+}
+
+// Execute creates a shipment for every consignment of plan, returning the
+// shipments created. Plans with unfulfilled lines are rejected with
+// ErrUnfulfillable before anything is created.
+func (r *Router) Execute(ctx context.Context, order *Order, plan *Plan) ([]*bigcommerce.Shipment, error) {
+	if !plan.Fulfilled() {
+		return nil, ErrUnfulfillable
+	}
+
+	var shipments []*bigcommerce.Shipment
+	for _, c := range plan.Consignments {
+		shipment := &bigcommerce.Shipment{
+			OrderAddressID: order.OrderAddressID,
+			Comments:       fmt.Sprintf("Shipped from %s", c.Location.Name),
+		}
+		for _, line := range c.Lines {
+			shipment.Items = append(shipment.Items, bigcommerce.ShipmentItem{OrderProductID: line.OrderProductID, Quantity: line.Quantity})
+		}
+		created, _, err := r.Client.Shipments.Create(ctx, order.ID, shipment)
+		if err != nil {
+			return shipments, fmt.Errorf("routing: shipping order %d from location %d: %v", order.ID, c.Location.ID, err)
+		}
+		shipments = append(shipments, created)
+	}
+	return shipments, nil
+}
+
+func (r *Router) loadLocations(ctx context.Context) ([]*Location, error) {
+	opts := &bigcommerce.LocationListOptions{ListOptions: bigcommerce.ListOptions{Page: 1, Limit: 250}}
+	var locations []*Location
+	for {
+		page, resp, err := r.Client.Inventory.ListLocations(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range page {
+			if !l.Enabled {
+				continue
+			}
+			loc := &Location{ID: l.ID, Name: l.Label}
+			if l.Address != nil {
+				loc.Coordinates = l.Address.GeoCoordinates
+			}
+			locations = append(locations, loc)
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+	return locations, nil
+}
+
+func (r *Router) loadStock(ctx context.Context, order *Order, locations []*Location) (Stock, error) {
+	stock := Stock{}
+	opts := &bigcommerce.InventoryItemListOptions{ListOptions: bigcommerce.ListOptions{Page: 1, Limit: 250}}
+	for _, loc := range locations {
+		stock[loc.ID] = map[string]int64{}
+		opts.LocationIDs = append(opts.LocationIDs, loc.ID)
+	}
+	for _, line := range order.Lines {
+		opts.SKUs = append(opts.SKUs, line.SKU)
+	}
+
+	for {
+		items, resp, err := r.Client.Inventory.ListItems(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			for _, l := range item.Locations {
+				if skus, ok := stock[l.LocationID]; ok && l.AvailableToSell > 0 {
+					skus[item.Identity.SKU] += l.AvailableToSell
+				}
+			}
+		}
+		if resp.Pagination == nil || int64(opts.Page) >= resp.Pagination.TotalPages {
+			break
+		}
+		opts.Page++
+	}
+	return stock, nil
+}
@@ -0,0 +1,78 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+func TestRouter(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	baseURL, _ := url.Parse(server.URL + "/stores/abc123/")
+	client := bigcommerce.NewClient("abc123", "token", bigcommerce.WithBaseURL(baseURL))
+
+	mux.HandleFunc("/stores/abc123/v3/inventory/locations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[
+			{"id":1,"label":"Austin","enabled":true,"address":{"geo_coordinates":{"latitude":30.27,"longitude":-97.74}}},
+			{"id":2,"label":"New York","enabled":true,"address":{"geo_coordinates":{"latitude":40.71,"longitude":-74.01}}},
+			{"id":3,"label":"Closed","enabled":false}
+		],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/inventory/items", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("sku:in"), "A,B"; got != want {
+			t.Errorf("sku:in = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("location_id:in"), "1,2"; got != want {
+			t.Errorf("location_id:in = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"data":[
+			{"identity":{"sku":"A"},"locations":[{"location_id":1,"available_to_sell":2},{"location_id":2,"available_to_sell":4}]},
+			{"identity":{"sku":"B"},"locations":[{"location_id":1,"available_to_sell":0},{"location_id":2,"available_to_sell":1}]}
+		],"meta":{}}`)
+	})
+	var created []string
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments", func(w http.ResponseWriter, r *http.Request) {
+		var s bigcommerce.Shipment
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, fmt.Sprintf("%s %+v", s.Comments, s.Items))
+		fmt.Fprintf(w, `{"id":%d,"order_id":100}`, len(created))
+	})
+
+	router := &Router{Client: client}
+	order := testOrder()
+	order.OrderAddressID = 7
+	plan, err := router.Route(context.Background(), order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shipments, err := router.Execute(context.Background(), order, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"Shipped from Austin [{OrderProductID:10 ProductID:0 Quantity:2}]",
+		"Shipped from New York [{OrderProductID:11 ProductID:0 Quantity:1}]",
+	}
+	if len(shipments) != 2 || fmt.Sprint(created) != fmt.Sprint(want) {
+		t.Errorf("Created shipments %q, want %q", created, want)
+	}
+}
+
+func TestRouter_ExecuteUnfulfilled(t *testing.T) {
+	router := &Router{}
+	plan := &Plan{OrderID: 100, Unfulfilled: []Line{{SKU: "A", Quantity: 1}}}
+	if _, err := router.Execute(context.Background(), testOrder(), plan); err != ErrUnfulfillable {
+		t.Errorf("Execute error = %v, want ErrUnfulfillable", err)
+	}
+}
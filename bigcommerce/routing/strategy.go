@@ -0,0 +1,128 @@
+package routing
+
+import (
+	"math"
+	"sort"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+// StrategyFunc adapts a function to the Strategy interface
+type StrategyFunc func(order *Order, locations []*Location, stock Stock) (*Plan, error)
+
+// Plan implements Strategy
+func (f StrategyFunc) Plan(order *Order, locations []*Location, stock Stock) (*Plan, error) {
+	return f(order, locations, stock)
+}
+
+// Nearest ships each line from the locations closest to the order's
+// destination first. Locations without coordinates are used last.
+func Nearest() Strategy {
+	return StrategyFunc(func(order *Order, locations []*Location, stock Stock) (*Plan, error) {
+		ranked := append([]*Location(nil), locations...)
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return distance(order.Destination, ranked[i].Coordinates) < distance(order.Destination, ranked[j].Coordinates)
+		})
+		return greedy(order, ranked, stock.clone()), nil
+	})
+}
+
+// Cheapest ships each line from the locations with the lowest Cost first
+func Cheapest() Strategy {
+	return StrategyFunc(func(order *Order, locations []*Location, stock Stock) (*Plan, error) {
+		ranked := append([]*Location(nil), locations...)
+		sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Cost < ranked[j].Cost })
+		return greedy(order, ranked, stock.clone()), nil
+	})
+}
+
+// MinimizeSplits ships the order in as few consignments as possible. A single
+// location holding everything is preferred, in the order locations are given;
+// otherwise the location covering the most remaining units is picked until the
+// order is covered.
+func MinimizeSplits() Strategy {
+	return StrategyFunc(func(order *Order, locations []*Location, stock Stock) (*Plan, error) {
+		stock = stock.clone()
+		remaining := append([]Line(nil), order.Lines...)
+		plan := &Plan{OrderID: order.ID}
+		used := map[int64]bool{}
+
+		for {
+			var best *Location
+			var bestUnits int64
+			for _, loc := range locations {
+				if used[loc.ID] {
+					continue
+				}
+				if units := coverage(remaining, loc, stock); units > bestUnits {
+					best, bestUnits = loc, units
+				}
+			}
+			if best == nil {
+				break
+			}
+			used[best.ID] = true
+			remaining = ship(plan, best, remaining, stock)
+		}
+		plan.Unfulfilled = remaining
+		return plan, nil
+	})
+}
+
+// greedy ships each line from locations in order, until the line is covered
+func greedy(order *Order, locations []*Location, stock Stock) *Plan {
+	plan := &Plan{OrderID: order.ID}
+	remaining := append([]Line(nil), order.Lines...)
+	for _, loc := range locations {
+		remaining = ship(plan, loc, remaining, stock)
+	}
+	plan.Unfulfilled = remaining
+	return plan
+}
+
+// ship takes whatever loc holds of lines, returning what is still to be shipped
+func ship(plan *Plan, loc *Location, lines []Line, stock Stock) []Line {
+	var remaining []Line
+	for _, line := range lines {
+		if n := stock.take(loc.ID, line.SKU, line.Quantity); n > 0 {
+			plan.add(loc, line, n)
+			line.Quantity -= n
+		}
+		if line.Quantity > 0 {
+			remaining = append(remaining, line)
+		}
+	}
+	return remaining
+}
+
+// coverage returns how many units of lines loc can ship
+func coverage(lines []Line, loc *Location, stock Stock) int64 {
+	// Lines may share a SKU, so stock is tracked as it is used.
+	used := map[string]int64{}
+	var units int64
+	for _, line := range lines {
+		n := stock.Available(loc.ID, line.SKU) - used[line.SKU]
+		if n > line.Quantity {
+			n = line.Quantity
+		}
+		if n > 0 {
+			used[line.SKU] += n
+			units += n
+		}
+	}
+	return units
+}
+
+// distance returns the great-circle distance between a and b in kilometres, or
+// +Inf if either is unknown
+func distance(a, b *bigcommerce.GeoCoordinates) float64 {
+	if a == nil || b == nil {
+		return math.Inf(1)
+	}
+	const earthRadius = 6371.0
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := rad(b.Latitude - a.Latitude)
+	dLng := rad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rad(a.Latitude))*math.Cos(rad(b.Latitude))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,103 @@
+package routing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce"
+)
+
+var (
+	austin  = &Location{ID: 1, Name: "Austin", Coordinates: &bigcommerce.GeoCoordinates{Latitude: 30.27, Longitude: -97.74}, Cost: 9}
+	newYork = &Location{ID: 2, Name: "New York", Coordinates: &bigcommerce.GeoCoordinates{Latitude: 40.71, Longitude: -74.01}, Cost: 5}
+	denver  = &Location{ID: 3, Name: "Denver", Coordinates: &bigcommerce.GeoCoordinates{Latitude: 39.74, Longitude: -104.99}, Cost: 7}
+)
+
+func testOrder() *Order {
+	return &Order{
+		ID:          100,
+		Destination: &bigcommerce.GeoCoordinates{Latitude: 32.78, Longitude: -96.80}, // Dallas
+		Lines:       []Line{{OrderProductID: 10, SKU: "A", Quantity: 2}, {OrderProductID: 11, SKU: "B", Quantity: 1}},
+	}
+}
+
+func testStock() Stock {
+	return Stock{
+		1: {"A": 1},
+		2: {"A": 5, "B": 1},
+		3: {"A": 2},
+	}
+}
+
+// summarize maps a plan onto location IDs and the quantity of each SKU they ship
+func summarize(p *Plan) map[int64]map[string]int64 {
+	m := map[int64]map[string]int64{}
+	for _, c := range p.Consignments {
+		m[c.Location.ID] = map[string]int64{}
+		for _, l := range c.Lines {
+			m[c.Location.ID][l.SKU] += l.Quantity
+		}
+	}
+	return m
+}
+
+func TestNearest(t *testing.T) {
+	stock := testStock()
+	plan, err := Nearest().Plan(testOrder(), []*Location{newYork, denver, austin}, stock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]map[string]int64{1: {"A": 1}, 3: {"A": 1}, 2: {"B": 1}}
+	if got := summarize(plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("Nearest = %v, want %v", got, want)
+	}
+	if !plan.Fulfilled() {
+		t.Errorf("Unfulfilled = %+v", plan.Unfulfilled)
+	}
+	if !reflect.DeepEqual(stock, testStock()) {
+		t.Errorf("Plan modified stock: %v", stock)
+	}
+}
+
+func TestCheapest(t *testing.T) {
+	plan, err := Cheapest().Plan(testOrder(), []*Location{austin, denver, newYork}, testStock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]map[string]int64{2: {"A": 2, "B": 1}}
+	if got := summarize(plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("Cheapest = %v, want %v", got, want)
+	}
+}
+
+func TestMinimizeSplits(t *testing.T) {
+	order := testOrder()
+	order.Lines = append(order.Lines, Line{OrderProductID: 12, SKU: "C", Quantity: 1})
+	stock := testStock()
+	stock[3] = map[string]int64{"C": 1}
+
+	plan, err := MinimizeSplits().Plan(order, []*Location{austin, denver, newYork}, stock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]map[string]int64{2: {"A": 2, "B": 1}, 3: {"C": 1}}
+	if got := summarize(plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("MinimizeSplits = %v, want %v", got, want)
+	}
+}
+
+func TestPlan_Unfulfilled(t *testing.T) {
+	order := testOrder()
+	order.Lines[0].Quantity = 20
+
+	for name, strategy := range map[string]Strategy{"nearest": Nearest(), "cheapest": Cheapest(), "splits": MinimizeSplits()} {
+		plan, err := strategy.Plan(order, []*Location{austin, denver, newYork}, testStock())
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Line{{OrderProductID: 10, SKU: "A", Quantity: 12}}
+		if !reflect.DeepEqual(plan.Unfulfilled, want) {
+			t.Errorf("%s: Unfulfilled = %+v, want %+v", name, plan.Unfulfilled, want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultDrainTimeout is how long Runner waits for its components to drain
+const DefaultDrainTimeout = 30 * time.Second
+
+// ErrDrainTimeout is returned by Runner.Run when components were still
+// draining after the drain timeout
+var ErrDrainTimeout = errors.New("bigcommerce: components did not drain in time")
+
+// Component is a background subsystem, e.g. a watcher, scheduler or queue
+// worker, managed by a Runner
+type Component interface {
+	// Run works until ctx is canceled, then finishes its in-flight work and
+	// returns. Returning context.Canceled is a clean stop.
+	Run(ctx context.Context) error
+}
+
+// ComponentFunc adapts a function to a Component
+type ComponentFunc func(ctx context.Context) error
+
+// Run implements Component
+func (f ComponentFunc) Run(ctx context.Context) error { return f(ctx) }
+
+// ComponentState is the lifecycle state of a component
+type ComponentState string
+
+// ComponentState values
+const (
+	ComponentPending  ComponentState = "pending"  // Not started yet.
+	ComponentRunning  ComponentState = "running"  // Started and working.
+	ComponentDraining ComponentState = "draining" // Told to stop, finishing in-flight work.
+	ComponentStopped  ComponentState = "stopped"  // Returned without error.
+	ComponentFailed   ComponentState = "failed"   // Returned an error.
+)
+
+// ComponentHealth is the state of a component of a Runner
+type ComponentHealth struct {
+	Name  string
+	State ComponentState
+	Err   error // Why the component failed.
+}
+
+// Runner starts background components together and stops them together: when
+// its context is canceled or any component fails, every component is told to
+// drain and Run waits for them to return, so apps embedding several of them
+// shut down without losing in-flight events.
+type Runner struct {
+	DrainTimeout time.Duration // How long to wait for draining components, DefaultDrainTimeout if zero.
+
+	mu         sync.Mutex
+	components []*runnerComponent
+}
+
+type runnerComponent struct {
+	name  string
+	c     Component
+	state ComponentState
+	err   error
+}
+
+// Add registers a component under a name used by Health and in errors. It
+// must be called before Run.
+func (r *Runner) Add(name string, c Component) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.components = append(r.components, &runnerComponent{name: name, c: c, state: ComponentPending})
+}
+
+// Run starts every component and blocks until they all returned. It returns
+// the first component error, or ErrDrainTimeout if components were still
+// draining after the drain timeout; canceling ctx is a clean shutdown.
+func (r *Runner) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r.mu.Lock()
+	components := append([]*runnerComponent(nil), r.components...)
+	r.mu.Unlock()
+
+	errs := make(chan error, len(components))
+	var wg sync.WaitGroup
+	for _, rc := range components {
+		r.setState(rc, ComponentRunning, nil)
+		wg.Add(1)
+		go func(rc *runnerComponent) {
+			defer wg.Done()
+			err := rc.c.Run(ctx)
+			if err == nil || errors.Is(err, context.Canceled) && ctx.Err() != nil {
+				r.setState(rc, ComponentStopped, nil)
+				return
+			}
+			r.setState(rc, ComponentFailed, err)
+			errs <- fmt.Errorf("bigcommerce: component %s: %w", rc.name, err)
+			cancel()
+		}(rc)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		r.mu.Lock()
+		for _, rc := range components {
+			if rc.state == ComponentRunning {
+				rc.state = ComponentDraining
+			}
+		}
+		r.mu.Unlock()
+
+		timeout := r.DrainTimeout
+		if timeout == 0 {
+			timeout = DefaultDrainTimeout
+		}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-done:
+		case <-timer.C:
+			select {
+			case err := <-errs:
+				return err
+			default:
+				return ErrDrainTimeout
+			}
+		}
+	}
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (r *Runner) setState(rc *runnerComponent, state ComponentState, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rc.state, rc.err = state, err
+}
+
+// Health returns the state of every component, in the order they were added
+func (r *Runner) Health() []*ComponentHealth {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	health := make([]*ComponentHealth, len(r.components))
+	for i, rc := range r.components {
+		health[i] = &ComponentHealth{Name: rc.name, State: rc.state, Err: rc.err}
+	}
+	return health
+}
+
+// Healthy reports whether every component is running, e.g. for a readiness
+// probe
+func (r *Runner) Healthy() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rc := range r.components {
+		if rc.state != ComponentRunning {
+			return false
+		}
+	}
+	return true
+}
+
+// EventWorker is a queue worker handling the events received on a channel, e.g.
+// from a webhook handler. Events are handled with a context carrying the values
+// of the one given to Run but never canceled, so that when told to stop, the
+// worker finishes the event in hand and the events already buffered in the
+// channel before returning. Handle's requests have WebhookPriority unless the
+// context given to Run has a priority.
+type EventWorker struct {
+	Events <-chan *Event
+	Handle func(ctx context.Context, e *Event) error
+
+	// OnError is called with the events Handle failed on, e.g. to requeue
+	// them. Errors are ignored if nil.
+	OnError func(e *Event, err error)
+}
+
+// Run implements Component. It returns when ctx is canceled or Events is
+// closed.
+func (w *EventWorker) Run(ctx context.Context) error {
+	handleCtx := uncanceled{ctx}
+	for ctx.Err() == nil {
+		select {
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			w.handle(handleCtx, e)
+		case <-ctx.Done():
+		}
+	}
+	for {
+		select {
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			w.handle(handleCtx, e)
+		default:
+			return nil
+		}
+	}
+}
+
+func (w *EventWorker) handle(ctx context.Context, e *Event) {
+	if _, ok := ctx.Value(priorityKey{}).(Priority); !ok {
+		ctx = WithPriority(ctx, WebhookPriority)
+	}
+	if err := w.Handle(ctx, e); err != nil && w.OnError != nil {
+		w.OnError(e, err)
+	}
+}
+
+// uncanceled is a context with the values of its parent that is never
+// canceled and has no deadline
+type uncanceled struct {
+	context.Context
+}
+
+func (uncanceled) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (uncanceled) Done() <-chan struct{}       { return nil }
+func (uncanceled) Err() error                  { return nil }
@@ -0,0 +1,156 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRunner_Run_cancel(t *testing.T) {
+	r := new(Runner)
+	drained := make(chan bool, 1)
+	r.Add("watcher", ComponentFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+	r.Add("worker", ComponentFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		drained <- true
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() { errc <- r.Run(ctx) }()
+	for !r.Healthy() {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	if err := <-errc; err != nil {
+		t.Errorf("Run returned error: %v", err)
+	}
+	if !<-drained {
+		t.Errorf("worker did not drain")
+	}
+	for _, h := range r.Health() {
+		if h.State != ComponentStopped {
+			t.Errorf("component %s is %s, want stopped", h.Name, h.State)
+		}
+	}
+}
+
+func TestRunner_Run_failure(t *testing.T) {
+	r := new(Runner)
+	boom := errors.New("boom")
+	r.Add("scheduler", ComponentFunc(func(ctx context.Context) error { return boom }))
+	r.Add("worker", ComponentFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	}))
+
+	err := r.Run(context.Background())
+	if !errors.Is(err, boom) {
+		t.Fatalf("Run returned %v, want boom", err)
+	}
+	want := []*ComponentHealth{
+		{Name: "scheduler", State: ComponentFailed, Err: boom},
+		{Name: "worker", State: ComponentStopped},
+	}
+	if got := r.Health(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Health returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRunner_Run_drainTimeout(t *testing.T) {
+	r := &Runner{DrainTimeout: 10 * time.Millisecond}
+	stuck := make(chan struct{})
+	defer close(stuck)
+	r.Add("stuck", ComponentFunc(func(ctx context.Context) error {
+		<-stuck
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := r.Run(ctx); err != ErrDrainTimeout {
+		t.Errorf("Run returned %v, want ErrDrainTimeout", err)
+	}
+	if got := r.Health()[0].State; got != ComponentDraining {
+		t.Errorf("component is %s, want draining", got)
+	}
+}
+
+func TestEventWorker_Run_drain(t *testing.T) {
+	events := make(chan *Event, 3)
+	for _, id := range []string{"1", "2", "3"} {
+		events <- &Event{Data: EventData{ID: id}}
+	}
+	var handled []string
+	w := &EventWorker{
+		Events: events,
+		Handle: func(ctx context.Context, e *Event) error {
+			if ctx.Err() != nil {
+				t.Errorf("event %s handled with a canceled context", e.Data.ID)
+			}
+			if p := PriorityFrom(ctx); p != WebhookPriority {
+				t.Errorf("event %s handled with priority %v, want WebhookPriority", e.Data.ID, p)
+			}
+			handled = append(handled, e.Data.ID)
+			if e.Data.ID == "2" {
+				return errors.New("failed")
+			}
+			return nil
+		},
+	}
+	var failed []string
+	w.OnError = func(e *Event, err error) { failed = append(failed, e.Data.ID) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := w.Run(ctx); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(handled, want) {
+		t.Errorf("handled %v, want %v", handled, want)
+	}
+	if want := []string{"2"}; !reflect.DeepEqual(failed, want) {
+		t.Errorf("failed %v, want %v", failed, want)
+	}
+}
+
+func TestEventWorker_Run_cancelWhileHandling(t *testing.T) {
+	events := make(chan *Event, 2)
+	events <- &Event{Data: EventData{ID: "1"}}
+	events <- &Event{Data: EventData{ID: "2"}}
+
+	ctx, cancel := context.WithCancel(WithPriority(context.Background(), BatchPriority))
+	var handled []string
+	w := &EventWorker{
+		Events: events,
+		Handle: func(hctx context.Context, e *Event) error {
+			if e.Data.ID == "1" {
+				cancel()
+			}
+			select {
+			case <-hctx.Done():
+				t.Errorf("event %s handled with a canceled context", e.Data.ID)
+			case <-time.After(time.Millisecond):
+			}
+			if p := PriorityFrom(hctx); p != BatchPriority {
+				t.Errorf("event %s handled with priority %v, want BatchPriority", e.Data.ID, p)
+			}
+			handled = append(handled, e.Data.ID)
+			return nil
+		},
+	}
+	if err := w.Run(ctx); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(handled, want) {
+		t.Errorf("handled %v, want %v", handled, want)
+	}
+}
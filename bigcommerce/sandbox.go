@@ -0,0 +1,22 @@
+package bigcommerce
+
+import (
+	"net/http"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+// WithSandbox routes every request to a new in-memory bctest.Server instead of
+// the API, so applications can be exercised end to end without network access
+// or a store. The client's public API is unchanged.
+func WithSandbox() ClientOption {
+	return WithSandboxServer(bctest.NewServer())
+}
+
+// WithSandboxServer routes every request to server, which callers can seed with
+// data beforehand and inspect afterwards
+func WithSandboxServer(server *bctest.Server) ClientOption {
+	return func(c *Client) {
+		c.client = &http.Client{Transport: server.Transport()}
+	}
+}
@@ -0,0 +1,57 @@
+package bigcommerce
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestWithSandbox(t *testing.T) {
+	client := NewClient("abc123", "token", WithSandbox())
+	ctx := context.Background()
+
+	created, _, err := client.Variants.Create(ctx, 7, &Variant{SKU: "A-1", Price: Float64(9.5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.ProductID != 7 {
+		t.Errorf("Create returned %+v, want an ID and product 7", created)
+	}
+
+	if _, _, err := client.Variants.Update(ctx, 7, created.ID, &Variant{SKU: "A-2"}); err != nil {
+		t.Fatal(err)
+	}
+	variants, resp, err := client.Variants.List(ctx, 7, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variants) != 1 || variants[0].SKU != "A-2" || *variants[0].Price != 9.5 {
+		t.Errorf("List returned %+v", variants)
+	}
+	if resp.Pagination == nil || resp.Pagination.Total != 1 {
+		t.Errorf("Pagination = %+v, want a total of 1", resp.Pagination)
+	}
+
+	if _, err := client.Variants.Delete(ctx, 7, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = client.Variants.Get(ctx, 7, created.ID)
+	if e, ok := err.(*ErrorResponse); !ok || e.Response.StatusCode != 404 {
+		t.Errorf("Get after Delete returned %v, want a 404 ErrorResponse", err)
+	}
+}
+
+func TestWithSandboxServer(t *testing.T) {
+	server := bctest.NewServer()
+	server.Seed("v2/products", &Product{ID: 3, Name: "Seeded"})
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+
+	product, _, err := client.Products.Get(context.Background(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.Name != "Seeded" {
+		t.Errorf("Get returned %+v", product)
+	}
+}
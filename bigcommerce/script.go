@@ -0,0 +1,131 @@
+package bigcommerce
+
+import "context"
+
+// ScriptService handles communication with the V3 script endpoints
+type ScriptService service
+
+// Script describes a BigCommerce V3 Script Object, JavaScript injected into
+// storefront pages by an app
+type Script struct {
+	UUID            string                `json:"uuid,omitempty"`             // The unique ID of the script.
+	Name            string                `json:"name"`                       // The script's name, shown in the control panel.
+	Description     string                `json:"description,omitempty"`      // Describes the script's purpose.
+	HTML            string                `json:"html,omitempty"`             // The inline script tag, for ScriptTagScript kinds.
+	Src             string                `json:"src,omitempty"`              // The script's URL, for SrcScript kinds.
+	AutoUninstall   bool                  `json:"auto_uninstall"`             // Whether the script is removed when the app is uninstalled.
+	LoadMethod      ScriptLoadMethod      `json:"load_method,omitempty"`      // How the script is loaded.
+	Location        ScriptLocation        `json:"location,omitempty"`         // Where on the page the script is placed.
+	Visibility      ScriptVisibility      `json:"visibility,omitempty"`       // Which pages the script is placed on.
+	Kind            ScriptKind            `json:"kind,omitempty"`             // Whether the script is given by Src or HTML.
+	ConsentCategory ScriptConsentCategory `json:"consent_category,omitempty"` // The cookie consent the shopper must give for the script to load.
+	Enabled         bool                  `json:"enabled"`                    // Whether the script is placed on the storefront.
+	ChannelID       int64                 `json:"channel_id,omitempty"`       // The storefront channel, 1 for the default storefront.
+	APIClientID     string                `json:"api_client_id,omitempty"`    // The app that created the script. Read-only.
+	DateCreated     string                `json:"date_created,omitempty"`     // Read-only.
+	DateModified    string                `json:"date_modified,omitempty"`    // Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ScriptLoadMethod - How a Script is loaded
+type ScriptLoadMethod string
+
+// ScriptLocation - Where on the page a Script is placed
+type ScriptLocation string
+
+// ScriptVisibility - Which pages a Script is placed on
+type ScriptVisibility string
+
+// ScriptKind - Whether a Script is given by URL or inline
+type ScriptKind string
+
+// ScriptConsentCategory - The cookie consent category of a Script
+type ScriptConsentCategory string
+
+const (
+	// DefaultLoad - a blocking script tag
+	DefaultLoad ScriptLoadMethod = "default"
+	// AsyncLoad - an async script tag
+	AsyncLoad ScriptLoadMethod = "async"
+	// DeferLoad - a deferred script tag
+	DeferLoad ScriptLoadMethod = "defer"
+
+	// HeadScript - in the page head
+	HeadScript ScriptLocation = "head"
+	// FooterScript - at the end of the page body
+	FooterScript ScriptLocation = "footer"
+
+	// StorefrontScript - every storefront page except checkout
+	StorefrontScript ScriptVisibility = "storefront"
+	// AllPagesScript - every page, including checkout
+	AllPagesScript ScriptVisibility = "all_pages"
+	// CheckoutScript - the checkout page only
+	CheckoutScript ScriptVisibility = "checkout"
+	// OrderConfirmationScript - the order confirmation page only
+	OrderConfirmationScript ScriptVisibility = "order_confirmation"
+
+	// SrcScript - a script loaded from Src
+	SrcScript ScriptKind = "src"
+	// ScriptTagScript - an inline script given in HTML
+	ScriptTagScript ScriptKind = "script_tag"
+
+	// EssentialScript - always loaded
+	EssentialScript ScriptConsentCategory = "essential"
+	// FunctionalScript - loaded with functional cookie consent
+	FunctionalScript ScriptConsentCategory = "functional"
+	// AnalyticsScript - loaded with analytics cookie consent
+	AnalyticsScript ScriptConsentCategory = "analytics"
+	// TargetingScript - loaded with targeting cookie consent
+	TargetingScript ScriptConsentCategory = "targeting"
+)
+
+// List returns a page of the app's scripts
+func (s *ScriptService) List(ctx context.Context, opts *ListOptions) ([]*Script, *Response, error) {
+	path, err := addOptions("v3/content/scripts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var scripts []*Script
+	resp, err := s.client.call(ctx, "GET", path, nil, &scripts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scripts, resp, nil
+}
+
+// Get returns a single script
+func (s *ScriptService) Get(ctx context.Context, uuid string) (*Script, *Response, error) {
+	script := new(Script)
+	resp, err := s.client.call(ctx, "GET", "v3/content/scripts/"+uuid, nil, script)
+	if err != nil {
+		return nil, resp, err
+	}
+	return script, resp, nil
+}
+
+// Create adds a script
+func (s *ScriptService) Create(ctx context.Context, script *Script) (*Script, *Response, error) {
+	created := new(Script)
+	resp, err := s.client.call(ctx, "POST", "v3/content/scripts", script, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a script
+func (s *ScriptService) Update(ctx context.Context, uuid string, script *Script) (*Script, *Response, error) {
+	updated := new(Script)
+	resp, err := s.client.call(ctx, "PUT", "v3/content/scripts/"+uuid, script, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a script
+func (s *ScriptService) Delete(ctx context.Context, uuid string) (*Response, error) {
+	return s.client.call(ctx, "DELETE", "v3/content/scripts/"+uuid, nil, nil)
+}
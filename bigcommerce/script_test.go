@@ -0,0 +1,44 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestScriptService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Script{
+		Name: "Analytics", Src: "https://cdn.example.com/a.js", Kind: SrcScript, LoadMethod: AsyncLoad,
+		Location: FooterScript, Visibility: AllPagesScript, ConsentCategory: AnalyticsScript, AutoUninstall: true, Enabled: true,
+	}
+	mux.HandleFunc("/stores/abc123/v3/content/scripts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Script), input)
+		fmt.Fprint(w, `{"data":{"uuid":"e1f1c0a4-0c7d-4c0d-9a57-2b2b1f0b7c1e","name":"Analytics","kind":"src","enabled":true},"meta":{}}`)
+	})
+
+	script, _, err := client.Scripts.Create(context.Background(), input)
+	if err != nil || script.UUID != "e1f1c0a4-0c7d-4c0d-9a57-2b2b1f0b7c1e" {
+		t.Errorf("Create = %+v, %v", script, err)
+	}
+}
+
+func TestScriptService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/content/scripts/e1f1c0a4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Script), &Script{Name: "Analytics", Enabled: false})
+		fmt.Fprint(w, `{"data":{"uuid":"e1f1c0a4","name":"Analytics","enabled":false},"meta":{}}`)
+	})
+
+	script, _, err := client.Scripts.Update(context.Background(), "e1f1c0a4", &Script{Name: "Analytics"})
+	if err != nil || script.Enabled {
+		t.Errorf("Update = %+v, %v", script, err)
+	}
+}
@@ -0,0 +1,348 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SegmentQuery is a predicate over the attribute values and fields of a
+// customer, e.g.
+//
+//	loyalty_tier == gold AND (ltv > 500 OR customer_group_id == 2)
+//
+// Names are customer attribute names or the customer fields id, email,
+// company, customer_group_id, store_credit and accepts_marketing. Values are
+// numbers, dates as YYYY-MM-DD, bare words or quoted strings. Operators are ==,
+// !=, <, <=, > and >=, combined with AND, OR, NOT and parentheses. Values are
+// compared as numbers when both sides are numbers, as strings otherwise. A
+// customer without a value for a name fails every comparison except !=.
+type SegmentQuery struct {
+	root  segmentNode
+	names []string
+}
+
+// ParseSegmentQuery parses a segmentation predicate
+func ParseSegmentQuery(query string) (*SegmentQuery, error) {
+	tokens, err := segmentTokens(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &segmentParser{tokens: tokens}
+	root, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("bigcommerce: unexpected %q in segment query", p.tokens[p.pos].text)
+	}
+	q := &SegmentQuery{root: root}
+	for name := range p.names {
+		q.names = append(q.names, name)
+	}
+	sort.Strings(q.names)
+	return q, nil
+}
+
+// Names returns the attribute and field names the query refers to, sorted
+func (q *SegmentQuery) Names() []string {
+	return append([]string(nil), q.names...)
+}
+
+// Match evaluates the query against the values of a customer, by name
+func (q *SegmentQuery) Match(values map[string]string) bool {
+	return q.root.match(values)
+}
+
+// CustomerSegmenter evaluates segment queries against every customer of the
+// store, producing the customer ID lists pushed into segments
+type CustomerSegmenter struct {
+	Client *Client
+}
+
+// CustomerIDs returns the IDs of the customers matching a query, in
+// ascending order. Customers and the values of the attributes the query refers
+// to are read a page at a time; only the values are kept in memory.
+func (s *CustomerSegmenter) CustomerIDs(ctx context.Context, query string) ([]int64, error) {
+	q, err := ParseSegmentQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeNames := map[int64]string{}
+	var names []string
+	for _, name := range q.names {
+		if !segmentCustomerFields[name] {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		attributes, _, err := s.Client.CustomerAttributes.List(ctx, &CustomerAttributeListOptions{
+			ListOptions: ListOptions{Limit: 250},
+			Names:       names,
+		})
+		if err != nil {
+			return nil, err
+		}
+		found := map[string]bool{}
+		for _, a := range attributes {
+			attributeNames[a.ID] = a.Name
+			found[a.Name] = true
+		}
+		for _, name := range names {
+			if !found[name] {
+				return nil, fmt.Errorf("bigcommerce: no customer attribute named %q", name)
+			}
+		}
+	}
+
+	values := map[int64]map[string]string{}
+	if len(attributeNames) > 0 {
+		ids := make([]int64, 0, len(attributeNames))
+		for id := range attributeNames {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		opts := &AttributeValueListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}, AttributeIDs: ids}
+		for {
+			page, _, err := s.Client.CustomerAttributes.ListValues(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range page {
+				if values[v.CustomerID] == nil {
+					values[v.CustomerID] = map[string]string{}
+				}
+				values[v.CustomerID][attributeNames[v.AttributeID]] = v.Value
+			}
+			if len(page) < opts.Limit {
+				break
+			}
+			opts.Page++
+		}
+	}
+
+	var matched []int64
+	opts := &CustomerListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}}
+	for {
+		page, _, err := s.Client.Customers.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page {
+			v := values[c.ID]
+			if v == nil {
+				v = map[string]string{}
+			}
+			v["id"] = strconv.FormatInt(c.ID, 10)
+			v["email"] = c.Email
+			v["company"] = c.Company
+			v["customer_group_id"] = strconv.FormatInt(c.CustomerGroupID, 10)
+			v["store_credit"] = c.StoreCredit
+			v["accepts_marketing"] = strconv.FormatBool(c.AcceptsMarketing)
+			if q.Match(v) {
+				matched = append(matched, c.ID)
+			}
+		}
+		if len(page) < opts.Limit {
+			break
+		}
+		opts.Page++
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i] < matched[j] })
+	return matched, nil
+}
+
+// segmentCustomerFields are the customer fields usable in segment queries
+var segmentCustomerFields = map[string]bool{
+	"id": true, "email": true, "company": true, "customer_group_id": true, "store_credit": true, "accepts_marketing": true,
+}
+
+type segmentNode interface {
+	match(values map[string]string) bool
+}
+
+type segmentAnd struct{ left, right segmentNode }
+
+func (n segmentAnd) match(v map[string]string) bool { return n.left.match(v) && n.right.match(v) }
+
+type segmentOr struct{ left, right segmentNode }
+
+func (n segmentOr) match(v map[string]string) bool { return n.left.match(v) || n.right.match(v) }
+
+type segmentNot struct{ node segmentNode }
+
+func (n segmentNot) match(v map[string]string) bool { return !n.node.match(v) }
+
+type segmentCompare struct {
+	name, op, value string
+}
+
+func (n segmentCompare) match(values map[string]string) bool {
+	v, ok := values[n.name]
+	if !ok {
+		return n.op == "!="
+	}
+	var cmp int
+	a, errA := strconv.ParseFloat(v, 64)
+	b, errB := strconv.ParseFloat(n.value, 64)
+	switch {
+	case errA == nil && errB == nil && a < b:
+		cmp = -1
+	case errA == nil && errB == nil && a > b:
+		cmp = 1
+	case errA == nil && errB == nil:
+		cmp = 0
+	default:
+		cmp = strings.Compare(v, n.value)
+	}
+	switch n.op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+type segmentToken struct {
+	text   string
+	quoted bool // A quoted string, never an operator or keyword.
+}
+
+func segmentTokens(query string) ([]segmentToken, error) {
+	var tokens []segmentToken
+	r := []rune(query)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, segmentToken{text: string(c)})
+			i++
+		case strings.ContainsRune("=!<>", c):
+			j := i + 1
+			if j < len(r) && r[j] == '=' {
+				j++
+			}
+			op := string(r[i:j])
+			if op == "=" || op == "!" {
+				return nil, fmt.Errorf("bigcommerce: invalid operator %q in segment query", op)
+			}
+			tokens = append(tokens, segmentToken{text: op})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(r) && r[j] != c {
+				j++
+			}
+			if j == len(r) {
+				return nil, fmt.Errorf("bigcommerce: unterminated string in segment query")
+			}
+			tokens = append(tokens, segmentToken{text: string(r[i+1 : j]), quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(r) && !unicode.IsSpace(r[j]) && !strings.ContainsRune("()=!<>\"'", r[j]) {
+				j++
+			}
+			tokens = append(tokens, segmentToken{text: string(r[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type segmentParser struct {
+	tokens []segmentToken
+	pos    int
+	names  map[string]bool
+}
+
+// keyword reports whether the next token is an unquoted keyword, consuming it
+func (p *segmentParser) keyword(k string) bool {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, k) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *segmentParser) or() (segmentNode, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("OR") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = segmentOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *segmentParser) and() (segmentNode, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("AND") {
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = segmentAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *segmentParser) unary() (segmentNode, error) {
+	if p.keyword("NOT") {
+		node, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return segmentNot{node}, nil
+	}
+	if p.keyword("(") {
+		node, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, fmt.Errorf("bigcommerce: missing ) in segment query")
+		}
+		return node, nil
+	}
+	if p.pos+3 > len(p.tokens) {
+		return nil, fmt.Errorf("bigcommerce: incomplete segment query")
+	}
+	name, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	switch op.text {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return nil, fmt.Errorf("bigcommerce: expected an operator after %q in segment query, got %q", name.text, op.text)
+	}
+	if name.quoted || op.quoted || !value.quoted && strings.ContainsAny(value.text, "()=!<>") {
+		return nil, fmt.Errorf("bigcommerce: invalid comparison %s %s %s in segment query", name.text, op.text, value.text)
+	}
+	p.pos += 3
+	if p.names == nil {
+		p.names = map[string]bool{}
+	}
+	p.names[name.text] = true
+	return segmentCompare{name: name.text, op: op.text, value: value.text}, nil
+}
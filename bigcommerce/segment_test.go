@@ -0,0 +1,94 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSegmentQuery_Match(t *testing.T) {
+	tests := []struct {
+		query  string
+		values map[string]string
+		want   bool
+	}{
+		{"loyalty_tier == gold AND ltv > 500", map[string]string{"loyalty_tier": "gold", "ltv": "600.5"}, true},
+		{"loyalty_tier == gold AND ltv > 500", map[string]string{"loyalty_tier": "gold", "ltv": "90"}, false},
+		{"loyalty_tier == gold AND ltv > 500", map[string]string{"loyalty_tier": "gold"}, false},
+		{"loyalty_tier != gold", map[string]string{}, true},
+		{"ltv < 500", map[string]string{}, false},
+		{"tier == 'Gold Plus' or ltv >= 1e3", map[string]string{"tier": "Gold Plus"}, true},
+		{"NOT (a == 1 OR b == 2) AND c == 3", map[string]string{"a": "0", "b": "2", "c": "3"}, false},
+		{"a == 1 OR b == 2 AND c == 3", map[string]string{"a": "1", "c": "0"}, true},
+		{"birthday >= 2000-01-01", map[string]string{"birthday": "2001-06-15"}, true},
+		{"ltv == 500", map[string]string{"ltv": "500.00"}, true},
+	}
+	for _, tt := range tests {
+		q, err := ParseSegmentQuery(tt.query)
+		if err != nil {
+			t.Errorf("ParseSegmentQuery(%q) returned error: %v", tt.query, err)
+			continue
+		}
+		if got := q.Match(tt.values); got != tt.want {
+			t.Errorf("%q matched %v: %v, want %v", tt.query, tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestParseSegmentQuery_errors(t *testing.T) {
+	for _, query := range []string{"", "ltv >", "ltv = 5", "(ltv > 5", "ltv > 5 extra", "'ltv' > 5", "ltv > (", "tier == 'gold"} {
+		if _, err := ParseSegmentQuery(query); err == nil {
+			t.Errorf("ParseSegmentQuery(%q) returned no error", query)
+		}
+	}
+}
+
+func TestSegmentQuery_Names(t *testing.T) {
+	q, _ := ParseSegmentQuery("ltv > 5 AND (tier == gold OR ltv < 2)")
+	if got, want := q.Names(), []string{"ltv", "tier"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names returned %v, want %v", got, want)
+	}
+}
+
+func TestCustomerSegmenter_CustomerIDs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/attributes", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("name:in"); !strings.HasPrefix(got, "loyalty_tier,ltv") {
+			t.Errorf("Query name:in = %q", got)
+		}
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"loyalty_tier","type":"string"},{"id":2,"name":"ltv","type":"number"}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/customers/attribute-values", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, map[string]string{"attribute_id:in": "1,2"})
+		fmt.Fprint(w, `{"data":[
+			{"attribute_id":1,"customer_id":10,"attribute_value":"gold"},
+			{"attribute_id":2,"customer_id":10,"attribute_value":"750"},
+			{"attribute_id":1,"customer_id":11,"attribute_value":"gold"},
+			{"attribute_id":2,"customer_id":11,"attribute_value":"100"},
+			{"attribute_id":2,"customer_id":12,"attribute_value":"900"},
+			{"attribute_id":1,"customer_id":13,"attribute_value":"gold"},
+			{"attribute_id":2,"customer_id":13,"attribute_value":"501"}
+		],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/customers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":10,"customer_group_id":1},{"id":11},{"id":12},{"id":13,"customer_group_id":2},{"id":14}]`)
+	})
+
+	s := &CustomerSegmenter{Client: client}
+	ids, err := s.CustomerIDs(context.Background(), "loyalty_tier == gold AND ltv > 500 AND customer_group_id != 2")
+	if err != nil {
+		t.Fatalf("CustomerIDs returned error: %v", err)
+	}
+	if want := []int64{10}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("CustomerIDs returned %v, want %v", ids, want)
+	}
+
+	if _, err := s.CustomerIDs(context.Background(), "loyalty_tier == gold AND ltv > 500 AND missing == 1"); err == nil {
+		t.Errorf("CustomerIDs with an unknown attribute returned no error")
+	}
+}
@@ -0,0 +1,44 @@
+package bigcommerce
+
+import "context"
+
+// SettingsService handles communication with the V3 store settings endpoints.
+// Each settings group is read and written as a whole, into a struct or map of
+// the caller's choosing.
+type SettingsService service
+
+// SettingsGroup - The path of a group of store settings, relative to v3/settings
+type SettingsGroup string
+
+const (
+	// StoreProfileSettings - the store's name, address, email and phone
+	StoreProfileSettings SettingsGroup = "store/profile"
+	// StoreLocaleSettings - the store's country, language and default shopper language
+	StoreLocaleSettings SettingsGroup = "store/locale"
+	// LogoSettings - the storefront logo
+	LogoSettings SettingsGroup = "logo"
+	// StorefrontStatusSettings - whether the storefront is open, and the down-for-maintenance message
+	StorefrontStatusSettings SettingsGroup = "storefront/status"
+	// StorefrontSearchSettings - storefront search behaviour
+	StorefrontSearchSettings SettingsGroup = "storefront/search"
+	// StorefrontSEOSettings - page titles and meta data
+	StorefrontSEOSettings SettingsGroup = "storefront/seo"
+	// StorefrontSecuritySettings - HSTS and related headers
+	StorefrontSecuritySettings SettingsGroup = "storefront/security"
+	// StorefrontProductSettings - product display options
+	StorefrontProductSettings SettingsGroup = "storefront/product"
+	// EmailStatusSettings - which transactional emails are sent
+	EmailStatusSettings SettingsGroup = "email-statuses"
+	// AnalyticsSettings - web analytics providers
+	AnalyticsSettings SettingsGroup = "analytics"
+)
+
+// Get reads a settings group into v
+func (s *SettingsService) Get(ctx context.Context, group SettingsGroup, v interface{}) (*Response, error) {
+	return s.client.call(ctx, "GET", "v3/settings/"+string(group), nil, v)
+}
+
+// Update writes v to a settings group. Fields left out of v keep their values.
+func (s *SettingsService) Update(ctx context.Context, group SettingsGroup, v interface{}) (*Response, error) {
+	return s.client.call(ctx, "PUT", "v3/settings/"+string(group), v, nil)
+}
@@ -0,0 +1,40 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSettingsService(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	type profile struct {
+		StoreName  string `json:"store_name,omitempty"`
+		StoreEmail string `json:"store_email,omitempty"`
+	}
+	mux.HandleFunc("/stores/abc123/v3/settings/store/profile", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":{"store_name":"Acme","store_email":"hello@acme.test"},"meta":{}}`)
+		case "PUT":
+			testBody(t, r, new(profile), &profile{StoreName: "Acme Inc"})
+			fmt.Fprint(w, `{"data":{"store_name":"Acme Inc","store_email":"hello@acme.test"},"meta":{}}`)
+		default:
+			t.Errorf("Unexpected %s", r.Method)
+		}
+	})
+
+	var p profile
+	if _, err := client.Settings.Get(context.Background(), StoreProfileSettings, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.StoreName != "Acme" || p.StoreEmail != "hello@acme.test" {
+		t.Errorf("Get = %+v", p)
+	}
+	if _, err := client.Settings.Update(context.Background(), StoreProfileSettings, &profile{StoreName: "Acme Inc"}); err != nil {
+		t.Fatal(err)
+	}
+}
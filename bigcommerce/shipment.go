@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ShipmentService handles communication with the V2 order shipment endpoints
+type ShipmentService service
+
+// Shipment describes a BigCommerce V2 Order Shipment Object
+type Shipment struct {
+	ID                    int64            `json:"id,omitempty"`                      // The unique numerical ID of the shipment.
+	OrderID               int64            `json:"order_id,omitempty"`                // The ID of the order.
+	OrderAddressID        int64            `json:"order_address_id,omitempty"`        // The ID of the order's shipping address. Required on create.
+	TrackingNumber        string           `json:"tracking_number,omitempty"`         // The carrier's tracking number.
+	ShippingMethod        string           `json:"shipping_method,omitempty"`         // The shipping method used.
+	ShippingProvider      ShippingProvider `json:"shipping_provider,omitempty"`       // The carrier, e.g. ups, usps or fedex.
+	TrackingCarrier       string           `json:"tracking_carrier,omitempty"`        // The carrier used to build tracking links.
+	TrackingLink          string           `json:"tracking_link,omitempty"`           // A custom tracking link, for carriers BigCommerce does not link to.
+	GeneratedTrackingLink string           `json:"generated_tracking_link,omitempty"` // The tracking link built by BigCommerce. Read-only.
+	MerchantShippingCost  string           `json:"merchant_shipping_cost,omitempty"`  // What the merchant paid to ship.
+	Comments              string           `json:"comments,omitempty"`                // Comments shown to the shopper.
+	CustomerID            int64            `json:"customer_id,omitempty"`             // Read-only.
+	DateCreated           string           `json:"date_created,omitempty"`            // Date the shipment was created. Read-only.
+	BillingAddress        *Address         `json:"billing_address,omitempty"`         // Read-only.
+	ShippingAddress       *Address         `json:"shipping_address,omitempty"`        // Read-only.
+	Items                 []ShipmentItem   `json:"items,omitempty"`                   // The shipped order products. Required on create.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ShipmentItem describes an order product included in a shipment
+type ShipmentItem struct {
+	OrderProductID int64 `json:"order_product_id"` // The ID of the order product.
+	ProductID      int64 `json:"product_id,omitempty"`
+	Quantity       int64 `json:"quantity"` // The quantity shipped.
+}
+
+// ShippingProvider - A carrier known to BigCommerce, used to link tracking numbers
+type ShippingProvider string
+
+const (
+	// AustraliaPostProvider - Australia Post
+	AustraliaPostProvider ShippingProvider = "auspost"
+	// CanadaPostProvider - Canada Post
+	CanadaPostProvider ShippingProvider = "canadapost"
+	// EndiciaProvider - Endicia
+	EndiciaProvider ShippingProvider = "endicia"
+	// FedExProvider - FedEx
+	FedExProvider ShippingProvider = "fedex"
+	// RoyalMailProvider - Royal Mail
+	RoyalMailProvider ShippingProvider = "royalmail"
+	// UPSProvider - UPS
+	UPSProvider ShippingProvider = "ups"
+	// USPSProvider - USPS
+	USPSProvider ShippingProvider = "usps"
+	// CustomProvider - any other carrier, with a TrackingLink or TrackingCarrier
+	CustomProvider ShippingProvider = ""
+)
+
+// List returns a page of the shipments of an order
+func (s *ShipmentService) List(ctx context.Context, orderID int64, opts *ListOptions) ([]*Shipment, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/orders/%d/shipments", orderID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var shipments []*Shipment
+	resp, err := s.client.call(ctx, "GET", path, nil, &shipments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return shipments, resp, nil
+}
+
+// Count returns the number of shipments of an order
+func (s *ShipmentService) Count(ctx context.Context, orderID int64) (int64, *Response, error) {
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/orders/%d/shipments/count", orderID), nil, &count)
+	if err != nil {
+		return 0, resp, err
+	}
+	return count.Count, resp, nil
+}
+
+// Get returns a single shipment of an order
+func (s *ShipmentService) Get(ctx context.Context, orderID, shipmentID int64) (*Shipment, *Response, error) {
+	path := fmt.Sprintf("v2/orders/%d/shipments/%d", orderID, shipmentID)
+	shipment := new(Shipment)
+	resp, err := s.client.call(ctx, "GET", path, nil, shipment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return shipment, resp, nil
+}
+
+// Create adds a shipment to an order, marking its items as shipped. The
+// shopper is emailed the tracking details unless the store disables shipment
+// emails.
+func (s *ShipmentService) Create(ctx context.Context, orderID int64, shipment *Shipment) (*Shipment, *Response, error) {
+	path := fmt.Sprintf("v2/orders/%d/shipments", orderID)
+	created := new(Shipment)
+	resp, err := s.client.call(ctx, "POST", path, shipment, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a shipment, e.g. to correct its tracking number
+func (s *ShipmentService) Update(ctx context.Context, orderID, shipmentID int64, shipment *Shipment) (*Shipment, *Response, error) {
+	path := fmt.Sprintf("v2/orders/%d/shipments/%d", orderID, shipmentID)
+	updated := new(Shipment)
+	resp, err := s.client.call(ctx, "PUT", path, shipment, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a shipment from an order, returning its items to unshipped
+func (s *ShipmentService) Delete(ctx context.Context, orderID, shipmentID int64) (*Response, error) {
+	path := fmt.Sprintf("v2/orders/%d/shipments/%d", orderID, shipmentID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// DeleteAll removes every shipment of an order
+func (s *ShipmentService) DeleteAll(ctx context.Context, orderID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/orders/%d/shipments", orderID), nil, nil)
+}
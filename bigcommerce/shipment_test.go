@@ -0,0 +1,89 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestShipmentService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Shipment{OrderAddressID: 9, TrackingNumber: "1Z", Items: []ShipmentItem{{OrderProductID: 3, Quantity: 2}}}
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Shipment), input)
+		fmt.Fprint(w, `{"id":1,"order_id":100,"order_address_id":9,"tracking_number":"1Z","items":[{"order_product_id":3,"quantity":2}]}`)
+	})
+
+	shipment, _, err := client.Shipments.Create(context.Background(), 100, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shipment.ID != 1 || shipment.OrderID != 100 {
+		t.Errorf("Create = %+v", shipment)
+	}
+}
+
+func TestShipmentService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"order_id":100,"tracking_number":"1Z","shipping_provider":"ups","generated_tracking_link":"https://www.ups.com/track?tracknum=1Z",
+			"shipping_address":{"first_name":"Jane","city":"Austin"},"items":[{"order_product_id":3,"product_id":32,"quantity":2}]}]`)
+	})
+
+	shipments, _, err := client.Shipments.List(context.Background(), 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Shipment{{
+		ID: 1, OrderID: 100, TrackingNumber: "1Z", ShippingProvider: UPSProvider, GeneratedTrackingLink: "https://www.ups.com/track?tracknum=1Z",
+		ShippingAddress: &Address{FirstName: "Jane", City: "Austin"}, Items: []ShipmentItem{{OrderProductID: 3, ProductID: 32, Quantity: 2}},
+	}}
+	if !reflect.DeepEqual(shipments, want) {
+		t.Errorf("List = %+v, want %+v", shipments, want)
+	}
+}
+
+func TestShipmentService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Shipment{TrackingNumber: "9400", ShippingProvider: USPSProvider}
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Shipment), input)
+		fmt.Fprint(w, `{"id":1,"order_id":100,"tracking_number":"9400","shipping_provider":"usps"}`)
+	})
+
+	shipment, _, err := client.Shipments.Update(context.Background(), 100, 1, input)
+	if err != nil || shipment.TrackingNumber != "9400" {
+		t.Errorf("Update = %+v, %v", shipment, err)
+	}
+}
+
+func TestShipmentService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/stores/abc123/v2/orders/100/shipments/count", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count":0}`)
+	})
+
+	if _, err := client.Shipments.Delete(context.Background(), 100, 1); err != nil {
+		t.Fatal(err)
+	}
+	if n, _, err := client.Shipments.Count(context.Background(), 100); err != nil || n != 0 {
+		t.Errorf("Count = %d, %v, want 0", n, err)
+	}
+}
@@ -0,0 +1,154 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ShippingLabel describes the label and package details of an order shipment.
+// Labels are stored as JSON order metafields, one per shipment, so
+// customer-service tools can retrieve them consistently.
+type ShippingLabel struct {
+	Version        int               `json:"version"`                   // Schema version, set by ShippingLabels.
+	ShipmentID     int64             `json:"shipment_id"`               // The ID of the order shipment.
+	Carrier        string            `json:"carrier"`                   // The carrier, e.g. ups.
+	Service        string            `json:"service,omitempty"`         // The carrier's service level, e.g. ground.
+	TrackingNumber string            `json:"tracking_number,omitempty"` // The carrier's tracking number.
+	LabelURL       string            `json:"label_url"`                 // Where the label can be downloaded.
+	LabelFormat    string            `json:"label_format,omitempty"`    // The label's format, e.g. PDF or ZPL.
+	Packages       []LabelPackage    `json:"packages,omitempty"`        // The packages the label covers.
+	CarrierData    map[string]string `json:"carrier_data,omitempty"`    // Carrier-specific details, e.g. a manifest ID.
+}
+
+// LabelPackage describes the dimensions and weight of a labelled package
+type LabelPackage struct {
+	Length        float64 `json:"length"`
+	Width         float64 `json:"width"`
+	Height        float64 `json:"height"`
+	DimensionUnit string  `json:"dimension_unit"` // One of in or cm.
+	Weight        float64 `json:"weight"`
+	WeightUnit    string  `json:"weight_unit"` // One of lb, oz, kg or g.
+}
+
+// shippingLabelVersion is the current ShippingLabel schema version
+const shippingLabelVersion = 1
+
+// maxMetafieldValue is the longest value the API accepts for a metafield
+const maxMetafieldValue = 65535
+
+// ShippingLabels stores ShippingLabels as order metafields
+type ShippingLabels struct {
+	Client    *Client
+	Namespace string // Metafield namespace, "shipping_labels" if empty.
+}
+
+func (l *ShippingLabels) namespace() string {
+	if l.Namespace == "" {
+		return "shipping_labels"
+	}
+	return l.Namespace
+}
+
+func shippingLabelKey(shipmentID int64) string {
+	return "shipment_" + strconv.FormatInt(shipmentID, 10)
+}
+
+// Attach records a shipment's label on its order, replacing any label already
+// recorded for the shipment
+func (l *ShippingLabels) Attach(ctx context.Context, orderID int64, label *ShippingLabel) error {
+	if label.ShipmentID == 0 {
+		return fmt.Errorf("bigcommerce: shipping label has no shipment ID")
+	}
+	stored := *label
+	stored.Version = shippingLabelVersion
+	value, err := json.Marshal(&stored)
+	if err != nil {
+		return err
+	}
+	if len(value) > maxMetafieldValue {
+		return fmt.Errorf("bigcommerce: shipping label for shipment %d is %d bytes, over the %d byte metafield limit", label.ShipmentID, len(value), maxMetafieldValue)
+	}
+
+	owner := MetafieldOwnerOf(OrderMetafields, orderID)
+	key := shippingLabelKey(label.ShipmentID)
+	existing, _, err := l.Client.Metafields.List(ctx, owner, &MetafieldListOptions{Namespace: l.namespace(), Key: key})
+	if err != nil {
+		return err
+	}
+	metafield := &Metafield{
+		Namespace:     l.namespace(),
+		Key:           key,
+		Value:         string(value),
+		PermissionSet: ReadMetafield,
+		Description:   "Shipping label",
+	}
+	if len(existing) > 0 {
+		_, _, err = l.Client.Metafields.Update(ctx, owner, existing[0].ID, metafield)
+	} else {
+		_, _, err = l.Client.Metafields.Create(ctx, owner, metafield)
+	}
+	return err
+}
+
+// Get returns the label recorded for a shipment, or nil if there is none
+func (l *ShippingLabels) Get(ctx context.Context, orderID, shipmentID int64) (*ShippingLabel, error) {
+	opts := &MetafieldListOptions{Namespace: l.namespace(), Key: shippingLabelKey(shipmentID)}
+	metafields, _, err := l.Client.Metafields.List(ctx, MetafieldOwnerOf(OrderMetafields, orderID), opts)
+	if err != nil || len(metafields) == 0 {
+		return nil, err
+	}
+	return decodeShippingLabel(metafields[0])
+}
+
+// List returns every label recorded on an order, ordered by shipment ID
+func (l *ShippingLabels) List(ctx context.Context, orderID int64) ([]*ShippingLabel, error) {
+	opts := &MetafieldListOptions{ListOptions: ListOptions{Limit: 250}, Namespace: l.namespace()}
+	metafields, _, err := l.Client.Metafields.List(ctx, MetafieldOwnerOf(OrderMetafields, orderID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []*ShippingLabel
+	for _, m := range metafields {
+		if !strings.HasPrefix(m.Key, "shipment_") {
+			continue
+		}
+		label, err := decodeShippingLabel(m)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].ShipmentID < labels[j].ShipmentID })
+	return labels, nil
+}
+
+// Remove deletes the label recorded for a shipment, if any
+func (l *ShippingLabels) Remove(ctx context.Context, orderID, shipmentID int64) error {
+	owner := MetafieldOwnerOf(OrderMetafields, orderID)
+	metafields, _, err := l.Client.Metafields.List(ctx, owner, &MetafieldListOptions{Namespace: l.namespace(), Key: shippingLabelKey(shipmentID)})
+	if err != nil {
+		return err
+	}
+	for _, m := range metafields {
+		if _, err := l.Client.Metafields.Delete(ctx, owner, m.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeShippingLabel(m *Metafield) (*ShippingLabel, error) {
+	label := new(ShippingLabel)
+	if err := json.Unmarshal([]byte(m.Value), label); err != nil {
+		return nil, fmt.Errorf("bigcommerce: decoding shipping label metafield %d: %v", m.ID, err)
+	}
+	if label.Version > shippingLabelVersion {
+		return nil, fmt.Errorf("bigcommerce: shipping label metafield %d uses unsupported schema version %d", m.ID, label.Version)
+	}
+	return label, nil
+}
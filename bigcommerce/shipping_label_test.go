@@ -0,0 +1,66 @@
+package bigcommerce
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/bctest"
+)
+
+func TestShippingLabels(t *testing.T) {
+	server := bctest.NewServer()
+	client := NewClient("abc123", "token", WithSandboxServer(server))
+	labels := &ShippingLabels{Client: client}
+	ctx := context.Background()
+
+	label := &ShippingLabel{
+		ShipmentID:     7,
+		Carrier:        "ups",
+		Service:        "ground",
+		TrackingNumber: "1Z999",
+		LabelURL:       "https://labels.example.com/7.pdf",
+		LabelFormat:    "PDF",
+		Packages:       []LabelPackage{{Length: 10, Width: 8, Height: 4, DimensionUnit: "in", Weight: 2.5, WeightUnit: "lb"}},
+		CarrierData:    map[string]string{"manifest": "M-1"},
+	}
+	if err := labels.Attach(ctx, 100, label); err != nil {
+		t.Fatal(err)
+	}
+	if err := labels.Attach(ctx, 100, &ShippingLabel{ShipmentID: 3, Carrier: "usps", LabelURL: "https://labels.example.com/3.pdf"}); err != nil {
+		t.Fatal(err)
+	}
+	// Attaching again replaces the shipment's label.
+	label.TrackingNumber = "1Z000"
+	if err := labels.Attach(ctx, 100, label); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.Objects("v3/orders/100/metafields")); n != 2 {
+		t.Errorf("Got %d metafields, want 2", n)
+	}
+
+	got, err := labels.Get(ctx, 100, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *label
+	want.Version = 1
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("Get = %+v, want %+v", got, &want)
+	}
+
+	all, err := labels.List(ctx, 100)
+	if err != nil || len(all) != 2 || all[0].ShipmentID != 3 || all[1].TrackingNumber != "1Z000" {
+		t.Errorf("List = %+v, %v", all, err)
+	}
+
+	if err := labels.Remove(ctx, 100, 3); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := labels.Get(ctx, 100, 3); got != nil || err != nil {
+		t.Errorf("Get after Remove = %+v, %v", got, err)
+	}
+	if err := labels.Attach(ctx, 100, &ShippingLabel{Carrier: "ups"}); err == nil {
+		t.Error("Expected an error for a label without a shipment ID")
+	}
+}
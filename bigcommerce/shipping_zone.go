@@ -0,0 +1,104 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// ShippingZoneService handles communication with the V2 shipping zone endpoints
+type ShippingZoneService service
+
+// ShippingZone describes a BigCommerce V2 Shipping Zone Object, a region
+// shipped to with its own shipping methods
+type ShippingZone struct {
+	ID           int64                  `json:"id,omitempty"`            // The unique numerical ID of the zone.
+	Name         string                 `json:"name"`                    // The name of the zone.
+	Type         ShippingZoneType       `json:"type"`                    // How the zone's locations are given.
+	Locations    []ShippingZoneLocation `json:"locations"`               // The places in the zone.
+	FreeShipping *ZoneFreeShipping      `json:"free_shipping,omitempty"` // Free shipping above an order subtotal.
+	HandlingFees *ZoneHandlingFees      `json:"handling_fees,omitempty"` // Fees added to every shipment to the zone.
+	Enabled      bool                   `json:"enabled"`                 // Whether the zone is offered at checkout.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// ShippingZoneLocation describes a place in a shipping zone
+type ShippingZoneLocation struct {
+	ID          int64  `json:"id,omitempty"`
+	Zip         string `json:"zip,omitempty"`          // A zip or postcode, for zip zones. Wildcards such as "787*" are allowed.
+	CountryISO2 string `json:"country_iso2,omitempty"` // The country, for every zone type but global.
+	StateISO2   string `json:"state_iso2,omitempty"`   // The state, for state zones.
+}
+
+// ZoneFreeShipping describes the free shipping offered by a shipping zone
+type ZoneFreeShipping struct {
+	Enabled                      bool   `json:"enabled"`
+	MinimumSubTotal              string `json:"minimum_sub_total,omitempty"`
+	ExcludeFixedShippingProducts bool   `json:"exclude_fixed_shipping_products"`
+}
+
+// ZoneHandlingFees describes the handling fees of a shipping zone
+type ZoneHandlingFees struct {
+	FixedSurcharge      string `json:"fixed_surcharge,omitempty"`
+	PercentageSurcharge string `json:"percentage_surcharge,omitempty"`
+	DisplaySeparately   bool   `json:"display_separately"`
+}
+
+// ShippingZoneType - How the locations of a ShippingZone are given
+type ShippingZoneType string
+
+const (
+	// ZipZone - zip or postcodes
+	ZipZone ShippingZoneType = "zip"
+	// CountryZone - whole countries
+	CountryZone ShippingZoneType = "country"
+	// StateZone - states or provinces
+	StateZone ShippingZoneType = "state"
+	// GlobalZone - everywhere not covered by another zone
+	GlobalZone ShippingZoneType = "global"
+)
+
+// List returns the store's shipping zones
+func (s *ShippingZoneService) List(ctx context.Context) ([]*ShippingZone, *Response, error) {
+	var zones []*ShippingZone
+	resp, err := s.client.call(ctx, "GET", "v2/shipping/zones", nil, &zones)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zones, resp, nil
+}
+
+// Get returns a single shipping zone
+func (s *ShippingZoneService) Get(ctx context.Context, id int64) (*ShippingZone, *Response, error) {
+	zone := new(ShippingZone)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/shipping/zones/%d", id), nil, zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zone, resp, nil
+}
+
+// Create adds a shipping zone
+func (s *ShippingZoneService) Create(ctx context.Context, zone *ShippingZone) (*ShippingZone, *Response, error) {
+	created := new(ShippingZone)
+	resp, err := s.client.call(ctx, "POST", "v2/shipping/zones", zone, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a shipping zone
+func (s *ShippingZoneService) Update(ctx context.Context, id int64, zone *ShippingZone) (*ShippingZone, *Response, error) {
+	updated := new(ShippingZone)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/shipping/zones/%d", id), zone, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a shipping zone
+func (s *ShippingZoneService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/shipping/zones/%d", id), nil, nil)
+}
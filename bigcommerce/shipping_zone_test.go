@@ -0,0 +1,49 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestShippingZoneService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/shipping/zones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"Texas","type":"state","locations":[{"id":4,"country_iso2":"US","state_iso2":"TX"}],
+			"free_shipping":{"enabled":true,"minimum_sub_total":"50.0000","exclude_fixed_shipping_products":false},"enabled":true}]`)
+	})
+
+	zones, _, err := client.ShippingZones.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ShippingZone{{
+		ID: 1, Name: "Texas", Type: StateZone, Locations: []ShippingZoneLocation{{ID: 4, CountryISO2: "US", StateISO2: "TX"}},
+		FreeShipping: &ZoneFreeShipping{Enabled: true, MinimumSubTotal: "50.0000"}, Enabled: true,
+	}}
+	if !reflect.DeepEqual(zones, want) {
+		t.Errorf("List = %+v, want %+v", zones, want)
+	}
+}
+
+func TestShippingZoneService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &ShippingZone{Name: "Austin", Type: ZipZone, Locations: []ShippingZoneLocation{{Zip: "787*", CountryISO2: "US"}}, Enabled: true}
+	mux.HandleFunc("/stores/abc123/v2/shipping/zones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(ShippingZone), input)
+		fmt.Fprint(w, `{"id":2,"name":"Austin","type":"zip","locations":[{"id":5,"zip":"787*","country_iso2":"US"}],"enabled":true}`)
+	})
+
+	zone, _, err := client.ShippingZones.Create(context.Background(), input)
+	if err != nil || zone.ID != 2 {
+		t.Errorf("Create = %+v, %v", zone, err)
+	}
+}
@@ -0,0 +1,295 @@
+package bigcommerce
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ShopifyProduct is a product read from a Shopify product CSV export, mapped onto
+// this package's types. Options must be created on the new product before its
+// Variants, whose OptionValues reference option values by display name and label.
+type ShopifyProduct struct {
+	Handle       string           // The Shopify handle, also used for the product's CustomURL.
+	Brand        string           // The Shopify vendor.
+	Product      *Product         // The product, priced from its first variant.
+	Options      []*ProductOption // Options built from the Option1-3 columns.
+	Variants     []*Variant       // Variants, when the product has options.
+	CustomFields []*CustomField   // Custom fields built from the product's tags.
+	Images       []string         // Image URLs, in position order.
+}
+
+// shopifyRow provides access to a CSV record by column name
+type shopifyRow struct {
+	header map[string]int
+	record []string
+}
+
+func (r shopifyRow) get(column string) string {
+	if i, ok := r.header[column]; ok && i < len(r.record) {
+		return strings.TrimSpace(r.record[i])
+	}
+	return ""
+}
+
+// readShopifyCSV reads a Shopify export, calling fn for every row
+func readShopifyCSV(r io.Reader, fn func(shopifyRow) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	columns, err := cr.Read()
+	if err != nil {
+		return err
+	}
+	header := make(map[string]int, len(columns))
+	for i, c := range columns {
+		header[strings.TrimSpace(strings.TrimPrefix(c, "\ufeff"))] = i
+	}
+
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(shopifyRow{header, record}); err != nil {
+			return fmt.Errorf("bigcommerce: shopify export line %d: %v", line, err)
+		}
+	}
+}
+
+// ReadShopifyProducts reads a Shopify product CSV export. Rows sharing a handle
+// are folded into a single product: option columns become options and variants,
+// and tags become custom fields ("key:value" tags are split into name and value).
+func ReadShopifyProducts(r io.Reader) ([]*ShopifyProduct, error) {
+	var products []*ShopifyProduct
+	byHandle := map[string]*ShopifyProduct{}
+
+	err := readShopifyCSV(r, func(row shopifyRow) error {
+		handle := row.get("Handle")
+		if handle == "" {
+			return fmt.Errorf("missing handle")
+		}
+
+		sp, ok := byHandle[handle]
+		if !ok {
+			sp = newShopifyProduct(row)
+			byHandle[handle] = sp
+			products = append(products, sp)
+		}
+		if src := row.get("Image Src"); src != "" {
+			sp.Images = append(sp.Images, src)
+		}
+		return sp.addVariant(row)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sp := range products {
+		sp.finish()
+	}
+	return products, nil
+}
+
+func newShopifyProduct(row shopifyRow) *ShopifyProduct {
+	handle := row.get("Handle")
+	sp := &ShopifyProduct{
+		Handle: handle,
+		Brand:  row.get("Vendor"),
+		Product: &Product{
+			Name:            row.get("Title"),
+			Type:            PhysicalProduct,
+			Description:     row.get("Body (HTML)"),
+			PageTitle:       row.get("SEO Title"),
+			MetaDescription: row.get("SEO Description"),
+			CustomURL:       "/" + handle + "/",
+			IsVisible:       shopifyVisible(row),
+			Availability:    AvailableProduct,
+		},
+	}
+	if row.get("Variant Requires Shipping") == "FALSE" {
+		sp.Product.Type = DigitalProduct
+	}
+
+	for _, tag := range strings.Split(row.get("Tags"), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		field := &CustomField{Name: "Tag", Value: tag}
+		if i := strings.Index(tag, ":"); i > 0 && i < len(tag)-1 {
+			field.Name, field.Value = strings.TrimSpace(tag[:i]), strings.TrimSpace(tag[i+1:])
+		}
+		sp.CustomFields = append(sp.CustomFields, field)
+	}
+
+	for n := 1; n <= 3; n++ {
+		name := row.get(fmt.Sprintf("Option%d Name", n))
+		if name == "" || name == "Title" {
+			break
+		}
+		sp.Options = append(sp.Options, &ProductOption{DisplayName: name, Type: RectanglesOption})
+	}
+	return sp
+}
+
+// addVariant records the variant columns of a row, if it has any
+func (sp *ShopifyProduct) addVariant(row shopifyRow) error {
+	if row.get("Variant Price") == "" && row.get("Variant SKU") == "" {
+		return nil // An image-only row.
+	}
+
+	price, salePrice, err := shopifyPrices(row)
+	if err != nil {
+		return err
+	}
+	qty, _ := strconv.ParseInt(row.get("Variant Inventory Qty"), 10, 64)
+	variant := &Variant{
+		SKU:            row.get("Variant SKU"),
+		Price:          price,
+		SalePrice:      salePrice,
+		CostPrice:      parseOptionalFloat(row.get("Cost per item")),
+		Weight:         shopifyWeight(row),
+		UPC:            row.get("Variant Barcode"),
+		InventoryLevel: qty,
+		ImageURL:       row.get("Variant Image"),
+	}
+
+	for n, option := range sp.Options {
+		label := row.get(fmt.Sprintf("Option%d Value", n+1))
+		if label == "" {
+			return fmt.Errorf("variant %q has no value for option %q", variant.SKU, option.DisplayName)
+		}
+		if option.Value(label) == nil {
+			option.OptionValues = append(option.OptionValues, OptionValue{Label: label, SortOrder: int64(len(option.OptionValues))})
+		}
+		variant.OptionValues = append(variant.OptionValues, VariantOptionValue{Label: label, OptionDisplayName: option.DisplayName})
+	}
+
+	if row.get("Variant Inventory Tracker") == "shopify" {
+		if len(sp.Options) > 0 {
+			sp.Product.InventoryTracking = inventoryType(SKUInventory)
+		} else {
+			sp.Product.InventoryTracking = inventoryType(SimpleInventory)
+		}
+	}
+	sp.Variants = append(sp.Variants, variant)
+	return nil
+}
+
+// finish prices the product from its first variant, and drops the variant list
+// of products without options since BigCommerce models those as a single product
+func (sp *ShopifyProduct) finish() {
+	if len(sp.Variants) == 0 {
+		return
+	}
+	first := sp.Variants[0]
+	p := sp.Product
+	p.Price = formatShopifyDecimal(first.Price)
+	p.SalePrice = formatShopifyDecimal(first.SalePrice)
+	p.CostPrice = formatShopifyDecimal(first.CostPrice)
+	p.Weight = formatShopifyDecimal(first.Weight)
+	if len(sp.Options) == 0 {
+		p.SKU, p.UPC, p.InventoryLevel = first.SKU, first.UPC, first.InventoryLevel
+		sp.Variants = nil
+	}
+}
+
+// ReadShopifyCustomers reads a Shopify customer CSV export. Shopify tags and
+// notes are combined into the customer's notes.
+func ReadShopifyCustomers(r io.Reader) ([]*Customer, error) {
+	var customers []*Customer
+	err := readShopifyCSV(r, func(row shopifyRow) error {
+		email := row.get("Email")
+		if email == "" {
+			return fmt.Errorf("missing email")
+		}
+
+		notes := row.get("Note")
+		if tags := row.get("Tags"); tags != "" {
+			notes = strings.TrimSpace(notes + "\nTags: " + tags)
+		}
+		customer := &Customer{
+			FirstName:        row.get("First Name"),
+			LastName:         row.get("Last Name"),
+			Email:            email,
+			Company:          row.get("Company"),
+			Phone:            row.get("Phone"),
+			Notes:            notes,
+			AcceptsMarketing: strings.EqualFold(row.get("Accepts Marketing"), "yes"),
+		}
+		customers = append(customers, customer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
+func shopifyVisible(row shopifyRow) bool {
+	if status := row.get("Status"); status != "" {
+		return status == "active"
+	}
+	return row.get("Published") != "FALSE"
+}
+
+// shopifyPrices maps Shopify's price and compare-at price onto BigCommerce's
+// price and sale price
+func shopifyPrices(row shopifyRow) (price, salePrice *float64, err error) {
+	value := row.get("Variant Price")
+	if value == "" {
+		return nil, nil, nil
+	}
+	p, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid variant price %q", value)
+	}
+	if compareAt := parseOptionalFloat(row.get("Variant Compare At Price")); compareAt != nil && *compareAt > p {
+		return compareAt, &p, nil
+	}
+	return &p, nil, nil
+}
+
+// shopifyWeight converts the exported grams into the variant's display weight unit
+func shopifyWeight(row shopifyRow) *float64 {
+	grams := parseOptionalFloat(row.get("Variant Grams"))
+	if grams == nil {
+		return nil
+	}
+	w := *grams
+	switch row.get("Variant Weight Unit") {
+	case "kg":
+		w /= 1000
+	case "lb":
+		w /= 453.59237
+	case "oz":
+		w /= 28.349523125
+	}
+	w = float64(int64(w*10000+0.5)) / 10000
+	return &w
+}
+
+func parseOptionalFloat(s string) *float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func formatShopifyDecimal(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', 4, 64)
+}
+
+func inventoryType(t InventoryType) *InventoryType {
+	return &t
+}
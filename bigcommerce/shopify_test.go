@@ -0,0 +1,69 @@
+package bigcommerce
+
+import (
+	"strings"
+	"testing"
+)
+
+const shopifyProductsCSV = `Handle,Title,Body (HTML),Vendor,Tags,Published,Option1 Name,Option1 Value,Option2 Name,Option2 Value,Variant SKU,Variant Grams,Variant Inventory Tracker,Variant Inventory Qty,Variant Price,Variant Compare At Price,Variant Requires Shipping,Variant Barcode,Image Src,Variant Weight Unit
+red-scarf,Red Scarf,<p>Warm</p>,Sample,"winter, material:wool",TRUE,Size,Small,Color,Red,SCARF-S-RED,300,shopify,4,89.00,99.00,TRUE,,https://cdn.shopify.com/1.jpg,kg
+red-scarf,,,,,,,Large,,Red,SCARF-L-RED,350,shopify,2,89.00,,TRUE,,https://cdn.shopify.com/2.jpg,kg
+red-scarf,,,,,,,,,,,,,,,,,,https://cdn.shopify.com/3.jpg,
+ebook,Handbook,,Sample,,TRUE,Title,Default Title,,,BOOK-1,0,,0,10.00,,FALSE,036000291452,,g
+`
+
+const shopifyCustomersCSV = `First Name,Last Name,Email,Company,Phone,Accepts Marketing,Tags,Note,Tax Exempt
+Jane,Doe,jane@example.com,Acme,555-0100,yes,vip,Prefers email,no
+`
+
+func TestReadShopifyProducts(t *testing.T) {
+	products, err := ReadShopifyProducts(strings.NewReader(shopifyProductsCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 {
+		t.Fatalf("Expected 2 products, got %d", len(products))
+	}
+
+	scarf := products[0]
+	if scarf.Product.Name != "Red Scarf" || scarf.Product.Price != "99.0000" || scarf.Product.SalePrice != "89.0000" {
+		t.Errorf("Unexpected product %+v", scarf.Product)
+	}
+	if *scarf.Product.InventoryTracking != SKUInventory || scarf.Product.CustomURL != "/red-scarf/" {
+		t.Errorf("Unexpected product %+v", scarf.Product)
+	}
+	if len(scarf.Options) != 2 || len(scarf.Options[0].OptionValues) != 2 || len(scarf.Options[1].OptionValues) != 1 {
+		t.Errorf("Unexpected options %+v", scarf.Options)
+	}
+	if len(scarf.Variants) != 2 || scarf.Variants[1].OptionValues[0].Label != "Large" || *scarf.Variants[1].Weight != 0.35 {
+		t.Errorf("Unexpected variants %+v", scarf.Variants)
+	}
+	if len(scarf.Images) != 3 {
+		t.Errorf("Expected 3 images, got %v", scarf.Images)
+	}
+	if len(scarf.CustomFields) != 2 || scarf.CustomFields[1].Name != "material" || scarf.CustomFields[1].Value != "wool" {
+		t.Errorf("Unexpected custom fields %+v", scarf.CustomFields)
+	}
+
+	book := products[1]
+	if book.Product.Type != DigitalProduct || book.Product.SKU != "BOOK-1" || book.Product.UPC != "036000291452" {
+		t.Errorf("Unexpected product %+v", book.Product)
+	}
+	if len(book.Options) != 0 || len(book.Variants) != 0 {
+		t.Error("Expected default-title product to have no options or variants")
+	}
+}
+
+func TestReadShopifyCustomers(t *testing.T) {
+	customers, err := ReadShopifyCustomers(strings.NewReader(shopifyCustomersCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(customers) != 1 {
+		t.Fatalf("Expected 1 customer, got %d", len(customers))
+	}
+	c := customers[0]
+	if c.Email != "jane@example.com" || !c.AcceptsMarketing || c.Notes != "Prefers email\nTags: vip" {
+		t.Errorf("Unexpected customer %+v", c)
+	}
+}
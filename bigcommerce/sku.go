@@ -0,0 +1,107 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// SKUService handles communication with the legacy V2 product SKU endpoints.
+// New integrations should use VariantService; SKU records are the V2 view of variants.
+type SKUService service
+
+// SKU describes a BigCommerce V2 SKU Object, a combination of option values with
+// its own stock keeping unit and inventory
+type SKU struct {
+	ID                        int64       `json:"id,omitempty"`                          // The unique numerical ID of the SKU.
+	ProductID                 int64       `json:"product_id,omitempty"`                  // The ID of the product the SKU belongs to.
+	SKU                       string      `json:"sku,omitempty"`                         // The stock keeping unit code.
+	Price                     *string     `json:"price,omitempty"`                       // Overrides the product's price. Null means the product's price is used.
+	AdjustedPrice             string      `json:"adjusted_price,omitempty"`              // The price after rules are applied. Read-only.
+	CostPrice                 string      `json:"cost_price,omitempty"`                  // The SKU's cost price, for reference only.
+	UPC                       string      `json:"upc,omitempty"`                         // The SKU's UPC code.
+	InventoryLevel            int64       `json:"inventory_level,omitempty"`             // Current inventory level, when inventory is tracked by SKU.
+	InventoryWarningLevel     int64       `json:"inventory_warning_level,omitempty"`     // Level at which the store owner is warned about low stock.
+	BinPickingNumber          string      `json:"bin_picking_number,omitempty"`          // The BIN picking number for the SKU.
+	Weight                    *string     `json:"weight,omitempty"`                      // Overrides the product's weight.
+	AdjustedWeight            string      `json:"adjusted_weight,omitempty"`             // The weight after rules are applied. Read-only.
+	IsPurchasingDisabled      bool        `json:"is_purchasing_disabled,omitempty"`      // If true, the SKU cannot be purchased on the storefront.
+	PurchasingDisabledMessage string      `json:"purchasing_disabled_message,omitempty"` // Message shown when purchasing is disabled.
+	ImageFile                 string      `json:"image_file,omitempty"`                  // The SKU's image.
+	Options                   []SKUOption `json:"options,omitempty"`                     // The option values that make up the SKU. Required on create.
+}
+
+// SKUOption describes an option value making up a SKU
+type SKUOption struct {
+	ProductOptionID int64 `json:"product_option_id"` // The ID of the product's option.
+	OptionValueID   int64 `json:"option_value_id"`   // The ID of the chosen value.
+}
+
+// SKUListOptions specifies the optional parameters to SKUService.List
+type SKUListOptions struct {
+	ListOptions
+	SKU   string `url:"sku,omitempty"`    // Filter by SKU code.
+	MinID int64  `url:"min_id,omitempty"` // Only return SKUs with an ID of at least this value.
+	MaxID int64  `url:"max_id,omitempty"` // Only return SKUs with an ID of at most this value.
+}
+
+// List returns the SKUs of a product
+func (s *SKUService) List(ctx context.Context, productID int64, opts *SKUListOptions) ([]*SKU, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/products/%d/skus", productID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var skus []*SKU
+	resp, err := s.client.call(ctx, "GET", path, nil, &skus)
+	if err != nil {
+		return nil, resp, err
+	}
+	return skus, resp, nil
+}
+
+// Get returns a single SKU of a product
+func (s *SKUService) Get(ctx context.Context, productID, skuID int64) (*SKU, *Response, error) {
+	sku := new(SKU)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/products/%d/skus/%d", productID, skuID), nil, sku)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sku, resp, nil
+}
+
+// Create adds a SKU to a product. SKU and Options are required.
+func (s *SKUService) Create(ctx context.Context, productID int64, sku *SKU) (*SKU, *Response, error) {
+	created := new(SKU)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/products/%d/skus", productID), sku, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a SKU of a product
+func (s *SKUService) Update(ctx context.Context, productID, skuID int64, sku *SKU) (*SKU, *Response, error) {
+	updated := new(SKU)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/products/%d/skus/%d", productID, skuID), sku, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a SKU from a product
+func (s *SKUService) Delete(ctx context.Context, productID, skuID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/products/%d/skus/%d", productID, skuID), nil, nil)
+}
+
+// Count returns the number of SKUs of a product
+func (s *SKUService) Count(ctx context.Context, productID int64) (int64, *Response, error) {
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/products/%d/skus/count", productID), nil, &count)
+	if err != nil {
+		return 0, resp, err
+	}
+	return count.Count, resp, nil
+}
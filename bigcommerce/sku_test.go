@@ -0,0 +1,66 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSKUService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &SKU{SKU: "SHIRT-RED-L", Price: String("12.50"), InventoryLevel: 4, Options: []SKUOption{{ProductOptionID: 3, OptionValueID: 70}, {ProductOptionID: 4, OptionValueID: 81}}}
+	mux.HandleFunc("/stores/abc123/v2/products/32/skus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(SKU), input)
+		fmt.Fprint(w, `{"id":5,"product_id":32,"sku":"SHIRT-RED-L","price":"12.5000","adjusted_price":"12.5000","inventory_level":4,
+			"options":[{"product_option_id":3,"option_value_id":70},{"product_option_id":4,"option_value_id":81}]}`)
+	})
+
+	sku, _, err := client.SKUs.Create(context.Background(), 32, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sku.ID != 5 || *sku.Price != "12.5000" || len(sku.Options) != 2 || sku.Options[1].OptionValueID != 81 {
+		t.Errorf("Unexpected SKU %+v", sku)
+	}
+}
+
+func TestSKUService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/products/32/skus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"sku": "SHIRT-RED-L", "page": "2"})
+		fmt.Fprint(w, `[{"id":5,"sku":"SHIRT-RED-L","price":null}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/products/33/skus", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	skus, _, err := client.SKUs.List(context.Background(), 32, &SKUListOptions{ListOptions: ListOptions{Page: 2}, SKU: "SHIRT-RED-L"})
+	if err != nil || len(skus) != 1 || skus[0].Price != nil {
+		t.Errorf("Unexpected SKUs %+v, %v", skus, err)
+	}
+	skus, _, err = client.SKUs.List(context.Background(), 33, nil)
+	if err != nil || len(skus) != 0 {
+		t.Errorf("Expected no SKUs for an empty listing, got %+v, %v", skus, err)
+	}
+}
+
+func TestSKUService_Count(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/products/32/skus/count", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count":12}`)
+	})
+
+	count, _, err := client.SKUs.Count(context.Background(), 32)
+	if err != nil || count != 12 {
+		t.Errorf("Count = %d, %v, want 12", count, err)
+	}
+}
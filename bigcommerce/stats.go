@@ -0,0 +1,205 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// statsSamples is the number of recent latencies kept per endpoint for percentiles
+const statsSamples = 1024
+
+// maxStatsEndpoints bounds the endpoints tracked, should paths carry opaque
+// values endpointName cannot tell from resource names. Calls to further
+// endpoints are counted under "<method> other".
+const maxStatsEndpoints = 500
+
+// EndpointStats is a snapshot of the calls made to a single endpoint
+type EndpointStats struct {
+	Endpoint  string        // Method and path template, e.g. "GET v3/catalog/products/{id}/variants".
+	Calls     int64         // Number of calls made.
+	Errors    int64         // Number of calls that failed or returned a non-2xx status.
+	ErrorRate float64       // Errors / Calls.
+	P50       time.Duration // Median latency of recent calls.
+	P95       time.Duration // 95th percentile latency of recent calls.
+}
+
+// SlowCall describes a call that took longer than the threshold given to WithSlowCallReporting
+type SlowCall struct {
+	Endpoint   string
+	URL        string
+	StatusCode int // Zero if the request failed without a response.
+	Duration   time.Duration
+}
+
+// WithSlowCallReporting calls report for every call slower than threshold.
+// report is called synchronously, so it should not block.
+func WithSlowCallReporting(threshold time.Duration, report func(SlowCall)) ClientOption {
+	return func(c *Client) {
+		c.stats.slowThreshold = threshold
+		c.stats.reportSlow = report
+	}
+}
+
+// Stats returns a snapshot of per-endpoint call statistics, busiest endpoints first
+func (c *Client) Stats() []EndpointStats {
+	return c.stats.snapshot()
+}
+
+type statsCollector struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointStats
+
+	slowThreshold time.Duration
+	reportSlow    func(SlowCall)
+}
+
+type endpointStats struct {
+	calls, errors int64
+	latencies     []time.Duration // Ring buffer of the most recent statsSamples latencies.
+	next          int
+}
+
+func newStatsCollector() *statsCollector {
+	return &statsCollector{endpoints: map[string]*endpointStats{}}
+}
+
+// record adds a completed call. resp is nil when the request failed without a response.
+func (s *statsCollector) record(req *http.Request, resp *http.Response, d time.Duration) {
+	endpoint := endpointName(req)
+	failed := resp == nil || resp.StatusCode < 200 || resp.StatusCode > 299
+
+	s.mu.Lock()
+	e, ok := s.endpoints[endpoint]
+	if !ok && len(s.endpoints) >= maxStatsEndpoints {
+		endpoint = req.Method + " other"
+		e, ok = s.endpoints[endpoint]
+	}
+	if !ok {
+		e = &endpointStats{}
+		s.endpoints[endpoint] = e
+	}
+	e.calls++
+	if failed {
+		e.errors++
+	}
+	if len(e.latencies) < statsSamples {
+		e.latencies = append(e.latencies, d)
+	} else {
+		e.latencies[e.next] = d
+		e.next = (e.next + 1) % statsSamples
+	}
+	s.mu.Unlock()
+
+	if s.reportSlow != nil && d > s.slowThreshold {
+		call := SlowCall{Endpoint: endpoint, URL: req.URL.String(), Duration: d}
+		if resp != nil {
+			call.StatusCode = resp.StatusCode
+		}
+		s.reportSlow(call)
+	}
+}
+
+func (s *statsCollector) snapshot() []EndpointStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make([]EndpointStats, 0, len(s.endpoints))
+	for endpoint, e := range s.endpoints {
+		sorted := append([]time.Duration(nil), e.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		stats = append(stats, EndpointStats{
+			Endpoint:  endpoint,
+			Calls:     e.calls,
+			Errors:    e.errors,
+			ErrorRate: float64(e.errors) / float64(e.calls),
+			P50:       percentile(sorted, 0.50),
+			P95:       percentile(sorted, 0.95),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Calls != stats[j].Calls {
+			return stats[i].Calls > stats[j].Calls
+		}
+		return stats[i].Endpoint < stats[j].Endpoint
+	})
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p*float64(len(sorted))+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// endpointName groups requests by method and path, replacing IDs, UUIDs and
+// codes with {id} and dropping the store prefix, e.g.
+// "GET v3/catalog/products/{id}/images". Any segment after the first that is
+// not a known resource name is taken for a value.
+func endpointName(req *http.Request) string {
+	path := req.URL.Path
+	if i := strings.Index(path, "/v2/"); i >= 0 {
+		path = path[i+1:]
+	} else if i := strings.Index(path, "/v3/"); i >= 0 {
+		path = path[i+1:]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segments {
+		if i > 0 && !isResourceName(seg) {
+			segments[i] = "{id}"
+		}
+	}
+	return req.Method + " " + strings.Join(segments, "/")
+}
+
+// resourceNames are the literal path segments of the endpoints the client
+// calls. Add the names of new endpoints here, or their paths are grouped with
+// {id} in place of the names.
+var resourceNames = map[string]bool{
+	"access_tokens": true, "addresses": true, "adjustments": true,
+	"api-token": true, "api-token-customer-impersonation": true,
+	"assignments": true, "attribute-values": true, "attributes": true,
+	"banners": true, "billing-address": true, "brands": true, "capture": true,
+	"carts": true, "catalog": true, "categories": true,
+	"category-assignments": true, "channel-assignments": true,
+	"channel-menus": true, "channels": true, "checkouts": true, "codes": true,
+	"complex-rules": true, "consignments": true, "content": true, "count": true,
+	"countries": true, "coupons": true, "currencies": true,
+	"currency-assignments": true, "custom-fields": true,
+	"custom-template-associations": true, "customer_groups": true,
+	"customers": true, "customfields": true, "form-field-values": true,
+	"form-fields": true, "gift_certificates": true, "hooks": true,
+	"image": true, "images": true, "inventory": true, "items": true,
+	"listings": true, "locations": true, "messages": true, "metafields": true,
+	"methods": true, "modifiers": true, "option_sets": true, "options": true,
+	"orders": true, "payment_actions": true, "payments": true,
+	"placements": true, "pricelists": true, "products": true,
+	"promotions": true, "records": true, "redirect_urls": true,
+	"redirects": true, "refund_quotes": true, "refunds": true, "relative": true,
+	"scripts": true, "settings": true, "shipments": true, "shipping": true,
+	"shipping_addresses": true, "skus": true, "states": true, "store": true,
+	"storefront": true, "subscribers": true, "summary": true,
+	"systemlogs": true, "tax_classes": true, "taxes": true,
+	"transactions": true, "trees": true, "validate": true,
+	"validate-credentials": true, "values": true, "variants": true,
+	"void": true, "widget-templates": true, "widgets": true, "zones": true,
+}
+
+// isResourceName reports whether a path segment is the name of a resource,
+// e.g. "products" or "billing-address", rather than a value such as an ID, a
+// SKU or a coupon code
+func isResourceName(s string) bool {
+	return resourceNames[s]
+}
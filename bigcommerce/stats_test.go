@@ -0,0 +1,96 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientStats(t *testing.T) {
+	var slow []SlowCall
+	client, mux, teardown := setup()
+	defer teardown()
+	WithSlowCallReporting(20*time.Millisecond, func(call SlowCall) { slow = append(slow, call) })(client)
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/1/variants/2", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(30 * time.Millisecond)
+		w.Write([]byte(`{"data":{},"meta":{}}`))
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/3/variants/4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/stores/abc123/v2/products", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	client.Variants.Get(ctx, 1, 2)
+	client.Variants.Get(ctx, 3, 4)
+	client.Products.List(ctx, nil)
+
+	stats := client.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 endpoints, got %+v", stats)
+	}
+	variants := stats[0]
+	if variants.Endpoint != "GET v3/catalog/products/{id}/variants/{id}" || variants.Calls != 2 || variants.Errors != 1 || variants.ErrorRate != 0.5 {
+		t.Errorf("Unexpected stats %+v", variants)
+	}
+	if variants.P95 < 30*time.Millisecond {
+		t.Errorf("Expected P95 to include the slow call, got %v", variants.P95)
+	}
+	if stats[1].Endpoint != "GET v2/products" || stats[1].Errors != 0 {
+		t.Errorf("Unexpected stats %+v", stats[1])
+	}
+	if len(slow) != 1 || slow[0].StatusCode != 200 {
+		t.Errorf("Unexpected slow calls %+v", slow)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+	if p := percentile(sorted, 0.5); p != 50 {
+		t.Errorf("P50 = %v, want 50", p)
+	}
+	if p := percentile(sorted, 0.95); p != 95 {
+		t.Errorf("P95 = %v, want 95", p)
+	}
+	if p := percentile(nil, 0.95); p != 0 {
+		t.Errorf("P95 of no samples = %v, want 0", p)
+	}
+}
+
+func TestEndpointName(t *testing.T) {
+	tests := map[string]string{
+		"/stores/abc123/v3/catalog/products/12/variants/7":                                   "v3/catalog/products/{id}/variants/{id}",
+		"/stores/abc123/v3/checkouts/5d7ff2e5-2b0f-4a5e-8c44-2c1b7c4f3a10/coupons/SAVE%2010": "v3/checkouts/{id}/coupons/{id}",
+		"/stores/abc123/v3/carts/5d7ff2e5-2b0f-4a5e-8c44-2c1b7c4f3a10/items":                 "v3/carts/{id}/items",
+		"/stores/abc123/v2/orders/100/shipping_addresses":                                    "v2/orders/{id}/shipping_addresses",
+		"/stores/abc123/v3/checkouts/abc-1/coupons/summer-sale":                              "v3/checkouts/{id}/coupons/{id}",
+		"/stores/abc123/v3/checkouts/abc-1/billing-address/e1f1c0a4":                         "v3/checkouts/{id}/billing-address/{id}",
+	}
+	for path, want := range tests {
+		req, _ := http.NewRequest("GET", "https://api.bigcommerce.com"+path, nil)
+		if got := endpointName(req); got != "GET "+want {
+			t.Errorf("endpointName(%s) = %q, want %q", path, got, "GET "+want)
+		}
+	}
+}
+
+func TestStatsCollector_maxEndpoints(t *testing.T) {
+	s := newStatsCollector()
+	for i := 0; i < maxStatsEndpoints+10; i++ {
+		// Paths outside the API keep their first segment, so each is an endpoint
+		req, _ := http.NewRequest("GET", fmt.Sprintf("https://example.com/code-%c%c/1", 'a'+i%26, 'a'+i/26), nil)
+		s.record(req, &http.Response{StatusCode: 200}, time.Millisecond)
+	}
+	stats := s.snapshot()
+	if len(stats) != maxStatsEndpoints+1 || stats[0].Endpoint != "GET other" || stats[0].Calls != 10 {
+		t.Errorf("tracked %d endpoints, busiest %+v", len(stats), stats[0])
+	}
+}
@@ -0,0 +1,74 @@
+package bigcommerce
+
+import "context"
+
+// StoreService handles communication with the V2 store information endpoint
+type StoreService service
+
+// Store describes the BigCommerce V2 Store Information Object, including the
+// store's display settings for prices, weights, dimensions and dates
+type Store struct {
+	ID                      string        `json:"id,omitempty"`                        // The store hash.
+	Domain                  string        `json:"domain,omitempty"`                    // The primary domain name.
+	SecureURL               string        `json:"secure_url,omitempty"`                // The store's HTTPS URL.
+	Status                  string        `json:"status,omitempty"`                    // The status of the store, e.g. live or prelaunch.
+	Name                    string        `json:"name,omitempty"`                      // The store's name.
+	FirstName               string        `json:"first_name,omitempty"`                // Store owner's first name.
+	LastName                string        `json:"last_name,omitempty"`                 // Store owner's last name.
+	Address                 string        `json:"address,omitempty"`                   // The store's address, as a single multi-line string.
+	Country                 string        `json:"country,omitempty"`                   // The store's country.
+	CountryCode             string        `json:"country_code,omitempty"`              // The store's ISO country code.
+	Phone                   string        `json:"phone,omitempty"`                     // The store's phone number.
+	AdminEmail              string        `json:"admin_email,omitempty"`               // Email address of the store administrator.
+	OrderEmail              string        `json:"order_email,omitempty"`               // Email address order notifications are sent from.
+	Language                string        `json:"language,omitempty"`                  // Default language code, e.g. en.
+	Timezone                StoreTimezone `json:"timezone"`                            // The store's time zone and date formats.
+	Currency                string        `json:"currency,omitempty"`                  // Default currency code.
+	CurrencySymbol          string        `json:"currency_symbol,omitempty"`           // Symbol of the default currency.
+	CurrencySymbolLocation  string        `json:"currency_symbol_location,omitempty"`  // Where the symbol is placed: left or right.
+	DecimalSeparator        string        `json:"decimal_separator,omitempty"`         // Decimal separator used in prices.
+	ThousandsSeparator      string        `json:"thousands_separator,omitempty"`       // Thousands separator used in prices.
+	DecimalPlaces           int           `json:"decimal_places,omitempty"`            // Number of decimal places shown in prices.
+	WeightUnits             string        `json:"weight_units,omitempty"`              // One of LBS, Ounces, KGS, Grams or Tonnes.
+	DimensionUnits          string        `json:"dimension_units,omitempty"`           // One of Inches or Centimeters.
+	DimensionDecimalPlaces  int           `json:"dimension_decimal_places,omitempty"`  // Number of decimal places shown in weights and dimensions.
+	DimensionDecimalToken   string        `json:"dimension_decimal_token,omitempty"`   // Decimal separator used in weights and dimensions.
+	DimensionThousandsToken string        `json:"dimension_thousands_token,omitempty"` // Thousands separator used in weights and dimensions.
+	PlanName                string        `json:"plan_name,omitempty"`                 // The store's plan.
+	IsPriceEnteredWithTax   bool          `json:"is_price_entered_with_tax,omitempty"` // Whether catalog prices include tax.
+}
+
+// StoreTimezone describes the time zone and date formats of a store
+type StoreTimezone struct {
+	Name          string          `json:"name,omitempty"`       // IANA time zone name, e.g. Australia/Sydney.
+	RawOffset     int             `json:"raw_offset,omitempty"` // Offset from UTC in seconds, excluding daylight saving.
+	DSTOffset     int             `json:"dst_offset,omitempty"` // Offset from UTC in seconds during daylight saving.
+	DSTCorrection bool            `json:"dst_correction"`       // Whether daylight saving applies.
+	DateFormat    StoreDateFormat `json:"date_format"`          // Date formats, in PHP date() syntax.
+}
+
+// StoreDateFormat describes a store's date formats, in PHP date() syntax
+type StoreDateFormat struct {
+	Display         string `json:"display,omitempty"`          // Used for dates shown to shoppers, e.g. "jS M Y".
+	Export          string `json:"export,omitempty"`           // Used for exported dates, e.g. "M jS Y".
+	ExtendedDisplay string `json:"extended_display,omitempty"` // Used for dates with times, e.g. "M jS Y @ g:i A".
+}
+
+// Get returns the store's information and display settings
+func (s *StoreService) Get(ctx context.Context) (*Store, *Response, error) {
+	store := new(Store)
+	resp, err := s.client.call(ctx, "GET", "v2/store", nil, store)
+	if err != nil {
+		return nil, resp, err
+	}
+	return store, resp, nil
+}
+
+// Formatter returns a Formatter for the store's display settings
+func (s *StoreService) Formatter(ctx context.Context) (*Formatter, *Response, error) {
+	store, resp, err := s.Get(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return NewFormatter(store), resp, nil
+}
@@ -0,0 +1,152 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// StoreOptionService handles communication with the V2 store option endpoints.
+// Store options are shared across products through option sets; V3 catalogs use
+// per-product options instead, see ProductOptionService.
+type StoreOptionService service
+
+// StoreOption describes a BigCommerce V2 Option Object
+type StoreOption struct {
+	ID          int64           `json:"id,omitempty"`           // The unique numerical ID of the option.
+	Name        string          `json:"name,omitempty"`         // The unique name of the option, used in the control panel.
+	DisplayName string          `json:"display_name,omitempty"` // The name shown on the storefront.
+	Type        StoreOptionType `json:"type,omitempty"`         // The type of storefront input.
+	Values      *BCResource     `json:"values,omitempty"`       // The option's values resource. Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// StoreOptionValue describes a value of a V2 store option
+type StoreOptionValue struct {
+	ID        int64  `json:"id,omitempty"`         // The unique numerical ID of the value.
+	OptionID  int64  `json:"option_id,omitempty"`  // The ID of the option the value belongs to.
+	Label     string `json:"label,omitempty"`      // The value shown on the storefront.
+	SortOrder int64  `json:"sort_order"`           // Order in which the value is displayed.
+	Value     string `json:"value,omitempty"`      // The value's data, e.g. a color for swatches.
+	IsDefault bool   `json:"is_default,omitempty"` // Whether the value is selected by default.
+}
+
+// StoreOptionType - The type of storefront input used for a V2 option
+type StoreOptionType string
+
+const (
+	// CheckboxStoreOption - a checkbox
+	CheckboxStoreOption StoreOptionType = "C"
+	// DateStoreOption - a date picker
+	DateStoreOption StoreOptionType = "D"
+	// FileStoreOption - a file upload
+	FileStoreOption StoreOptionType = "F"
+	// NumbersOnlyStoreOption - a numeric text field
+	NumbersOnlyStoreOption StoreOptionType = "N"
+	// TextStoreOption - a single-line text field
+	TextStoreOption StoreOptionType = "T"
+	// MultiLineTextStoreOption - a multi-line text area
+	MultiLineTextStoreOption StoreOptionType = "MT"
+	// ProductListStoreOption - a list of other products
+	ProductListStoreOption StoreOptionType = "P"
+	// ProductListWithImagesStoreOption - a list of other products, with their images
+	ProductListWithImagesStoreOption StoreOptionType = "PI"
+	// RadioButtonsStoreOption - a set of radio buttons
+	RadioButtonsStoreOption StoreOptionType = "RB"
+	// RectanglesStoreOption - a set of rectangle buttons
+	RectanglesStoreOption StoreOptionType = "RT"
+	// SelectStoreOption - a drop-down list
+	SelectStoreOption StoreOptionType = "S"
+	// SwatchStoreOption - a set of color or pattern swatches
+	SwatchStoreOption StoreOptionType = "CS"
+)
+
+// List returns a page of store options
+func (s *StoreOptionService) List(ctx context.Context, opts *ListOptions) ([]*StoreOption, *Response, error) {
+	path, err := addOptions("v2/options", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var options []*StoreOption
+	resp, err := s.client.call(ctx, "GET", path, nil, &options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
+
+// Get returns a single store option
+func (s *StoreOptionService) Get(ctx context.Context, optionID int64) (*StoreOption, *Response, error) {
+	option := new(StoreOption)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/options/%d", optionID), nil, option)
+	if err != nil {
+		return nil, resp, err
+	}
+	return option, resp, nil
+}
+
+// Create adds a store option. Name and Type are required.
+func (s *StoreOptionService) Create(ctx context.Context, option *StoreOption) (*StoreOption, *Response, error) {
+	created := new(StoreOption)
+	resp, err := s.client.call(ctx, "POST", "v2/options", option, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a store option
+func (s *StoreOptionService) Update(ctx context.Context, optionID int64, option *StoreOption) (*StoreOption, *Response, error) {
+	updated := new(StoreOption)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/options/%d", optionID), option, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a store option
+func (s *StoreOptionService) Delete(ctx context.Context, optionID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/options/%d", optionID), nil, nil)
+}
+
+// ListValues returns the values of a store option
+func (s *StoreOptionService) ListValues(ctx context.Context, optionID int64, opts *ListOptions) ([]*StoreOptionValue, *Response, error) {
+	path, err := addOptions(fmt.Sprintf("v2/options/%d/values", optionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []*StoreOptionValue
+	resp, err := s.client.call(ctx, "GET", path, nil, &values)
+	if err != nil {
+		return nil, resp, err
+	}
+	return values, resp, nil
+}
+
+// CreateValue adds a value to a store option
+func (s *StoreOptionService) CreateValue(ctx context.Context, optionID int64, value *StoreOptionValue) (*StoreOptionValue, *Response, error) {
+	created := new(StoreOptionValue)
+	resp, err := s.client.call(ctx, "POST", fmt.Sprintf("v2/options/%d/values", optionID), value, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateValue modifies a value of a store option
+func (s *StoreOptionService) UpdateValue(ctx context.Context, optionID, valueID int64, value *StoreOptionValue) (*StoreOptionValue, *Response, error) {
+	updated := new(StoreOptionValue)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v2/options/%d/values/%d", optionID, valueID), value, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteValue removes a value from a store option
+func (s *StoreOptionService) DeleteValue(ctx context.Context, optionID, valueID int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v2/options/%d/values/%d", optionID, valueID), nil, nil)
+}
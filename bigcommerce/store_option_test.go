@@ -0,0 +1,50 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStoreOptionService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &StoreOption{Name: "Shirt Size", DisplayName: "Size", Type: RectanglesStoreOption}
+	mux.HandleFunc("/stores/abc123/v2/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(StoreOption), input)
+		fmt.Fprint(w, `{"id":3,"name":"Shirt Size","display_name":"Size","type":"RT","values":{"url":"","resource":"/options/3/values"}}`)
+	})
+
+	option, _, err := client.StoreOptions.Create(context.Background(), input)
+	if err != nil || option.ID != 3 || option.Type != RectanglesStoreOption {
+		t.Errorf("Unexpected option %+v, %v", option, err)
+	}
+}
+
+func TestStoreOptionService_values(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/options/3/values", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"id":70,"option_id":3,"label":"Small","sort_order":0,"value":"S","is_default":true}]`)
+		case "POST":
+			testBody(t, r, new(StoreOptionValue), &StoreOptionValue{Label: "Large", SortOrder: 1, Value: "L"})
+			fmt.Fprint(w, `{"id":71,"option_id":3,"label":"Large","sort_order":1,"value":"L"}`)
+		}
+	})
+
+	ctx := context.Background()
+	values, _, err := client.StoreOptions.ListValues(ctx, 3, nil)
+	if err != nil || len(values) != 1 || !values[0].IsDefault {
+		t.Errorf("Unexpected values %+v, %v", values, err)
+	}
+	value, _, err := client.StoreOptions.CreateValue(ctx, 3, &StoreOptionValue{Label: "Large", SortOrder: 1, Value: "L"})
+	if err != nil || value.ID != 71 {
+		t.Errorf("Unexpected value %+v, %v", value, err)
+	}
+}
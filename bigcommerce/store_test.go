@@ -0,0 +1,33 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStoreService_Formatter(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/store", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"abc123","name":"Le Magasin","currency":"EUR","currency_symbol":"€","currency_symbol_location":"right",
+			"decimal_separator":",","thousands_separator":".","decimal_places":2,"weight_units":"KGS","dimension_units":"Centimeters",
+			"dimension_decimal_places":1,"dimension_decimal_token":",","dimension_thousands_token":".",
+			"timezone":{"name":"Europe/Paris","raw_offset":3600,"dst_offset":7200,"dst_correction":true,
+			"date_format":{"display":"d/m/Y","export":"d/m/Y","extended_display":"d/m/Y H:i"}}}`)
+	})
+
+	f, _, err := client.Store.Formatter(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Money(1234.5), "1.234,50 €"; got != want {
+		t.Errorf("Money = %q, want %q", got, want)
+	}
+	if got, want := f.Weight(2.25), "2,3 kg"; got != want {
+		t.Errorf("Weight = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,185 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/graphql"
+)
+
+// StorefrontGraphQL executes queries and mutations against a storefront's
+// GraphQL Storefront API, authenticated with a token from
+// StorefrontTokenService
+type StorefrontGraphQL struct {
+	Endpoint   string       // The storefront's GraphQL URL, e.g. "https://example.com/graphql".
+	Token      string       // A storefront or customer impersonation token.
+	CustomerID int64        // With an impersonation token, the customer to act as.
+	HTTPClient *http.Client // http.DefaultClient if nil.
+
+	// TokenSource supplies the token of each request instead of Token, e.g.
+	// a StorefrontTokenManager's Source.
+	TokenSource StorefrontTokenSource
+}
+
+// NewStorefrontGraphQL returns a client for the GraphQL endpoint of a
+// storefront, e.g. "https://store-abc123.mybigcommerce.com"
+func NewStorefrontGraphQL(storeURL, token string) *StorefrontGraphQL {
+	return &StorefrontGraphQL{Endpoint: strings.TrimRight(storeURL, "/") + "/graphql", Token: token}
+}
+
+// Do executes a query with its variables and decodes the response's data into
+// v. GraphQL errors are returned as graphql.Errors after decoding whatever
+// data came with them; HTTP errors as *ErrorResponse. The response is returned
+// whenever it could be decoded, so its extensions can be inspected.
+func (c *StorefrontGraphQL) Do(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*graphql.Response, error) {
+	body, err := json.Marshal(struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{query, variables})
+	if err != nil {
+		return nil, err
+	}
+	token := c.Token
+	if c.TokenSource != nil {
+		if token, err = c.TokenSource.StorefrontToken(ctx); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	if c.CustomerID != 0 {
+		req.Header.Set("X-Bc-Customer-Id", strconv.FormatInt(c.CustomerID, 10))
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return graphql.DecodeResponse(data, v)
+}
+
+// Execute runs an operation built with the graphql package, or generated by
+// cmd/bcgen, decoding its data into v
+func (c *StorefrontGraphQL) Execute(ctx context.Context, op *graphql.Operation, v interface{}) (*graphql.Response, error) {
+	return c.Do(ctx, op.Query(), op.Variables(), v)
+}
+
+// StorefrontMoney is an amount in the GraphQL Storefront API
+type StorefrontMoney struct {
+	Value        float64 `json:"value"`
+	CurrencyCode string  `json:"currencyCode"`
+}
+
+// StorefrontProduct is the subset of a GraphQL Storefront API product
+// returned by ProductByID
+type StorefrontProduct struct {
+	EntityID int64  `json:"entityId"`
+	Name     string `json:"name"`
+	SKU      string `json:"sku"`
+	Path     string `json:"path"`
+	Prices   *struct {
+		Price     *StorefrontMoney `json:"price"`
+		SalePrice *StorefrontMoney `json:"salePrice"` // Nil unless the product is on sale.
+	} `json:"prices"` // The prices seen by the token's customer, if any.
+}
+
+// ProductByID returns a product as the storefront sees it, or nil if it is
+// not visible on the token's channel
+func (c *StorefrontGraphQL) ProductByID(ctx context.Context, id int64) (*StorefrontProduct, error) {
+	op, root := graphql.NewQuery()
+	root.Object("site", nil, func(site *graphql.Selection) {
+		site.Object("product", []graphql.Arg{{Name: "entityId", Type: "Int!", Value: id}}, func(p *graphql.Selection) {
+			p.Field("entityId")
+			p.Field("name")
+			p.Field("sku")
+			p.Field("path")
+			p.Object("prices", nil, func(prices *graphql.Selection) {
+				for _, name := range []string{"price", "salePrice"} {
+					prices.Object(name, nil, func(m *graphql.Selection) {
+						m.Field("value")
+						m.Field("currencyCode")
+					})
+				}
+			})
+		})
+	})
+	var data struct {
+		Site struct {
+			Product *StorefrontProduct `json:"product"`
+		} `json:"site"`
+	}
+	if _, err := c.Execute(ctx, op, &data); err != nil {
+		return nil, err
+	}
+	return data.Site.Product, nil
+}
+
+// StorefrontLineItem is an item added to a cart with CreateCart
+type StorefrontLineItem struct {
+	ProductEntityID int64 `json:"productEntityId"`
+	VariantEntityID int64 `json:"variantEntityId,omitempty"`
+	Quantity        int64 `json:"quantity"`
+}
+
+// StorefrontCart is the subset of a GraphQL Storefront API cart returned by
+// CreateCart
+type StorefrontCart struct {
+	EntityID     string           `json:"entityId"` // The cart's UUID, usable with CartService.
+	CurrencyCode string           `json:"currencyCode"`
+	Amount       *StorefrontMoney `json:"amount"`
+}
+
+// CreateCart creates a cart holding the items, for the token's customer if
+// CustomerID is set
+func (c *StorefrontGraphQL) CreateCart(ctx context.Context, items []*StorefrontLineItem) (*StorefrontCart, error) {
+	input := map[string]interface{}{"lineItems": items}
+	op, root := graphql.NewMutation()
+	root.Object("cart", nil, func(cart *graphql.Selection) {
+		cart.Object("createCart", []graphql.Arg{{Name: "input", Type: "CreateCartInput!", Value: input}}, func(result *graphql.Selection) {
+			result.Object("cart", nil, func(c *graphql.Selection) {
+				c.Field("entityId")
+				c.Field("currencyCode")
+				c.Object("amount", nil, func(m *graphql.Selection) {
+					m.Field("value")
+					m.Field("currencyCode")
+				})
+			})
+		})
+	})
+	var data struct {
+		Cart struct {
+			CreateCart struct {
+				Cart *StorefrontCart `json:"cart"`
+			} `json:"createCart"`
+		} `json:"cart"`
+	}
+	if _, err := c.Execute(ctx, op, &data); err != nil {
+		return nil, err
+	}
+	return data.Cart.CreateCart.Cart, nil
+}
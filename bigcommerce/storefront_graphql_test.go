@@ -0,0 +1,97 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/micahthomas/bigcommerce-go-client/bigcommerce/graphql"
+)
+
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+func storefrontSetup(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, req *graphqlRequest)) (*StorefrontGraphQL, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if r.URL.Path != "/graphql" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer sf-token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		req := new(graphqlRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		handler(w, r, req)
+	}))
+	return NewStorefrontGraphQL(server.URL+"/", "sf-token"), server.Close
+}
+
+func TestStorefrontGraphQL_ProductByID(t *testing.T) {
+	client, teardown := storefrontSetup(t, func(w http.ResponseWriter, r *http.Request, req *graphqlRequest) {
+		if !strings.HasPrefix(req.Query, "query($v1: Int!) {site {product(entityId: $v1)") || req.Variables["v1"] != 77.0 {
+			t.Errorf("request = %+v", req)
+		}
+		fmt.Fprint(w, `{"data":{"site":{"product":{"entityId":77,"name":"Shirt","prices":{"price":{"value":20,"currencyCode":"USD"},"salePrice":null}}}}}`)
+	})
+	defer teardown()
+
+	product, err := client.ProductByID(context.Background(), 77)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.EntityID != 77 || product.Prices.Price.Value != 20 || product.Prices.SalePrice != nil {
+		t.Errorf("ProductByID returned %+v", product)
+	}
+}
+
+func TestStorefrontGraphQL_CreateCart(t *testing.T) {
+	client, teardown := storefrontSetup(t, func(w http.ResponseWriter, r *http.Request, req *graphqlRequest) {
+		if got := r.Header.Get("X-Bc-Customer-Id"); got != "7" {
+			t.Errorf("X-Bc-Customer-Id = %q", got)
+		}
+		input, _ := json.Marshal(req.Variables["v1"])
+		if string(input) != `{"lineItems":[{"productEntityId":77,"quantity":2}]}` {
+			t.Errorf("input = %s", input)
+		}
+		fmt.Fprint(w, `{"data":{"cart":{"createCart":{"cart":{"entityId":"abc-1","currencyCode":"USD","amount":{"value":40,"currencyCode":"USD"}}}}}}`)
+	})
+	defer teardown()
+
+	client.CustomerID = 7
+	cart, err := client.CreateCart(context.Background(), []*StorefrontLineItem{{ProductEntityID: 77, Quantity: 2}})
+	if err != nil || cart.EntityID != "abc-1" || cart.Amount.Value != 40 {
+		t.Errorf("CreateCart returned %+v, %v", cart, err)
+	}
+}
+
+func TestStorefrontGraphQL_Do_errors(t *testing.T) {
+	client, teardown := storefrontSetup(t, func(w http.ResponseWriter, r *http.Request, req *graphqlRequest) {
+		fmt.Fprint(w, `{"data":null,"errors":[{"message":"Invalid query"}],"extensions":{"cost":1}}`)
+	})
+	defer teardown()
+
+	resp, err := client.Do(context.Background(), "query { nope }", nil, new(struct{}))
+	var gqlErrs graphql.Errors
+	if !errors.As(err, &gqlErrs) || resp == nil || string(resp.Extensions["cost"]) != "1" {
+		t.Errorf("Do returned %+v, %v", resp, err)
+	}
+}
+
+func TestStorefrontGraphQL_Do_httpError(t *testing.T) {
+	client, teardown := storefrontSetup(t, func(w http.ResponseWriter, r *http.Request, req *graphqlRequest) {
+		http.Error(w, `{"status":401,"title":"Unauthorized"}`, http.StatusUnauthorized)
+	})
+	defer teardown()
+
+	if _, err := client.Do(context.Background(), "query { site { settings { storeName } } }", nil, new(struct{})); Classify(err).Category != AuthError {
+		t.Errorf("Do returned %v, want an auth error", err)
+	}
+}
@@ -0,0 +1,57 @@
+package bigcommerce
+
+import (
+	"context"
+	"time"
+)
+
+// StorefrontTokenService handles communication with the V3 endpoints minting
+// tokens for the GraphQL Storefront API
+type StorefrontTokenService service
+
+// StorefrontTokenRequest describes the token to create
+type StorefrontTokenRequest struct {
+	ChannelID          int64    `json:"channel_id"`                     // The channel the token is valid for.
+	ExpiresAt          int64    `json:"expires_at"`                     // Unix timestamp after which the token is rejected.
+	AllowedCORSOrigins []string `json:"allowed_cors_origins,omitempty"` // Origins allowed to use the token from browsers, at most 2.
+}
+
+// NewStorefrontTokenRequest returns a request for a token valid on a channel
+// until now plus ttl
+func NewStorefrontTokenRequest(channelID int64, ttl time.Duration) *StorefrontTokenRequest {
+	return &StorefrontTokenRequest{ChannelID: channelID, ExpiresAt: time.Now().Add(ttl).Unix()}
+}
+
+// CreateToken returns a token for browser-side GraphQL Storefront API requests
+func (s *StorefrontTokenService) CreateToken(ctx context.Context, req *StorefrontTokenRequest) (string, *Response, error) {
+	return s.create(ctx, "v3/storefront/api-token", req)
+}
+
+// CreateImpersonationToken returns a customer impersonation token, for
+// server-side GraphQL Storefront API requests made on behalf of any customer
+// by sending their ID in the X-Bc-Customer-Id header. These tokens must be
+// kept secret; CORS origins are not allowed.
+func (s *StorefrontTokenService) CreateImpersonationToken(ctx context.Context, req *StorefrontTokenRequest) (string, *Response, error) {
+	return s.create(ctx, "v3/storefront/api-token-customer-impersonation", req)
+}
+
+func (s *StorefrontTokenService) create(ctx context.Context, path string, req *StorefrontTokenRequest) (string, *Response, error) {
+	var token struct {
+		Token string `json:"token"`
+	}
+	resp, err := s.client.call(ctx, "POST", path, req, &token)
+	if err != nil {
+		return "", resp, err
+	}
+	return token.Token, resp, nil
+}
+
+// RevokeToken revokes a storefront token before it expires
+func (s *StorefrontTokenService) RevokeToken(ctx context.Context, token string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, "DELETE", "v3/storefront/api-token", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Sf-Api-Token", token)
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,181 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Defaults of StorefrontTokenManager
+const (
+	DefaultStorefrontTokenTTL    = 24 * time.Hour
+	DefaultStorefrontTokenRotate = time.Hour
+)
+
+// StorefrontTokenSource supplies the token of StorefrontGraphQL requests
+type StorefrontTokenSource interface {
+	// StorefrontToken returns a token valid for at least the duration of a request.
+	StorefrontToken(ctx context.Context) (string, error)
+}
+
+// StorefrontTokenManager mints storefront tokens per channel and replaces
+// them ahead of their expiry, so headless backends never serve an expired
+// token. It is safe for concurrent use.
+type StorefrontTokenManager struct {
+	Client *Client
+
+	TTL                time.Duration // Lifetime of minted tokens, DefaultStorefrontTokenTTL if zero.
+	RotateBefore       time.Duration // How long before expiry a token is replaced, DefaultStorefrontTokenRotate if zero. Must be shorter than TTL.
+	AllowedCORSOrigins []string      // Origins allowed to use the tokens from browsers.
+	Impersonation      bool          // Mint customer impersonation tokens, for server-side use only.
+
+	mu      sync.Mutex
+	tokens  map[int64]*storefrontToken
+	minting map[int64]*mintCall
+	now     func() time.Time
+}
+
+type storefrontToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+// mintCall is a token being minted, shared by the callers asking for it
+type mintCall struct {
+	done  chan struct{}
+	token *storefrontToken
+	err   error
+}
+
+// NewStorefrontTokenManager returns a manager minting tokens with client
+func NewStorefrontTokenManager(client *Client) *StorefrontTokenManager {
+	return &StorefrontTokenManager{Client: client, tokens: map[int64]*storefrontToken{}, now: time.Now}
+}
+
+// Token returns the current token of a channel, minting a new one if there is
+// none or it expires within RotateBefore. Replaced tokens are not revoked, so
+// requests already using them complete. Concurrent calls for a channel share
+// one mint, which is made again for the others if the caller making it gives
+// up, and mints for different channels run in parallel.
+func (m *StorefrontTokenManager) Token(ctx context.Context, channelID int64) (string, error) {
+	rotate := m.RotateBefore
+	if rotate == 0 {
+		rotate = DefaultStorefrontTokenRotate
+	}
+	ttl := m.TTL
+	if ttl == 0 {
+		ttl = DefaultStorefrontTokenTTL
+	}
+	if rotate >= ttl {
+		return "", fmt.Errorf("bigcommerce: storefront tokens rotated %v before expiry must live longer than %v", rotate, ttl)
+	}
+
+	for {
+		m.mu.Lock()
+		now := m.clock()
+		if t, ok := m.tokens[channelID]; ok && now.Add(rotate).Before(t.expiresAt) {
+			m.mu.Unlock()
+			return t.value, nil
+		}
+		if call, ok := m.minting[channelID]; ok {
+			m.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			switch {
+			case call.err == nil:
+				return call.token.value, nil
+			case ctx.Err() == nil && isContextErr(call.err):
+				// The caller minting gave up; mint for this one
+				continue
+			default:
+				return "", call.err
+			}
+		}
+		call := &mintCall{done: make(chan struct{})}
+		if m.minting == nil {
+			m.minting = map[int64]*mintCall{}
+		}
+		m.minting[channelID] = call
+		m.mu.Unlock()
+
+		call.token, call.err = m.mint(ctx, channelID, now.Add(ttl))
+
+		m.mu.Lock()
+		delete(m.minting, channelID)
+		if call.err == nil {
+			if m.tokens == nil {
+				m.tokens = map[int64]*storefrontToken{}
+			}
+			m.tokens[channelID] = call.token
+		}
+		m.mu.Unlock()
+		close(call.done)
+
+		if call.err != nil {
+			return "", call.err
+		}
+		return call.token.value, nil
+	}
+}
+
+func (m *StorefrontTokenManager) mint(ctx context.Context, channelID int64, expiresAt time.Time) (*storefrontToken, error) {
+	req := &StorefrontTokenRequest{ChannelID: channelID, ExpiresAt: expiresAt.Unix()}
+	var (
+		value string
+		err   error
+	)
+	if m.Impersonation {
+		value, _, err = m.Client.StorefrontTokens.CreateImpersonationToken(ctx, req)
+	} else {
+		req.AllowedCORSOrigins = m.AllowedCORSOrigins
+		value, _, err = m.Client.StorefrontTokens.CreateToken(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &storefrontToken{value: value, expiresAt: expiresAt}, nil
+}
+
+func (m *StorefrontTokenManager) clock() time.Time {
+	if m.now == nil {
+		return time.Now()
+	}
+	return m.now()
+}
+
+// Expiry returns when the current token of a channel expires, or the zero
+// time if none was minted
+func (m *StorefrontTokenManager) Expiry(channelID int64) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t, ok := m.tokens[channelID]; ok {
+		return t.expiresAt
+	}
+	return time.Time{}
+}
+
+// Invalidate forgets the token of a channel, e.g. after it was revoked, so the
+// next call to Token mints a new one
+func (m *StorefrontTokenManager) Invalidate(channelID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tokens, channelID)
+}
+
+// Source returns a StorefrontTokenSource for the tokens of a channel
+func (m *StorefrontTokenManager) Source(channelID int64) StorefrontTokenSource {
+	return channelTokenSource{m, channelID}
+}
+
+type channelTokenSource struct {
+	m         *StorefrontTokenManager
+	channelID int64
+}
+
+func (s channelTokenSource) StorefrontToken(ctx context.Context) (string, error) {
+	return s.m.Token(ctx, s.channelID)
+}
@@ -0,0 +1,158 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStorefrontTokenManager_Token(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var minted []*StorefrontTokenRequest
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token", func(w http.ResponseWriter, r *http.Request) {
+		req := new(StorefrontTokenRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		minted = append(minted, req)
+		fmt.Fprintf(w, `{"data":{"token":"token-%d"},"meta":{}}`, len(minted))
+	})
+
+	now := time.Unix(1000000, 0)
+	m := NewStorefrontTokenManager(client)
+	m.now = func() time.Time { return now }
+	m.TTL, m.RotateBefore = 2*time.Hour, 30*time.Minute
+	m.AllowedCORSOrigins = []string{"https://example.com"}
+	ctx := context.Background()
+
+	for i, want := range []struct {
+		advance time.Duration
+		channel int64
+		token   string
+	}{
+		{0, 1, "token-1"},
+		{time.Hour, 1, "token-1"},
+		{0, 2, "token-2"},
+		{31 * time.Minute, 1, "token-3"}, // Within RotateBefore of the expiry.
+		{0, 1, "token-3"},
+	} {
+		now = now.Add(want.advance)
+		if got, err := m.Token(ctx, want.channel); err != nil || got != want.token {
+			t.Errorf("%d: Token(%d) = %q, %v, want %q", i, want.channel, got, err, want.token)
+		}
+	}
+	if len(minted) != 3 || minted[0].ExpiresAt != 1000000+7200 || minted[1].ChannelID != 2 || len(minted[2].AllowedCORSOrigins) != 1 {
+		t.Errorf("minted %+v", minted)
+	}
+	if got := m.Expiry(1); !got.Equal(now.Add(2 * time.Hour)) {
+		t.Errorf("Expiry = %v", got)
+	}
+
+	m.Invalidate(1)
+	if got, _ := m.Token(ctx, 1); got != "token-4" {
+		t.Errorf("Token after Invalidate = %q", got)
+	}
+}
+
+func TestStorefrontTokenManager_Source(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token-customer-impersonation", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"token":"impersonation-token"},"meta":{}}`)
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer impersonation-token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		fmt.Fprint(w, `{"data":{}}`)
+	}))
+	defer server.Close()
+
+	m := NewStorefrontTokenManager(client)
+	m.Impersonation = true
+	gql := &StorefrontGraphQL{Endpoint: server.URL, TokenSource: m.Source(1)}
+	if _, err := gql.Do(context.Background(), "query { site { settings { storeName } } }", nil, new(struct{})); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStorefrontTokenManager_zeroValue(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mints int32
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&mints, 1)
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"data":{"token":"token"},"meta":{}}`)
+	})
+
+	m := &StorefrontTokenManager{Client: client}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := m.Token(context.Background(), 1); err != nil || got != "token" {
+				t.Errorf("Token = %q, %v", got, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if mints != 1 {
+		t.Errorf("concurrent calls minted %d tokens, want 1", mints)
+	}
+
+	m.RotateBefore = DefaultStorefrontTokenTTL
+	if _, err := m.Token(context.Background(), 1); err == nil {
+		t.Error("Token with RotateBefore not shorter than TTL returned no error")
+	}
+}
+
+func TestStorefrontTokenManager_canceledMint(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		fmt.Fprint(w, `{"data":{"token":"token"},"meta":{}}`)
+	})
+
+	m := &StorefrontTokenManager{Client: client}
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, err := m.Token(leaderCtx, 1)
+		leaderDone <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	waiterDone := make(chan string)
+	go func() {
+		got, err := m.Token(context.Background(), 1)
+		if err != nil {
+			t.Errorf("waiting Token returned %v", err)
+		}
+		waiterDone <- got
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-leaderDone; err == nil {
+		t.Error("canceled Token returned no error")
+	}
+	close(release)
+	if got := <-waiterDone; got != "token" {
+		t.Errorf("waiting Token = %q, want a token minted for it", got)
+	}
+}
@@ -0,0 +1,62 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStorefrontTokenService_CreateToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(StorefrontTokenRequest), &StorefrontTokenRequest{ChannelID: 1, ExpiresAt: 1885635176, AllowedCORSOrigins: []string{"https://example.com"}})
+		fmt.Fprint(w, `{"data":{"token":"sf-token"},"meta":{}}`)
+	})
+
+	req := &StorefrontTokenRequest{ChannelID: 1, ExpiresAt: 1885635176, AllowedCORSOrigins: []string{"https://example.com"}}
+	token, _, err := client.StorefrontTokens.CreateToken(context.Background(), req)
+	if err != nil || token != "sf-token" {
+		t.Errorf("CreateToken returned %q, %v", token, err)
+	}
+}
+
+func TestStorefrontTokenService_CreateImpersonationToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token-customer-impersonation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":{"token":"impersonation-token"},"meta":{}}`)
+	})
+
+	req := NewStorefrontTokenRequest(2, time.Hour)
+	if d := time.Until(time.Unix(req.ExpiresAt, 0)); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("ExpiresAt is %v from now", d)
+	}
+	token, _, err := client.StorefrontTokens.CreateImpersonationToken(context.Background(), req)
+	if err != nil || token != "impersonation-token" {
+		t.Errorf("CreateImpersonationToken returned %q, %v", token, err)
+	}
+}
+
+func TestStorefrontTokenService_RevokeToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/storefront/api-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got := r.Header.Get("Sf-Api-Token"); got != "sf-token" {
+			t.Errorf("Sf-Api-Token = %q", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.StorefrontTokens.RevokeToken(context.Background(), "sf-token"); err != nil {
+		t.Errorf("RevokeToken returned error: %v", err)
+	}
+}
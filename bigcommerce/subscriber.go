@@ -0,0 +1,73 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriberService handles communication with the V3 newsletter subscriber endpoints
+type SubscriberService service
+
+// Subscriber describes a BigCommerce V3 Subscriber Object, a shopper signed up
+// to the store's newsletter
+type Subscriber struct {
+	ID           int64  `json:"id,omitempty"`            // The unique numerical ID of the subscriber.
+	Email        string `json:"email"`                   // The subscriber's email address.
+	FirstName    string `json:"first_name,omitempty"`    // First name of the subscriber.
+	LastName     string `json:"last_name,omitempty"`     // Last name of the subscriber.
+	Source       string `json:"source,omitempty"`        // Where the subscriber signed up, e.g. storefront or checkout.
+	OrderID      int64  `json:"order_id,omitempty"`      // The order placed when subscribing at checkout.
+	ChannelID    int64  `json:"channel_id,omitempty"`    // The channel the subscriber signed up on.
+	DateCreated  string `json:"date_created,omitempty"`  // Date the subscriber was created. Read-only.
+	DateModified string `json:"date_modified,omitempty"` // Date the subscriber was last modified. Read-only.
+}
+
+// SubscriberListOptions specifies the optional parameters to SubscriberService.List
+type SubscriberListOptions struct {
+	ListOptions
+	Email     string  `url:"email,omitempty"`
+	FirstName string  `url:"first_name,omitempty"`
+	LastName  string  `url:"last_name,omitempty"`
+	Source    string  `url:"source,omitempty"`
+	IDs       []int64 `url:"id:in,omitempty"`
+}
+
+// List returns a page of subscribers
+func (s *SubscriberService) List(ctx context.Context, opts *SubscriberListOptions) ([]*Subscriber, *Response, error) {
+	path, err := addOptions("v3/customers/subscribers", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []*Subscriber
+	resp, err := s.client.call(ctx, "GET", path, nil, &subscribers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return subscribers, resp, nil
+}
+
+// Create adds a subscriber
+func (s *SubscriberService) Create(ctx context.Context, subscriber *Subscriber) (*Subscriber, *Response, error) {
+	created := new(Subscriber)
+	resp, err := s.client.call(ctx, "POST", "v3/customers/subscribers", subscriber, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a subscriber
+func (s *SubscriberService) Update(ctx context.Context, id int64, subscriber *Subscriber) (*Subscriber, *Response, error) {
+	updated := new(Subscriber)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/customers/subscribers/%d", id), subscriber, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a subscriber
+func (s *SubscriberService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/customers/subscribers/%d", id), nil, nil)
+}
@@ -0,0 +1,45 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSubscriberService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"email": "jane@example.com"})
+		fmt.Fprint(w, `{"data":[{"id":3,"email":"jane@example.com","source":"checkout","order_id":100}],"meta":{}}`)
+	})
+
+	subscribers, _, err := client.Subscribers.List(context.Background(), &SubscriberListOptions{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Subscriber{{ID: 3, Email: "jane@example.com", Source: "checkout", OrderID: 100}}
+	if !reflect.DeepEqual(subscribers, want) {
+		t.Errorf("List = %+v, want %+v", subscribers, want)
+	}
+}
+
+func TestSubscriberService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/customers/subscribers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Subscriber), &Subscriber{Email: "new@example.com"})
+		fmt.Fprint(w, `{"data":{"id":3,"email":"new@example.com"},"meta":{}}`)
+	})
+
+	subscriber, _, err := client.Subscribers.Update(context.Background(), 3, &Subscriber{Email: "new@example.com"})
+	if err != nil || subscriber.Email != "new@example.com" {
+		t.Errorf("Update = %+v, %v", subscriber, err)
+	}
+}
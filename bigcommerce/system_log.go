@@ -0,0 +1,62 @@
+package bigcommerce
+
+import (
+	"context"
+	"time"
+)
+
+// SystemLogService handles communication with the V3 store system log endpoint
+type SystemLogService service
+
+// SystemLog describes a BigCommerce V3 System Log Object, an entry of the
+// store's log of configuration changes and background errors
+type SystemLog struct {
+	ID          int64             `json:"id"`
+	Type        string            `json:"type"`   // e.g. general, payment, shipping, tax, notification, emails, ordersettings or design.
+	Module      string            `json:"module"` // The part of the store that logged the entry.
+	Severity    SystemLogSeverity `json:"severity"`
+	Summary     string            `json:"summary"`
+	Message     string            `json:"message"`      // The details, possibly HTML.
+	DateCreated string            `json:"date_created"` // RFC 3339.
+}
+
+// SystemLogSeverity - How serious a system log entry is
+type SystemLogSeverity int
+
+const (
+	// SuccessLog - an operation succeeded
+	SuccessLog SystemLogSeverity = 1
+	// NoticeLog - information, such as a setting changed
+	NoticeLog SystemLogSeverity = 2
+	// WarningLog - something may need attention
+	WarningLog SystemLogSeverity = 3
+	// ErrorLog - an operation failed
+	ErrorLog SystemLogSeverity = 4
+)
+
+// SystemLogListOptions specifies the optional parameters to SystemLogService.List
+type SystemLogListOptions struct {
+	ListOptions
+	Type           string            `url:"type,omitempty"`
+	Module         string            `url:"module,omitempty"`
+	MinSeverity    SystemLogSeverity `url:"severity:min,omitempty"`
+	MinDateCreated time.Time         `url:"date_created:min,omitempty"`
+	MaxDateCreated time.Time         `url:"date_created:max,omitempty"`
+	Sort           string            `url:"sort,omitempty"`      // e.g. "date_created".
+	Direction      string            `url:"direction,omitempty"` // "asc" or "desc".
+}
+
+// List returns a page of system log entries
+func (s *SystemLogService) List(ctx context.Context, opts *SystemLogListOptions) ([]*SystemLog, *Response, error) {
+	path, err := addOptions("v3/store/systemlogs", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var logs []*SystemLog
+	resp, err := s.client.call(ctx, "GET", path, nil, &logs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return logs, resp, nil
+}
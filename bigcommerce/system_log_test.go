@@ -0,0 +1,34 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSystemLogService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/store/systemlogs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"type": "payment", "severity:min": "3", "date_created:min": "2021-05-01T00:00:00Z"})
+		fmt.Fprint(w, `{"data":[{"id":4,"type":"payment","module":"stripe","severity":4,"summary":"Payment failed","message":"Card declined","date_created":"2021-05-02T10:00:00Z"}],"meta":{}}`)
+	})
+
+	logs, _, err := client.SystemLogs.List(context.Background(), &SystemLogListOptions{
+		Type:           "payment",
+		MinSeverity:    WarningLog,
+		MinDateCreated: time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	want := []*SystemLog{{ID: 4, Type: "payment", Module: "stripe", Severity: ErrorLog, Summary: "Payment failed", Message: "Card declined", DateCreated: "2021-05-02T10:00:00Z"}}
+	if !reflect.DeepEqual(logs, want) {
+		t.Errorf("List returned %+v, want %+v", logs, want)
+	}
+}
@@ -0,0 +1,53 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// TaxClassService handles communication with the V2 tax class endpoints
+type TaxClassService service
+
+// TaxClass describes a BigCommerce V2 Tax Class Object. Products are assigned
+// a tax class to be taxed at the rates configured for it.
+type TaxClass struct {
+	ID        int64  `json:"id,omitempty"`         // The unique numerical ID of the tax class.
+	Name      string `json:"name"`                 // The name of the tax class.
+	CreatedAt string `json:"created_at,omitempty"` // Read-only.
+	UpdatedAt string `json:"updated_at,omitempty"` // Read-only.
+}
+
+// List returns a page of tax classes
+func (s *TaxClassService) List(ctx context.Context, opts *ListOptions) ([]*TaxClass, *Response, error) {
+	path, err := addOptions("v2/tax_classes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var classes []*TaxClass
+	resp, err := s.client.call(ctx, "GET", path, nil, &classes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return classes, resp, nil
+}
+
+// Get returns a single tax class
+func (s *TaxClassService) Get(ctx context.Context, id int64) (*TaxClass, *Response, error) {
+	class := new(TaxClass)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v2/tax_classes/%d", id), nil, class)
+	if err != nil {
+		return nil, resp, err
+	}
+	return class, resp, nil
+}
+
+// Create adds a tax class
+func (s *TaxClassService) Create(ctx context.Context, class *TaxClass) (*TaxClass, *Response, error) {
+	created := new(TaxClass)
+	resp, err := s.client.call(ctx, "POST", "v2/tax_classes", class, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
@@ -0,0 +1,44 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTaxClassService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/tax_classes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"Default Tax Class","created_at":"2021-01-01T00:00:00+00:00"}]`)
+	})
+
+	classes, _, err := client.TaxClasses.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*TaxClass{{ID: 1, Name: "Default Tax Class", CreatedAt: "2021-01-01T00:00:00+00:00"}}
+	if !reflect.DeepEqual(classes, want) {
+		t.Errorf("List = %+v, want %+v", classes, want)
+	}
+}
+
+func TestTaxClassService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/tax_classes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(TaxClass), &TaxClass{Name: "Clothing"})
+		fmt.Fprint(w, `{"id":2,"name":"Clothing"}`)
+	})
+
+	class, _, err := client.TaxClasses.Create(context.Background(), &TaxClass{Name: "Clothing"})
+	if err != nil || class.ID != 2 {
+		t.Errorf("Create = %+v, %v", class, err)
+	}
+}
@@ -0,0 +1,81 @@
+package bigcommerce
+
+import "strconv"
+
+// Totals are the money totals of an order, a cart or a checkout in one shape,
+// so financial code can handle them alike. V2 orders give amounts as decimal
+// strings and V3 carts and checkouts as numbers; Totals holds numbers in the
+// currency of Currency.
+type Totals struct {
+	Currency    string  // ISO 4217 code, e.g. USD. Empty if the source did not say.
+	Subtotal    float64 // The items, before discounts and excluding tax unless TaxIncluded.
+	Discount    float64 // Discounts and coupons, as a positive amount.
+	Shipping    float64 // Shipping, excluding tax.
+	Handling    float64 // Handling and gift wrapping, excluding tax.
+	Tax         float64 // Tax on everything; zero for carts, whose tax is known from checkout on.
+	GrandTotal  float64 // The amount paid or to pay, including tax.
+	Refunded    float64 // The amount refunded; only orders have refunds.
+	TaxIncluded bool    // Whether Subtotal includes tax, as it may for carts.
+}
+
+// Net returns the grand total less refunds
+func (t *Totals) Net() float64 {
+	return t.GrandTotal - t.Refunded
+}
+
+// Totals returns the totals of the order
+func (o *Order) Totals() *Totals {
+	return &Totals{
+		Currency:   o.CurrencyCode,
+		Subtotal:   parseAmount(o.SubtotalExTax),
+		Discount:   parseAmount(o.DiscountAmount) + parseAmount(o.CouponDiscount),
+		Shipping:   parseAmount(o.ShippingCostExTax),
+		Handling:   parseAmount(o.HandlingCostExTax) + parseAmount(o.WrappingCostExTax),
+		Tax:        parseAmount(o.TotalTax),
+		GrandTotal: parseAmount(o.TotalIncTax),
+		Refunded:   parseAmount(o.RefundedAmount),
+	}
+}
+
+// Totals returns the totals of the cart. Carts have no shipping or tax yet;
+// read the totals of their checkout for those.
+func (c *Cart) Totals() *Totals {
+	t := &Totals{
+		Subtotal:    c.BaseAmount,
+		Discount:    c.DiscountAmount,
+		GrandTotal:  c.CartAmount,
+		TaxIncluded: c.TaxIncluded,
+	}
+	if c.Currency != nil {
+		t.Currency = c.Currency.Code
+	}
+	return t
+}
+
+// Totals returns the totals of the checkout, including coupon discounts
+func (c *Checkout) Totals() *Totals {
+	t := &Totals{
+		Subtotal:   c.SubtotalExTax,
+		Shipping:   c.ShippingCostTotalExTax,
+		Handling:   c.HandlingCostTotalExTax,
+		Tax:        c.TaxTotal,
+		GrandTotal: c.GrandTotal,
+	}
+	if c.Cart != nil {
+		t.Discount = c.Cart.DiscountAmount
+		if c.Cart.Currency != nil {
+			t.Currency = c.Cart.Currency.Code
+		}
+	}
+	for _, coupon := range c.Coupons {
+		t.Discount += coupon.DiscountedAmount
+	}
+	return t
+}
+
+// parseAmount parses a V2 decimal amount, treating empty or invalid amounts as
+// zero
+func parseAmount(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
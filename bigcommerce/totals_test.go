@@ -0,0 +1,53 @@
+package bigcommerce
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrder_Totals(t *testing.T) {
+	order := &Order{
+		CurrencyCode:      "EUR",
+		SubtotalExTax:     "100.0000",
+		DiscountAmount:    "5.0000",
+		CouponDiscount:    "10.0000",
+		ShippingCostExTax: "8.0000",
+		HandlingCostExTax: "1.5000",
+		WrappingCostExTax: "2.0000",
+		TotalTax:          "19.3200",
+		TotalIncTax:       "115.8200",
+		RefundedAmount:    "15.8200",
+	}
+	want := &Totals{Currency: "EUR", Subtotal: 100, Discount: 15, Shipping: 8, Handling: 3.5, Tax: 19.32, GrandTotal: 115.82, Refunded: 15.82}
+	got := order.Totals()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+	if net := got.Net(); net != 100 {
+		t.Errorf("Net = %v, want 100", net)
+	}
+}
+
+func TestCart_Totals(t *testing.T) {
+	cart := &Cart{Currency: &CartCurrency{Code: "USD"}, TaxIncluded: true, BaseAmount: 50, DiscountAmount: 5, CartAmount: 45}
+	want := &Totals{Currency: "USD", Subtotal: 50, Discount: 5, GrandTotal: 45, TaxIncluded: true}
+	if got := cart.Totals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+}
+
+func TestCheckout_Totals(t *testing.T) {
+	checkout := &Checkout{
+		Cart:                   &Cart{Currency: &CartCurrency{Code: "USD"}, DiscountAmount: 5},
+		Coupons:                []*CheckoutCoupon{{Code: "SAVE10", DiscountedAmount: 10}},
+		SubtotalExTax:          100,
+		ShippingCostTotalExTax: 8,
+		HandlingCostTotalExTax: 2,
+		TaxTotal:               7.6,
+		GrandTotal:             102.6,
+	}
+	want := &Totals{Currency: "USD", Subtotal: 100, Discount: 15, Shipping: 8, Handling: 2, Tax: 7.6, GrandTotal: 102.6}
+	if got := checkout.Totals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+}
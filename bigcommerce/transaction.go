@@ -0,0 +1,50 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// TransactionService handles communication with the V3 order transaction endpoints
+type TransactionService service
+
+// Transaction describes a BigCommerce V3 Transaction Object, a payment event of an order
+type Transaction struct {
+	ID                 int64   `json:"id,omitempty"`       // The unique numerical ID of the transaction.
+	OrderID            string  `json:"order_id,omitempty"` // The ID of the order, as a string.
+	Event              string  `json:"event,omitempty"`    // One of purchase, authorization, capture, refund, void, pending or settled.
+	Method             string  `json:"method,omitempty"`   // One of credit_card, electronic_wallet, store_credit, gift_certificate, custom, token, nonce or offsite.
+	Amount             float64 `json:"amount"`             // The amount of the transaction.
+	Currency           string  `json:"currency,omitempty"` // The currency of the transaction.
+	Gateway            string  `json:"gateway,omitempty"`  // The payment gateway.
+	GatewayTransaction string  `json:"gateway_transaction_id,omitempty"`
+	Status             string  `json:"status,omitempty"` // One of ok, error.
+	Test               bool    `json:"test,omitempty"`   // Whether the gateway was in test mode.
+	Fraud              bool    `json:"fraud_review,omitempty"`
+	ReferenceID        int64   `json:"reference_transaction_id,omitempty"` // The transaction this one refers to, e.g. the authorization of a capture.
+	DateCreated        string  `json:"date_created,omitempty"`
+}
+
+// List returns the transactions of an order
+func (s *TransactionService) List(ctx context.Context, orderID int64) ([]*Transaction, *Response, error) {
+	var transactions []*Transaction
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/orders/%d/transactions", orderID), nil, &transactions)
+	if err != nil {
+		return nil, resp, err
+	}
+	return transactions, resp, nil
+}
+
+// Capture captures the funds of an order paid with an authorize-only flow.
+// The capture is processed asynchronously: it shows up as a capture
+// transaction, and the payment status of the order changes, once the gateway
+// has settled it
+func (s *TransactionService) Capture(ctx context.Context, orderID int64) (*Response, error) {
+	return s.client.call(ctx, "POST", fmt.Sprintf("v3/orders/%d/payment_actions/capture", orderID), nil, nil)
+}
+
+// Void voids the authorization of an order's payment, releasing the funds
+// held on the shopper's card. Like Capture, it is processed asynchronously
+func (s *TransactionService) Void(ctx context.Context, orderID int64) (*Response, error) {
+	return s.client.call(ctx, "POST", fmt.Sprintf("v3/orders/%d/payment_actions/void", orderID), nil, nil)
+}
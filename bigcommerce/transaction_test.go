@@ -0,0 +1,56 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTransactionService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/100/transactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data":[{"id":1,"order_id":"100","event":"capture","method":"credit_card","amount":50,"currency":"USD","gateway":"braintree","status":"ok"}],"meta":{}}`)
+	})
+
+	transactions, _, err := client.Transactions.List(context.Background(), 100)
+	if err != nil || len(transactions) != 1 || transactions[0].Event != "capture" || transactions[0].Amount != 50 {
+		t.Errorf("Unexpected transactions %+v, %v", transactions, err)
+	}
+}
+
+func TestTransactionService_Capture(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/100/payment_actions/capture", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data":{},"meta":{}}`)
+	})
+
+	if _, err := client.Transactions.Capture(context.Background(), 100); err != nil {
+		t.Errorf("Capture returned error: %v", err)
+	}
+}
+
+func TestTransactionService_Void(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/orders/100/payment_actions/void", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status":422,"title":"The order has already been captured and cannot be voided."}`)
+	})
+
+	_, err := client.Transactions.Void(context.Background(), 100)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Status != http.StatusUnprocessableEntity {
+		t.Errorf("Void returned %v, want a 422 ErrorResponse", err)
+	}
+}
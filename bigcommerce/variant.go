@@ -0,0 +1,141 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+)
+
+// VariantService handles communication with the V3 product variant endpoints
+type VariantService service
+
+// Variant describes a BigCommerce V3 Product Variant Object
+type Variant struct {
+	ID                        int64                `json:"id,omitempty"`                          // The unique numerical ID of the variant.
+	ProductID                 int64                `json:"product_id,omitempty"`                  // The ID of the product this variant belongs to.
+	SKU                       string               `json:"sku,omitempty"`                         // The variant's stock keeping unit.
+	SKUID                     int64                `json:"sku_id,omitempty"`                      // Read-only reference to the V2 SKU record.
+	Price                     *float64             `json:"price,omitempty"`                       // Overrides the product's price. Null means the product's price is used.
+	CalculatedPrice           float64              `json:"calculated_price,omitempty"`            // Price as displayed to guests, adjusted for sales and rules. Read-only.
+	SalePrice                 *float64             `json:"sale_price,omitempty"`                  // Overrides the product's sale price.
+	RetailPrice               *float64             `json:"retail_price,omitempty"`                // Overrides the product's retail price.
+	MapPrice                  *float64             `json:"map_price,omitempty"`                   // Minimum advertised price.
+	CostPrice                 *float64             `json:"cost_price,omitempty"`                  // The variant's cost price, for reference only.
+	Weight                    *float64             `json:"weight,omitempty"`                      // Overrides the product's weight.
+	Width                     *float64             `json:"width,omitempty"`                       // Overrides the product's width.
+	Height                    *float64             `json:"height,omitempty"`                      // Overrides the product's height.
+	Depth                     *float64             `json:"depth,omitempty"`                       // Overrides the product's depth.
+	IsFreeShipping            bool                 `json:"is_free_shipping,omitempty"`            // Whether the variant ships for free.
+	FixedCostShippingPrice    *float64             `json:"fixed_cost_shipping_price,omitempty"`   // A fixed shipping cost used instead of normal shipping calculation.
+	PurchasingDisabled        bool                 `json:"purchasing_disabled,omitempty"`         // If true, the variant cannot be purchased on the storefront.
+	PurchasingDisabledMessage string               `json:"purchasing_disabled_message,omitempty"` // Message shown when purchasing is disabled.
+	ImageURL                  string               `json:"image_url,omitempty"`                   // URL of the variant's image.
+	UPC                       string               `json:"upc,omitempty"`                         // The variant's UPC code.
+	MPN                       string               `json:"mpn,omitempty"`                         // Manufacturer part number.
+	GTIN                      string               `json:"gtin,omitempty"`                        // Global trade item number.
+	InventoryLevel            int64                `json:"inventory_level,omitempty"`             // Current inventory level, when inventory is tracked by variant.
+	InventoryWarningLevel     int64                `json:"inventory_warning_level,omitempty"`     // Level at which the store owner is warned about low stock.
+	BinPickingNumber          string               `json:"bin_picking_number,omitempty"`          // The BIN picking number for the variant.
+	OptionValues              []VariantOptionValue `json:"option_values,omitempty"`               // The option values that identify this variant.
+}
+
+// FeedVariant returns the variant for the marketplace feeds, e.g.
+// ToAmazonFlatFileVariant. Its UPC is used as the GTIN if set.
+func (v *Variant) FeedVariant() *FeedVariant {
+	fv := &FeedVariant{
+		SKU:            v.SKU,
+		GTIN:           v.UPC,
+		Price:          v.Price,
+		SalePrice:      v.SalePrice,
+		InventoryLevel: v.InventoryLevel,
+		ImageURL:       v.ImageURL,
+	}
+	if fv.GTIN == "" {
+		fv.GTIN = v.GTIN
+	}
+	for _, ov := range v.OptionValues {
+		fv.Options = append(fv.Options, FeedOption{Name: ov.OptionDisplayName, Value: ov.Label})
+	}
+	return fv
+}
+
+// VariantOptionValue describes an option value that makes up a Variant
+type VariantOptionValue struct {
+	ID                int64  `json:"id,omitempty"`                  // The ID of the option value.
+	OptionID          int64  `json:"option_id,omitempty"`           // The ID of the option the value belongs to.
+	Label             string `json:"label,omitempty"`               // The value's label, e.g. "Red".
+	OptionDisplayName string `json:"option_display_name,omitempty"` // The option's display name, e.g. "Color".
+}
+
+// VariantListOptions specifies the optional parameters to the variant list methods
+type VariantListOptions struct {
+	ListOptions
+	IDs           []int64  `url:"id:in,omitempty"`         // Filter by variant IDs.
+	SKU           string   `url:"sku,omitempty"`           // Filter by SKU.
+	ProductIDs    []int64  `url:"product_id:in,omitempty"` // Filter by product IDs. Only used by ListCatalog.
+	IncludeFields []string `url:"include_fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// List returns the variants of a product
+func (s *VariantService) List(ctx context.Context, productID int64, opts *VariantListOptions) ([]*Variant, *Response, error) {
+	return s.list(ctx, fmt.Sprintf("v3/catalog/products/%d/variants", productID), opts)
+}
+
+// ListCatalog returns variants across all products in the store, for bulk variant exports
+func (s *VariantService) ListCatalog(ctx context.Context, opts *VariantListOptions) ([]*Variant, *Response, error) {
+	return s.list(ctx, "v3/catalog/variants", opts)
+}
+
+func (s *VariantService) list(ctx context.Context, path string, opts *VariantListOptions) ([]*Variant, *Response, error) {
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var variants []*Variant
+	resp, err := s.client.call(ctx, "GET", path, nil, &variants)
+	if err != nil {
+		return nil, resp, err
+	}
+	return variants, resp, nil
+}
+
+// Get returns a single variant of a product
+func (s *VariantService) Get(ctx context.Context, productID, variantID int64) (*Variant, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/variants/%d", productID, variantID)
+	variant := new(Variant)
+	resp, err := s.client.call(ctx, "GET", path, nil, variant)
+	if err != nil {
+		return nil, resp, err
+	}
+	return variant, resp, nil
+}
+
+// Create adds a variant to a product. The variant's OptionValues must reference
+// existing option values of the product.
+func (s *VariantService) Create(ctx context.Context, productID int64, variant *Variant) (*Variant, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/variants", productID)
+	created := new(Variant)
+	resp, err := s.client.call(ctx, "POST", path, variant, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a variant of a product
+func (s *VariantService) Update(ctx context.Context, productID, variantID int64, variant *Variant) (*Variant, *Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/variants/%d", productID, variantID)
+	updated := new(Variant)
+	resp, err := s.client.call(ctx, "PUT", path, variant, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a variant from a product
+func (s *VariantService) Delete(ctx context.Context, productID, variantID int64) (*Response, error) {
+	path := fmt.Sprintf("v3/catalog/products/%d/variants/%d", productID, variantID)
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
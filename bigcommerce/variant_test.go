@@ -0,0 +1,120 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestVariantService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/variants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"sku": "SCARF-RED", "page": "2"})
+		fmt.Fprint(w, `{"data":[{"id":1,"product_id":32,"sku":"SCARF-RED","price":null,"option_values":[{"id":7,"label":"Red","option_id":3,"option_display_name":"Color"}]}],
+			"meta":{"pagination":{"total":3,"count":1,"per_page":1,"current_page":2,"total_pages":3}}}`)
+	})
+
+	variants, resp, err := client.Variants.List(context.Background(), 32, &VariantListOptions{ListOptions: ListOptions{Page: 2}, SKU: "SCARF-RED"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variants) != 1 || variants[0].Price != nil || variants[0].OptionValues[0].Label != "Red" {
+		t.Errorf("Unexpected variants %+v", variants)
+	}
+	if resp.Pagination == nil || resp.Pagination.TotalPages != 3 {
+		t.Errorf("Unexpected pagination %+v", resp.Pagination)
+	}
+}
+
+func TestVariantService_ListCatalog(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/variants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"product_id:in": "1,2"})
+		fmt.Fprint(w, `{"data":[{"id":1,"product_id":1},{"id":2,"product_id":2}],"meta":{}}`)
+	})
+
+	variants, _, err := client.Variants.ListCatalog(context.Background(), &VariantListOptions{ProductIDs: []int64{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(variants) != 2 {
+		t.Errorf("Expected 2 variants, got %d", len(variants))
+	}
+}
+
+func TestVariantService_CreateUpdateDelete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Variant{SKU: "SCARF-BLUE", Price: Float64(79), OptionValues: []VariantOptionValue{{ID: 8, OptionID: 3}}}
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/variants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Variant), input)
+		fmt.Fprint(w, `{"data":{"id":5,"product_id":32,"sku":"SCARF-BLUE","price":79},"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/32/variants/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testBody(t, r, new(Variant), &Variant{InventoryLevel: 10})
+			fmt.Fprint(w, `{"data":{"id":5,"inventory_level":10},"meta":{}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %v", r.Method)
+		}
+	})
+
+	variant, _, err := client.Variants.Create(context.Background(), 32, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if variant.ID != 5 || *variant.Price != 79 {
+		t.Errorf("Unexpected variant %+v", variant)
+	}
+
+	variant, _, err = client.Variants.Update(context.Background(), 32, 5, &Variant{InventoryLevel: 10})
+	if err != nil || variant.InventoryLevel != 10 {
+		t.Errorf("Unexpected update result %+v, %v", variant, err)
+	}
+
+	if _, err := client.Variants.Delete(context.Background(), 32, 5); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVariant_FeedVariant(t *testing.T) {
+	price := 95.5
+	v := &Variant{
+		ID:             70,
+		SKU:            "SHIRT-RED",
+		Price:          &price,
+		GTIN:           "036000291452",
+		InventoryLevel: 3,
+		ImageURL:       "https://cdn.example.com/red.jpg",
+		OptionValues:   []VariantOptionValue{{Label: "Red", OptionDisplayName: "Color"}},
+	}
+	want := &FeedVariant{
+		SKU:            "SHIRT-RED",
+		GTIN:           "036000291452",
+		Price:          &price,
+		InventoryLevel: 3,
+		ImageURL:       "https://cdn.example.com/red.jpg",
+		Options:        []FeedOption{{Name: "Color", Value: "Red"}},
+	}
+	if got := v.FeedVariant(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FeedVariant returned %+v, want %+v", got, want)
+	}
+
+	v.UPC = "4006381333931"
+	if got := v.FeedVariant(); got.GTIN != v.UPC {
+		t.Errorf("FeedVariant used GTIN %q, want the UPC", got.GTIN)
+	}
+}
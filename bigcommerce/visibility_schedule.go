@@ -0,0 +1,250 @@
+package bigcommerce
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultVisibilityInterval is how often VisibilityScheduler.Run applies due
+// schedules
+const DefaultVisibilityInterval = time.Minute
+
+// ListingStateSetter sets the state of products on a channel, e.g. through
+// the channel's listings with ChannelService. Setting a product to the state
+// it is in must be harmless.
+type ListingStateSetter interface {
+	SetListingState(ctx context.Context, channelID int64, productIDs []int64, state ListingState) error
+}
+
+// ScheduleStatus - Where a visibility schedule is in its lifecycle
+type ScheduleStatus string
+
+const (
+	// PendingSchedule - waiting for its time
+	PendingSchedule ScheduleStatus = "pending"
+	// AppliedSchedule - the products were set to the schedule's state
+	AppliedSchedule ScheduleStatus = "applied"
+	// FailedSchedule - setting the products' state failed for good; see Error
+	FailedSchedule ScheduleStatus = "failed"
+)
+
+// VisibilitySchedule shows or hides products on a channel at a time, e.g. for
+// a launch or the end of a regional embargo
+type VisibilitySchedule struct {
+	ID         string         `json:"id"` // Set by VisibilityScheduler.Add.
+	ChannelID  int64          `json:"channel_id"`
+	ProductIDs []int64        `json:"product_ids"`
+	State      ListingState   `json:"state"` // ActiveListing to show the products, DisabledListing to hide them.
+	At         time.Time      `json:"at"`
+	Reason     string         `json:"reason,omitempty"` // Why the schedule exists, e.g. "EU launch".
+	Status     ScheduleStatus `json:"status"`
+	AppliedAt  *time.Time     `json:"applied_at,omitempty"`
+	Error      string         `json:"error,omitempty"` // Why applying the schedule failed, or last failed if it is still pending.
+}
+
+// ErrScheduleConflict is wrapped by the *ScheduleConflictError returned by
+// VisibilityScheduler.Add
+var ErrScheduleConflict = errors.New("bigcommerce: visibility schedule conflicts with a pending one")
+
+// ScheduleConflictError is returned when a schedule sets a product on a channel
+// to a different state than a pending schedule at the same time, since there
+// is no telling which should win
+type ScheduleConflictError struct {
+	Schedule  *VisibilitySchedule
+	Conflicts []*VisibilitySchedule
+}
+
+func (e *ScheduleConflictError) Error() string {
+	ids := make([]string, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		ids[i] = c.ID
+	}
+	return fmt.Sprintf("%v: %s", ErrScheduleConflict, strings.Join(ids, ", "))
+}
+
+func (e *ScheduleConflictError) Unwrap() error { return ErrScheduleConflict }
+
+// VisibilityScheduler keeps visibility schedules in a KVStore, under
+// "visibility/", and applies them when they are due. Run it as a Component of
+// a Runner, or call Apply from a cron job. Schedules due together are applied
+// in order of time. A schedule failing with an error worth retrying, e.g. a
+// rate limit or a server error, stays pending and is applied again on the next
+// call to Apply; one failing otherwise stays failed.
+type VisibilityScheduler struct {
+	Listings ListingStateSetter
+	Store    KVStore
+	Interval time.Duration // How often Run applies due schedules, DefaultVisibilityInterval if zero.
+
+	now func() time.Time
+}
+
+// NewVisibilityScheduler returns a scheduler setting product states through
+// listings and keeping schedules in store
+func NewVisibilityScheduler(listings ListingStateSetter, store KVStore) *VisibilityScheduler {
+	return &VisibilityScheduler{Listings: listings, Store: store, now: time.Now}
+}
+
+const visibilityPrefix = "visibility/"
+
+// Add records a pending schedule, setting its ID. It returns a
+// *ScheduleConflictError if a pending schedule sets one of its products on the
+// same channel to another state at the same time.
+func (s *VisibilityScheduler) Add(ctx context.Context, schedule *VisibilitySchedule) error {
+	if schedule.ChannelID == 0 || len(schedule.ProductIDs) == 0 || schedule.At.IsZero() {
+		return errors.New("bigcommerce: a visibility schedule needs a channel, products and a time")
+	}
+	if schedule.State != ActiveListing && schedule.State != DisabledListing {
+		return fmt.Errorf("bigcommerce: invalid visibility schedule state %q", schedule.State)
+	}
+
+	pending, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+	var conflicts []*VisibilitySchedule
+	for _, p := range pending {
+		if p.Status == PendingSchedule && p.ChannelID == schedule.ChannelID && p.State != schedule.State &&
+			p.At.Equal(schedule.At) && sharesProduct(p.ProductIDs, schedule.ProductIDs) {
+			conflicts = append(conflicts, p)
+		}
+	}
+	if len(conflicts) > 0 {
+		return &ScheduleConflictError{Schedule: schedule, Conflicts: conflicts}
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	schedule.ID = hex.EncodeToString(id)
+	schedule.Status = PendingSchedule
+	return PutJSON(ctx, s.Store, visibilityPrefix+schedule.ID, schedule)
+}
+
+// Cancel removes a schedule. Applied schedules are not undone.
+func (s *VisibilityScheduler) Cancel(ctx context.Context, id string) error {
+	return s.Store.Delete(ctx, visibilityPrefix+id)
+}
+
+// List returns every schedule, ordered by time
+func (s *VisibilityScheduler) List(ctx context.Context) ([]*VisibilitySchedule, error) {
+	keys, err := s.Store.Keys(ctx, visibilityPrefix)
+	if err != nil {
+		return nil, err
+	}
+	var schedules []*VisibilitySchedule
+	for _, key := range keys {
+		schedule := new(VisibilitySchedule)
+		ok, err := GetJSON(ctx, s.Store, key, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			schedules = append(schedules, schedule)
+		}
+	}
+	sort.SliceStable(schedules, func(i, j int) bool { return schedules[i].At.Before(schedules[j].At) })
+	return schedules, nil
+}
+
+// Apply sets the products of every pending schedule that is due and returns
+// those schedules, applied or failed. It only returns an error if the store
+// fails. A schedule canceled while it is applied is not recorded again.
+func (s *VisibilityScheduler) Apply(ctx context.Context) ([]*VisibilitySchedule, error) {
+	schedules, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock()
+	var done []*VisibilitySchedule
+	for _, listed := range schedules {
+		if listed.Status != PendingSchedule || listed.At.After(now) {
+			continue
+		}
+		key := visibilityPrefix + listed.ID
+		old, ok, err := s.Store.Get(ctx, key)
+		if err != nil {
+			return done, err
+		}
+		if !ok {
+			continue
+		}
+		schedule := new(VisibilitySchedule)
+		if err := json.Unmarshal(old, schedule); err != nil {
+			return done, err
+		}
+		if schedule.Status != PendingSchedule {
+			continue
+		}
+
+		err = s.Listings.SetListingState(ctx, schedule.ChannelID, schedule.ProductIDs, schedule.State)
+		switch {
+		case err == nil:
+			appliedAt := s.clock()
+			schedule.Status, schedule.AppliedAt, schedule.Error = AppliedSchedule, &appliedAt, ""
+		case ctx.Err() != nil:
+			return done, ctx.Err()
+		case Classify(err).Retry:
+			schedule.Error = err.Error()
+		default:
+			failedAt := s.clock()
+			schedule.Status, schedule.AppliedAt, schedule.Error = FailedSchedule, &failedAt, err.Error()
+		}
+		data, err := json.Marshal(schedule)
+		if err != nil {
+			return done, err
+		}
+		swapped, err := s.Store.CompareAndSwap(ctx, key, old, data)
+		if err != nil {
+			return done, err
+		}
+		if swapped && schedule.Status != PendingSchedule {
+			done = append(done, schedule)
+		}
+	}
+	return done, nil
+}
+
+// Run applies due schedules every Interval until ctx is canceled. It
+// implements Component.
+func (s *VisibilityScheduler) Run(ctx context.Context) error {
+	interval := s.Interval
+	if interval == 0 {
+		interval = DefaultVisibilityInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Apply(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *VisibilityScheduler) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+func sharesProduct(a, b []int64) bool {
+	for _, id := range a {
+		if hasInt64(b, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+type fakeListings struct {
+	calls []string
+	fail  map[int64]error
+	set   func() // Called before the state is set, if not nil.
+}
+
+func (f *fakeListings) SetListingState(ctx context.Context, channelID int64, productIDs []int64, state ListingState) error {
+	if f.set != nil {
+		f.set()
+	}
+	if err := f.fail[channelID]; err != nil {
+		return err
+	}
+	f.calls = append(f.calls, string(state))
+	return nil
+}
+
+func TestVisibilityScheduler(t *testing.T) {
+	ctx := context.Background()
+	listings := &fakeListings{fail: map[int64]error{3: errors.New("channel unavailable")}}
+	s := NewVisibilityScheduler(listings, NewMemoryKVStore())
+	now := time.Date(2021, 6, 1, 9, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	launch := &VisibilitySchedule{ChannelID: 1, ProductIDs: []int64{10, 11}, State: ActiveListing, At: now.Add(time.Hour), Reason: "launch"}
+	embargo := &VisibilitySchedule{ChannelID: 1, ProductIDs: []int64{10}, State: DisabledListing, At: now.Add(-time.Minute)}
+	failing := &VisibilitySchedule{ChannelID: 3, ProductIDs: []int64{10}, State: ActiveListing, At: now}
+	for _, schedule := range []*VisibilitySchedule{launch, embargo, failing} {
+		if err := s.Add(ctx, schedule); err != nil {
+			t.Fatalf("Add returned error: %v", err)
+		}
+	}
+
+	clash := &VisibilitySchedule{ChannelID: 1, ProductIDs: []int64{11, 12}, State: DisabledListing, At: launch.At}
+	err := s.Add(ctx, clash)
+	var conflict *ScheduleConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrScheduleConflict) || len(conflict.Conflicts) != 1 || conflict.Conflicts[0].ID != launch.ID {
+		t.Errorf("Add of a conflicting schedule returned %v", err)
+	}
+	if err := s.Add(ctx, &VisibilitySchedule{ChannelID: 2, ProductIDs: []int64{11}, State: DisabledListing, At: launch.At}); err != nil {
+		t.Errorf("Add on another channel returned error: %v", err)
+	}
+
+	done, err := s.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if len(done) != 2 || done[0].ID != embargo.ID || done[0].Status != AppliedSchedule || done[0].AppliedAt == nil || done[1].Status != FailedSchedule || done[1].Error != "channel unavailable" {
+		t.Errorf("Apply returned %+v", done)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if done, _ := s.Apply(ctx); len(done) != 2 {
+		t.Errorf("Apply returned %d schedules, want 2", len(done))
+	}
+	sort.Strings(listings.calls[1:])
+	if want := []string{"disabled", "active", "disabled"}; !reflect.DeepEqual(listings.calls, want) {
+		t.Errorf("Listing states set = %v, want %v", listings.calls, want)
+	}
+
+	if err := s.Cancel(ctx, launch.ID); err != nil {
+		t.Fatalf("Cancel returned error: %v", err)
+	}
+	schedules, _ := s.List(ctx)
+	if len(schedules) != 3 {
+		t.Errorf("List returned %d schedules, want 3", len(schedules))
+	}
+}
+
+func TestVisibilityScheduler_Apply_retryable(t *testing.T) {
+	ctx := context.Background()
+	listings := &fakeListings{fail: map[int64]error{1: &url.Error{Op: "Put", URL: "https://api.bigcommerce.com", Err: errors.New("connection reset")}}}
+	s := NewVisibilityScheduler(listings, NewMemoryKVStore())
+	schedule := &VisibilitySchedule{ChannelID: 1, ProductIDs: []int64{10}, State: ActiveListing, At: time.Now().Add(-time.Minute)}
+	if err := s.Add(ctx, schedule); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+
+	if done, err := s.Apply(ctx); err != nil || len(done) != 0 {
+		t.Fatalf("Apply returned %+v, %v", done, err)
+	}
+	schedules, _ := s.List(ctx)
+	if len(schedules) != 1 || schedules[0].Status != PendingSchedule || schedules[0].Error == "" || schedules[0].AppliedAt != nil {
+		t.Errorf("Schedule after a retryable failure = %+v", schedules[0])
+	}
+
+	delete(listings.fail, 1)
+	if done, err := s.Apply(ctx); err != nil || len(done) != 1 || done[0].Status != AppliedSchedule || done[0].Error != "" {
+		t.Errorf("Apply returned %+v, %v", done, err)
+	}
+}
+
+func TestVisibilityScheduler_Apply_canceled(t *testing.T) {
+	ctx := context.Background()
+	listings := &fakeListings{}
+	s := NewVisibilityScheduler(listings, NewMemoryKVStore())
+	schedule := &VisibilitySchedule{ChannelID: 1, ProductIDs: []int64{10}, State: ActiveListing, At: time.Now().Add(-time.Minute)}
+	if err := s.Add(ctx, schedule); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	listings.set = func() {
+		if err := s.Cancel(ctx, schedule.ID); err != nil {
+			t.Errorf("Cancel returned error: %v", err)
+		}
+	}
+
+	if done, err := s.Apply(ctx); err != nil || len(done) != 0 {
+		t.Errorf("Apply returned %+v, %v", done, err)
+	}
+	if schedules, _ := s.List(ctx); len(schedules) != 0 {
+		t.Errorf("List returned %+v, want the canceled schedule gone", schedules)
+	}
+}
@@ -0,0 +1,195 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WarmupResource - Reference data Client.Warmup can load
+type WarmupResource string
+
+const (
+	// WarmCategories - the category tree
+	WarmCategories WarmupResource = "categories"
+	// WarmBrands - every brand
+	WarmBrands WarmupResource = "brands"
+	// WarmTaxClasses - every tax class
+	WarmTaxClasses WarmupResource = "tax_classes"
+	// WarmCountries - every country
+	WarmCountries WarmupResource = "countries"
+	// WarmCurrencies - every currency
+	WarmCurrencies WarmupResource = "currencies"
+	// WarmStore - the store's information and display settings
+	WarmStore WarmupResource = "store"
+)
+
+// allWarmupResources are the resources loaded when Warmup is given none
+var allWarmupResources = []WarmupResource{WarmCategories, WarmBrands, WarmTaxClasses, WarmCountries, WarmCurrencies, WarmStore}
+
+// ReferenceData is the reference data loaded by Client.Warmup. Resources not
+// loaded yet are nil.
+type ReferenceData struct {
+	Categories *CategoryTree
+	Brands     []*Brand
+	TaxClasses []*TaxClass
+	Countries  []*Country
+	Currencies []*Currency
+	Store      *Store
+	LoadedAt   map[WarmupResource]time.Time // When each resource was last loaded.
+}
+
+// WarmupError reports the resources Warmup failed to load. The others were
+// loaded.
+type WarmupError struct {
+	Errors map[WarmupResource]error
+}
+
+func (e *WarmupError) Error() string {
+	for _, r := range allWarmupResources {
+		if err, ok := e.Errors[r]; ok {
+			msg := fmt.Sprintf("bigcommerce: warming up %s: %v", r, err)
+			if len(e.Errors) > 1 {
+				msg += fmt.Sprintf(" (and %d more)", len(e.Errors)-1)
+			}
+			return msg
+		}
+	}
+	return fmt.Sprintf("bigcommerce: warming up %d resources failed", len(e.Errors))
+}
+
+type referenceCache struct {
+	mu   sync.Mutex
+	data ReferenceData
+}
+
+// Warmup loads reference data concurrently, one goroutine per resource, and
+// caches it on the client for ReferenceData. With no resources, every
+// resource is loaded. Loading a resource again replaces the cached copy, so
+// Warmup can also be called periodically to refresh it. If any resource fails
+// to load, Warmup returns a *WarmupError once the others have finished.
+func (c *Client) Warmup(ctx context.Context, resources ...WarmupResource) error {
+	if len(resources) == 0 {
+		resources = allWarmupResources
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := map[WarmupResource]error{}
+	for _, r := range resources {
+		wg.Add(1)
+		go func(r WarmupResource) {
+			defer wg.Done()
+			if err := c.warm(ctx, r); err != nil {
+				mu.Lock()
+				failed[r] = err
+				mu.Unlock()
+			}
+		}(r)
+	}
+	wg.Wait()
+	if len(failed) > 0 {
+		return &WarmupError{Errors: failed}
+	}
+	return nil
+}
+
+// ReferenceData returns the reference data loaded by Warmup so far
+func (c *Client) ReferenceData() ReferenceData {
+	c.reference.mu.Lock()
+	defer c.reference.mu.Unlock()
+	data := c.reference.data
+	data.LoadedAt = make(map[WarmupResource]time.Time, len(c.reference.data.LoadedAt))
+	for r, t := range c.reference.data.LoadedAt {
+		data.LoadedAt[r] = t
+	}
+	return data
+}
+
+func (c *Client) warm(ctx context.Context, r WarmupResource) error {
+	var store func(*ReferenceData)
+	switch r {
+	case WarmCategories:
+		tree, err := c.Categories.Tree(ctx)
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.Categories = tree }
+	case WarmBrands:
+		var brands []*Brand
+		err := warmPages(func(opts *ListOptions) (int, error) {
+			page, _, err := c.Brands.List(ctx, &BrandListOptions{ListOptions: *opts})
+			brands = append(brands, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.Brands = brands }
+	case WarmTaxClasses:
+		var classes []*TaxClass
+		err := warmPages(func(opts *ListOptions) (int, error) {
+			page, _, err := c.TaxClasses.List(ctx, opts)
+			classes = append(classes, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.TaxClasses = classes }
+	case WarmCountries:
+		var countries []*Country
+		err := warmPages(func(opts *ListOptions) (int, error) {
+			page, _, err := c.Countries.List(ctx, opts)
+			countries = append(countries, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.Countries = countries }
+	case WarmCurrencies:
+		var currencies []*Currency
+		err := warmPages(func(opts *ListOptions) (int, error) {
+			page, _, err := c.Currencies.List(ctx, opts)
+			currencies = append(currencies, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.Currencies = currencies }
+	case WarmStore:
+		info, _, err := c.Store.Get(ctx)
+		if err != nil {
+			return err
+		}
+		store = func(d *ReferenceData) { d.Store = info }
+	default:
+		return fmt.Errorf("bigcommerce: unknown warmup resource %q", r)
+	}
+
+	c.reference.mu.Lock()
+	defer c.reference.mu.Unlock()
+	store(&c.reference.data)
+	if c.reference.data.LoadedAt == nil {
+		c.reference.data.LoadedAt = map[WarmupResource]time.Time{}
+	}
+	c.reference.data.LoadedAt[r] = time.Now()
+	return nil
+}
+
+// warmPages calls list with the next page until it returns a short page
+func warmPages(list func(opts *ListOptions) (int, error)) error {
+	opts := &ListOptions{Page: 1, Limit: 250}
+	for {
+		n, err := list(opts)
+		if err != nil {
+			return err
+		}
+		if n < opts.Limit {
+			return nil
+		}
+		opts.Page++
+	}
+}
@@ -0,0 +1,56 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Warmup(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/catalog/categories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":1,"name":"Shop","parent_id":0},{"id":2,"name":"Shirts","parent_id":1}],"meta":{"pagination":{"total_pages":1}}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/brands", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":3,"name":"Acme"}],"meta":{}}`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/tax_classes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"name":"Default Tax Class"}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/countries", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":226,"country":"United States","country_iso2":"US"}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/currencies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"currency_code":"USD","name":"US Dollar"}]`)
+	})
+	mux.HandleFunc("/stores/abc123/v2/store", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"status":500,"title":"Internal error"}`, http.StatusInternalServerError)
+	})
+
+	err := client.Warmup(context.Background())
+	werr, ok := err.(*WarmupError)
+	if !ok || len(werr.Errors) != 1 || werr.Errors[WarmStore] == nil {
+		t.Fatalf("Warmup returned %v, want a store error", err)
+	}
+
+	data := client.ReferenceData()
+	if data.Categories == nil || len(data.Categories.Roots) != 1 || len(data.Categories.Roots[0].Children) != 1 {
+		t.Errorf("Categories = %+v", data.Categories)
+	}
+	if len(data.Brands) != 1 || len(data.TaxClasses) != 1 || len(data.Countries) != 1 || len(data.Currencies) != 1 {
+		t.Errorf("ReferenceData = %+v", data)
+	}
+	if data.Store != nil {
+		t.Errorf("Store = %+v, want nil", data.Store)
+	}
+	if _, ok := data.LoadedAt[WarmBrands]; !ok || len(data.LoadedAt) != 5 {
+		t.Errorf("LoadedAt = %v", data.LoadedAt)
+	}
+
+	if err := client.Warmup(context.Background(), "widgets"); err == nil {
+		t.Error("Warmup of an unknown resource returned no error")
+	}
+}
@@ -0,0 +1,160 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// WebhookService handles communication with the V3 webhook endpoints
+type WebhookService service
+
+// Webhook describes a BigCommerce V3 Webhook Object, a subscription delivering
+// events of a scope to a destination URL
+type Webhook struct {
+	ID          int64             `json:"id,omitempty"`         // The unique numerical ID of the webhook.
+	ClientID    string            `json:"client_id,omitempty"`  // The app that created the webhook. Read-only.
+	StoreHash   string            `json:"store_hash,omitempty"` // Read-only.
+	Scope       string            `json:"scope"`                // The events delivered, e.g. "store/order/created" or "store/product/*".
+	Destination string            `json:"destination"`          // The HTTPS URL events are posted to.
+	IsActive    bool              `json:"is_active"`            // Whether events are delivered.
+	Headers     map[string]string `json:"headers,omitempty"`    // Headers sent with every event, e.g. for authentication.
+	CreatedAt   int64             `json:"created_at,omitempty"` // Unix timestamp. Read-only.
+	UpdatedAt   int64             `json:"updated_at,omitempty"` // Unix timestamp. Read-only.
+}
+
+// WebhookListOptions specifies the optional parameters to WebhookService.List
+type WebhookListOptions struct {
+	ListOptions
+	IsActive    *bool  `url:"is_active,omitempty"`
+	Scope       string `url:"scope,omitempty"`
+	Destination string `url:"destination,omitempty"`
+}
+
+// List returns a page of the app's webhooks
+func (s *WebhookService) List(ctx context.Context, opts *WebhookListOptions) ([]*Webhook, *Response, error) {
+	path, err := addOptions("v3/hooks", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hooks []*Webhook
+	resp, err := s.client.call(ctx, "GET", path, nil, &hooks)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hooks, resp, nil
+}
+
+// Get returns a single webhook
+func (s *WebhookService) Get(ctx context.Context, id int64) (*Webhook, *Response, error) {
+	hook := new(Webhook)
+	resp, err := s.client.call(ctx, "GET", fmt.Sprintf("v3/hooks/%d", id), nil, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+// Create adds a webhook
+func (s *WebhookService) Create(ctx context.Context, hook *Webhook) (*Webhook, *Response, error) {
+	created := new(Webhook)
+	resp, err := s.client.call(ctx, "POST", "v3/hooks", hook, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a webhook
+func (s *WebhookService) Update(ctx context.Context, id int64, hook *Webhook) (*Webhook, *Response, error) {
+	updated := new(Webhook)
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/hooks/%d", id), hook, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a webhook
+func (s *WebhookService) Delete(ctx context.Context, id int64) (*Response, error) {
+	return s.client.call(ctx, "DELETE", fmt.Sprintf("v3/hooks/%d", id), nil, nil)
+}
+
+// Deactivate stops the delivery of a webhook's events, keeping the webhook
+func (s *WebhookService) Deactivate(ctx context.Context, id int64) (*Webhook, *Response, error) {
+	updated := new(Webhook)
+	body := map[string]interface{}{"is_active": false}
+	resp, err := s.client.call(ctx, "PUT", fmt.Sprintf("v3/hooks/%d", id), body, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// ListAll returns all of the app's webhooks, reading every page
+func (s *WebhookService) ListAll(ctx context.Context, opts *WebhookListOptions) ([]*Webhook, error) {
+	o := WebhookListOptions{ListOptions: ListOptions{Page: 1, Limit: 250}}
+	if opts != nil {
+		o = *opts
+		if o.Page < 1 {
+			o.Page = 1
+		}
+	}
+
+	var hooks []*Webhook
+	for {
+		page, resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, page...)
+		if resp.Pagination == nil || int64(o.Page) >= resp.Pagination.TotalPages {
+			return hooks, nil
+		}
+		o.Page++
+	}
+}
+
+// Register makes the app's webhooks match hooks, e.g. on install: webhooks
+// are matched by scope and destination, missing ones are created and ones
+// whose activity or headers differ are updated. The app's other active
+// webhooks are stale and deactivated. It returns the registered webhooks, in
+// the order of hooks.
+func (s *WebhookService) Register(ctx context.Context, hooks []*Webhook) ([]*Webhook, error) {
+	existing, err := s.ListAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]*Webhook{}
+	for _, h := range existing {
+		current[h.Scope+" "+h.Destination] = h
+	}
+
+	registered := make([]*Webhook, len(hooks))
+	for i, h := range hooks {
+		key := h.Scope + " " + h.Destination
+		old, ok := current[key]
+		delete(current, key)
+		switch {
+		case !ok:
+			registered[i], _, err = s.Create(ctx, h)
+		case old.IsActive != h.IsActive || (h.Headers != nil && !reflect.DeepEqual(old.Headers, h.Headers)):
+			registered[i], _, err = s.Update(ctx, old.ID, h)
+		default:
+			registered[i] = old
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bigcommerce: registering webhook %s: %v", key, err)
+		}
+	}
+
+	for _, h := range existing {
+		if _, stale := current[h.Scope+" "+h.Destination]; stale && h.IsActive {
+			if _, _, err := s.Deactivate(ctx, h.ID); err != nil {
+				return nil, fmt.Errorf("bigcommerce: deactivating webhook %d: %v", h.ID, err)
+			}
+		}
+	}
+	return registered, nil
+}
@@ -0,0 +1,115 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWebhookService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"is_active": "true", "scope": "store/order/created"})
+		fmt.Fprint(w, `{"data":[{"id":18,"client_id":"client","store_hash":"abc123","scope":"store/order/created","destination":"https://example.com/hooks","is_active":true,"created_at":1600000000,"updated_at":1600000000}],"meta":{}}`)
+	})
+
+	hooks, _, err := client.Webhooks.List(context.Background(), &WebhookListOptions{IsActive: Bool(true), Scope: "store/order/created"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Webhook{{ID: 18, ClientID: "client", StoreHash: "abc123", Scope: "store/order/created", Destination: "https://example.com/hooks", IsActive: true, CreatedAt: 1600000000, UpdatedAt: 1600000000}}
+	if !reflect.DeepEqual(hooks, want) {
+		t.Errorf("List = %+v, want %+v", hooks, want)
+	}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Webhook{Scope: "store/product/*", Destination: "https://example.com/hooks", IsActive: true, Headers: map[string]string{"X-Secret": "s3cret"}}
+	mux.HandleFunc("/stores/abc123/v3/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Webhook), input)
+		fmt.Fprint(w, `{"data":{"id":19,"scope":"store/product/*","destination":"https://example.com/hooks","is_active":true},"meta":{}}`)
+	})
+
+	hook, _, err := client.Webhooks.Create(context.Background(), input)
+	if err != nil || hook.ID != 19 {
+		t.Errorf("Create = %+v, %v", hook, err)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/hooks/19", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"data":{"id":19},"meta":{}}`)
+	})
+
+	if _, err := client.Webhooks.Delete(context.Background(), 19); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebhookService_Register(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	const dest = "https://example.com/hooks"
+	mux.HandleFunc("/stores/abc123/v3/hooks", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQuery(t, r, map[string]string{"page": "1", "limit": "250"})
+			fmt.Fprint(w, `{"data":[
+				{"id":18,"scope":"store/order/created","destination":"https://example.com/hooks","is_active":true},
+				{"id":20,"scope":"store/product/*","destination":"https://example.com/hooks","is_active":false},
+				{"id":21,"scope":"store/customer/*","destination":"https://example.com/hooks","is_active":true},
+				{"id":22,"scope":"store/sku/*","destination":"https://example.com/hooks","is_active":false}
+			],"meta":{"pagination":{"total_pages":1}}}`)
+		case "POST":
+			testBody(t, r, new(Webhook), &Webhook{Scope: "store/cart/created", Destination: dest, IsActive: true})
+			fmt.Fprint(w, `{"data":{"id":23,"scope":"store/cart/created","destination":"https://example.com/hooks","is_active":true}}`)
+		default:
+			t.Errorf("Request method: %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/stores/abc123/v3/hooks/20", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, new(Webhook), &Webhook{Scope: "store/product/*", Destination: dest, IsActive: true})
+		fmt.Fprint(w, `{"data":{"id":20,"scope":"store/product/*","destination":"https://example.com/hooks","is_active":true}}`)
+	})
+	deactivated := false
+	mux.HandleFunc("/stores/abc123/v3/hooks/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, &map[string]interface{}{}, &map[string]interface{}{"is_active": false})
+		deactivated = true
+		fmt.Fprint(w, `{"data":{"id":21,"scope":"store/customer/*","destination":"https://example.com/hooks","is_active":false}}`)
+	})
+
+	hooks, err := client.Webhooks.Register(context.Background(), []*Webhook{
+		{Scope: "store/order/created", Destination: dest, IsActive: true},
+		{Scope: "store/product/*", Destination: dest, IsActive: true},
+		{Scope: "store/cart/created", Destination: dest, IsActive: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, h := range hooks {
+		ids = append(ids, h.ID)
+	}
+	if want := []int64{18, 20, 23}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Register returned webhooks %v, want %v", ids, want)
+	}
+	if !deactivated {
+		t.Error("Register did not deactivate the stale webhook")
+	}
+}
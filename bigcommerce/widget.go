@@ -0,0 +1,196 @@
+package bigcommerce
+
+import "context"
+
+// WidgetService handles communication with the V3 widget endpoints: widget
+// templates, the widgets built from them, and their placements on pages
+type WidgetService service
+
+// WidgetTemplate describes a BigCommerce V3 Widget Template Object, the
+// Handlebars markup and settings schema shared by widgets
+type WidgetTemplate struct {
+	UUID               string        `json:"uuid,omitempty"`                 // The unique ID of the template.
+	Name               string        `json:"name"`                           // The template's name, shown in Page Builder.
+	Schema             []interface{} `json:"schema,omitempty"`               // The Page Builder settings schema.
+	Template           string        `json:"template"`                       // The Handlebars markup.
+	StorefrontAPIQuery string        `json:"storefront_api_query,omitempty"` // A GraphQL Storefront API query whose result is passed to the template.
+	Kind               string        `json:"kind,omitempty"`                 // "custom" for app templates. Read-only.
+	ChannelID          int64         `json:"channel_id,omitempty"`
+	CurrentVersionUUID string        `json:"current_version_uuid,omitempty"` // Read-only.
+	DateCreated        string        `json:"date_created,omitempty"`         // Read-only.
+	DateModified       string        `json:"date_modified,omitempty"`        // Read-only.
+}
+
+// Widget describes a BigCommerce V3 Widget Object, a configured instance of a
+// WidgetTemplate
+type Widget struct {
+	UUID                string                 `json:"uuid,omitempty"`                 // The unique ID of the widget.
+	Name                string                 `json:"name"`                           // The widget's name.
+	Description         string                 `json:"description,omitempty"`          // Describes the widget's purpose.
+	WidgetConfiguration map[string]interface{} `json:"widget_configuration,omitempty"` // The values rendered by the template.
+	WidgetTemplateUUID  string                 `json:"widget_template_uuid,omitempty"` // The template the widget is built from.
+	ChannelID           int64                  `json:"channel_id,omitempty"`
+	DateCreated         string                 `json:"date_created,omitempty"`  // Read-only.
+	DateModified        string                 `json:"date_modified,omitempty"` // Read-only.
+}
+
+// Placement describes a BigCommerce V3 Placement Object, the position of a
+// widget in a region of a storefront page
+type Placement struct {
+	UUID         string          `json:"uuid,omitempty"`          // The unique ID of the placement.
+	WidgetUUID   string          `json:"widget_uuid,omitempty"`   // The widget placed.
+	TemplateFile string          `json:"template_file,omitempty"` // The page, e.g. "pages/home".
+	Region       string          `json:"region,omitempty"`        // The region of the page, e.g. "home_below_featured_products".
+	EntityID     string          `json:"entity_id,omitempty"`     // The product, category, brand or page, for pages of a single entity.
+	SortOrder    int64           `json:"sort_order"`              // The position among the region's placements.
+	Status       PlacementStatus `json:"status,omitempty"`        // Whether the widget is shown.
+	ChannelID    int64           `json:"channel_id,omitempty"`
+	DateCreated  string          `json:"date_created,omitempty"`  // Read-only.
+	DateModified string          `json:"date_modified,omitempty"` // Read-only.
+
+	UnknownValues map[string]string `json:"-"` // Enum values unknown to the client, with CaptureEnums.
+}
+
+// PlacementStatus - Whether a placed widget is shown
+type PlacementStatus string
+
+const (
+	// ActivePlacement - the widget is shown
+	ActivePlacement PlacementStatus = "active"
+	// InactivePlacement - the widget is hidden
+	InactivePlacement PlacementStatus = "inactive"
+)
+
+// WidgetListOptions specifies the optional parameters to WidgetService.List
+type WidgetListOptions struct {
+	ListOptions
+	WidgetTemplateKind string `url:"widget_template_kind,omitempty"`
+	WidgetTemplateUUID string `url:"widget_template_uuid,omitempty"`
+}
+
+// PlacementListOptions specifies the optional parameters to WidgetService.ListPlacements
+type PlacementListOptions struct {
+	ListOptions
+	WidgetTemplateKind string `url:"widget_template_kind,omitempty"`
+	TemplateFile       string `url:"template_file,omitempty"`
+}
+
+// ListTemplates returns a page of widget templates
+func (s *WidgetService) ListTemplates(ctx context.Context, opts *ListOptions) ([]*WidgetTemplate, *Response, error) {
+	path, err := addOptions("v3/content/widget-templates", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var templates []*WidgetTemplate
+	resp, err := s.client.call(ctx, "GET", path, nil, &templates)
+	if err != nil {
+		return nil, resp, err
+	}
+	return templates, resp, nil
+}
+
+// CreateTemplate adds a widget template
+func (s *WidgetService) CreateTemplate(ctx context.Context, template *WidgetTemplate) (*WidgetTemplate, *Response, error) {
+	created := new(WidgetTemplate)
+	resp, err := s.client.call(ctx, "POST", "v3/content/widget-templates", template, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdateTemplate modifies a widget template, creating a new version of it
+func (s *WidgetService) UpdateTemplate(ctx context.Context, uuid string, template *WidgetTemplate) (*WidgetTemplate, *Response, error) {
+	updated := new(WidgetTemplate)
+	resp, err := s.client.call(ctx, "PUT", "v3/content/widget-templates/"+uuid, template, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeleteTemplate removes a widget template. Its widgets must be removed first.
+func (s *WidgetService) DeleteTemplate(ctx context.Context, uuid string) (*Response, error) {
+	return s.client.call(ctx, "DELETE", "v3/content/widget-templates/"+uuid, nil, nil)
+}
+
+// List returns a page of widgets
+func (s *WidgetService) List(ctx context.Context, opts *WidgetListOptions) ([]*Widget, *Response, error) {
+	path, err := addOptions("v3/content/widgets", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var widgets []*Widget
+	resp, err := s.client.call(ctx, "GET", path, nil, &widgets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return widgets, resp, nil
+}
+
+// Create adds a widget
+func (s *WidgetService) Create(ctx context.Context, widget *Widget) (*Widget, *Response, error) {
+	created := new(Widget)
+	resp, err := s.client.call(ctx, "POST", "v3/content/widgets", widget, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update modifies a widget
+func (s *WidgetService) Update(ctx context.Context, uuid string, widget *Widget) (*Widget, *Response, error) {
+	updated := new(Widget)
+	resp, err := s.client.call(ctx, "PUT", "v3/content/widgets/"+uuid, widget, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete removes a widget and its placements
+func (s *WidgetService) Delete(ctx context.Context, uuid string) (*Response, error) {
+	return s.client.call(ctx, "DELETE", "v3/content/widgets/"+uuid, nil, nil)
+}
+
+// ListPlacements returns a page of widget placements
+func (s *WidgetService) ListPlacements(ctx context.Context, opts *PlacementListOptions) ([]*Placement, *Response, error) {
+	path, err := addOptions("v3/content/placements", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var placements []*Placement
+	resp, err := s.client.call(ctx, "GET", path, nil, &placements)
+	if err != nil {
+		return nil, resp, err
+	}
+	return placements, resp, nil
+}
+
+// CreatePlacement places a widget on a page
+func (s *WidgetService) CreatePlacement(ctx context.Context, placement *Placement) (*Placement, *Response, error) {
+	created := new(Placement)
+	resp, err := s.client.call(ctx, "POST", "v3/content/placements", placement, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// UpdatePlacement modifies a placement
+func (s *WidgetService) UpdatePlacement(ctx context.Context, uuid string, placement *Placement) (*Placement, *Response, error) {
+	updated := new(Placement)
+	resp, err := s.client.call(ctx, "PUT", "v3/content/placements/"+uuid, placement, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// DeletePlacement removes a placement, leaving its widget in place
+func (s *WidgetService) DeletePlacement(ctx context.Context, uuid string) (*Response, error) {
+	return s.client.call(ctx, "DELETE", "v3/content/placements/"+uuid, nil, nil)
+}
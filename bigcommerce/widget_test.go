@@ -0,0 +1,58 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWidgetService_CreateTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &WidgetTemplate{Name: "Banner", Template: "<h1>{{title}}</h1>"}
+	mux.HandleFunc("/stores/abc123/v3/content/widget-templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(WidgetTemplate), input)
+		fmt.Fprint(w, `{"data":{"uuid":"b1","name":"Banner","template":"<h1>{{title}}</h1>","kind":"custom"},"meta":{}}`)
+	})
+
+	template, _, err := client.Widgets.CreateTemplate(context.Background(), input)
+	if err != nil || template.UUID != "b1" || template.Kind != "custom" {
+		t.Errorf("CreateTemplate = %+v, %v", template, err)
+	}
+}
+
+func TestWidgetService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v3/content/widgets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, map[string]string{"widget_template_uuid": "b1"})
+		fmt.Fprint(w, `{"data":[{"uuid":"w1","name":"Home Banner","widget_template_uuid":"b1","widget_configuration":{"title":"Sale"}}],"meta":{}}`)
+	})
+
+	widgets, _, err := client.Widgets.List(context.Background(), &WidgetListOptions{WidgetTemplateUUID: "b1"})
+	if err != nil || len(widgets) != 1 || widgets[0].WidgetConfiguration["title"] != "Sale" {
+		t.Errorf("List = %+v, %v", widgets, err)
+	}
+}
+
+func TestWidgetService_CreatePlacement(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	input := &Placement{WidgetUUID: "w1", TemplateFile: "pages/home", Region: "home_below_menu", SortOrder: 1, Status: ActivePlacement}
+	mux.HandleFunc("/stores/abc123/v3/content/placements", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, new(Placement), input)
+		fmt.Fprint(w, `{"data":{"uuid":"p1","widget_uuid":"w1","template_file":"pages/home","region":"home_below_menu","sort_order":1,"status":"active"},"meta":{}}`)
+	})
+
+	placement, _, err := client.Widgets.CreatePlacement(context.Background(), input)
+	if err != nil || placement.UUID != "p1" {
+		t.Errorf("CreatePlacement = %+v, %v", placement, err)
+	}
+}
@@ -0,0 +1,319 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WooProduct describes a product in the WooCommerce REST API (wc/v3) JSON format
+type WooProduct struct {
+	ID               int64          `json:"id"`
+	Name             string         `json:"name"`
+	Slug             string         `json:"slug"`
+	Permalink        string         `json:"permalink"`
+	Type             string         `json:"type"`   // simple, variable, grouped or external.
+	Status           string         `json:"status"` // publish, draft, pending or private.
+	Description      string         `json:"description"`
+	ShortDescription string         `json:"short_description"`
+	SKU              string         `json:"sku"`
+	RegularPrice     string         `json:"regular_price"`
+	SalePrice        string         `json:"sale_price"`
+	Virtual          bool           `json:"virtual"`
+	Downloadable     bool           `json:"downloadable"`
+	ManageStock      bool           `json:"manage_stock"`
+	StockQuantity    *int64         `json:"stock_quantity"`
+	Weight           string         `json:"weight"`
+	Dimensions       WooDimensions  `json:"dimensions"`
+	Categories       []WooTerm      `json:"categories"`
+	Tags             []WooTerm      `json:"tags"`
+	Images           []WooImage     `json:"images"`
+	Attributes       []WooAttribute `json:"attributes"`
+	Variations       WooVariations  `json:"variations"` // Embedded variations. Plain variation IDs are ignored.
+}
+
+// WooDimensions describes the dimensions of a WooCommerce product or variation
+type WooDimensions struct {
+	Length string `json:"length"`
+	Width  string `json:"width"`
+	Height string `json:"height"`
+}
+
+// WooTerm describes a WooCommerce category or tag reference
+type WooTerm struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// WooImage describes a WooCommerce product image
+type WooImage struct {
+	ID  int64  `json:"id"`
+	Src string `json:"src"`
+	Alt string `json:"alt"`
+}
+
+// WooAttribute describes a WooCommerce product attribute. Attributes used for
+// variations become product options.
+type WooAttribute struct {
+	ID        int64    `json:"id"`
+	Name      string   `json:"name"`
+	Position  int64    `json:"position"`
+	Variation bool     `json:"variation"`
+	Options   []string `json:"options"`
+}
+
+// WooVariation describes a variation of a variable WooCommerce product
+type WooVariation struct {
+	ID            int64                   `json:"id"`
+	SKU           string                  `json:"sku"`
+	RegularPrice  string                  `json:"regular_price"`
+	SalePrice     string                  `json:"sale_price"`
+	StockQuantity *int64                  `json:"stock_quantity"`
+	Weight        string                  `json:"weight"`
+	Image         *WooImage               `json:"image"`
+	Attributes    []WooVariationAttribute `json:"attributes"`
+}
+
+// WooVariationAttribute describes the attribute value chosen by a variation
+type WooVariationAttribute struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Option string `json:"option"`
+}
+
+// WooVariations holds the variations embedded in a WooCommerce product export.
+// The REST API lists variations as IDs only; those are skipped when decoding.
+type WooVariations []WooVariation
+
+// UnmarshalJSON decodes either embedded variation objects or a list of IDs
+func (v *WooVariations) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for _, r := range raw {
+		if r = bytes.TrimSpace(r); len(r) == 0 || r[0] != '{' {
+			continue
+		}
+		var variation WooVariation
+		if err := json.Unmarshal(r, &variation); err != nil {
+			return err
+		}
+		*v = append(*v, variation)
+	}
+	return nil
+}
+
+// ReadWooCommerceProducts reads a JSON array of products exported from the WooCommerce REST API
+func ReadWooCommerceProducts(r io.Reader) ([]*WooProduct, error) {
+	var products []*WooProduct
+	if err := json.NewDecoder(r).Decode(&products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// MigrationReport records how the IDs and URLs of migrated products map onto
+// the BigCommerce products created for them, for redirect generation
+type MigrationReport struct {
+	Products []*MigratedProduct // Successfully created products.
+	Errors   []*MigrationError  // Products that could not be migrated.
+}
+
+// MigratedProduct maps a source product onto the BigCommerce product created for it
+type MigratedProduct struct {
+	SourceID  int64           `json:"source_id"`          // The product's ID in the source platform.
+	SourceURL string          `json:"source_url"`         // The product's URL in the source store.
+	ProductID int64           `json:"product_id"`         // The ID of the new BigCommerce product.
+	CustomURL string          `json:"custom_url"`         // The storefront path of the new product.
+	Variants  map[int64]int64 `json:"variants,omitempty"` // Source variation IDs mapped to BigCommerce variant IDs.
+}
+
+// MigrationError records why a source product could not be migrated
+type MigrationError struct {
+	SourceID int64  `json:"source_id"`
+	Err      error  `json:"-"`
+	Message  string `json:"message"`
+}
+
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("bigcommerce: migrating product %d: %v", e.SourceID, e.Err)
+}
+
+// WooCommerceImporter creates BigCommerce products from WooCommerce products
+type WooCommerceImporter struct {
+	Client *Client
+
+	// CategoryIDs maps WooCommerce category IDs onto existing BigCommerce
+	// category IDs. BigCommerce requires every product to have a category.
+	CategoryIDs map[int64]int64
+
+	// DefaultCategoryID is used for products none of whose categories are mapped.
+	DefaultCategoryID int64
+}
+
+// Import creates every product, continuing past failures, which are recorded in
+// the report's Errors. Only a cancelled context stops the import early.
+func (m *WooCommerceImporter) Import(ctx context.Context, products []*WooProduct) (*MigrationReport, error) {
+	report := new(MigrationReport)
+	for _, wp := range products {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		// A product whose variants failed has still been created, so it is
+		// reported in both lists.
+		migrated, err := m.importProduct(ctx, wp)
+		if migrated != nil {
+			report.Products = append(report.Products, migrated)
+		}
+		if err != nil {
+			report.Errors = append(report.Errors, &MigrationError{SourceID: wp.ID, Err: err, Message: err.Error()})
+		}
+	}
+	return report, nil
+}
+
+func (m *WooCommerceImporter) importProduct(ctx context.Context, wp *WooProduct) (*MigratedProduct, error) {
+	product, err := m.mapProduct(wp)
+	if err != nil {
+		return nil, err
+	}
+	created, _, err := m.Client.Products.Create(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := &MigratedProduct{
+		SourceID:  wp.ID,
+		SourceURL: wp.Permalink,
+		ProductID: created.ID,
+		CustomURL: created.CustomURL,
+	}
+	if wp.Type != "variable" || len(wp.Variations) == 0 {
+		return migrated, nil
+	}
+
+	options := map[string]*ProductOption{}
+	for _, attr := range wp.Attributes {
+		if !attr.Variation {
+			continue
+		}
+		option := &ProductOption{DisplayName: attr.Name, Type: RectanglesOption, SortOrder: attr.Position}
+		for i, label := range attr.Options {
+			option.OptionValues = append(option.OptionValues, OptionValue{Label: label, SortOrder: int64(i)})
+		}
+		if option, _, err = m.Client.ProductOptions.Create(ctx, created.ID, option); err != nil {
+			return migrated, err
+		}
+		options[strings.ToLower(attr.Name)] = option
+	}
+
+	migrated.Variants = map[int64]int64{}
+	for _, wv := range wp.Variations {
+		variant, err := mapWooVariation(&wv, options)
+		if err != nil {
+			return migrated, err
+		}
+		if variant, _, err = m.Client.Variants.Create(ctx, created.ID, variant); err != nil {
+			return migrated, fmt.Errorf("variation %d: %v", wv.ID, err)
+		}
+		migrated.Variants[wv.ID] = variant.ID
+	}
+	return migrated, nil
+}
+
+// mapProduct maps a WooCommerce product onto a V2 product create payload
+func (m *WooCommerceImporter) mapProduct(wp *WooProduct) (*Product, error) {
+	p := &Product{
+		Name:         wp.Name,
+		Type:         PhysicalProduct,
+		SKU:          wp.SKU,
+		Description:  wp.Description,
+		Price:        wp.RegularPrice,
+		SalePrice:    wp.SalePrice,
+		Weight:       wp.Weight,
+		Width:        wp.Dimensions.Width,
+		Height:       wp.Dimensions.Height,
+		Depth:        wp.Dimensions.Length,
+		IsVisible:    wp.Status == "publish",
+		Availability: AvailableProduct,
+		CustomURL:    "/" + wp.Slug + "/",
+	}
+	if p.Description == "" {
+		p.Description = wp.ShortDescription
+	}
+	if wp.Virtual || wp.Downloadable {
+		p.Type = DigitalProduct
+	}
+	if p.Price == "" {
+		p.Price = "0"
+		if len(wp.Variations) > 0 {
+			p.Price = wp.Variations[0].RegularPrice
+		}
+	}
+	if p.Weight == "" {
+		p.Weight = "0"
+	}
+	if wp.ManageStock && wp.StockQuantity != nil {
+		p.InventoryTracking = inventoryType(SimpleInventory)
+		p.InventoryLevel = *wp.StockQuantity
+	}
+	if wp.Type == "variable" {
+		p.SKU = "" // The SKUs belong to the variants.
+		p.InventoryTracking = inventoryType(SKUInventory)
+	}
+
+	var keywords []string
+	for _, tag := range wp.Tags {
+		keywords = append(keywords, tag.Name)
+	}
+	p.SearchKeywords = strings.Join(keywords, ",")
+
+	for _, c := range wp.Categories {
+		if id, ok := m.CategoryIDs[c.ID]; ok {
+			p.Categories = append(p.Categories, id)
+		}
+	}
+	if len(p.Categories) == 0 {
+		if m.DefaultCategoryID == 0 {
+			return nil, fmt.Errorf("no BigCommerce category mapped for %q", wp.Name)
+		}
+		p.Categories = []int64{m.DefaultCategoryID}
+	}
+	return p, nil
+}
+
+// mapWooVariation maps a WooCommerce variation onto a variant referencing the
+// option values created for the product's variation attributes
+func mapWooVariation(wv *WooVariation, options map[string]*ProductOption) (*Variant, error) {
+	variant := &Variant{
+		SKU:       wv.SKU,
+		Price:     parseOptionalFloat(wv.RegularPrice),
+		SalePrice: parseOptionalFloat(wv.SalePrice),
+		Weight:    parseOptionalFloat(wv.Weight),
+	}
+	if wv.StockQuantity != nil {
+		variant.InventoryLevel = *wv.StockQuantity
+	}
+	if wv.Image != nil {
+		variant.ImageURL = wv.Image.Src
+	}
+
+	for _, attr := range wv.Attributes {
+		option, ok := options[strings.ToLower(attr.Name)]
+		if !ok {
+			return nil, fmt.Errorf("variation %d uses unknown attribute %q", wv.ID, attr.Name)
+		}
+		ref, ok := option.VariantOptionValue(attr.Option)
+		if !ok {
+			return nil, fmt.Errorf("variation %d uses unknown %s value %q", wv.ID, attr.Name, attr.Option)
+		}
+		variant.OptionValues = append(variant.OptionValues, ref)
+	}
+	return variant, nil
+}
@@ -0,0 +1,75 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const wooProductsJSON = `[
+  {"id": 101, "name": "Hoodie", "slug": "hoodie", "permalink": "https://shop.example.com/product/hoodie/", "type": "variable",
+   "status": "publish", "description": "<p>Cozy</p>", "sku": "HOODIE", "regular_price": "", "manage_stock": false,
+   "weight": "0.5", "dimensions": {"length": "", "width": "", "height": ""},
+   "categories": [{"id": 9, "name": "Clothing", "slug": "clothing"}], "tags": [{"id": 1, "name": "warm"}],
+   "attributes": [{"id": 1, "name": "Color", "position": 0, "variation": true, "options": ["Blue", "Green"]},
+                  {"id": 2, "name": "Material", "position": 1, "variation": false, "options": ["Cotton"]}],
+   "variations": [{"id": 201, "sku": "HOODIE-BLUE", "regular_price": "45", "stock_quantity": 3, "attributes": [{"id": 1, "name": "Color", "option": "Blue"}]},
+                  {"id": 202, "sku": "HOODIE-GREEN", "regular_price": "45", "attributes": [{"id": 1, "name": "Color", "option": "Green"}]}]},
+  {"id": 102, "name": "Unmapped", "slug": "unmapped", "type": "simple", "regular_price": "5", "categories": [{"id": 99}], "variations": [301]}
+]`
+
+func TestReadWooCommerceProducts(t *testing.T) {
+	products, err := ReadWooCommerceProducts(strings.NewReader(wooProductsJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || len(products[0].Variations) != 2 || len(products[1].Variations) != 0 {
+		t.Errorf("Unexpected products %+v", products)
+	}
+}
+
+func TestWooCommerceImporter_Import(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/stores/abc123/v2/products", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var p Product
+		json.NewDecoder(r.Body).Decode(&p)
+		if p.Price != "45" || p.SKU != "" || len(p.Categories) != 1 || p.Categories[0] != 14 || p.SearchKeywords != "warm" {
+			t.Errorf("Unexpected product payload %+v", p)
+		}
+		fmt.Fprint(w, `{"id":500,"name":"Hoodie","custom_url":"/hoodie/"}`)
+	})
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/500/options", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"id":3,"display_name":"Color","option_values":[{"id":7,"label":"Blue"},{"id":8,"label":"Green"}]},"meta":{}}`)
+	})
+	variantID := int64(600)
+	mux.HandleFunc("/stores/abc123/v3/catalog/products/500/variants", func(w http.ResponseWriter, r *http.Request) {
+		var v Variant
+		json.NewDecoder(r.Body).Decode(&v)
+		if len(v.OptionValues) != 1 || v.OptionValues[0].OptionID != 3 {
+			t.Errorf("Unexpected variant payload %+v", v)
+		}
+		variantID++
+		fmt.Fprintf(w, `{"data":{"id":%d,"sku":%q},"meta":{}}`, variantID, v.SKU)
+	})
+
+	products, _ := ReadWooCommerceProducts(strings.NewReader(wooProductsJSON))
+	importer := &WooCommerceImporter{Client: client, CategoryIDs: map[int64]int64{9: 14}}
+	report, err := importer.Import(context.Background(), products)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Products) != 1 || len(report.Errors) != 1 || report.Errors[0].SourceID != 102 {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+	migrated := report.Products[0]
+	if migrated.ProductID != 500 || migrated.SourceURL != "https://shop.example.com/product/hoodie/" || migrated.Variants[202] != 602 {
+		t.Errorf("Unexpected migrated product %+v", migrated)
+	}
+}
@@ -0,0 +1,502 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/micahthomas/bigcommerce-go-client/cmd/internal/naming"
+)
+
+// methods are the HTTP methods bcapigen generates service methods for
+var methods = []string{"GET", "POST", "PUT", "DELETE"}
+
+// operation is an operation of a service
+type operation struct {
+	*Operation
+	method string
+	path   string
+	params []*Parameter // Path and query parameters, path level ones first.
+}
+
+// service is a group of operations sharing a tag
+type service struct {
+	name  string // The Go type, e.g. "WishlistService".
+	field string // The Client field, e.g. "Wishlists".
+	base  string // The singular resource of the service, e.g. "Wishlist".
+	ops   []*operation
+}
+
+type generator struct {
+	spec    *Spec
+	base    string
+	buf     bytes.Buffer
+	imports map[string]bool
+
+	queue   []string           // Named schemas to generate, in order of first use.
+	schemas map[string]*Schema // The schemas of queued names.
+}
+
+// generate returns the Go source of the services, structs and enums
+// described by spec, along with the services so callers can register them
+func generate(spec *Spec, pkg string) ([]byte, []*service, error) {
+	g := &generator{
+		spec:    spec,
+		base:    spec.basePath(),
+		imports: map[string]bool{"context": true},
+		schemas: map[string]*Schema{},
+	}
+
+	services := g.services()
+	var body bytes.Buffer
+	for _, svc := range services {
+		g.service(svc)
+	}
+	for i := 0; i < len(g.queue); i++ { // Generating a type may queue more.
+		g.namedType(g.queue[i])
+	}
+	body, g.buf = g.buf, body
+
+	g.printf("// Code generated by bcapigen from %q %s; DO NOT EDIT.\n\n", spec.Info.Title, spec.Info.Version)
+	g.printf("package %s\n\nimport (\n", pkg)
+	var imports []string
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		g.printf("%q\n", imp)
+	}
+	g.printf(")\n\n")
+	g.buf.Write(body.Bytes())
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, services, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// firstLine returns the first line of a description, without a trailing period
+func firstLine(description string) string {
+	return strings.TrimSuffix(strings.TrimSpace(strings.SplitN(description, "\n", 2)[0]), ".")
+}
+
+// services groups the operations of the spec by their first tag
+func (g *generator) services() []*service {
+	var paths []string
+	for path := range g.spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	byTag := map[string]*service{}
+	var services []*service
+	for _, path := range paths {
+		item := g.spec.Paths[path]
+		for _, method := range methods {
+			op := map[string]*Operation{"GET": item.Get, "POST": item.Post, "PUT": item.Put, "DELETE": item.Delete}[method]
+			if op == nil {
+				continue
+			}
+			tag := "Default"
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+			svc := byTag[tag]
+			if svc == nil {
+				field := naming.Exported(tag)
+				svc = &service{name: naming.Singular(field) + "Service", field: field, base: naming.Singular(field)}
+				byTag[tag] = svc
+				services = append(services, svc)
+			}
+
+			o := &operation{Operation: op, method: method, path: path}
+			for _, p := range append(append([]*Parameter(nil), item.Parameters...), op.Parameters...) {
+				if p = g.spec.parameter(p); p.In == "path" || p.In == "query" {
+					o.params = append(o.params, p)
+				}
+			}
+			svc.ops = append(svc.ops, o)
+		}
+	}
+	return services
+}
+
+// service writes a service type and its methods
+func (g *generator) service(svc *service) {
+	g.printf("// %s handles communication with the %s endpoints\n", svc.name, strings.ToLower(svc.base))
+	g.printf("type %s service\n\n", svc.name)
+
+	seen := map[string]bool{}
+	for _, op := range svc.ops {
+		name := methodName(svc, op)
+		if seen[name] && op.OperationID != "" {
+			name = naming.Exported(op.OperationID)
+		}
+		seen[name] = true
+		g.method(svc, op, name)
+	}
+}
+
+// methodName names an operation after its method and the last static segment
+// of its path, e.g. "ListItems" for GET /wishlists/{wishlist_id}/items. The
+// noun is left out for the resource of the service itself, as in "Get".
+func methodName(svc *service, op *operation) string {
+	segments := strings.Split(strings.Trim(op.path, "/"), "/")
+	single := strings.HasPrefix(segments[len(segments)-1], "{")
+	noun := ""
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(segments[i], "{") {
+			noun = naming.Exported(segments[i])
+			break
+		}
+	}
+	if single || op.method == "POST" {
+		noun = naming.Singular(noun)
+	}
+	if naming.Singular(noun) == svc.base {
+		noun = ""
+	}
+
+	switch op.method {
+	case "GET":
+		if single {
+			return "Get" + noun
+		}
+		return "List" + noun
+	case "POST":
+		return "Create" + noun
+	case "PUT":
+		return "Update" + noun
+	}
+	return "Delete" + noun
+}
+
+// method writes the method of an operation
+func (g *generator) method(svc *service, op *operation, name string) {
+	var params, pathArgs []string
+	var query []*Parameter
+	format := op.path
+	for _, p := range op.params {
+		if p.In == "query" {
+			query = append(query, p)
+			continue
+		}
+		typ, verb := "string", "%s"
+		if s := g.spec.schema(p.Schema); s != nil && s.Type == "integer" {
+			typ, verb = "int64", "%d"
+		}
+		arg := naming.Unexported(p.Name)
+		params = append(params, arg+" "+typ)
+		pathArgs = append(pathArgs, arg)
+		format = strings.Replace(format, "{"+p.Name+"}", verb, 1)
+	}
+	path := fmt.Sprintf("%q", g.base+format)
+	if len(pathArgs) > 0 {
+		g.imports["fmt"] = true
+		path = fmt.Sprintf("fmt.Sprintf(%s, %s)", path, strings.Join(pathArgs, ", "))
+	}
+
+	body := "nil"
+	if rb := g.spec.requestBody(op.RequestBody); rb != nil {
+		if schema := jsonSchema(rb.Content); schema != nil {
+			typ := g.goType(schema, svc.base+strings.TrimPrefix(name, svc.base)+"Request", false)
+			body = bodyName(typ)
+			params = append(params, body+" "+typ)
+		}
+	}
+
+	var opts string
+	if len(query) > 0 {
+		opts = svc.base + name + "Options"
+		if strings.HasPrefix(name, "List") {
+			opts = naming.Singular(strings.TrimPrefix(name, "List")) + "ListOptions"
+			if opts == "ListOptions" {
+				opts = svc.base + opts
+			}
+		}
+		g.options(opts, name, svc, query)
+		params = append(params, "opts *"+opts)
+	}
+
+	out := g.result(op, svc.base+strings.TrimPrefix(name, svc.base)+"Response")
+
+	if op.Summary != "" {
+		g.printf("// %s - %s\n", name, firstLine(op.Summary))
+	} else {
+		g.printf("// %s calls %s %s\n", name, op.method, g.base+op.path)
+	}
+	g.printf("func (s *%s) %s(ctx context.Context", svc.name, name)
+	for _, p := range params {
+		g.printf(", %s", p)
+	}
+	if out == "" {
+		g.printf(") (*Response, error) {\n")
+	} else {
+		g.printf(") (%s, *Response, error) {\n", out)
+	}
+
+	if opts != "" {
+		g.printf("path, err := addOptions(%s, opts)\nif err != nil {\n", path)
+		if out == "" {
+			g.printf("return nil, err\n}\n")
+		} else {
+			g.printf("return nil, nil, err\n}\n")
+		}
+		path = "path"
+	}
+
+	if out == "" {
+		g.printf("return s.client.call(ctx, %q, %s, %s, nil)\n}\n\n", op.method, path, body)
+		return
+	}
+	if strings.HasPrefix(out, "*") {
+		g.printf("x := new(%s)\n", out[1:])
+		g.printf("resp, err := s.client.call(ctx, %q, %s, %s, x)\n", op.method, path, body)
+	} else {
+		g.printf("var x %s\n", out)
+		g.printf("resp, err := s.client.call(ctx, %q, %s, %s, &x)\n", op.method, path, body)
+	}
+	g.printf("if err != nil {\nreturn nil, resp, err\n}\nreturn x, resp, nil\n}\n\n")
+}
+
+// bodyName names the request body argument after its type, e.g. "wishlist"
+// for *Wishlist and "wishlistItems" for []*WishlistItem. Inline bodies are
+// named "body".
+func bodyName(typ string) string {
+	name := strings.TrimLeft(typ, "*")
+	if strings.HasSuffix(name, "Request") {
+		return "body"
+	}
+	if strings.HasPrefix(name, "[]") {
+		name = strings.TrimLeft(name[2:], "*")
+		return naming.Unexported(name) + "s"
+	}
+	if strings.ContainsAny(name, "[{.") {
+		return "body"
+	}
+	return naming.Unexported(name)
+}
+
+// result returns the Go type of the successful response of an operation, or
+// "" when it has no body. The `data` envelope of V3 responses is unwrapped
+// by Client.call.
+func (g *generator) result(op *operation, inline string) string {
+	for _, code := range []string{"200", "201", "207"} {
+		r := g.spec.response(op.Responses[code])
+		if r == nil {
+			continue
+		}
+		schema := jsonSchema(r.Content)
+		if schema == nil {
+			return ""
+		}
+		if _, props, _ := g.spec.properties(schema); props["data"] != nil {
+			schema = props["data"]
+		}
+		return g.goType(schema, inline, false)
+	}
+	return ""
+}
+
+// options writes the struct of an operation's query parameters
+func (g *generator) options(name, method string, svc *service, query []*Parameter) {
+	paging := 0
+	for _, p := range query {
+		if p.Name == "page" || p.Name == "limit" {
+			paging++
+		}
+	}
+
+	g.printf("// %s specifies the optional parameters to %s.%s\n", name, svc.name, method)
+	g.printf("type %s struct {\n", name)
+	if paging == 2 {
+		g.printf("ListOptions\n")
+	}
+	for _, p := range query {
+		if paging == 2 && (p.Name == "page" || p.Name == "limit") {
+			continue
+		}
+		g.printf("%s %s `url:\"%s,omitempty\"`", optionName(p.Name), g.queryType(p.Schema), p.Name)
+		if line := firstLine(p.Description); line != "" {
+			g.printf(" // %s.", line)
+		}
+		g.printf("\n")
+	}
+	g.printf("}\n\n")
+}
+
+// optionName returns the field of a query parameter. Filters like "id:in"
+// and "date_created:min" get the names of the hand-written options, "IDs"
+// and "MinDateCreated".
+func optionName(param string) string {
+	base, filter := param, ""
+	if i := strings.Index(param, ":"); i >= 0 {
+		base, filter = param[:i], param[i+1:]
+	}
+	name := naming.Exported(base)
+	switch filter {
+	case "":
+		return name
+	case "in":
+		return name + "s"
+	case "min", "max":
+		return naming.Exported(filter) + name
+	}
+	return name + naming.Exported(filter)
+}
+
+// queryType returns the Go type of a query parameter
+func (g *generator) queryType(schema *Schema) string {
+	schema = g.spec.schema(schema)
+	if schema == nil {
+		return "string"
+	}
+	switch schema.Type {
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "*bool"
+	case "array":
+		return "[]" + strings.TrimPrefix(g.queryType(schema.Items), "*")
+	case "string":
+		if schema.Format == "date-time" || schema.Format == "date" {
+			g.imports["time"] = true
+			return "time.Time"
+		}
+	}
+	return "string"
+}
+
+// goType returns the Go type of a schema, queueing the named types it uses.
+// inline names an object or enum declared in place. Objects are pointers,
+// and nullable scalars are pointers when pointer is set.
+func (g *generator) goType(schema *Schema, inline string, pointer bool) string {
+	if schema == nil {
+		return "interface{}"
+	}
+	if schema.Ref == "" && len(schema.AllOf) == 1 && len(schema.Properties.Names) == 0 {
+		return g.goType(schema.AllOf[0], inline, pointer)
+	}
+
+	name := inline
+	if schema.Ref != "" {
+		name = naming.Exported(refName(schema.Ref))
+	}
+	resolved := g.spec.schema(schema)
+	if resolved == nil {
+		return "interface{}"
+	}
+
+	switch {
+	case g.spec.isObject(resolved):
+		return "*" + g.enqueue(name, resolved)
+	case resolved.Type == "array":
+		return "[]" + g.goType(resolved.Items, naming.Singular(inline), false)
+	case resolved.Type == "string" && len(resolved.Enum) > 0:
+		return g.enqueue(name, resolved)
+	}
+
+	var typ string
+	switch resolved.Type {
+	case "string":
+		typ = "string"
+	case "integer":
+		typ = "int64"
+	case "number":
+		typ = "float64"
+	case "boolean":
+		typ = "bool"
+	case "object":
+		return "map[string]interface{}"
+	default:
+		return "interface{}"
+	}
+	if pointer && (schema.Nullable || resolved.Nullable) {
+		return "*" + typ
+	}
+	return typ
+}
+
+// enqueue queues a named type and returns its name, which is suffixed when
+// another schema already has it
+func (g *generator) enqueue(name string, schema *Schema) string {
+	for i := 2; ; i++ {
+		existing, ok := g.schemas[name]
+		if !ok {
+			g.schemas[name] = schema
+			g.queue = append(g.queue, name)
+			return name
+		}
+		if existing == schema {
+			return name
+		}
+		name = strings.TrimRight(name, "0123456789") + fmt.Sprint(i)
+	}
+}
+
+// namedType writes a struct or string enum
+func (g *generator) namedType(name string) {
+	schema := g.schemas[name]
+	if !g.spec.isObject(schema) {
+		g.enum(name, schema)
+		return
+	}
+
+	if line := firstLine(schema.Description); line != "" {
+		g.printf("// %s - %s\n", name, line)
+	} else {
+		g.printf("// %s is generated from the spec\n", name)
+	}
+	g.printf("type %s struct {\n", name)
+	names, props, required := g.spec.properties(schema)
+	for _, prop := range names {
+		s := props[prop]
+		field := naming.Exported(prop)
+		tag := prop
+		if !required[prop] {
+			tag += ",omitempty"
+		}
+		g.printf("%s %s `json:\"%s\"`", field, g.goType(s, name+field, true), tag)
+		var comment []string
+		if line := firstLine(s.Description); line != "" {
+			comment = append(comment, line+".")
+		} else if resolved := g.spec.schema(s); resolved != nil && firstLine(resolved.Description) != "" {
+			comment = append(comment, firstLine(resolved.Description)+".")
+		}
+		if s.ReadOnly {
+			comment = append(comment, "Read-only.")
+		}
+		if len(comment) > 0 {
+			g.printf(" // %s", strings.Join(comment, " "))
+		}
+		g.printf("\n")
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) enum(name string, schema *Schema) {
+	if line := firstLine(schema.Description); line != "" {
+		g.printf("// %s - %s\n", name, line)
+	} else {
+		g.printf("// %s is generated from the spec\n", name)
+	}
+	g.printf("type %s string\n\nconst (\n", name)
+	for _, v := range schema.Enum {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		g.printf("%s%s %s = %q\n", name, naming.Exported(s), name, s)
+	}
+	g.printf(")\n\n")
+}
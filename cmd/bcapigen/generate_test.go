@@ -0,0 +1,248 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testSpec = `{
+	"openapi": "3.0.0",
+	"info": {"title": "Wishlists", "version": "3.0"},
+	"servers": [{"url": "https://api.bigcommerce.com/stores/{store_hash}/v3"}],
+	"paths": {
+		"/wishlists": {
+			"get": {
+				"operationId": "getWishlists",
+				"summary": "Get All Wishlists.",
+				"tags": ["Wishlists"],
+				"parameters": [
+					{"name": "customer_id", "in": "query", "schema": {"type": "integer"}},
+					{"name": "id:in", "in": "query", "schema": {"type": "array", "items": {"type": "integer"}}},
+					{"name": "date_created:min", "in": "query", "description": "Minimum creation date.", "schema": {"type": "string", "format": "date-time"}},
+					{"name": "is_public", "in": "query", "schema": {"type": "boolean"}},
+					{"$ref": "#/components/parameters/Page"},
+					{"$ref": "#/components/parameters/Limit"}
+				],
+				"responses": {"200": {"content": {"application/json": {"schema": {
+					"type": "object",
+					"properties": {
+						"data": {"type": "array", "items": {"$ref": "#/components/schemas/Wishlist"}},
+						"meta": {"type": "object"}
+					}
+				}}}}}
+			},
+			"post": {
+				"operationId": "createWishlist",
+				"summary": "Create a Wishlist",
+				"tags": ["Wishlists"],
+				"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Wishlist"}}}},
+				"responses": {"200": {"$ref": "#/components/responses/WishlistResponse"}}
+			}
+		},
+		"/wishlists/{wishlist_id}": {
+			"parameters": [{"name": "wishlist_id", "in": "path", "required": true, "schema": {"type": "integer"}}],
+			"get": {
+				"operationId": "getWishlist",
+				"tags": ["Wishlists"],
+				"responses": {"200": {"$ref": "#/components/responses/WishlistResponse"}}
+			},
+			"put": {
+				"operationId": "updateWishlist",
+				"tags": ["Wishlists"],
+				"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Wishlist"}}}},
+				"responses": {"200": {"$ref": "#/components/responses/WishlistResponse"}}
+			},
+			"delete": {
+				"operationId": "deleteWishlist",
+				"tags": ["Wishlists"],
+				"responses": {"204": {"description": "No content."}}
+			}
+		},
+		"/wishlists/{wishlist_id}/items": {
+			"post": {
+				"operationId": "addWishlistItems",
+				"tags": ["Wishlists"],
+				"parameters": [{"name": "wishlist_id", "in": "path", "required": true, "schema": {"type": "integer"}}],
+				"requestBody": {"content": {"application/json": {"schema": {
+					"type": "object",
+					"properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/WishlistItem"}}}
+				}}}},
+				"responses": {"200": {"$ref": "#/components/responses/WishlistResponse"}}
+			}
+		},
+		"/wishlists/{wishlist_id}/items/{item_id}": {
+			"delete": {
+				"operationId": "deleteWishlistItem",
+				"tags": ["Wishlists"],
+				"parameters": [
+					{"name": "wishlist_id", "in": "path", "required": true, "schema": {"type": "integer"}},
+					{"name": "item_id", "in": "path", "required": true, "schema": {"type": "integer"}}
+				],
+				"responses": {"200": {"$ref": "#/components/responses/WishlistResponse"}}
+			}
+		}
+	},
+	"components": {
+		"parameters": {
+			"Page": {"name": "page", "in": "query", "schema": {"type": "integer"}},
+			"Limit": {"name": "limit", "in": "query", "schema": {"type": "integer"}}
+		},
+		"responses": {
+			"WishlistResponse": {"content": {"application/json": {"schema": {
+				"type": "object",
+				"properties": {"data": {"$ref": "#/components/schemas/Wishlist"}, "meta": {"type": "object"}}
+			}}}}
+		},
+		"schemas": {
+			"Wishlist": {
+				"type": "object",
+				"description": "A customer's wishlist.",
+				"required": ["customer_id"],
+				"properties": {
+					"id": {"type": "integer", "readOnly": true},
+					"customer_id": {"type": "integer", "description": "The customer owning the wishlist."},
+					"name": {"type": "string"},
+					"is_public": {"type": "boolean"},
+					"token": {"type": "string", "format": "uuid", "nullable": true},
+					"visibility": {"$ref": "#/components/schemas/Visibility"},
+					"items": {"type": "array", "items": {"$ref": "#/components/schemas/WishlistItem"}},
+					"sharing": {"type": "object", "properties": {"url": {"type": "string"}}}
+				}
+			},
+			"WishlistItem": {
+				"allOf": [
+					{"type": "object", "properties": {"id": {"type": "integer"}}},
+					{"type": "object", "properties": {"product_id": {"type": "integer"}, "variant_id": {"type": "integer"}}}
+				]
+			},
+			"Visibility": {"type": "string", "enum": ["private", "shared_link"]}
+		}
+	}
+}`
+
+// generateTestSpec generates the test spec into the bigcommerce package and
+// type-checks it together with the package's sources
+func generateTestSpec(t *testing.T) (string, *types.Package) {
+	spec, err := readSpec(strings.NewReader(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, _, err := generate(spec, "bigcommerce")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "../../bigcommerce", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, f := range pkgs["bigcommerce"].Files {
+		files = append(files, f)
+	}
+	file, err := parser.ParseFile(fset, "wishlist_gen.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("bigcommerce", fset, append(files, file), nil)
+	if err != nil {
+		t.Fatalf("Generated code does not compile: %v\n%s", err, src)
+	}
+	return string(src), pkg
+}
+
+func TestGenerate(t *testing.T) {
+	src, pkg := generateTestSpec(t)
+	// Compare without gofmt's alignment.
+	src = strings.Join(strings.FieldsFunc(src, func(r rune) bool { return r == ' ' || r == '\t' }), " ")
+
+	for _, want := range []string{
+		"// Code generated by bcapigen from \"Wishlists\" 3.0; DO NOT EDIT.",
+		"// WishlistService handles communication with the wishlist endpoints\ntype WishlistService service",
+		"// Wishlist - A customer's wishlist\ntype Wishlist struct",
+		"ID int64 `json:\"id,omitempty\"` // Read-only.",
+		"CustomerID int64 `json:\"customer_id\"` // The customer owning the wishlist.",
+		"Token *string `json:\"token,omitempty\"`",
+		"Visibility Visibility `json:\"visibility,omitempty\"`",
+		"Items []*WishlistItem `json:\"items,omitempty\"`",
+		"Sharing *WishlistSharing `json:\"sharing,omitempty\"`",
+		"VisibilitySharedLink Visibility = \"shared_link\"",
+		"type WishlistListOptions struct {\n ListOptions\n",
+		"IDs []int64 `url:\"id:in,omitempty\"`",
+		"MinDateCreated time.Time `url:\"date_created:min,omitempty\"` // Minimum creation date.",
+		"IsPublic *bool `url:\"is_public,omitempty\"`",
+		"// List - Get All Wishlists\n",
+		"path, err := addOptions(\"v3/wishlists\", opts)",
+		"fmt.Sprintf(\"v3/wishlists/%d/items/%d\", wishlistID, itemID)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Generated code lacks %q", want)
+		}
+	}
+
+	item := pkg.Scope().Lookup("WishlistItem").Type().Underlying().(*types.Struct)
+	if item.NumFields() != 3 {
+		t.Errorf("WishlistItem has %d fields, want the 3 of its allOf members", item.NumFields())
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(pkg.Scope().Lookup("WishlistService").Type()))
+	for method, signature := range map[string]string{
+		"List":       "func(ctx context.Context, opts *WishlistListOptions) ([]*Wishlist, *Response, error)",
+		"Create":     "func(ctx context.Context, wishlist *Wishlist) (*Wishlist, *Response, error)",
+		"Get":        "func(ctx context.Context, wishlistID int64) (*Wishlist, *Response, error)",
+		"Update":     "func(ctx context.Context, wishlistID int64, wishlist *Wishlist) (*Wishlist, *Response, error)",
+		"Delete":     "func(ctx context.Context, wishlistID int64) (*Response, error)",
+		"CreateItem": "func(ctx context.Context, wishlistID int64, body *WishlistCreateItemRequest) (*Wishlist, *Response, error)",
+		"DeleteItem": "func(ctx context.Context, wishlistID int64, itemID int64) (*Wishlist, *Response, error)",
+	} {
+		sel := methods.Lookup(pkg, method)
+		if sel == nil {
+			t.Errorf("WishlistService lacks %s", method)
+			continue
+		}
+		got := types.TypeString(sel.Type(), types.RelativeTo(pkg))
+		if got != signature {
+			t.Errorf("%s = %s, want %s", method, got, signature)
+		}
+	}
+}
+
+func TestMethodName(t *testing.T) {
+	svc := &service{base: "Wishlist"}
+	for _, test := range []struct{ method, path, want string }{
+		{"GET", "/wishlists", "List"},
+		{"GET", "/wishlists/{id}", "Get"},
+		{"PUT", "/wishlists/{id}/items", "UpdateItems"},
+		{"POST", "/wishlists/{id}/items", "CreateItem"},
+		{"GET", "/wishlists/{id}/addresses/{address_id}", "GetAddress"},
+		{"DELETE", "/wishlists/{id}/categories", "DeleteCategories"},
+	} {
+		if got := methodName(svc, &operation{method: test.method, path: test.path}); got != test.want {
+			t.Errorf("methodName(%s %s) = %s, want %s", test.method, test.path, got, test.want)
+		}
+	}
+}
+
+func TestOptionName(t *testing.T) {
+	for param, want := range map[string]string{
+		"customer_id":       "CustomerID",
+		"id:in":             "IDs",
+		"name:like":         "NameLike",
+		"date_modified:max": "MaxDateModified",
+		"sku:in":            "SKUs",
+	} {
+		if got := optionName(param); got != want {
+			t.Errorf("optionName(%q) = %s, want %s", param, got, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Command bcapigen generates services and structs for the bigcommerce package
+// from BigCommerce's published OpenAPI specifications, so newly released REST
+// endpoints can be covered without writing the boilerplate by hand.
+//
+// It reads an OpenAPI 3 document in JSON, from a file or a URL, and writes a
+// single Go file following the conventions of the hand-written services:
+//
+//   - operations are grouped into a service per tag, e.g. "WishlistService"
+//     for the "Wishlists" tag;
+//   - methods are named after the HTTP method and the resource, e.g. List,
+//     Get, Create, Update and Delete for /wishlists and /wishlists/{id}, and
+//     ListItems or DeleteItem for /wishlists/{id}/items/{item_id};
+//   - path parameters become arguments, query parameters an options struct
+//     embedding ListOptions when the endpoint is paginated, and request bodies
+//     a typed argument;
+//   - responses are unwrapped from their `data` envelope;
+//   - the schemas reachable from the operations become structs with json tags,
+//     and string enums become string types with a constant per value.
+//
+// The published specs are mostly YAML; convert them to JSON first, e.g. with
+// yq -o=json. The generated services still have to be added to Client:
+// bcapigen prints the fields and initialisations to add.
+//
+// Usage:
+//
+//	bcapigen -spec wishlists.json [-pkg name] [-check] -o wishlist_gen.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+	specFile := flag.String("spec", "", "The OpenAPI document, a JSON file or an http(s) URL.")
+	pkg := flag.String("pkg", "bigcommerce", "The package name of the generated file.")
+	out := flag.String("o", "", "The generated file.")
+	check := flag.Bool("check", false, "Report whether the generated file is out of date instead of writing it.")
+	flag.Parse()
+
+	if *specFile == "" || *out == "" {
+		fatalf("bcapigen: -spec and -o are required")
+	}
+
+	spec, err := loadSpec(*specFile)
+	if err != nil {
+		fatalf("bcapigen: %v", err)
+	}
+	src, services, err := generate(spec, *pkg)
+	if err != nil {
+		fatalf("bcapigen: %v", err)
+	}
+	if *check {
+		current, err := os.ReadFile(*out)
+		if err != nil || !bytes.Equal(current, src) {
+			fatalf("bcapigen: %s is out of date with the spec", *out)
+		}
+		return
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fatalf("bcapigen: %v", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "bcapigen: wrote %s; register its services in client.go:\n", *out)
+	for _, svc := range services {
+		fmt.Fprintf(os.Stderr, "\t%s *%s\n", svc.field, svc.name)
+	}
+	for _, svc := range services {
+		fmt.Fprintf(os.Stderr, "\tc.%s = (*%s)(&c.common)\n", svc.field, svc.name)
+	}
+}
+
+// loadSpec reads an OpenAPI document from a file or URL
+func loadSpec(location string) (*Spec, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		f, err := os.Open(location)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return readSpec(f)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("fetching %s: %s", location, resp.Status)
+	}
+	return readSpec(resp.Body)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
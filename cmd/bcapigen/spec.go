@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document bcapigen reads
+type Spec struct {
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components struct {
+		Schemas       map[string]*Schema      `json:"schemas"`
+		Parameters    map[string]*Parameter   `json:"parameters"`
+		RequestBodies map[string]*RequestBody `json:"requestBodies"`
+		Responses     map[string]*Response    `json:"responses"`
+	} `json:"components"`
+}
+
+// PathItem holds the operations on a path
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Post       *Operation   `json:"post"`
+	Put        *Operation   `json:"put"`
+	Delete     *Operation   `json:"delete"`
+}
+
+// Operation is an API operation
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Tags        []string             `json:"tags"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path, query or header parameter
+type Parameter struct {
+	Ref         string  `json:"$ref"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the body of an operation
+type RequestBody struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*MediaType `json:"content"`
+}
+
+// Response is a response of an operation
+type Response struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*MediaType `json:"content"`
+}
+
+// MediaType describes the content of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema
+type Schema struct {
+	Ref         string        `json:"$ref"`
+	Type        string        `json:"type"`
+	Format      string        `json:"format"`
+	Description string        `json:"description"`
+	Properties  Properties    `json:"properties"`
+	Required    []string      `json:"required"`
+	Items       *Schema       `json:"items"`
+	AllOf       []*Schema     `json:"allOf"`
+	OneOf       []*Schema     `json:"oneOf"`
+	AnyOf       []*Schema     `json:"anyOf"`
+	Enum        []interface{} `json:"enum"`
+	Nullable    bool          `json:"nullable"`
+	ReadOnly    bool          `json:"readOnly"`
+
+	AdditionalProperties json.RawMessage `json:"additionalProperties"`
+}
+
+// Properties are the properties of an object schema, in document order
+type Properties struct {
+	Names   []string
+	Schemas map[string]*Schema
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the order of properties
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("properties must be an object")
+	}
+	p.Schemas = map[string]*Schema{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		s := new(Schema)
+		if err := dec.Decode(s); err != nil {
+			return err
+		}
+		p.Names = append(p.Names, name)
+		p.Schemas[name] = s
+	}
+	return nil
+}
+
+// readSpec decodes a JSON OpenAPI document
+func readSpec(r io.Reader) (*Spec, error) {
+	spec := new(Spec)
+	if err := json.NewDecoder(r).Decode(spec); err != nil {
+		return nil, err
+	}
+	if len(spec.Paths) == 0 {
+		return nil, fmt.Errorf("spec has no paths")
+	}
+	return spec, nil
+}
+
+// basePath returns the API version prefix of the spec's paths, e.g. "v3" for
+// a server URL of "https://api.bigcommerce.com/stores/{store_hash}/v3"
+func (s *Spec) basePath() string {
+	if len(s.Servers) == 0 {
+		return "v3"
+	}
+	u := s.Servers[0].URL
+	if i := strings.Index(u, "{store_hash}"); i >= 0 {
+		return strings.Trim(u[i+len("{store_hash}"):], "/")
+	}
+	return "v3"
+}
+
+// refName returns the component name of a reference, e.g. "Wishlist" for
+// "#/components/schemas/Wishlist"
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// schema resolves a schema reference
+func (s *Spec) schema(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[refName(schema.Ref)]
+	}
+	return schema
+}
+
+func (s *Spec) parameter(p *Parameter) *Parameter {
+	if p.Ref != "" {
+		if resolved := s.Components.Parameters[refName(p.Ref)]; resolved != nil {
+			return resolved
+		}
+	}
+	return p
+}
+
+func (s *Spec) requestBody(b *RequestBody) *RequestBody {
+	if b != nil && b.Ref != "" {
+		return s.Components.RequestBodies[refName(b.Ref)]
+	}
+	return b
+}
+
+func (s *Spec) response(r *Response) *Response {
+	if r != nil && r.Ref != "" {
+		return s.Components.Responses[refName(r.Ref)]
+	}
+	return r
+}
+
+// jsonSchema returns the schema of JSON content, or nil
+func jsonSchema(content map[string]*MediaType) *Schema {
+	if mt := content["application/json"]; mt != nil {
+		return mt.Schema
+	}
+	return nil
+}
+
+// properties returns the properties of an object schema, merging those of
+// allOf members, and its required properties
+func (s *Spec) properties(schema *Schema) ([]string, map[string]*Schema, map[string]bool) {
+	var names []string
+	props := map[string]*Schema{}
+	required := map[string]bool{}
+
+	var walk func(*Schema)
+	walk = func(schema *Schema) {
+		schema = s.schema(schema)
+		if schema == nil {
+			return
+		}
+		for _, member := range schema.AllOf {
+			walk(member)
+		}
+		for _, name := range schema.Properties.Names {
+			if _, ok := props[name]; !ok {
+				names = append(names, name)
+			}
+			props[name] = schema.Properties.Schemas[name]
+		}
+		for _, name := range schema.Required {
+			required[name] = true
+		}
+	}
+	walk(schema)
+	return names, props, required
+}
+
+// isObject reports whether a schema describes a JSON object with properties
+func (s *Spec) isObject(schema *Schema) bool {
+	schema = s.schema(schema)
+	return schema != nil && (len(schema.Properties.Names) > 0 || len(schema.AllOf) > 0)
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/micahthomas/bigcommerce-go-client/cmd/internal/naming"
+)
+
+// runtimeImport is the package implementing the generated builders
+const runtimeImport = "github.com/micahthomas/bigcommerce-go-client/bigcommerce/graphql"
+
+// scalars maps built-in and BigCommerce scalars to Go types. Other custom
+// scalars are decoded as interface{}.
+var scalars = map[string]string{
+	"ID":         "string",
+	"String":     "string",
+	"Int":        "int64",
+	"Float":      "float64",
+	"Boolean":    "bool",
+	"DateTime":   "string",
+	"Long":       "int64",
+	"BigDecimal": "float64",
+}
+
+type generator struct {
+	schema *Schema
+	types  map[string]*Type
+	buf    bytes.Buffer
+}
+
+// generate returns the Go source of the types and builders of schema
+func generate(schema *Schema, pkg string) ([]byte, error) {
+	g := &generator{schema: schema, types: map[string]*Type{}}
+	var names []string
+	for _, t := range schema.Types {
+		if strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		g.types[t.Name] = t
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+
+	g.printf("// Code generated by bcgen; DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import %q\n\n", runtimeImport)
+
+	if schema.QueryType != nil {
+		g.entryPoint("NewQuery", "query", schema.QueryType.Name)
+	}
+	if schema.MutationType != nil {
+		g.entryPoint("NewMutation", "mutation", schema.MutationType.Name)
+	}
+
+	for _, name := range names {
+		t := g.types[name]
+		switch t.Kind {
+		case "ENUM":
+			g.enum(t)
+		case "INPUT_OBJECT":
+			g.input(t)
+		case "OBJECT", "INTERFACE", "UNION":
+			g.object(t)
+			g.selection(t)
+		}
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// comment writes a doc comment from a schema description
+func (g *generator) comment(name, description, fallback string) {
+	line := strings.TrimSpace(strings.SplitN(description, "\n", 2)[0])
+	if line == "" {
+		line = fallback
+	}
+	g.printf("// %s - %s\n", name, line)
+}
+
+func (g *generator) entryPoint(fn, kind, root string) {
+	sel := typeName(root) + "Selection"
+	g.printf("// %s returns a %s selecting the fields chosen by fn\n", fn, kind)
+	g.printf("func %s(fn func(%s)) *graphql.Operation {\n", fn, sel)
+	g.printf("op, root := graphql.%s()\nfn(%s{root})\nreturn op\n}\n\n", fn, sel)
+}
+
+func (g *generator) enum(t *Type) {
+	name := typeName(t.Name)
+	g.comment(name, t.Description, "the GraphQL enum "+t.Name)
+	g.printf("type %s string\n\nconst (\n", name)
+	for _, v := range t.EnumValues {
+		if v.Description != "" {
+			g.printf("// %s%s - %s\n", name, naming.Exported(v.Name), strings.TrimSpace(strings.SplitN(v.Description, "\n", 2)[0]))
+		}
+		g.printf("%s%s %s = %q\n", name, naming.Exported(v.Name), name, v.Name)
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) input(t *Type) {
+	name := typeName(t.Name)
+	g.comment(name, t.Description, "the GraphQL input "+t.Name)
+	g.printf("type %s struct {\n", name)
+	g.inputFields(t.InputFields)
+	g.printf("}\n\n")
+}
+
+// inputFields writes the fields of an input object or arguments struct.
+// Nullable fields are pointers or slices, omitted when nil.
+func (g *generator) inputFields(values []*InputValue) {
+	for _, v := range values {
+		tag := v.Name
+		if v.Type.Kind != "NON_NULL" {
+			tag += ",omitempty"
+		}
+		g.printf("%s %s `json:%q`", naming.Exported(v.Name), g.goType(v.Type, true), tag)
+		g.fieldComment(v.Description)
+	}
+}
+
+func (g *generator) fieldComment(description string) {
+	if line := strings.TrimSpace(strings.SplitN(description, "\n", 2)[0]); line != "" {
+		g.printf(" // %s", line)
+	}
+	g.printf("\n")
+}
+
+// object writes the response struct of an object, interface or union. The
+// structs of interfaces and unions merge the fields of their possible types,
+// so the fields of inline fragments decode into them too.
+func (g *generator) object(t *Type) {
+	name := typeName(t.Name)
+	g.comment(name, t.Description, "the GraphQL type "+t.Name)
+	g.printf("type %s struct {\n", name)
+	if t.Kind != "OBJECT" {
+		g.printf("Typename string `json:\"__typename,omitempty\"` // The concrete type.\n")
+	}
+	for _, f := range g.responseFields(t) {
+		g.printf("%s %s `json:\"%s,omitempty\"`", naming.Exported(f.Name), g.goType(f.Type, false), f.Name)
+		g.fieldComment(f.Description)
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) responseFields(t *Type) []*Field {
+	fields := append([]*Field(nil), t.Fields...)
+	seen := map[string]bool{}
+	for _, f := range fields {
+		seen[f.Name] = true
+	}
+	for _, p := range t.PossibleTypes {
+		possible := g.types[p.Name]
+		if possible == nil {
+			continue
+		}
+		for _, f := range possible.Fields {
+			if !seen[f.Name] {
+				seen[f.Name] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+// selection writes the builder selecting fields of an object, interface or union
+func (g *generator) selection(t *Type) {
+	name := typeName(t.Name)
+	sel := name + "Selection"
+	g.printf("// %s selects fields of %s\ntype %s struct {\ns *graphql.Selection\n}\n\n", sel, name, sel)
+
+	if t.Kind != "OBJECT" {
+		g.printf("// Typename selects the concrete type\n")
+		g.printf("func (s %s) Typename() %s {\ns.s.Field(\"__typename\")\nreturn s\n}\n\n", sel, sel)
+		for _, p := range t.PossibleTypes {
+			if g.types[p.Name] == nil {
+				continue
+			}
+			possible := typeName(p.Name) + "Selection"
+			g.printf("// On%s selects fields only present on %s\n", typeName(p.Name), typeName(p.Name))
+			g.printf("func (s %s) On%s(fn func(%s)) %s {\n", sel, typeName(p.Name), possible, sel)
+			g.printf("s.s.On(%q, func(sel *graphql.Selection) { fn(%s{sel}) })\nreturn s\n}\n\n", p.Name, possible)
+		}
+	}
+
+	for _, f := range t.Fields {
+		method := naming.Exported(f.Name)
+		params, args := "", "nil"
+		if len(f.Args) > 0 {
+			argsType := name + method + "Args"
+			g.printf("// %s holds the arguments of %s.%s\ntype %s struct {\n", argsType, t.Name, f.Name, argsType)
+			g.inputFields(f.Args)
+			g.printf("}\n\n")
+
+			params = "args " + argsType
+			var list []string
+			for _, a := range f.Args {
+				list = append(list, fmt.Sprintf("{Name: %q, Type: %q, Value: args.%s}", a.Name, a.Type.String(), naming.Exported(a.Name)))
+			}
+			args = "[]graphql.Arg{" + strings.Join(list, ", ") + "}"
+		}
+
+		g.printf("// %s selects %s\n", method, f.Name)
+		named := g.types[f.Type.named().Name]
+		if named == nil || named.Kind == "SCALAR" || named.Kind == "ENUM" {
+			g.printf("func (s %s) %s(%s) %s {\n", sel, method, params, sel)
+			if args == "nil" {
+				g.printf("s.s.Field(%q)\n", f.Name)
+			} else {
+				g.printf("s.s.Field(%q, %s...)\n", f.Name, args)
+			}
+			g.printf("return s\n}\n\n")
+			continue
+		}
+
+		sub := typeName(named.Name) + "Selection"
+		if params != "" {
+			params += ", "
+		}
+		g.printf("func (s %s) %s(%sfn func(%s)) %s {\n", sel, method, params, sub, sel)
+		g.printf("s.s.Object(%q, %s, func(sel *graphql.Selection) { fn(%s{sel}) })\nreturn s\n}\n\n", f.Name, args, sub)
+	}
+}
+
+// goType returns the Go type of a reference. Input values that may be null
+// are pointers, as are all objects and nullable scalars of responses.
+func (g *generator) goType(r *TypeRef, input bool) string {
+	nonNull := false
+	if r.Kind == "NON_NULL" {
+		nonNull, r = true, r.OfType
+	}
+	if r.Kind == "LIST" {
+		return "[]" + g.goType(r.OfType, input)
+	}
+
+	t := g.types[r.Name]
+	base, ok := scalars[r.Name]
+	switch {
+	case ok:
+	case t == nil || t.Kind == "SCALAR":
+		return "interface{}"
+	default:
+		base = typeName(r.Name)
+		if t.Kind != "ENUM" && !input {
+			return "*" + base
+		}
+	}
+	if nonNull {
+		return base
+	}
+	return "*" + base
+}
+
+// typeName returns the Go name of a schema type
+func typeName(name string) string {
+	if name == "" {
+		return name
+	}
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
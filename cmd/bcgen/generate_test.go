@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testSchema = `{"data": {"__schema": {
+	"queryType": {"name": "Query"},
+	"mutationType": {"name": "Mutation"},
+	"types": [
+		{"kind": "OBJECT", "name": "Query", "fields": [
+			{"name": "site", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "OBJECT", "name": "Site"}}}
+		]},
+		{"kind": "OBJECT", "name": "Site", "fields": [
+			{"name": "product", "args": [
+				{"name": "entityId", "type": {"kind": "SCALAR", "name": "Int"}},
+				{"name": "variantEntityIds", "type": {"kind": "LIST", "ofType": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}}}
+			], "type": {"kind": "OBJECT", "name": "Product"}},
+			{"name": "route", "args": [{"name": "path", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}}],
+				"type": {"kind": "NON_NULL", "ofType": {"kind": "OBJECT", "name": "Route"}}}
+		]},
+		{"kind": "OBJECT", "name": "Product", "description": "A product.\nWith details.", "fields": [
+			{"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+			{"name": "entityId", "description": "The product's ID.", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}},
+			{"name": "sku", "args": [], "type": {"kind": "SCALAR", "name": "String"}},
+			{"name": "availability", "args": [], "type": {"kind": "ENUM", "name": "ProductAvailability"}},
+			{"name": "createdAt", "args": [], "type": {"kind": "SCALAR", "name": "DateTime"}},
+			{"name": "metadata", "args": [], "type": {"kind": "SCALAR", "name": "JSON"}},
+			{"name": "images", "args": [{"name": "first", "type": {"kind": "SCALAR", "name": "Int"}}],
+				"type": {"kind": "LIST", "ofType": {"kind": "NON_NULL", "ofType": {"kind": "OBJECT", "name": "Image"}}}}
+		]},
+		{"kind": "OBJECT", "name": "Category", "fields": [
+			{"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+			{"name": "name", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}}
+		]},
+		{"kind": "OBJECT", "name": "Image", "fields": [
+			{"name": "urlOriginal", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}}
+		]},
+		{"kind": "OBJECT", "name": "Route", "fields": [
+			{"name": "node", "args": [], "type": {"kind": "INTERFACE", "name": "Node"}}
+		]},
+		{"kind": "INTERFACE", "name": "Node", "fields": [
+			{"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}
+		], "possibleTypes": [{"name": "Product"}, {"name": "Category"}]},
+		{"kind": "ENUM", "name": "ProductAvailability", "enumValues": [
+			{"name": "AVAILABLE", "description": "Can be bought."}, {"name": "PRE_ORDER"}
+		]},
+		{"kind": "OBJECT", "name": "Mutation", "fields": [
+			{"name": "addToCart", "args": [{"name": "input", "type": {"kind": "NON_NULL", "ofType": {"kind": "INPUT_OBJECT", "name": "AddToCartInput"}}}],
+				"type": {"kind": "SCALAR", "name": "String"}}
+		]},
+		{"kind": "INPUT_OBJECT", "name": "AddToCartInput", "inputFields": [
+			{"name": "productEntityId", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}},
+			{"name": "quantity", "type": {"kind": "SCALAR", "name": "Int"}},
+			{"name": "options", "type": {"kind": "LIST", "ofType": {"kind": "NON_NULL", "ofType": {"kind": "INPUT_OBJECT", "name": "OptionInput"}}}}
+		]},
+		{"kind": "INPUT_OBJECT", "name": "OptionInput", "inputFields": [
+			{"name": "optionEntityId", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "Int"}}}
+		]},
+		{"kind": "SCALAR", "name": "JSON"},
+		{"kind": "OBJECT", "name": "__Type", "fields": []}
+	]
+}}}`
+
+func generateTestSchema(t *testing.T) (string, *types.Package) {
+	schema, err := readSchema(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(schema, "storefront")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "storefront.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("storefront", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("Generated code does not compile: %v\n%s", err, src)
+	}
+	return string(src), pkg
+}
+
+func TestGenerate(t *testing.T) {
+	src, pkg := generateTestSchema(t)
+	// Compare without gofmt's alignment.
+	src = strings.Join(strings.FieldsFunc(src, func(r rune) bool { return r == ' ' || r == '\t' }), " ")
+
+	for _, want := range []string{
+		"// Code generated by bcgen; DO NOT EDIT.",
+		"// Product - A product.\ntype Product struct",
+		"EntityID int64 `json:\"entityId,omitempty\"` // The product's ID.",
+		"SKU *string `json:\"sku,omitempty\"`",
+		"Availability *ProductAvailability `json:\"availability,omitempty\"`",
+		"CreatedAt *string `json:\"createdAt,omitempty\"`",
+		"Metadata interface{} `json:\"metadata,omitempty\"`",
+		"Images []*Image `json:\"images,omitempty\"`",
+		"ProductAvailabilityAvailable ProductAvailability = \"AVAILABLE\"",
+		"ProductAvailabilityPreOrder ProductAvailability = \"PRE_ORDER\"",
+		"ProductEntityID int64 `json:\"productEntityId\"`",
+		"Quantity *int64 `json:\"quantity,omitempty\"`",
+		"Options []OptionInput `json:\"options,omitempty\"`",
+		"VariantEntityIDs []int64 `json:\"variantEntityIds,omitempty\"`",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Generated code lacks %q", want)
+		}
+	}
+	if strings.Contains(src, "__Type") {
+		t.Error("Generated code includes introspection types")
+	}
+
+	// Interfaces merge the fields of their possible types.
+	node := pkg.Scope().Lookup("Node").Type().Underlying().(*types.Struct)
+	var fields []string
+	for i := 0; i < node.NumFields(); i++ {
+		fields = append(fields, node.Field(i).Name())
+	}
+	if got := strings.Join(fields, ","); got != "Typename,ID,EntityID,SKU,Availability,CreatedAt,Metadata,Images,Name" {
+		t.Errorf("Node fields = %s", got)
+	}
+
+	for _, name := range []string{"NewQuery", "NewMutation", "SiteProductArgs", "MutationAddToCartArgs", "NodeSelection"} {
+		if pkg.Scope().Lookup(name) == nil {
+			t.Errorf("Generated code lacks %s", name)
+		}
+	}
+	nodeSel := types.NewMethodSet(pkg.Scope().Lookup("NodeSelection").Type())
+	for _, method := range []string{"Typename", "OnProduct", "OnCategory", "ID"} {
+		if nodeSel.Lookup(pkg, method) == nil && nodeSel.Lookup(nil, method) == nil {
+			t.Errorf("NodeSelection lacks %s", method)
+		}
+	}
+}
+
+func TestIntrospect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sf-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Query string `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if !strings.Contains(req.Query, "__schema") {
+			t.Errorf("Unexpected query %q", req.Query)
+		}
+		w.Write([]byte(testSchema))
+	}))
+	defer server.Close()
+
+	schema, err := introspect(context.Background(), server.URL, "sf-token", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.QueryType.Name != "Query" || len(schema.Types) != 13 {
+		t.Errorf("Schema = %+v", schema)
+	}
+	if _, err := introspect(context.Background(), server.URL, "wrong", false); err == nil {
+		t.Error("Expected an error for a rejected token")
+	}
+}
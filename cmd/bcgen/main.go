@@ -0,0 +1,125 @@
+// Command bcgen generates typed Go query builders and response structs from
+// BigCommerce's GraphQL schemas.
+//
+// It introspects the Storefront or Admin GraphQL API of a store, or reads a
+// saved introspection result, and writes a single Go file holding a struct for
+// every object, interface, union and input type, a string type for every
+// enum, and a selection builder for every object type:
+//
+//	op := storefront.NewQuery(func(q storefront.QuerySelection) {
+//		q.Site(func(s storefront.SiteSelection) {
+//			s.Product(storefront.SiteProductArgs{EntityID: &id}, func(p storefront.ProductSelection) {
+//				p.Name().SKU()
+//			})
+//		})
+//	})
+//
+// The operation marshals to a GraphQL request body, and its response decodes
+// into the generated Query struct with graphql.Decode.
+//
+// To keep generated code in sync with the schema, save the schema with
+// -save-schema, generate from it with a go:generate directive, and run
+// bcgen -check in CI against the live endpoint:
+//
+//	//go:generate bcgen -schema storefront.json -o storefront.go
+//
+// Usage:
+//
+//	bcgen [-api storefront|admin] [-store hash] [-token token] [-url endpoint] [-schema file]
+//	      [-save-schema file] [-pkg name] [-check] -o file.go
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func main() {
+	api := flag.String("api", "storefront", `The API to introspect, "storefront" or "admin".`)
+	store := flag.String("store", os.Getenv("BIGCOMMERCE_STORE_HASH"), "The store hash, used to build the endpoint URL.")
+	token := flag.String("token", os.Getenv("BIGCOMMERCE_TOKEN"), "A storefront token, or an API account access token for the admin API.")
+	endpoint := flag.String("url", "", "The GraphQL endpoint, overriding the one built from -store.")
+	schemaFile := flag.String("schema", "", "Read the schema from a saved introspection result instead of an endpoint.")
+	saveSchema := flag.String("save-schema", "", "Also write the introspection result to this file.")
+	pkg := flag.String("pkg", "", "The package name of the generated file. Defaults to the name of its directory.")
+	out := flag.String("o", "", "The generated file.")
+	check := flag.Bool("check", false, "Report whether the generated file is out of date instead of writing it.")
+	flag.Parse()
+
+	if *out == "" {
+		fatalf("bcgen: -o is required")
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			fatalf("bcgen: %v", err)
+		}
+		*pkg = filepath.Base(filepath.Dir(abs))
+	}
+
+	schema, err := loadSchema(*schemaFile, *api, *store, *token, *endpoint)
+	if err != nil {
+		fatalf("bcgen: %v", err)
+	}
+	if *saveSchema != "" {
+		data, _ := json.MarshalIndent(map[string]interface{}{"__schema": schema}, "", "  ")
+		if err := os.WriteFile(*saveSchema, append(data, '\n'), 0644); err != nil {
+			fatalf("bcgen: %v", err)
+		}
+	}
+
+	src, err := generate(schema, *pkg)
+	if err != nil {
+		fatalf("bcgen: %v", err)
+	}
+	if *check {
+		current, err := os.ReadFile(*out)
+		if err != nil || !bytes.Equal(current, src) {
+			fatalf("bcgen: %s is out of date with the schema", *out)
+		}
+		return
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fatalf("bcgen: %v", err)
+	}
+}
+
+// loadSchema reads a saved schema, or introspects the endpoint of a store's API
+func loadSchema(file, api, store, token, endpoint string) (*Schema, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return readSchema(f)
+	}
+
+	admin := api == "admin"
+	if !admin && api != "storefront" {
+		return nil, fmt.Errorf("unknown API %q", api)
+	}
+	if endpoint == "" {
+		if store == "" {
+			return nil, fmt.Errorf("one of -schema, -url or -store is required")
+		}
+		endpoint = "https://store-" + store + ".mybigcommerce.com/graphql"
+		if admin {
+			endpoint = "https://api.bigcommerce.com/stores/" + store + "/graphql"
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	return introspect(ctx, endpoint, token, admin)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// introspectionQuery reads everything bcgen needs from a schema
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    types {
+      kind name description
+      fields(includeDeprecated: true) { name description args { name type { ...TypeRef } } type { ...TypeRef } }
+      inputFields { name description type { ...TypeRef } }
+      enumValues(includeDeprecated: true) { name description }
+      possibleTypes { name }
+    }
+  }
+}
+fragment TypeRef on __Type {
+  kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } }
+}`
+
+// Schema is the result of an introspection query
+type Schema struct {
+	QueryType    *TypeName `json:"queryType"`
+	MutationType *TypeName `json:"mutationType"`
+	Types        []*Type   `json:"types"`
+}
+
+// TypeName names a type
+type TypeName struct {
+	Name string `json:"name"`
+}
+
+// Type is a named type of a schema
+type Type struct {
+	Kind          string        `json:"kind"` // SCALAR, OBJECT, INTERFACE, UNION, ENUM or INPUT_OBJECT.
+	Name          string        `json:"name"`
+	Description   string        `json:"description"`
+	Fields        []*Field      `json:"fields"`
+	InputFields   []*InputValue `json:"inputFields"`
+	EnumValues    []*EnumValue  `json:"enumValues"`
+	PossibleTypes []*TypeName   `json:"possibleTypes"`
+}
+
+// Field is a field of an object or interface type
+type Field struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Args        []*InputValue `json:"args"`
+	Type        *TypeRef      `json:"type"`
+}
+
+// InputValue is an argument or a field of an input object
+type InputValue struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        *TypeRef `json:"type"`
+}
+
+// EnumValue is a value of an enum type
+type EnumValue struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// TypeRef refers to a named type, possibly wrapped in lists and non-null markers
+type TypeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// String returns the reference in GraphQL syntax, e.g. "[ID!]!"
+func (r *TypeRef) String() string {
+	switch r.Kind {
+	case "NON_NULL":
+		return r.OfType.String() + "!"
+	case "LIST":
+		return "[" + r.OfType.String() + "]"
+	}
+	return r.Name
+}
+
+// named returns the named type at the core of the reference
+func (r *TypeRef) named() *TypeRef {
+	for r.OfType != nil {
+		r = r.OfType
+	}
+	return r
+}
+
+// readSchema decodes an introspection result, either a full response with a
+// "data" member or the bare "__schema" object
+func readSchema(r io.Reader) (*Schema, error) {
+	var doc struct {
+		Data *struct {
+			Schema *Schema `json:"__schema"`
+		} `json:"data"`
+		Schema *Schema `json:"__schema"`
+	}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	switch {
+	case doc.Data != nil && doc.Data.Schema != nil:
+		return doc.Data.Schema, nil
+	case doc.Schema != nil:
+		return doc.Schema, nil
+	}
+	return nil, fmt.Errorf("no __schema in introspection result")
+}
+
+// introspect reads the schema of a GraphQL endpoint. Admin API tokens are
+// sent as X-Auth-Token, storefront tokens as a bearer token.
+func introspect(ctx context.Context, endpoint, token string, admin bool) (*Schema, error) {
+	body, _ := json.Marshal(map[string]string{"query": introspectionQuery})
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if admin {
+		req.Header.Set("X-Auth-Token", token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("introspecting %s: %s: %s", endpoint, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return readSchema(resp.Body)
+}
@@ -0,0 +1,97 @@
+// Package naming converts schema names to Go names for the code generators
+package naming
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// initialisms are kept upper case in Go names
+var initialisms = map[string]bool{
+	"API": true, "GTIN": true, "HTML": true, "HTTP": true, "ID": true, "ISBN": true, "JSON": true, "MPN": true,
+	"SEO": true, "SKU": true, "UPC": true, "URI": true, "URL": true, "UUID": true,
+}
+
+// Exported returns the exported Go name of a field, argument or enum value,
+// e.g. "EntityID" for entityId, "AToZ" for A_TO_Z and "CustomerIDs" for
+// customer_ids
+func Exported(name string) string {
+	var b strings.Builder
+	for _, word := range words(name) {
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		if plural := strings.TrimSuffix(upper, "S"); plural != upper && initialisms[plural] {
+			b.WriteString(plural + "s") // As in "IDs".
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + strings.ToLower(word[1:]))
+	}
+	if b.Len() == 0 || !unicode.IsLetter([]rune(b.String())[0]) {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+// Unexported returns the unexported Go name of a parameter or variable, e.g.
+// "wishlistID" for wishlist_id. Names that are Go keywords get a trailing
+// underscore.
+func Unexported(name string) string {
+	ws := words(name)
+	if len(ws) == 0 {
+		return "x"
+	}
+	first := strings.ToLower(ws[0])
+	if first == "" || !unicode.IsLetter([]rune(first)[0]) {
+		first = "x" + first
+	}
+	s := first
+	if len(ws) > 1 {
+		s += Exported(strings.Join(ws[1:], "_"))
+	}
+	if token.IsKeyword(s) {
+		s += "_"
+	}
+	return s
+}
+
+// Singular returns the singular of an English plural noun, e.g. "Category"
+// for Categories
+func Singular(noun string) string {
+	switch {
+	case strings.HasSuffix(noun, "ies"):
+		return strings.TrimSuffix(noun, "ies") + "y"
+	case strings.HasSuffix(noun, "sses"), strings.HasSuffix(noun, "xes"):
+		return noun[:len(noun)-2]
+	case strings.HasSuffix(noun, "ss"), strings.HasSuffix(noun, "us"):
+		return noun
+	case strings.HasSuffix(noun, "s"):
+		return strings.TrimSuffix(noun, "s")
+	}
+	return noun
+}
+
+// words splits a camelCase, PascalCase, snake_case or kebab-case name into words
+func words(name string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == ' ' || r == '.' }) {
+		r := []rune(part)
+		start := 0
+		for i := 1; i < len(r); i++ {
+			lowerToUpper := unicode.IsLower(r[i-1]) && unicode.IsUpper(r[i])
+			// The last capital of a run starts a new word, as in "HTMLBody".
+			acronymEnd := i+1 < len(r) && unicode.IsUpper(r[i-1]) && unicode.IsUpper(r[i]) && unicode.IsLower(r[i+1])
+			if lowerToUpper || acronymEnd {
+				words = append(words, string(r[start:i]))
+				start = i
+			}
+		}
+		if start < len(r) {
+			words = append(words, string(r[start:]))
+		}
+	}
+	return words
+}
@@ -0,0 +1,56 @@
+package naming
+
+import "testing"
+
+func TestExported(t *testing.T) {
+	for name, want := range map[string]string{
+		"entityId":       "EntityID",
+		"urlOriginal":    "URLOriginal",
+		"HTMLBody":       "HTMLBody",
+		"A_TO_Z":         "AToZ",
+		"PRE_ORDER":      "PreOrder",
+		"seoDetails":     "SEODetails",
+		"sku":            "SKU",
+		"entityIds":      "EntityIDs",
+		"customer_ids":   "CustomerIDs",
+		"_private":       "Private",
+		"2xImage":        "X2xImage",
+		"productGtinUpc": "ProductGTINUPC",
+		"Price Lists":    "PriceLists",
+		"date-created":   "DateCreated",
+	} {
+		if got := Exported(name); got != want {
+			t.Errorf("Exported(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestUnexported(t *testing.T) {
+	for name, want := range map[string]string{
+		"wishlist_id": "wishlistID",
+		"ItemID":      "itemID",
+		"type":        "type_",
+		"id":          "id",
+		"2fa":         "x2fa",
+	} {
+		if got := Unexported(name); got != want {
+			t.Errorf("Unexported(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	for noun, want := range map[string]string{
+		"Categories": "Category",
+		"Items":      "Item",
+		"Addresses":  "Address",
+		"Boxes":      "Box",
+		"Status":     "Status",
+		"Access":     "Access",
+		"Price":      "Price",
+	} {
+		if got := Singular(noun); got != want {
+			t.Errorf("Singular(%q) = %q, want %q", noun, got, want)
+		}
+	}
+}